@@ -496,7 +496,7 @@ func buildChainRecords(prefix string, index int, baseDate time.Time) ([]seedReco
 				"box":              draftBox,
 				"supplierName":     supplierName,
 				"signDate":         formatDate(signDate),
-				"salesNo":          fmt.Sprintf("XSY-%03d", index),
+				"salesNo":          exportCode,
 				"deliveryDate":     formatDate(deliveryDate),
 				"deliveryAddress":  "杭州临平仓",
 				"follower":         fmt.Sprintf("跟单员%02d", (index%9)+1),
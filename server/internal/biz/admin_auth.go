@@ -18,13 +18,26 @@ import (
 type AdminAuthRepo interface {
 	GetAdminByUsername(ctx context.Context, username string) (*AdminUser, error)
 	UpdateAdminLastLogin(ctx context.Context, id int, t time.Time) error
+	// UpdateAdminPassword 更新密码哈希与强制改密标记，同时记录修改时间并解除锁定。
+	UpdateAdminPassword(ctx context.Context, id int, passwordHash string, mustChange bool) error
+	SetAdminLockedUntil(ctx context.Context, id int, until *time.Time) error
+	RecordLoginAttempt(ctx context.Context, attempt *AdminLoginAttempt) error
+	// CountFailedLoginAttempts since 之后、且晚于最近一次成功登录的失败次数（密码错误与两步验证码错误）。
+	CountFailedLoginAttempts(ctx context.Context, adminID int, since time.Time) (int, error)
+}
+
+// AdminSecondFactor 登录第二步校验（两步验证），未启用时直接通过。
+type AdminSecondFactor interface {
+	VerifyLogin(ctx context.Context, adminID int, code string) error
 }
 
 type AdminUser struct {
-	ID           int
-	Username     string
-	PasswordHash string
-	Disabled     bool
+	ID                 int
+	Username           string
+	PasswordHash       string
+	Disabled           bool
+	MustChangePassword bool
+	LockedUntil        *time.Time
 }
 
 type AdminAuthUsecase struct {
@@ -34,6 +47,9 @@ type AdminAuthUsecase struct {
 	tracer trace.Tracer
 	repo   AdminAuthRepo
 	genTok AdminTokenGenerator
+	policy AdminSecurityPolicy
+	now    func() time.Time
+	second AdminSecondFactor
 }
 
 func NewAdminAuthUsecase(repo AdminAuthRepo, genTok AdminTokenGenerator, logger log.Logger, tp *tracesdk.TracerProvider) *AdminAuthUsecase {
@@ -49,6 +65,8 @@ func NewAdminAuthUsecase(repo AdminAuthRepo, genTok AdminTokenGenerator, logger
 	return &AdminAuthUsecase{
 		repo:   repo,
 		genTok: genTok,
+		policy: DefaultAdminSecurityPolicy(),
+		now:    time.Now,
 		log:    helper,
 		logger: logger,
 		tp:     tp,
@@ -56,6 +74,16 @@ func NewAdminAuthUsecase(repo AdminAuthRepo, genTok AdminTokenGenerator, logger
 	}
 }
 
+// SetSecurityPolicy 注入登录失败锁定策略。
+func (uc *AdminAuthUsecase) SetSecurityPolicy(p AdminSecurityPolicy) {
+	uc.policy = p
+}
+
+// SetSecondFactor 注入两步验证校验；未注入时只校验密码。
+func (uc *AdminAuthUsecase) SetSecondFactor(v AdminSecondFactor) {
+	uc.second = v
+}
+
 func (uc *AdminAuthUsecase) Tracer(opts ...trace.TracerOption) trace.Tracer {
 	if uc.tracer != nil {
 		return uc.tracer
@@ -63,7 +91,7 @@ func (uc *AdminAuthUsecase) Tracer(opts ...trace.TracerOption) trace.Tracer {
 	return otel.Tracer("biz.admin_auth", opts...)
 }
 
-func (uc *AdminAuthUsecase) Login(ctx context.Context, username, password string) (token string, expireAt time.Time, u *AdminUser, err error) {
+func (uc *AdminAuthUsecase) Login(ctx context.Context, username, password, otpCode string) (token string, expireAt time.Time, u *AdminUser, err error) {
 	ctx, span := uc.Tracer().Start(ctx, "admin_auth.login",
 		trace.WithAttributes(
 			attribute.String("admin_auth.username", username),
@@ -73,57 +101,141 @@ func (uc *AdminAuthUsecase) Login(ctx context.Context, username, password string
 
 	l := uc.log.WithContext(ctx)
 
-	if username == "" || password == "" {
-		err = errors.New("missing username or password")
+	admin, err := uc.Authenticate(ctx, username, password, otpCode)
+	if err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, "invalid argument")
-		l.Warnf("Login invalid args username=%q", username)
+		span.SetStatus(codes.Error, err.Error())
 		return "", time.Time{}, nil, err
 	}
 
-	admin, e := uc.repo.GetAdminByUsername(ctx, username)
-	if e != nil || admin == nil {
-		err = ErrUserNotFound
-		span.RecordError(e)
-		span.SetStatus(codes.Error, err.Error())
-		l.Infof("Login admin not found username=%s err=%v", username, e)
+	span.SetAttributes(attribute.Int("admin_auth.admin_id", admin.ID))
+
+	token, expireAt, e := uc.genTok(admin.ID, admin.Username, int8(RoleAdmin))
+	if e != nil {
+		err = e
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "generate token failed")
+		l.Errorf("Login admin generate token failed admin_id=%d username=%s err=%v", admin.ID, admin.Username, err)
 		return "", time.Time{}, nil, err
 	}
 
-	span.SetAttributes(attribute.Int("admin_auth.admin_id", admin.ID))
+	span.SetAttributes(attribute.Int64("admin_auth.token_expires_at", expireAt.Unix()))
+	span.SetStatus(codes.Ok, "OK")
+
+	return token, expireAt, admin, nil
+}
+
+// Authenticate 校验账号密码及两步验证码并记录登录尝试（含 IP/User-Agent），不签发 token（会话模式由 AdminSessionUsecase 签发）。
+// 锁定期内直接拒绝；窗口内失败次数达到上限时锁定账号。otpCode 为验证码或恢复码，未启用两步验证时忽略。
+func (uc *AdminAuthUsecase) Authenticate(ctx context.Context, username, password, otpCode string) (*AdminUser, error) {
+	l := uc.log.WithContext(ctx)
+
+	if username == "" || password == "" {
+		l.Warnf("Login invalid args username=%q", username)
+		return nil, errors.New("missing username or password")
+	}
+
+	now := uc.now()
+	admin, err := uc.repo.GetAdminByUsername(ctx, username)
+	if err != nil || admin == nil {
+		l.Infof("Login admin not found username=%s err=%v", username, err)
+		uc.recordAttempt(ctx, username, nil, AdminLoginNotFound, now)
+		return nil, ErrUserNotFound
+	}
 
 	if admin.Disabled {
-		err = ErrUserDisabled
-		span.SetStatus(codes.Error, err.Error())
 		l.Infof("Login admin disabled admin_id=%d username=%s", admin.ID, username)
-		return "", time.Time{}, nil, err
+		uc.recordAttempt(ctx, username, admin, AdminLoginDisabled, now)
+		return nil, ErrUserDisabled
+	}
+
+	if admin.LockedUntil != nil && now.Before(*admin.LockedUntil) {
+		l.Infof("Login admin locked admin_id=%d until=%s", admin.ID, admin.LockedUntil.Format(time.RFC3339))
+		uc.recordAttempt(ctx, username, admin, AdminLoginLocked, now)
+		return nil, ErrAdminLocked
 	}
 
 	if bcrypt.CompareHashAndPassword([]byte(admin.PasswordHash), []byte(password)) != nil {
-		err = ErrInvalidPassword
-		span.SetStatus(codes.Error, err.Error())
 		l.Infof("Login admin invalid password admin_id=%d username=%s", admin.ID, username)
-		return "", time.Time{}, nil, err
+		uc.recordAttempt(ctx, username, admin, AdminLoginInvalidPassword, now)
+		if uc.lockIfExceeded(ctx, admin, now) {
+			return nil, ErrAdminLocked
+		}
+		return nil, ErrInvalidPassword
 	}
 
-	token, expireAt, e = uc.genTok(admin.ID, admin.Username, int8(RoleAdmin))
-	if e != nil {
-		err = e
-		span.RecordError(err)
-		span.SetStatus(codes.Error, "generate token failed")
-		l.Errorf("Login admin generate token failed admin_id=%d username=%s err=%v", admin.ID, admin.Username, err)
-		return "", time.Time{}, nil, err
+	if uc.second != nil {
+		if err := uc.second.VerifyLogin(ctx, admin.ID, otpCode); err != nil {
+			switch {
+			case errors.Is(err, ErrTOTPRequired):
+				uc.recordAttempt(ctx, username, admin, AdminLoginTOTPRequired, now)
+			case errors.Is(err, ErrInvalidTOTP):
+				l.Infof("Login admin invalid totp admin_id=%d username=%s", admin.ID, username)
+				uc.recordAttempt(ctx, username, admin, AdminLoginInvalidTOTP, now)
+				if uc.lockIfExceeded(ctx, admin, now) {
+					return nil, ErrAdminLocked
+				}
+			default:
+				l.Errorf("Login admin verify totp failed admin_id=%d err=%v", admin.ID, err)
+			}
+			return nil, err
+		}
 	}
 
-	span.SetAttributes(attribute.Int64("admin_auth.token_expires_at", expireAt.Unix()))
-
-	if e := uc.repo.UpdateAdminLastLogin(ctx, admin.ID, time.Now()); e != nil {
-		span.RecordError(e)
-		l.Warnf("Login admin update last_login_at failed admin_id=%d err=%v", admin.ID, e)
+	uc.recordAttempt(ctx, username, admin, AdminLoginOK, now)
+	if admin.LockedUntil != nil {
+		if err := uc.repo.SetAdminLockedUntil(ctx, admin.ID, nil); err != nil {
+			l.Warnf("Login admin clear locked_until failed admin_id=%d err=%v", admin.ID, err)
+		}
+	}
+	if err := uc.repo.UpdateAdminLastLogin(ctx, admin.ID, now); err != nil {
+		l.Warnf("Login admin update last_login_at failed admin_id=%d err=%v", admin.ID, err)
 	}
 
-	span.SetStatus(codes.Ok, "OK")
 	l.Infof("Login admin success admin_id=%d username=%s", admin.ID, admin.Username)
+	return admin, nil
+}
 
-	return token, expireAt, admin, nil
+func (uc *AdminAuthUsecase) recordAttempt(ctx context.Context, username string, admin *AdminUser, reason string, now time.Time) {
+	client := ClientInfoFromContext(ctx)
+	attempt := &AdminLoginAttempt{
+		Username:  username,
+		Success:   reason == AdminLoginOK,
+		Reason:    reason,
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+		CreatedAt: now,
+	}
+	if admin != nil {
+		attempt.AdminID = &admin.ID
+	}
+	if err := uc.repo.RecordLoginAttempt(ctx, attempt); err != nil {
+		uc.log.WithContext(ctx).Warnf("record admin login attempt failed username=%s err=%v", username, err)
+	}
+}
+
+// lockIfExceeded 统计窗口内（不早于上次锁定结束）的失败次数，达到上限时锁定。
+func (uc *AdminAuthUsecase) lockIfExceeded(ctx context.Context, admin *AdminUser, now time.Time) bool {
+	if uc.policy.MaxFailedAttempts <= 0 {
+		return false
+	}
+	since := now.Add(-uc.policy.FailedWindow)
+	if admin.LockedUntil != nil && admin.LockedUntil.After(since) {
+		since = *admin.LockedUntil
+	}
+	failed, err := uc.repo.CountFailedLoginAttempts(ctx, admin.ID, since)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("count admin failed logins failed admin_id=%d err=%v", admin.ID, err)
+		return false
+	}
+	if failed < uc.policy.MaxFailedAttempts {
+		return false
+	}
+	until := now.Add(uc.policy.Lockout)
+	if err := uc.repo.SetAdminLockedUntil(ctx, admin.ID, &until); err != nil {
+		uc.log.WithContext(ctx).Errorf("lock admin failed admin_id=%d err=%v", admin.ID, err)
+		return false
+	}
+	uc.log.WithContext(ctx).Warnf("admin locked admin_id=%d failed=%d until=%s", admin.ID, failed, until.Format(time.RFC3339))
+	return true
 }
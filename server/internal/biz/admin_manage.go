@@ -31,13 +31,20 @@ var (
 )
 
 type AdminAccount struct {
-	ID                  int
-	Username            string
-	Level               AdminLevel
-	MenuPermissions     []string
+	ID              int
+	Username        string
+	Level           AdminLevel
+	MenuPermissions []string
+	// RoleBased 为 true 时权限只来自 Roles，不再读取 MenuPermissions。
+	RoleBased           bool
+	Roles               []*AdminRole
 	ParentID            *int
 	Disabled            bool
 	LastLoginAt         *time.Time
+	MustChangePassword  bool
+	PasswordChangedAt   *time.Time
+	LockedUntil         *time.Time
+	TOTPEnabled         bool
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 	UserCount           int
@@ -74,10 +81,17 @@ type AdminManageRepo interface {
 	TransferChildAdmins(ctx context.Context, fromAdminID int, toAdminID *int) (int, error)
 }
 
+// AdminSessionRevoker 注销管理员的全部会话，返回注销数量。
+type AdminSessionRevoker interface {
+	RevokeAdmin(ctx context.Context, adminID int, reason string) (int, error)
+}
+
 type AdminManageUsecase struct {
-	repo   AdminManageRepo
-	log    *log.Helper
-	tracer trace.Tracer
+	repo     AdminManageRepo
+	sessions AdminSessionRevoker
+	policy   AdminSecurityPolicy
+	log      *log.Helper
+	tracer   trace.Tracer
 }
 
 func NewAdminManageUsecase(repo AdminManageRepo, logger log.Logger, tp *tracesdk.TracerProvider) *AdminManageUsecase {
@@ -90,6 +104,7 @@ func NewAdminManageUsecase(repo AdminManageRepo, logger log.Logger, tp *tracesdk
 	}
 	return &AdminManageUsecase{
 		repo:   repo,
+		policy: DefaultAdminSecurityPolicy(),
 		log:    helper,
 		tracer: tr,
 	}
@@ -102,6 +117,33 @@ func (uc *AdminManageUsecase) Tracer() trace.Tracer {
 	return otel.Tracer("biz.admin_manage")
 }
 
+// SetSessionRevoker 注入会话注销；禁用或调整层级、权限后该管理员需要重新登录。
+func (uc *AdminManageUsecase) SetSessionRevoker(r AdminSessionRevoker) {
+	uc.sessions = r
+}
+
+// SetSecurityPolicy 注入密码复杂度规则，新建管理员与改密、重置密码共用。
+func (uc *AdminManageUsecase) SetSecurityPolicy(p AdminSecurityPolicy) {
+	uc.policy = p
+}
+
+func (uc *AdminManageUsecase) SecurityPolicy() AdminSecurityPolicy {
+	return uc.policy
+}
+
+// revokeSessions 变更已生效，注销失败只记录日志（每次请求仍会重新校验账号状态与权限）。
+func (uc *AdminManageUsecase) revokeSessions(ctx context.Context, adminID int, reason string) {
+	if uc.sessions == nil {
+		return
+	}
+	n, err := uc.sessions.RevokeAdmin(ctx, adminID, reason)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("revoke admin sessions failed admin_id=%d reason=%s err=%v", adminID, reason, err)
+		return
+	}
+	uc.log.WithContext(ctx).Infof("revoke admin sessions admin_id=%d reason=%s count=%d", adminID, reason, n)
+}
+
 func (uc *AdminManageUsecase) requireAdmin(ctx context.Context) (*AuthClaims, error) {
 	c, ok := GetClaimsFromContext(ctx)
 	if !ok || c == nil {
@@ -258,6 +300,9 @@ func (uc *AdminManageUsecase) Create(
 	if username == "" || password == "" {
 		return nil, ErrBadParam
 	}
+	if err := uc.policy.ValidatePassword(username, password); err != nil {
+		return nil, err
+	}
 
 	if level != AdminLevelPrimary && level != AdminLevelSecondary {
 		return nil, ErrAdminInvalidLevel
@@ -385,6 +430,7 @@ func (uc *AdminManageUsecase) UpdateHierarchy(
 	if err := uc.repo.UpdateAdminHierarchy(ctx, adminID, level, parentID); err != nil {
 		return nil, err
 	}
+	uc.revokeSessions(ctx, adminID, AdminSessionRevokePermissionChanged)
 	updated, err := uc.repo.GetAdminByID(ctx, adminID)
 	if err != nil {
 		return nil, err
@@ -478,6 +524,7 @@ func (uc *AdminManageUsecase) Revoke(
 	if err := uc.repo.SetAdminDisabled(ctx, adminID, true); err != nil {
 		return nil, err
 	}
+	uc.revokeSessions(ctx, adminID, AdminSessionRevokeDisabled)
 
 	return &AdminRevokeResult{
 		TransferredUsers:       usersMoved,
@@ -514,6 +561,7 @@ func (uc *AdminManageUsecase) SetMenuPermissions(
 	if err := uc.repo.UpdateAdminMenuPermissions(ctx, adminID, normalizedMenus); err != nil {
 		return nil, err
 	}
+	uc.revokeSessions(ctx, adminID, AdminSessionRevokePermissionChanged)
 
 	updated, err := uc.repo.GetAdminByID(ctx, adminID)
 	if err != nil {
@@ -527,5 +575,5 @@ func (uc *AdminManageUsecase) fillEffectiveMenuPermissions(admin *AdminAccount)
 	if admin == nil {
 		return
 	}
-	admin.MenuPermissions = EffectiveAdminMenuPermissions(admin.Level, admin.MenuPermissions)
+	admin.MenuPermissions = AdminMenuPermissionsFor(admin)
 }
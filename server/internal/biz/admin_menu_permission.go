@@ -20,6 +20,9 @@ var adminMenuPermissionOptions = []AdminMenuPermissionOption{
 	{Key: "/warehouse/outbound", Label: "出库"},
 	{Key: "/finance/settlements", Label: "结汇"},
 	{Key: "/finance/bank-receipts", Label: "水单认领"},
+	{Key: "/finance/payables", Label: "应付/物流费用"},
+	{Key: "/finance/rebates", Label: "出口退税"},
+	{Key: "/reports/profit", Label: "订单毛利"},
 	{Key: "/docs/print-center", Label: "打印模板中心"},
 	{Key: "/system/permissions", Label: "权限管理"},
 }
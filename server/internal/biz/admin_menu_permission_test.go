@@ -38,3 +38,84 @@ func TestEffectiveAdminMenuPermissions(t *testing.T) {
 	}
 }
 
+func TestAuthorizeERPModule(t *testing.T) {
+	admin := &AdminAccount{Level: AdminLevelSecondary, MenuPermissions: []string{"/sales/export"}}
+	if err := AuthorizeERPModule(admin, ERPModuleExportSales, ERPActionView); err != nil {
+		t.Fatalf("export sales should be allowed, got %v", err)
+	}
+	if err := AuthorizeERPModule(admin, ERPModuleSettlements, ERPActionView); err != ErrNoPermission {
+		t.Fatalf("settlements should be denied, got %v", err)
+	}
+	if err := AuthorizeERPModule(admin, "unknown", ERPActionView); err != ErrERPInvalidModule {
+		t.Fatalf("unknown module should be invalid, got %v", err)
+	}
+
+	super := &AdminAccount{Level: AdminLevelSuper}
+	if err := AuthorizeERPModule(super, ERPModuleSupplierPayments, ERPActionDelete); err != nil {
+		t.Fatalf("super admin should be allowed, got %v", err)
+	}
+
+	if got := ERPRecordAction("update", map[string]any{"box": "已批箱"}); got != ERPActionApprove {
+		t.Fatalf("saving into approved box should be approve, got %s", got)
+	}
+	if got := ERPRecordAction("create", map[string]any{"box": "草稿箱"}); got != ERPActionCreate {
+		t.Fatalf("draft create should be create, got %s", got)
+	}
+}
+
+func TestEffectiveAdminPermissionsRoleBased(t *testing.T) {
+	var warehouse *AdminRole
+	for _, role := range BuiltinAdminRoles() {
+		if role.Key == "warehouse" {
+			warehouse = role
+		}
+	}
+	admin := &AdminAccount{Level: AdminLevelSecondary, RoleBased: true, Roles: []*AdminRole{warehouse},
+		MenuPermissions: []string{"/finance/settlements"}}
+
+	if err := AuthorizeERPModule(admin, ERPModuleInbound, ERPActionCreate); err != nil {
+		t.Fatalf("warehouse role should create inbound, got %v", err)
+	}
+	if err := AuthorizeERPModule(admin, ERPModuleInbound, ERPActionDelete); err != ErrNoPermission {
+		t.Fatalf("warehouse role should not delete inbound, got %v", err)
+	}
+	if err := AuthorizeERPModule(admin, ERPModuleSettlements, ERPActionView); err != ErrNoPermission {
+		t.Fatalf("role based admin should ignore menu permissions, got %v", err)
+	}
+
+	menus := map[string]bool{}
+	for _, key := range AdminMenuPermissionsFor(admin) {
+		menus[key] = true
+	}
+	if !menus["/dashboard"] || !menus["/warehouse/inbound"] || !menus["/docs/print-center"] ||
+		menus["/finance/settlements"] || menus["/reports/profit"] {
+		t.Fatalf("unexpected role based menus: %v", menus)
+	}
+
+	if err := AuthorizeERPModule(&AdminAccount{Level: AdminLevelSecondary, RoleBased: true}, ERPModuleProducts, ERPActionView); err != ErrNoPermission {
+		t.Fatalf("admin without roles should be denied, got %v", err)
+	}
+}
+
+func TestAdminRoleFromMenuPermissions(t *testing.T) {
+	menus := []string{"/sales/export", "/finance/payables"}
+	legacy := &AdminAccount{Level: AdminLevelPrimary, MenuPermissions: menus}
+	role := AdminRoleFromMenuPermissions(menus)
+	converted := &AdminAccount{Level: AdminLevelPrimary, RoleBased: true, Roles: []*AdminRole{role}}
+
+	for moduleKey := range erpModuleMenuKeys {
+		for _, action := range ERPActions() {
+			want := AuthorizeERPModule(legacy, moduleKey, action) == nil
+			if got := AuthorizeERPModule(converted, moduleKey, action) == nil; got != want {
+				t.Fatalf("%s/%s: converted role=%v legacy=%v", moduleKey, action, got, want)
+			}
+		}
+	}
+	if again := AdminRoleFromMenuPermissions([]string{"/finance/payables", "/sales/export"}); again.Key != role.Key {
+		t.Fatalf("same menus should map to the same role key: %s vs %s", again.Key, role.Key)
+	}
+
+	if _, err := NormalizeAdminRoleGrants(map[string][]string{ERPModuleProducts: {"fly"}}); err != ErrBadParam {
+		t.Fatalf("unknown action should be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrPasswordTooWeak   = errors.New("password does not meet complexity rules")
+	ErrPasswordUnchanged = errors.New("new password equals current password")
+	ErrAdminLocked       = errors.New("admin account locked")
+)
+
+// 登录尝试结果（admin_login_attempts.reason）。
+const (
+	AdminLoginOK              = "ok"
+	AdminLoginNotFound        = "not_found"
+	AdminLoginDisabled        = "disabled"
+	AdminLoginLocked          = "locked"
+	AdminLoginInvalidPassword = "invalid_password"
+	// AdminLoginTOTPRequired 密码正确但未提交两步验证码，不计入失败次数。
+	AdminLoginTOTPRequired = "totp_required"
+	AdminLoginInvalidTOTP  = "invalid_totp"
+)
+
+// AdminSecurityPolicy 管理员密码复杂度与登录失败锁定策略，来自配置 data.admin_security。
+type AdminSecurityPolicy struct {
+	PasswordMinLength  int
+	PasswordMinClasses int
+	// MaxFailedAttempts 在 FailedWindow 内失败达到该次数即锁定 Lockout；<=0 不锁定。
+	MaxFailedAttempts int
+	FailedWindow      time.Duration
+	Lockout           time.Duration
+	// TOTPRequiredLevels 这些等级的管理员必须启用两步验证；其余等级可自愿启用。
+	TOTPRequiredLevels []AdminLevel
+	TOTPIssuer         string
+}
+
+func DefaultAdminSecurityPolicy() AdminSecurityPolicy {
+	return AdminSecurityPolicy{
+		PasswordMinLength:  8,
+		PasswordMinClasses: 3,
+		MaxFailedAttempts:  5,
+		FailedWindow:       15 * time.Minute,
+		Lockout:            15 * time.Minute,
+		TOTPIssuer:         "ERP",
+	}
+}
+
+// TOTPRequired 该等级是否强制两步验证。
+func (p AdminSecurityPolicy) TOTPRequired(level AdminLevel) bool {
+	for _, l := range p.TOTPRequiredLevels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe 复杂度规则说明，随 ErrPasswordTooWeak 返回给前端。
+func (p AdminSecurityPolicy) Describe() string {
+	parts := []string{fmt.Sprintf("密码至少 %d 位", p.PasswordMinLength)}
+	if p.PasswordMinClasses > 1 {
+		parts = append(parts, fmt.Sprintf("包含大写字母、小写字母、数字、符号中的至少 %d 类", p.PasswordMinClasses))
+	}
+	parts = append(parts, "不能包含账号名")
+	return strings.Join(parts, "，")
+}
+
+// ValidatePassword 校验长度、字符类别数，且不包含账号名（忽略大小写）。
+func (p AdminSecurityPolicy) ValidatePassword(username, password string) error {
+	if utf8.RuneCountInString(password) < p.PasswordMinLength {
+		return ErrPasswordTooWeak
+	}
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+	classes := 0
+	for _, ok := range []bool{upper, lower, digit, symbol} {
+		if ok {
+			classes++
+		}
+	}
+	if classes < p.PasswordMinClasses {
+		return ErrPasswordTooWeak
+	}
+	if name := strings.ToLower(strings.TrimSpace(username)); name != "" && strings.Contains(strings.ToLower(password), name) {
+		return ErrPasswordTooWeak
+	}
+	return nil
+}
+
+// AdminLoginAttempt 一次登录尝试，IP/User-Agent 取自请求。
+type AdminLoginAttempt struct {
+	Username  string
+	AdminID   *int
+	Success   bool
+	Reason    string
+	IP        string
+	UserAgent string
+	CreatedAt time.Time
+}
+
+// AdminPasswordUsecase 修改密码（本人）与重置密码（超级管理员）。
+type AdminPasswordUsecase struct {
+	repo   AdminAuthRepo
+	admins *AdminManageUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAdminPasswordUsecase(repo AdminAuthRepo, admins *AdminManageUsecase, logger log.Logger, tp *tracesdk.TracerProvider) *AdminPasswordUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.admin_password"))
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.admin_password")
+	} else {
+		tr = otel.Tracer("biz.admin_password")
+	}
+	return &AdminPasswordUsecase{
+		repo:   repo,
+		admins: admins,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+// ChangePassword 校验原密码后修改本人密码，清除强制改密标记并注销本人全部会话。
+func (uc *AdminPasswordUsecase) ChangePassword(ctx context.Context, oldPassword, newPassword string) (*AdminAccount, error) {
+	_, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if oldPassword == "" || newPassword == "" {
+		return nil, ErrBadParam
+	}
+	current, err := uc.repo.GetAdminByUsername(ctx, operator.Username)
+	if err != nil || current == nil {
+		return nil, ErrAdminNotFound
+	}
+	if bcrypt.CompareHashAndPassword([]byte(current.PasswordHash), []byte(oldPassword)) != nil {
+		return nil, ErrInvalidPassword
+	}
+	if oldPassword == newPassword {
+		return nil, ErrPasswordUnchanged
+	}
+	if err := uc.admins.policy.ValidatePassword(operator.Username, newPassword); err != nil {
+		return nil, err
+	}
+	if err := uc.setPassword(ctx, operator.ID, newPassword, false); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("admin change password admin_id=%d", operator.ID)
+	return uc.admins.repo.GetAdminByID(ctx, operator.ID)
+}
+
+// ResetPassword 超级管理员重置他人密码：对方下次登录须先修改密码，现有会话全部注销，锁定同时解除。
+func (uc *AdminPasswordUsecase) ResetPassword(ctx context.Context, adminID int, newPassword string) (*AdminAccount, error) {
+	_, operator, err := uc.admins.requireSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if adminID <= 0 || adminID == operator.ID || newPassword == "" {
+		return nil, ErrBadParam
+	}
+	target, err := uc.admins.repo.GetAdminByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.admins.policy.ValidatePassword(target.Username, newPassword); err != nil {
+		return nil, err
+	}
+	if err := uc.setPassword(ctx, target.ID, newPassword, true); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("admin reset password operator_id=%d admin_id=%d", operator.ID, target.ID)
+	return uc.admins.repo.GetAdminByID(ctx, target.ID)
+}
+
+func (uc *AdminPasswordUsecase) setPassword(ctx context.Context, adminID int, password string, mustChange bool) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.UpdateAdminPassword(ctx, adminID, string(hash), mustChange); err != nil {
+		return err
+	}
+	uc.admins.revokeSessions(ctx, adminID, AdminSessionRevokePasswordChanged)
+	return nil
+}
@@ -0,0 +1,194 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type memAdminAuthRepo struct {
+	users    map[string]*AdminUser
+	accounts *memAdminManageRepo
+	attempts []*AdminLoginAttempt
+}
+
+func (r *memAdminAuthRepo) byID(id int) *AdminUser {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user
+		}
+	}
+	return nil
+}
+
+func (r *memAdminAuthRepo) GetAdminByUsername(ctx context.Context, username string) (*AdminUser, error) {
+	user, ok := r.users[username]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	out := *user
+	return &out, nil
+}
+
+func (r *memAdminAuthRepo) UpdateAdminLastLogin(ctx context.Context, id int, t time.Time) error {
+	return nil
+}
+
+func (r *memAdminAuthRepo) UpdateAdminPassword(ctx context.Context, id int, passwordHash string, mustChange bool) error {
+	user := r.byID(id)
+	user.PasswordHash = passwordHash
+	user.MustChangePassword = mustChange
+	user.LockedUntil = nil
+	if r.accounts != nil {
+		r.accounts.admins[id].MustChangePassword = mustChange
+	}
+	return nil
+}
+
+func (r *memAdminAuthRepo) SetAdminLockedUntil(ctx context.Context, id int, until *time.Time) error {
+	r.byID(id).LockedUntil = until
+	return nil
+}
+
+func (r *memAdminAuthRepo) RecordLoginAttempt(ctx context.Context, attempt *AdminLoginAttempt) error {
+	r.attempts = append(r.attempts, attempt)
+	return nil
+}
+
+func (r *memAdminAuthRepo) CountFailedLoginAttempts(ctx context.Context, adminID int, since time.Time) (int, error) {
+	n := 0
+	for _, attempt := range r.attempts {
+		if attempt.AdminID == nil || *attempt.AdminID != adminID {
+			continue
+		}
+		if attempt.Success {
+			n = 0
+			continue
+		}
+		if (attempt.Reason == AdminLoginInvalidPassword || attempt.Reason == AdminLoginInvalidTOTP) && !attempt.CreatedAt.Before(since) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func TestAdminSecurityPolicy_ValidatePassword(t *testing.T) {
+	p := DefaultAdminSecurityPolicy()
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{name: "too short", password: "Ab1!", wantErr: true},
+		{name: "two classes", password: "abcdefgh12", wantErr: true},
+		{name: "contains username", password: "Sales#2026x", wantErr: true},
+		{name: "ok", password: "Erp#2026pass", wantErr: false},
+		{name: "chinese counts as symbol", password: "密码abcd1234", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.ValidatePassword("sales", tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidatePassword(%q) error = %v, wantErr %v", tt.password, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdminAuthUsecase_LockoutAfterFailures(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("Erp#2026pass"), bcrypt.MinCost)
+	repo := &memAdminAuthRepo{users: map[string]*AdminUser{
+		"sales": {ID: 3, Username: "sales", PasswordHash: string(hash)},
+	}}
+	uc := NewAdminAuthUsecase(repo, nil, log.NewStdLogger(io.Discard), nil)
+	uc.SetSecurityPolicy(AdminSecurityPolicy{PasswordMinLength: 8, MaxFailedAttempts: 3, FailedWindow: 10 * time.Minute, Lockout: 15 * time.Minute})
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	ctx := NewContextWithClientInfo(context.Background(), ClientInfo{IP: "10.0.0.8", UserAgent: "erp-test"})
+
+	for i := 0; i < 2; i++ {
+		if _, err := uc.Authenticate(ctx, "sales", "wrong", ""); !errors.Is(err, ErrInvalidPassword) {
+			t.Fatalf("attempt %d should be invalid password, got %v", i+1, err)
+		}
+	}
+	if _, err := uc.Authenticate(ctx, "sales", "wrong", ""); !errors.Is(err, ErrAdminLocked) {
+		t.Fatalf("third failure should lock, got %v", err)
+	}
+	// 锁定期内正确密码也被拒绝
+	now = now.Add(5 * time.Minute)
+	if _, err := uc.Authenticate(ctx, "sales", "Erp#2026pass", ""); !errors.Is(err, ErrAdminLocked) {
+		t.Fatalf("locked admin should be rejected, got %v", err)
+	}
+	// 锁定到期后，锁定前的失败不再计入
+	now = now.Add(11 * time.Minute)
+	if _, err := uc.Authenticate(ctx, "sales", "wrong", ""); !errors.Is(err, ErrInvalidPassword) {
+		t.Fatalf("failure after lockout should not relock immediately, got %v", err)
+	}
+	if _, err := uc.Authenticate(ctx, "sales", "Erp#2026pass", ""); err != nil {
+		t.Fatalf("login after lockout should succeed, got %v", err)
+	}
+	if repo.users["sales"].LockedUntil != nil {
+		t.Fatalf("successful login should clear lock")
+	}
+	last := repo.attempts[len(repo.attempts)-1]
+	if !last.Success || last.IP != "10.0.0.8" || last.UserAgent != "erp-test" {
+		t.Fatalf("attempt should record client info, got %+v", last)
+	}
+}
+
+func TestAdminPasswordUsecase_ChangeAndReset(t *testing.T) {
+	sessions, sessionRepo, admins, _ := newTestAdminSessionUsecase(t)
+	ctx := context.Background()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("Erp#2026pass"), bcrypt.MinCost)
+	authRepo := &memAdminAuthRepo{
+		users: map[string]*AdminUser{
+			"root":  {ID: 1, Username: "root", PasswordHash: string(hash)},
+			"sales": {ID: 3, Username: "sales", PasswordHash: string(hash)},
+		},
+		accounts: admins,
+	}
+	uc := NewAdminPasswordUsecase(authRepo, sessions.admins, log.NewStdLogger(io.Discard), nil)
+	salesCtx := NewContextWithClaims(ctx, &AuthClaims{UserID: 3, Role: RoleAdmin})
+	rootCtx := NewContextWithClaims(ctx, &AuthClaims{UserID: 1, Role: RoleAdmin})
+
+	if _, err := sessions.Start(ctx, &AdminUser{ID: 3, Username: "sales"}); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if _, err := uc.ChangePassword(salesCtx, "bad", "New#2026pass"); !errors.Is(err, ErrInvalidPassword) {
+		t.Fatalf("wrong old password should fail, got %v", err)
+	}
+	if _, err := uc.ChangePassword(salesCtx, "Erp#2026pass", "Erp#2026pass"); !errors.Is(err, ErrPasswordUnchanged) {
+		t.Fatalf("same password should fail, got %v", err)
+	}
+	if _, err := uc.ChangePassword(salesCtx, "Erp#2026pass", "weakpass"); !errors.Is(err, ErrPasswordTooWeak) {
+		t.Fatalf("weak password should fail, got %v", err)
+	}
+	if _, err := uc.ChangePassword(salesCtx, "Erp#2026pass", "New#2026pass"); err != nil {
+		t.Fatalf("ChangePassword() error = %v", err)
+	}
+	if sessionRepo.sessions[1].RevokeReason != AdminSessionRevokePasswordChanged {
+		t.Fatalf("change password should revoke sessions, got %+v", sessionRepo.sessions[1])
+	}
+
+	if _, err := uc.ResetPassword(salesCtx, 1, "Reset#2026pw"); !errors.Is(err, ErrNoPermission) {
+		t.Fatalf("non-super reset should be forbidden, got %v", err)
+	}
+	if _, err := uc.ResetPassword(rootCtx, 1, "Reset#2026pw"); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("reset self should be rejected, got %v", err)
+	}
+	admin, err := uc.ResetPassword(rootCtx, 3, "Reset#2026pw")
+	if err != nil {
+		t.Fatalf("ResetPassword() error = %v", err)
+	}
+	if !admin.MustChangePassword || !authRepo.users["sales"].MustChangePassword {
+		t.Fatalf("reset should force password change, got %+v", admin)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(authRepo.users["sales"].PasswordHash), []byte("Reset#2026pw")) != nil {
+		t.Fatalf("reset should store new password hash")
+	}
+}
@@ -0,0 +1,374 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrAdminRoleNotFound = errors.New("admin role not found")
+	ErrAdminRoleExists   = errors.New("admin role already exists")
+	ErrAdminRoleBuiltin  = errors.New("builtin admin role cannot be deleted")
+)
+
+// AdminRole 角色：按模块授予动作，一个管理员可持有多个角色，权限取并集。
+type AdminRole struct {
+	ID          int
+	Key         string
+	Name        string
+	Description string
+	Builtin     bool
+	// Grants 模块 key → 动作列表（见 ERPAction*）。
+	Grants     map[string][]string
+	AdminCount int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type AdminRoleRepo interface {
+	ListRoles(ctx context.Context) ([]*AdminRole, error)
+	GetRole(ctx context.Context, id int) (*AdminRole, error)
+	CreateRole(ctx context.Context, role *AdminRole) (*AdminRole, error)
+	UpdateRole(ctx context.Context, role *AdminRole) (*AdminRole, error)
+	DeleteRole(ctx context.Context, id int) error
+	// ListRoleAdminIDs 持有该角色的管理员。
+	ListRoleAdminIDs(ctx context.Context, roleID int) ([]int, error)
+	// SetAdminRoles 覆盖管理员持有的角色，并将其切换为按角色鉴权。
+	SetAdminRoles(ctx context.Context, adminID int, roleIDs []int) error
+}
+
+type AdminRoleUsecase struct {
+	repo   AdminRoleRepo
+	admins *AdminManageUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAdminRoleUsecase(repo AdminRoleRepo, admins *AdminManageUsecase, logger log.Logger, tp *tracesdk.TracerProvider) *AdminRoleUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.admin_role"))
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.admin_role")
+	} else {
+		tr = otel.Tracer("biz.admin_role")
+	}
+	return &AdminRoleUsecase{
+		repo:   repo,
+		admins: admins,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *AdminRoleUsecase) List(ctx context.Context) ([]*AdminRole, error) {
+	if _, _, err := uc.admins.requireSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListRoles(ctx)
+}
+
+func (uc *AdminRoleUsecase) Create(ctx context.Context, role *AdminRole) (*AdminRole, error) {
+	if _, _, err := uc.admins.requireSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	clean, err := normalizeAdminRole(role)
+	if err != nil {
+		return nil, err
+	}
+	clean.Builtin = false
+	return uc.repo.CreateRole(ctx, clean)
+}
+
+// Update 修改角色名称、说明与授权；内置角色同样可调整授权，但 key 不可修改。
+func (uc *AdminRoleUsecase) Update(ctx context.Context, role *AdminRole) (*AdminRole, error) {
+	if _, _, err := uc.admins.requireSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if role == nil || role.ID <= 0 {
+		return nil, ErrBadParam
+	}
+	existing, err := uc.repo.GetRole(ctx, role.ID)
+	if err != nil {
+		return nil, err
+	}
+	clean, err := normalizeAdminRole(&AdminRole{
+		ID:          existing.ID,
+		Key:         existing.Key,
+		Name:        role.Name,
+		Description: role.Description,
+		Grants:      role.Grants,
+	})
+	if err != nil {
+		return nil, err
+	}
+	clean.Builtin = existing.Builtin
+	updated, err := uc.repo.UpdateRole(ctx, clean)
+	if err != nil {
+		return nil, err
+	}
+	uc.revokeRoleHolderSessions(ctx, updated.ID)
+	return updated, nil
+}
+
+func (uc *AdminRoleUsecase) Delete(ctx context.Context, id int) error {
+	if _, _, err := uc.admins.requireSuperAdmin(ctx); err != nil {
+		return err
+	}
+	if id <= 0 {
+		return ErrBadParam
+	}
+	existing, err := uc.repo.GetRole(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing.Builtin {
+		return ErrAdminRoleBuiltin
+	}
+	adminIDs, err := uc.repo.ListRoleAdminIDs(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteRole(ctx, id); err != nil {
+		return err
+	}
+	for _, adminID := range adminIDs {
+		uc.admins.revokeSessions(ctx, adminID, AdminSessionRevokePermissionChanged)
+	}
+	return nil
+}
+
+// revokeRoleHolderSessions 角色授权变化后，持有该角色的管理员需要重新登录。
+func (uc *AdminRoleUsecase) revokeRoleHolderSessions(ctx context.Context, roleID int) {
+	adminIDs, err := uc.repo.ListRoleAdminIDs(ctx, roleID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("list role admins failed role_id=%d err=%v", roleID, err)
+		return
+	}
+	for _, adminID := range adminIDs {
+		uc.admins.revokeSessions(ctx, adminID, AdminSessionRevokePermissionChanged)
+	}
+}
+
+// Assign 覆盖管理员的角色；超级管理员无需分配角色。
+func (uc *AdminRoleUsecase) Assign(ctx context.Context, adminID int, roleIDs []int) (*AdminAccount, error) {
+	if _, _, err := uc.admins.requireSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if adminID <= 0 {
+		return nil, ErrBadParam
+	}
+	target, err := uc.admins.repo.GetAdminByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if target.Level == AdminLevelSuper {
+		return nil, ErrNoPermission
+	}
+
+	seen := map[int]struct{}{}
+	ids := make([]int, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		if roleID <= 0 {
+			return nil, ErrBadParam
+		}
+		if _, ok := seen[roleID]; ok {
+			continue
+		}
+		if _, err := uc.repo.GetRole(ctx, roleID); err != nil {
+			return nil, err
+		}
+		seen[roleID] = struct{}{}
+		ids = append(ids, roleID)
+	}
+	sort.Ints(ids)
+	if err := uc.repo.SetAdminRoles(ctx, adminID, ids); err != nil {
+		return nil, err
+	}
+	uc.admins.revokeSessions(ctx, adminID, AdminSessionRevokePermissionChanged)
+
+	updated, err := uc.admins.repo.GetAdminByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	uc.admins.fillEffectiveMenuPermissions(updated)
+	return updated, nil
+}
+
+// normalizeAdminRole 校验角色 key/名称，并将授权规范为已知模块与动作（按固定顺序去重）。
+func normalizeAdminRole(role *AdminRole) (*AdminRole, error) {
+	if role == nil {
+		return nil, ErrBadParam
+	}
+	key := strings.TrimSpace(role.Key)
+	name := strings.TrimSpace(role.Name)
+	if key == "" || name == "" || len(key) > 64 || len([]rune(name)) > 64 {
+		return nil, ErrBadParam
+	}
+	grants, err := NormalizeAdminRoleGrants(role.Grants)
+	if err != nil {
+		return nil, err
+	}
+	return &AdminRole{
+		ID:          role.ID,
+		Key:         key,
+		Name:        name,
+		Description: strings.TrimSpace(role.Description),
+		Grants:      grants,
+	}, nil
+}
+
+// NormalizeAdminRoleGrants 规范角色授权；未知模块或动作返回 ErrBadParam。
+func NormalizeAdminRoleGrants(grants map[string][]string) (map[string][]string, error) {
+	out := make(map[string][]string, len(grants))
+	for moduleKey, actions := range grants {
+		moduleKey = strings.TrimSpace(moduleKey)
+		if _, ok := erpModuleMenuKeys[moduleKey]; !ok {
+			return nil, ErrBadParam
+		}
+		selected := map[string]struct{}{}
+		for _, action := range actions {
+			action = strings.TrimSpace(action)
+			if _, ok := erpActionSet[action]; !ok {
+				return nil, ErrBadParam
+			}
+			selected[action] = struct{}{}
+		}
+		if len(selected) == 0 {
+			continue
+		}
+		list := make([]string, 0, len(selected))
+		for _, action := range ERPActions() {
+			if _, ok := selected[action]; ok {
+				list = append(list, action)
+			}
+		}
+		out[moduleKey] = list
+	}
+	return out, nil
+}
+
+// AdminRoleFromMenuPermissions 将旧版菜单权限转换为等价角色：菜单下的模块授予全部动作。
+// 相同菜单组合得到相同 key，便于多个管理员共用同一转换角色。
+func AdminRoleFromMenuPermissions(menuKeys []string) *AdminRole {
+	menus := NormalizeAdminMenuPermissions(menuKeys)
+	menuSet := make(map[string]struct{}, len(menus))
+	for _, menuKey := range menus {
+		menuSet[menuKey] = struct{}{}
+	}
+	grants := map[string][]string{}
+	for moduleKey, menuKey := range erpModuleMenuKeys {
+		if _, ok := menuSet[menuKey]; ok {
+			grants[moduleKey] = ERPActions()
+		}
+	}
+
+	labels := make([]string, 0, len(menus))
+	for _, item := range adminMenuPermissionOptions {
+		if _, ok := menuSet[item.Key]; ok {
+			labels = append(labels, item.Label)
+		}
+	}
+	sum := sha1.Sum([]byte(strings.Join(menus, ",")))
+	name := []rune("菜单权限：" + strings.Join(labels, "、"))
+	if len(name) > 64 {
+		name = append(name[:63], '…')
+	}
+	return &AdminRole{
+		Key:         "menu-" + hex.EncodeToString(sum[:6]),
+		Name:        string(name),
+		Description: "由旧版菜单权限转换",
+		Grants:      grants,
+	}
+}
+
+// BuiltinAdminRoles 系统内置角色及默认授权，启动时补齐缺失的角色，并为已存在的内置角色补齐缺失的模块/动作（不删除已有授权）。
+func BuiltinAdminRoles() []*AdminRole {
+	all := ERPActions()
+	daily := []string{ERPActionView, ERPActionCreate, ERPActionEdit, ERPActionSubmit, ERPActionPrint, ERPActionExport, ERPActionViewAmounts}
+	view := []string{ERPActionView}
+	// 销售只看本人客户与单据；其余岗位需跨业务员查看。
+	viewAll := []string{ERPActionView, ERPActionViewAll}
+	with := func(actions []string, extra ...string) []string {
+		return append(append([]string(nil), actions...), extra...)
+	}
+	grant := func(actions []string, modules ...string) map[string][]string {
+		out := make(map[string][]string, len(modules))
+		for _, moduleKey := range modules {
+			out[moduleKey] = append([]string(nil), actions...)
+		}
+		return out
+	}
+	merge := func(parts ...map[string][]string) map[string][]string {
+		out := map[string][]string{}
+		for _, part := range parts {
+			for key, actions := range part {
+				out[key] = actions
+			}
+		}
+		return out
+	}
+
+	financeModules := []string{
+		ERPModuleSettlements, ERPModuleBankReceipts, ERPModuleSupplierInvoices, ERPModuleSupplierPayments,
+		ERPModuleShipmentCosts, ERPModuleRebateRates, ERPModuleRebateDeclarations, ERPModuleExchangeRates,
+	}
+	manager := map[string][]string{}
+	for moduleKey := range erpModuleMenuKeys {
+		manager[moduleKey] = append([]string(nil), all...)
+	}
+
+	return []*AdminRole{
+		{
+			Key: "sales", Name: "销售", Builtin: true,
+			Description: "客户、报价、外销合同",
+			Grants: merge(
+				grant(daily, ERPModulePartners, ERPModuleQuotations, ERPModuleExportSales),
+				grant(view, ERPModuleProducts, ERPModuleHSCodes, ERPModuleShipmentDetails),
+				grant(with(view, ERPActionViewAmounts), ERPModulePriceLists),
+			),
+		},
+		{
+			Key: "merchandiser", Name: "跟单", Builtin: true,
+			Description: "采购、出运跟进",
+			Grants: merge(
+				grant(daily, ERPModulePurchaseContracts, ERPModuleShipmentCosts),
+				grant(with(daily, ERPActionViewAll), ERPModuleShipmentDetails),
+				grant(viewAll, ERPModulePartners, ERPModuleProducts, ERPModuleHSCodes, ERPModuleExportSales, ERPModuleInbound,
+					ERPModuleInventory, ERPModuleProductionOrders),
+			),
+		},
+		{
+			Key: "warehouse", Name: "仓库", Builtin: true,
+			Description: "入库、库存、出库（不含金额）",
+			Grants: merge(
+				grant([]string{ERPActionView, ERPActionCreate, ERPActionEdit, ERPActionSubmit, ERPActionPrint},
+					ERPModuleInbound, ERPModuleInventory, ERPModuleOutbound, ERPModuleProductionOrders),
+				grant(viewAll, ERPModuleProducts, ERPModuleHSCodes, ERPModuleShipmentDetails),
+			),
+		},
+		{
+			Key: "finance", Name: "财务", Builtin: true,
+			Description: "结汇、水单、应付、退税",
+			Grants: merge(
+				grant(with(daily, ERPActionApprove, ERPActionDelete), financeModules...),
+				grant(with(viewAll, ERPActionViewAmounts, ERPActionExport),
+					ERPModulePartners, ERPModuleExportSales, ERPModulePurchaseContracts, ERPModuleShipmentDetails),
+			),
+		},
+		{
+			Key: "manager", Name: "经理", Builtin: true,
+			Description: "全部模块全部动作",
+			Grants:      manager,
+		},
+	}
+}
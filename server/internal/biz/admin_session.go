@@ -0,0 +1,393 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrAdminSessionNotFound = errors.New("admin session not found")
+	ErrAdminSessionRevoked  = errors.New("admin session revoked")
+	ErrAdminSessionExpired  = errors.New("admin session expired")
+)
+
+// 会话注销原因（admin_sessions.revoke_reason）。
+const (
+	AdminSessionRevokeLogout            = "logout"
+	AdminSessionRevokeKick              = "kick"
+	AdminSessionRevokeDisabled          = "disabled"
+	AdminSessionRevokePermissionChanged = "permission_changed"
+	AdminSessionRevokePasswordChanged   = "password_changed"
+	AdminSessionRevokeTOTPReset         = "totp_reset"
+	// AdminSessionRevokeRefreshReused 已轮换的 refresh token 被再次使用，视为泄露，整个会话作废。
+	AdminSessionRevokeRefreshReused = "refresh_reused"
+)
+
+// adminSessionTouchInterval last_seen_at 的最小更新间隔，避免每个请求都写库。
+const adminSessionTouchInterval = time.Minute
+
+// AdminSessionTokenGenerator 签发绑定会话的短期 access token（sid=sessionKey, jti=tokenID）。
+type AdminSessionTokenGenerator func(adminID int, username, sessionKey, tokenID string) (token string, expireAt time.Time, err error)
+
+// AdminSession 管理员登录会话；refresh token 只保存摘要。
+type AdminSession struct {
+	ID               int
+	SessionKey       string
+	AdminID          int
+	AccessTokenID    string
+	RefreshTokenHash string
+	IP               string
+	UserAgent        string
+	CreatedAt        time.Time
+	LastSeenAt       time.Time
+	ExpiresAt        time.Time
+	RevokedAt        *time.Time
+	RevokeReason     string
+	// Current 是否为发起请求的会话（仅列表填充）。
+	Current bool
+}
+
+// AdminSessionTokens 登录或刷新后下发的一组 token。
+type AdminSessionTokens struct {
+	SessionKey       string
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+type AdminSessionRepo interface {
+	CreateSession(ctx context.Context, session *AdminSession) (*AdminSession, error)
+	GetSessionByID(ctx context.Context, id int) (*AdminSession, error)
+	GetSessionByKey(ctx context.Context, sessionKey string) (*AdminSession, error)
+	ListActiveSessions(ctx context.Context, adminID int, now time.Time) ([]*AdminSession, error)
+	// RotateSession 仅当 refresh token 摘要仍为 previousRefreshHash 且会话未注销时更新，否则返回 ErrAdminSessionRevoked。
+	RotateSession(ctx context.Context, id int, previousRefreshHash, accessTokenID, refreshTokenHash string, client ClientInfo, t time.Time) error
+	TouchSession(ctx context.Context, id int, t time.Time) error
+	RevokeSession(ctx context.Context, id int, reason string, t time.Time) error
+	RevokeAdminSessions(ctx context.Context, adminID int, reason string, t time.Time) (int, error)
+}
+
+type AdminSessionUsecase struct {
+	repo   AdminSessionRepo
+	admins *AdminManageUsecase
+	genTok AdminSessionTokenGenerator
+	ttl    time.Duration
+	now    func() time.Time
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+// NewAdminSessionUsecase ttl 为会话（refresh token）的绝对有效期，刷新不延长。
+func NewAdminSessionUsecase(
+	repo AdminSessionRepo,
+	admins *AdminManageUsecase,
+	genTok AdminSessionTokenGenerator,
+	ttl time.Duration,
+	logger log.Logger,
+	tp *tracesdk.TracerProvider,
+) *AdminSessionUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.admin_session"))
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.admin_session")
+	} else {
+		tr = otel.Tracer("biz.admin_session")
+	}
+	if ttl <= 0 {
+		ttl = 7 * 24 * time.Hour
+	}
+	return &AdminSessionUsecase{
+		repo:   repo,
+		admins: admins,
+		genTok: genTok,
+		ttl:    ttl,
+		now:    time.Now,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+// Start 为登录成功的管理员创建会话并签发 access/refresh token。
+func (uc *AdminSessionUsecase) Start(ctx context.Context, admin *AdminUser) (*AdminSessionTokens, error) {
+	if admin == nil || admin.ID <= 0 {
+		return nil, ErrBadParam
+	}
+	sessionKey, err := newAdminSessionSecret(16)
+	if err != nil {
+		return nil, err
+	}
+	tokenID, err := newAdminSessionSecret(16)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := newAdminRefreshToken(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	accessToken, accessExpiresAt, err := uc.genTok(admin.ID, admin.Username, sessionKey, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	client := ClientInfoFromContext(ctx)
+	session, err := uc.repo.CreateSession(ctx, &AdminSession{
+		SessionKey:       sessionKey,
+		AdminID:          admin.ID,
+		AccessTokenID:    tokenID,
+		RefreshTokenHash: hashAdminRefreshToken(refreshToken),
+		IP:               client.IP,
+		UserAgent:        client.UserAgent,
+		CreatedAt:        now,
+		LastSeenAt:       now,
+		ExpiresAt:        now.Add(uc.ttl),
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("admin session start admin_id=%d session_id=%d ip=%s", admin.ID, session.ID, client.IP)
+	return &AdminSessionTokens{
+		SessionKey:       sessionKey,
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
+// Refresh 用 refresh token 换取新的 access token，同时轮换 refresh token；旧 access token 随即失效。
+func (uc *AdminSessionUsecase) Refresh(ctx context.Context, refreshToken string) (*AdminSessionTokens, error) {
+	sessionKey, _, ok := strings.Cut(strings.TrimSpace(refreshToken), ".")
+	if !ok || sessionKey == "" {
+		return nil, ErrAdminSessionNotFound
+	}
+	session, err := uc.repo.GetSessionByKey(ctx, sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	now := uc.now()
+	if err := checkAdminSessionActive(session, now); err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashAdminRefreshToken(refreshToken)), []byte(session.RefreshTokenHash)) != 1 {
+		uc.log.WithContext(ctx).Warnf("admin session refresh token reused admin_id=%d session_id=%d", session.AdminID, session.ID)
+		if err := uc.repo.RevokeSession(ctx, session.ID, AdminSessionRevokeRefreshReused, now); err != nil {
+			return nil, err
+		}
+		return nil, ErrAdminSessionRevoked
+	}
+
+	admin, err := uc.admins.repo.GetAdminByID(ctx, session.AdminID)
+	if err != nil && !errors.Is(err, ErrAdminNotFound) {
+		return nil, err
+	}
+	if admin == nil || admin.Disabled {
+		if err := uc.repo.RevokeSession(ctx, session.ID, AdminSessionRevokeDisabled, now); err != nil {
+			return nil, err
+		}
+		return nil, ErrAdminDisabled
+	}
+
+	tokenID, err := newAdminSessionSecret(16)
+	if err != nil {
+		return nil, err
+	}
+	nextRefreshToken, err := newAdminRefreshToken(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	accessToken, accessExpiresAt, err := uc.genTok(admin.ID, admin.Username, sessionKey, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if accessExpiresAt.After(session.ExpiresAt) {
+		accessExpiresAt = session.ExpiresAt
+	}
+	if err := uc.repo.RotateSession(ctx, session.ID, session.RefreshTokenHash, tokenID,
+		hashAdminRefreshToken(nextRefreshToken), ClientInfoFromContext(ctx), now); err != nil {
+		return nil, err
+	}
+	return &AdminSessionTokens{
+		SessionKey:       sessionKey,
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     nextRefreshToken,
+		RefreshExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
+// Verify 校验 access token 仍对应有效会话：会话未注销、未过期，且 jti 为会话当前 token。
+func (uc *AdminSessionUsecase) Verify(ctx context.Context, claims *AuthClaims) (*AdminSession, error) {
+	if claims == nil || claims.SessionID == "" {
+		return nil, ErrAdminSessionNotFound
+	}
+	session, err := uc.repo.GetSessionByKey(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.AdminID != claims.UserID {
+		return nil, ErrAdminSessionNotFound
+	}
+	now := uc.now()
+	if err := checkAdminSessionActive(session, now); err != nil {
+		return nil, err
+	}
+	if claims.TokenID != session.AccessTokenID {
+		return nil, ErrAdminSessionRevoked
+	}
+	if now.Sub(session.LastSeenAt) >= adminSessionTouchInterval {
+		if err := uc.repo.TouchSession(ctx, session.ID, now); err != nil {
+			uc.log.WithContext(ctx).Warnf("admin session touch failed session_id=%d err=%v", session.ID, err)
+		} else {
+			session.LastSeenAt = now
+		}
+	}
+	return session, nil
+}
+
+// Logout 注销当前请求所属会话；token 不带会话或会话已失效时直接返回。
+func (uc *AdminSessionUsecase) Logout(ctx context.Context) error {
+	claims, ok := GetClaimsFromContext(ctx)
+	if !ok || claims == nil || claims.SessionID == "" {
+		return nil
+	}
+	session, err := uc.repo.GetSessionByKey(ctx, claims.SessionID)
+	if errors.Is(err, ErrAdminSessionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if session.AdminID != claims.UserID || session.RevokedAt != nil {
+		return nil
+	}
+	return uc.repo.RevokeSession(ctx, session.ID, AdminSessionRevokeLogout, uc.now())
+}
+
+// List 返回管理员的有效会话；adminID<=0 表示当前管理员。
+func (uc *AdminSessionUsecase) List(ctx context.Context, adminID int) ([]*AdminSession, error) {
+	claims, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if adminID <= 0 {
+		adminID = operator.ID
+	}
+	if err := uc.authorizeTarget(ctx, operator, adminID); err != nil {
+		return nil, err
+	}
+	sessions, err := uc.repo.ListActiveSessions(ctx, adminID, uc.now())
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		session.Current = session.SessionKey == claims.SessionID
+	}
+	return sessions, nil
+}
+
+// Kick 注销指定会话。
+func (uc *AdminSessionUsecase) Kick(ctx context.Context, sessionID int) error {
+	_, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return err
+	}
+	if sessionID <= 0 {
+		return ErrBadParam
+	}
+	session, err := uc.repo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if err := uc.authorizeTarget(ctx, operator, session.AdminID); err != nil {
+		return err
+	}
+	if session.RevokedAt != nil {
+		return nil
+	}
+	uc.log.WithContext(ctx).Infof("admin session kick operator_id=%d admin_id=%d session_id=%d", operator.ID, session.AdminID, session.ID)
+	return uc.repo.RevokeSession(ctx, session.ID, AdminSessionRevokeKick, uc.now())
+}
+
+// KickAdmin 注销管理员的全部会话，返回注销数量。
+func (uc *AdminSessionUsecase) KickAdmin(ctx context.Context, adminID int) (int, error) {
+	_, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if adminID <= 0 {
+		return 0, ErrBadParam
+	}
+	if err := uc.authorizeTarget(ctx, operator, adminID); err != nil {
+		return 0, err
+	}
+	uc.log.WithContext(ctx).Infof("admin session kick all operator_id=%d admin_id=%d", operator.ID, adminID)
+	return uc.repo.RevokeAdminSessions(ctx, adminID, AdminSessionRevokeKick, uc.now())
+}
+
+// RevokeAdmin 实现 AdminSessionRevoker，供禁用、权限或密码变更后注销会话。
+func (uc *AdminSessionUsecase) RevokeAdmin(ctx context.Context, adminID int, reason string) (int, error) {
+	return uc.repo.RevokeAdminSessions(ctx, adminID, reason, uc.now())
+}
+
+// authorizeTarget 会话管理范围：本人；超级管理员管理所有人；一级管理员管理自己的二级管理员。
+func (uc *AdminSessionUsecase) authorizeTarget(ctx context.Context, operator *AdminAccount, adminID int) error {
+	if operator.ID == adminID || operator.Level == AdminLevelSuper {
+		return nil
+	}
+	if operator.Level != AdminLevelPrimary {
+		return ErrNoPermission
+	}
+	target, err := uc.admins.repo.GetAdminByID(ctx, adminID)
+	if err != nil {
+		return err
+	}
+	if target.Level != AdminLevelSecondary || target.ParentID == nil || *target.ParentID != operator.ID {
+		return ErrNoPermission
+	}
+	return nil
+}
+
+func checkAdminSessionActive(session *AdminSession, now time.Time) error {
+	if session.RevokedAt != nil {
+		return ErrAdminSessionRevoked
+	}
+	if !now.Before(session.ExpiresAt) {
+		return ErrAdminSessionExpired
+	}
+	return nil
+}
+
+func newAdminSessionSecret(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// newAdminRefreshToken 格式为 "<sessionKey>.<随机串>"，便于按会话查找后比对摘要。
+func newAdminRefreshToken(sessionKey string) (string, error) {
+	secret, err := newAdminSessionSecret(32)
+	if err != nil {
+		return "", err
+	}
+	return sessionKey + "." + secret, nil
+}
+
+func hashAdminRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,292 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type memAdminSessionRepo struct {
+	sessions map[int]*AdminSession
+	nextID   int
+}
+
+func (r *memAdminSessionRepo) CreateSession(ctx context.Context, session *AdminSession) (*AdminSession, error) {
+	r.nextID++
+	copied := *session
+	copied.ID = r.nextID
+	r.sessions[copied.ID] = &copied
+	out := copied
+	return &out, nil
+}
+
+func (r *memAdminSessionRepo) GetSessionByID(ctx context.Context, id int) (*AdminSession, error) {
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, ErrAdminSessionNotFound
+	}
+	out := *session
+	return &out, nil
+}
+
+func (r *memAdminSessionRepo) GetSessionByKey(ctx context.Context, sessionKey string) (*AdminSession, error) {
+	for _, session := range r.sessions {
+		if session.SessionKey == sessionKey {
+			out := *session
+			return &out, nil
+		}
+	}
+	return nil, ErrAdminSessionNotFound
+}
+
+func (r *memAdminSessionRepo) ListActiveSessions(ctx context.Context, adminID int, now time.Time) ([]*AdminSession, error) {
+	out := []*AdminSession{}
+	for id := 1; id <= r.nextID; id++ {
+		session, ok := r.sessions[id]
+		if ok && session.AdminID == adminID && session.RevokedAt == nil && session.ExpiresAt.After(now) {
+			copied := *session
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (r *memAdminSessionRepo) RotateSession(ctx context.Context, id int, previousRefreshHash, accessTokenID, refreshTokenHash string, client ClientInfo, t time.Time) error {
+	session := r.sessions[id]
+	if session == nil || session.RevokedAt != nil || session.RefreshTokenHash != previousRefreshHash {
+		return ErrAdminSessionRevoked
+	}
+	session.AccessTokenID = accessTokenID
+	session.RefreshTokenHash = refreshTokenHash
+	session.LastSeenAt = t
+	return nil
+}
+
+func (r *memAdminSessionRepo) TouchSession(ctx context.Context, id int, t time.Time) error {
+	r.sessions[id].LastSeenAt = t
+	return nil
+}
+
+func (r *memAdminSessionRepo) RevokeSession(ctx context.Context, id int, reason string, t time.Time) error {
+	if session := r.sessions[id]; session != nil && session.RevokedAt == nil {
+		session.RevokedAt = &t
+		session.RevokeReason = reason
+	}
+	return nil
+}
+
+func (r *memAdminSessionRepo) RevokeAdminSessions(ctx context.Context, adminID int, reason string, t time.Time) (int, error) {
+	n := 0
+	for _, session := range r.sessions {
+		if session.AdminID == adminID && session.RevokedAt == nil {
+			session.RevokedAt = &t
+			session.RevokeReason = reason
+			n++
+		}
+	}
+	return n, nil
+}
+
+type memAdminManageRepo struct {
+	AdminManageRepo
+	admins map[int]*AdminAccount
+}
+
+func (r *memAdminManageRepo) GetAdminByID(ctx context.Context, id int) (*AdminAccount, error) {
+	admin, ok := r.admins[id]
+	if !ok {
+		return nil, ErrAdminNotFound
+	}
+	out := *admin
+	return &out, nil
+}
+
+func (r *memAdminManageRepo) UpdateAdminMenuPermissions(ctx context.Context, id int, menuPermissions []string) error {
+	r.admins[id].MenuPermissions = menuPermissions
+	return nil
+}
+
+func (r *memAdminManageRepo) SetAdminDisabled(ctx context.Context, id int, disabled bool) error {
+	r.admins[id].Disabled = disabled
+	return nil
+}
+
+func newTestAdminSessionUsecase(t *testing.T) (*AdminSessionUsecase, *memAdminSessionRepo, *memAdminManageRepo, *time.Time) {
+	t.Helper()
+	parentID := 2
+	admins := &memAdminManageRepo{admins: map[int]*AdminAccount{
+		1: {ID: 1, Username: "root", Level: AdminLevelSuper},
+		2: {ID: 2, Username: "lead", Level: AdminLevelPrimary},
+		3: {ID: 3, Username: "sales", Level: AdminLevelSecondary, ParentID: &parentID},
+		4: {ID: 4, Username: "other", Level: AdminLevelPrimary},
+	}}
+	logger := log.NewStdLogger(io.Discard)
+	manageUC := NewAdminManageUsecase(admins, logger, nil)
+	repo := &memAdminSessionRepo{sessions: map[int]*AdminSession{}}
+	seq := 0
+	genTok := func(adminID int, username, sessionKey, tokenID string) (string, time.Time, error) {
+		seq++
+		return "tok-" + strconv.Itoa(seq), time.Now().Add(15 * time.Minute), nil
+	}
+	uc := NewAdminSessionUsecase(repo, manageUC, genTok, 24*time.Hour, logger, nil)
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	manageUC.SetSessionRevoker(uc)
+	return uc, repo, admins, &now
+}
+
+func adminSessionClaims(t *testing.T, repo *memAdminSessionRepo, adminID int, sessionKey string) *AuthClaims {
+	t.Helper()
+	for _, session := range repo.sessions {
+		if session.SessionKey == sessionKey {
+			return &AuthClaims{UserID: adminID, Role: RoleAdmin, SessionID: session.SessionKey, TokenID: session.AccessTokenID}
+		}
+	}
+	t.Fatalf("session %s not found", sessionKey)
+	return nil
+}
+
+func TestAdminSessionUsecase_RefreshRotatesTokens(t *testing.T) {
+	uc, repo, _, now := newTestAdminSessionUsecase(t)
+	ctx := NewContextWithClientInfo(context.Background(), ClientInfo{IP: "10.0.0.1", UserAgent: "ua"})
+
+	tokens, err := uc.Start(ctx, &AdminUser{ID: 3, Username: "sales"})
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if tokens.RefreshToken == "" || !tokens.RefreshExpiresAt.Equal(now.Add(24*time.Hour)) {
+		t.Fatalf("unexpected tokens %+v", tokens)
+	}
+	if repo.sessions[1].IP != "10.0.0.1" || repo.sessions[1].RefreshTokenHash == tokens.RefreshToken {
+		t.Fatalf("unexpected stored session %+v", repo.sessions[1])
+	}
+	oldClaims := adminSessionClaims(t, repo, 3, tokens.SessionKey)
+	if _, err := uc.Verify(ctx, oldClaims); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	*now = now.Add(20 * time.Minute)
+	refreshed, err := uc.Refresh(ctx, tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if refreshed.RefreshToken == tokens.RefreshToken || !refreshed.RefreshExpiresAt.Equal(tokens.RefreshExpiresAt) {
+		t.Fatalf("refresh should rotate token without extending session: %+v", refreshed)
+	}
+	if _, err := uc.Verify(ctx, oldClaims); !errors.Is(err, ErrAdminSessionRevoked) {
+		t.Fatalf("old access token should be rejected, got %v", err)
+	}
+	if _, err := uc.Verify(ctx, adminSessionClaims(t, repo, 3, tokens.SessionKey)); err != nil {
+		t.Fatalf("new access token Verify() error = %v", err)
+	}
+
+	// 旧 refresh token 再次使用视为泄露，整个会话作废
+	if _, err := uc.Refresh(ctx, tokens.RefreshToken); !errors.Is(err, ErrAdminSessionRevoked) {
+		t.Fatalf("reused refresh token should be rejected, got %v", err)
+	}
+	if repo.sessions[1].RevokeReason != AdminSessionRevokeRefreshReused {
+		t.Fatalf("expected session revoked for reuse, got %+v", repo.sessions[1])
+	}
+	if _, err := uc.Refresh(ctx, refreshed.RefreshToken); !errors.Is(err, ErrAdminSessionRevoked) {
+		t.Fatalf("revoked session refresh should fail, got %v", err)
+	}
+}
+
+func TestAdminSessionUsecase_VerifyRejects(t *testing.T) {
+	uc, repo, _, now := newTestAdminSessionUsecase(t)
+	ctx := context.Background()
+
+	if _, err := uc.Verify(ctx, &AuthClaims{UserID: 3, Role: RoleAdmin}); !errors.Is(err, ErrAdminSessionNotFound) {
+		t.Fatalf("token without sid should be rejected, got %v", err)
+	}
+	tokens, err := uc.Start(ctx, &AdminUser{ID: 3, Username: "sales"})
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	claims := adminSessionClaims(t, repo, 3, tokens.SessionKey)
+	forged := *claims
+	forged.UserID = 2
+	if _, err := uc.Verify(ctx, &forged); !errors.Is(err, ErrAdminSessionNotFound) {
+		t.Fatalf("sid of another admin should be rejected, got %v", err)
+	}
+
+	*now = now.Add(25 * time.Hour)
+	if _, err := uc.Verify(ctx, claims); !errors.Is(err, ErrAdminSessionExpired) {
+		t.Fatalf("expired session should be rejected, got %v", err)
+	}
+	if _, err := uc.Refresh(ctx, tokens.RefreshToken); !errors.Is(err, ErrAdminSessionExpired) {
+		t.Fatalf("expired session refresh should fail, got %v", err)
+	}
+}
+
+func TestAdminSessionUsecase_LogoutAndKick(t *testing.T) {
+	uc, repo, _, _ := newTestAdminSessionUsecase(t)
+	ctx := context.Background()
+
+	first, _ := uc.Start(ctx, &AdminUser{ID: 3, Username: "sales"})
+	second, _ := uc.Start(ctx, &AdminUser{ID: 3, Username: "sales"})
+	salesCtx := NewContextWithClaims(ctx, adminSessionClaims(t, repo, 3, first.SessionKey))
+
+	sessions, err := uc.List(salesCtx, 0)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(sessions) != 2 || !sessions[0].Current || sessions[1].Current {
+		t.Fatalf("unexpected sessions %+v", sessions)
+	}
+
+	// 其他一级管理员不能管理非下级的会话；上级可以
+	otherCtx := NewContextWithClaims(ctx, &AuthClaims{UserID: 4, Role: RoleAdmin})
+	if err := uc.Kick(otherCtx, 2); !errors.Is(err, ErrNoPermission) {
+		t.Fatalf("expected ErrNoPermission, got %v", err)
+	}
+	leadCtx := NewContextWithClaims(ctx, &AuthClaims{UserID: 2, Role: RoleAdmin})
+	if err := uc.Kick(leadCtx, 2); err != nil {
+		t.Fatalf("Kick() error = %v", err)
+	}
+	if repo.sessions[2].RevokeReason != AdminSessionRevokeKick {
+		t.Fatalf("expected kicked session, got %+v", repo.sessions[2])
+	}
+	if _, err := uc.Refresh(ctx, second.RefreshToken); !errors.Is(err, ErrAdminSessionRevoked) {
+		t.Fatalf("kicked session refresh should fail, got %v", err)
+	}
+
+	if err := uc.Logout(salesCtx); err != nil {
+		t.Fatalf("Logout() error = %v", err)
+	}
+	if repo.sessions[1].RevokeReason != AdminSessionRevokeLogout {
+		t.Fatalf("expected logout session, got %+v", repo.sessions[1])
+	}
+}
+
+func TestAdminManageUsecase_RevokesSessionsOnChange(t *testing.T) {
+	uc, repo, admins, _ := newTestAdminSessionUsecase(t)
+	ctx := context.Background()
+	rootCtx := NewContextWithClaims(ctx, &AuthClaims{UserID: 1, Role: RoleAdmin})
+
+	tokens, _ := uc.Start(ctx, &AdminUser{ID: 3, Username: "sales"})
+	if _, err := uc.admins.SetMenuPermissions(rootCtx, 3, []string{"erp"}); err != nil {
+		t.Fatalf("SetMenuPermissions() error = %v", err)
+	}
+	if repo.sessions[1].RevokeReason != AdminSessionRevokePermissionChanged {
+		t.Fatalf("expected permission_changed, got %+v", repo.sessions[1])
+	}
+
+	// 账号被禁用后，refresh 不再签发新 token 并注销会话
+	second, _ := uc.Start(ctx, &AdminUser{ID: 3, Username: "sales"})
+	admins.admins[3].Disabled = true
+	if _, err := uc.Refresh(ctx, tokens.RefreshToken); !errors.Is(err, ErrAdminSessionRevoked) {
+		t.Fatalf("revoked session refresh should fail, got %v", err)
+	}
+	if _, err := uc.Refresh(ctx, second.RefreshToken); !errors.Is(err, ErrAdminDisabled) {
+		t.Fatalf("disabled admin refresh should fail, got %v", err)
+	}
+	if repo.sessions[2].RevokeReason != AdminSessionRevokeDisabled {
+		t.Fatalf("expected disabled, got %+v", repo.sessions[2])
+	}
+}
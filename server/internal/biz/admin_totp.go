@@ -0,0 +1,344 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"server/pkg/totp"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrTOTPRequired       = errors.New("totp code required")
+	ErrInvalidTOTP        = errors.New("invalid totp code")
+	ErrTOTPAlreadyEnabled = errors.New("totp already enabled")
+	ErrTOTPNotEnabled     = errors.New("totp not enabled")
+	ErrTOTPNotSetup       = errors.New("totp setup not started")
+	ErrTOTPEnforced       = errors.New("totp enforced for admin level")
+)
+
+const (
+	// adminTOTPSkew 允许前后各一个时间步（±30 秒）的时钟偏差。
+	adminTOTPSkew = 1
+	// adminRecoveryCodeCount 每次生成的恢复码数量。
+	adminRecoveryCodeCount = 10
+)
+
+// AdminTOTP 管理员两步验证状态；Secret 非空且未启用表示绑定待确认。
+type AdminTOTP struct {
+	AdminID   int
+	Secret    string
+	Enabled   bool
+	EnabledAt *time.Time
+	// LastStep 最近一次验证通过的时间步，同一时间步的验证码不能重复使用。
+	LastStep int64
+}
+
+// AdminTOTPSetup 开始绑定时返回给本人的密钥与 otpauth:// 地址（前端渲染为二维码）。
+type AdminTOTPSetup struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// AdminTOTPStatus 两步验证状态（admin.me / admin.totp_status）。
+type AdminTOTPStatus struct {
+	Enabled                bool
+	Required               bool
+	EnabledAt              *time.Time
+	RecoveryCodesRemaining int
+}
+
+type AdminTOTPRepo interface {
+	GetAdminTOTP(ctx context.Context, adminID int) (*AdminTOTP, error)
+	// SetAdminTOTPSecret 保存待确认的密钥（totp_enabled 保持 false）。
+	SetAdminTOTPSecret(ctx context.Context, adminID int, secret string) error
+	// EnableAdminTOTP 启用两步验证并替换全部恢复码（同一事务）。
+	EnableAdminTOTP(ctx context.Context, adminID int, step int64, recoveryCodeHashes []string, t time.Time) error
+	// AdvanceAdminTOTPStep 仅当 step 大于已记录的时间步时更新，返回是否更新成功（防重放）。
+	AdvanceAdminTOTPStep(ctx context.Context, adminID int, step int64) (bool, error)
+	// ClearAdminTOTP 清除密钥、关闭两步验证并删除恢复码。
+	ClearAdminTOTP(ctx context.Context, adminID int) error
+	ReplaceRecoveryCodes(ctx context.Context, adminID int, hashes []string, t time.Time) error
+	// UseRecoveryCode 将未使用的恢复码标记为已用，返回是否命中。
+	UseRecoveryCode(ctx context.Context, adminID int, hash string, t time.Time) (bool, error)
+	CountRecoveryCodes(ctx context.Context, adminID int) (int, error)
+}
+
+// AdminTOTPUsecase RFC 6238 两步验证：本人绑定/启用/关闭/重新生成恢复码，超级管理员重置，登录时校验。
+// 验证码在本地计算，不依赖任何外部服务。
+type AdminTOTPUsecase struct {
+	repo   AdminTOTPRepo
+	admins *AdminManageUsecase
+	now    func() time.Time
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAdminTOTPUsecase(repo AdminTOTPRepo, admins *AdminManageUsecase, logger log.Logger, tp *tracesdk.TracerProvider) *AdminTOTPUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.admin_totp"))
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.admin_totp")
+	} else {
+		tr = otel.Tracer("biz.admin_totp")
+	}
+	return &AdminTOTPUsecase{
+		repo:   repo,
+		admins: admins,
+		now:    time.Now,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+// Status 本人两步验证状态。
+func (uc *AdminTOTPUsecase) Status(ctx context.Context) (*AdminTOTPStatus, error) {
+	_, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state, err := uc.repo.GetAdminTOTP(ctx, operator.ID)
+	if err != nil {
+		return nil, err
+	}
+	status := &AdminTOTPStatus{
+		Enabled:   state.Enabled,
+		Required:  uc.admins.policy.TOTPRequired(operator.Level),
+		EnabledAt: state.EnabledAt,
+	}
+	if state.Enabled {
+		if status.RecoveryCodesRemaining, err = uc.repo.CountRecoveryCodes(ctx, operator.ID); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+// Setup 生成新密钥等待确认；已启用时需先关闭或由超级管理员重置。
+func (uc *AdminTOTPUsecase) Setup(ctx context.Context) (*AdminTOTPSetup, error) {
+	_, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state, err := uc.repo.GetAdminTOTP(ctx, operator.ID)
+	if err != nil {
+		return nil, err
+	}
+	if state.Enabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.SetAdminTOTPSecret(ctx, operator.ID, secret); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("admin totp setup admin_id=%d", operator.ID)
+	return &AdminTOTPSetup{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(uc.admins.policy.TOTPIssuer, operator.Username, secret),
+	}, nil
+}
+
+// Enable 用验证器 App 当前验证码确认绑定，返回一次性展示的恢复码。
+func (uc *AdminTOTPUsecase) Enable(ctx context.Context, code string) ([]string, error) {
+	_, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state, err := uc.repo.GetAdminTOTP(ctx, operator.ID)
+	if err != nil {
+		return nil, err
+	}
+	if state.Enabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+	if state.Secret == "" {
+		return nil, ErrTOTPNotSetup
+	}
+	now := uc.now()
+	step, ok := totp.Validate(state.Secret, code, now, adminTOTPSkew)
+	if !ok {
+		return nil, ErrInvalidTOTP
+	}
+	codes, hashes, err := newAdminRecoveryCodes(operator.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.EnableAdminTOTP(ctx, operator.ID, step, hashes, now); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("admin totp enabled admin_id=%d", operator.ID)
+	return codes, nil
+}
+
+// Disable 本人关闭两步验证，需提交验证码或恢复码；所在等级强制启用时不可关闭。
+func (uc *AdminTOTPUsecase) Disable(ctx context.Context, code string) error {
+	_, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return err
+	}
+	if uc.admins.policy.TOTPRequired(operator.Level) {
+		return ErrTOTPEnforced
+	}
+	if err := uc.verify(ctx, operator.ID, code, true); err != nil {
+		return err
+	}
+	if err := uc.repo.ClearAdminTOTP(ctx, operator.ID); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("admin totp disabled admin_id=%d", operator.ID)
+	return nil
+}
+
+// RegenerateRecoveryCodes 校验当前验证码后作废旧恢复码并生成新的一组。
+func (uc *AdminTOTPUsecase) RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error) {
+	_, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.verify(ctx, operator.ID, code, false); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := newAdminRecoveryCodes(operator.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.ReplaceRecoveryCodes(ctx, operator.ID, hashes, uc.now()); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("admin totp recovery codes regenerated admin_id=%d", operator.ID)
+	return codes, nil
+}
+
+// Reset 超级管理员为他人清除两步验证（如丢失手机且恢复码用尽），并注销对方全部会话。
+func (uc *AdminTOTPUsecase) Reset(ctx context.Context, adminID int) error {
+	_, operator, err := uc.admins.requireSuperAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if adminID <= 0 || adminID == operator.ID {
+		return ErrBadParam
+	}
+	if _, err := uc.admins.repo.GetAdminByID(ctx, adminID); err != nil {
+		return err
+	}
+	if err := uc.repo.ClearAdminTOTP(ctx, adminID); err != nil {
+		return err
+	}
+	uc.admins.revokeSessions(ctx, adminID, AdminSessionRevokeTOTPReset)
+	uc.log.WithContext(ctx).Infof("admin totp reset operator_id=%d admin_id=%d", operator.ID, adminID)
+	return nil
+}
+
+// VerifyLogin 登录第二步：未启用两步验证直接通过；已启用时 code 可为 6 位验证码或恢复码。
+func (uc *AdminTOTPUsecase) VerifyLogin(ctx context.Context, adminID int, code string) error {
+	state, err := uc.repo.GetAdminTOTP(ctx, adminID)
+	if err != nil {
+		return err
+	}
+	if !state.Enabled {
+		return nil
+	}
+	if strings.TrimSpace(code) == "" {
+		return ErrTOTPRequired
+	}
+	return uc.verify(ctx, adminID, code, true)
+}
+
+// verify 校验已启用的两步验证码；allowRecovery 时非 6 位数字按恢复码处理。
+func (uc *AdminTOTPUsecase) verify(ctx context.Context, adminID int, code string, allowRecovery bool) error {
+	state, err := uc.repo.GetAdminTOTP(ctx, adminID)
+	if err != nil {
+		return err
+	}
+	if !state.Enabled {
+		return ErrTOTPNotEnabled
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return ErrTOTPRequired
+	}
+	now := uc.now()
+	if isTOTPCode(code) {
+		step, ok := totp.Validate(state.Secret, code, now, adminTOTPSkew)
+		if !ok || step <= state.LastStep {
+			return ErrInvalidTOTP
+		}
+		advanced, err := uc.repo.AdvanceAdminTOTPStep(ctx, adminID, step)
+		if err != nil {
+			return err
+		}
+		if !advanced {
+			// 并发请求使用同一验证码
+			return ErrInvalidTOTP
+		}
+		return nil
+	}
+	if !allowRecovery {
+		return ErrInvalidTOTP
+	}
+	used, err := uc.repo.UseRecoveryCode(ctx, adminID, hashAdminRecoveryCode(adminID, code), now)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidTOTP
+	}
+	uc.log.WithContext(ctx).Infof("admin totp recovery code used admin_id=%d", adminID)
+	return nil
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// adminRecoveryCodeAlphabet 去掉易混淆的 0/1/l/o。
+const adminRecoveryCodeAlphabet = "23456789abcdefghijkmnpqrstuvwxyz"
+
+// newAdminRecoveryCodes 生成 xxxx-xxxx 格式恢复码及其摘要。
+func newAdminRecoveryCodes(adminID int) (codes []string, hashes []string, err error) {
+	buf := make([]byte, 8)
+	for i := 0; i < adminRecoveryCodeCount; i++ {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		var b strings.Builder
+		for j, c := range buf {
+			if j == 4 {
+				b.WriteByte('-')
+			}
+			b.WriteByte(adminRecoveryCodeAlphabet[int(c)%len(adminRecoveryCodeAlphabet)])
+		}
+		code := b.String()
+		codes = append(codes, code)
+		hashes = append(hashes, hashAdminRecoveryCode(adminID, code))
+	}
+	return codes, hashes, nil
+}
+
+// hashAdminRecoveryCode 忽略大小写、空格与连字符，摘要带上管理员 ID。
+func hashAdminRecoveryCode(adminID int, code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%s", adminID, normalized)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,186 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"server/pkg/totp"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type memAdminTOTPRepo struct {
+	states map[int]*AdminTOTP
+	codes  map[int]map[string]bool // hash -> used
+}
+
+func newMemAdminTOTPRepo() *memAdminTOTPRepo {
+	return &memAdminTOTPRepo{states: map[int]*AdminTOTP{}, codes: map[int]map[string]bool{}}
+}
+
+func (r *memAdminTOTPRepo) state(adminID int) *AdminTOTP {
+	if r.states[adminID] == nil {
+		r.states[adminID] = &AdminTOTP{AdminID: adminID}
+	}
+	return r.states[adminID]
+}
+
+func (r *memAdminTOTPRepo) GetAdminTOTP(ctx context.Context, adminID int) (*AdminTOTP, error) {
+	out := *r.state(adminID)
+	return &out, nil
+}
+
+func (r *memAdminTOTPRepo) SetAdminTOTPSecret(ctx context.Context, adminID int, secret string) error {
+	r.state(adminID).Secret = secret
+	return nil
+}
+
+func (r *memAdminTOTPRepo) EnableAdminTOTP(ctx context.Context, adminID int, step int64, hashes []string, t time.Time) error {
+	s := r.state(adminID)
+	s.Enabled, s.EnabledAt, s.LastStep = true, &t, step
+	return r.ReplaceRecoveryCodes(ctx, adminID, hashes, t)
+}
+
+func (r *memAdminTOTPRepo) AdvanceAdminTOTPStep(ctx context.Context, adminID int, step int64) (bool, error) {
+	s := r.state(adminID)
+	if step <= s.LastStep {
+		return false, nil
+	}
+	s.LastStep = step
+	return true, nil
+}
+
+func (r *memAdminTOTPRepo) ClearAdminTOTP(ctx context.Context, adminID int) error {
+	r.states[adminID] = &AdminTOTP{AdminID: adminID}
+	delete(r.codes, adminID)
+	return nil
+}
+
+func (r *memAdminTOTPRepo) ReplaceRecoveryCodes(ctx context.Context, adminID int, hashes []string, t time.Time) error {
+	r.codes[adminID] = map[string]bool{}
+	for _, hash := range hashes {
+		r.codes[adminID][hash] = false
+	}
+	return nil
+}
+
+func (r *memAdminTOTPRepo) UseRecoveryCode(ctx context.Context, adminID int, hash string, t time.Time) (bool, error) {
+	used, ok := r.codes[adminID][hash]
+	if !ok || used {
+		return false, nil
+	}
+	r.codes[adminID][hash] = true
+	return true, nil
+}
+
+func (r *memAdminTOTPRepo) CountRecoveryCodes(ctx context.Context, adminID int) (int, error) {
+	n := 0
+	for _, used := range r.codes[adminID] {
+		if !used {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func TestAdminTOTPUsecase_EnrollAndLogin(t *testing.T) {
+	sessions, _, _, _ := newTestAdminSessionUsecase(t)
+	logger := log.NewStdLogger(io.Discard)
+	repo := newMemAdminTOTPRepo()
+	uc := NewAdminTOTPUsecase(repo, sessions.admins, logger, nil)
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	salesCtx := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 3, Role: RoleAdmin})
+
+	if _, err := uc.Enable(salesCtx, "123456"); !errors.Is(err, ErrTOTPNotSetup) {
+		t.Fatalf("enable before setup should fail, got %v", err)
+	}
+	setup, err := uc.Setup(salesCtx)
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	if setup.ProvisioningURI != totp.ProvisioningURI("ERP", "sales", setup.Secret) {
+		t.Fatalf("unexpected provisioning uri %s", setup.ProvisioningURI)
+	}
+	if _, err := uc.Enable(salesCtx, "000000"); !errors.Is(err, ErrInvalidTOTP) {
+		t.Fatalf("wrong code should fail, got %v", err)
+	}
+	code, _ := totp.Code(setup.Secret, totp.Step(now))
+	recovery, err := uc.Enable(salesCtx, code)
+	if err != nil || len(recovery) != adminRecoveryCodeCount {
+		t.Fatalf("Enable() = %v, %v", recovery, err)
+	}
+
+	hash, _ := bcrypt.GenerateFromPassword([]byte("Erp#2026pass"), bcrypt.MinCost)
+	auth := NewAdminAuthUsecase(&memAdminAuthRepo{users: map[string]*AdminUser{
+		"sales": {ID: 3, Username: "sales", PasswordHash: string(hash)},
+	}}, nil, logger, nil)
+	auth.now = func() time.Time { return now }
+	auth.SetSecondFactor(uc)
+
+	if _, err := auth.Authenticate(context.Background(), "sales", "Erp#2026pass", ""); !errors.Is(err, ErrTOTPRequired) {
+		t.Fatalf("login without code should require totp, got %v", err)
+	}
+	// 启用时用过的验证码不能再次用于登录
+	if _, err := auth.Authenticate(context.Background(), "sales", "Erp#2026pass", code); !errors.Is(err, ErrInvalidTOTP) {
+		t.Fatalf("replayed code should fail, got %v", err)
+	}
+	now = now.Add(30 * time.Second)
+	next, _ := totp.Code(setup.Secret, totp.Step(now))
+	if _, err := auth.Authenticate(context.Background(), "sales", "Erp#2026pass", next); err != nil {
+		t.Fatalf("login with current code should succeed, got %v", err)
+	}
+	if _, err := auth.Authenticate(context.Background(), "sales", "Erp#2026pass", " "+recovery[0]+" "); err != nil {
+		t.Fatalf("login with recovery code should succeed, got %v", err)
+	}
+	if _, err := auth.Authenticate(context.Background(), "sales", "Erp#2026pass", recovery[0]); !errors.Is(err, ErrInvalidTOTP) {
+		t.Fatalf("recovery code should be single use, got %v", err)
+	}
+	status, _ := uc.Status(salesCtx)
+	if !status.Enabled || status.RecoveryCodesRemaining != adminRecoveryCodeCount-1 {
+		t.Fatalf("unexpected status %+v", status)
+	}
+}
+
+func TestAdminTOTPUsecase_EnforcedAndReset(t *testing.T) {
+	sessions, sessionRepo, _, _ := newTestAdminSessionUsecase(t)
+	policy := DefaultAdminSecurityPolicy()
+	policy.TOTPRequiredLevels = []AdminLevel{AdminLevelSecondary}
+	sessions.admins.SetSecurityPolicy(policy)
+	repo := newMemAdminTOTPRepo()
+	uc := NewAdminTOTPUsecase(repo, sessions.admins, log.NewStdLogger(io.Discard), nil)
+	salesCtx := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 3, Role: RoleAdmin})
+	rootCtx := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Role: RoleAdmin})
+
+	setup, _ := uc.Setup(salesCtx)
+	code, _ := totp.Code(setup.Secret, totp.Step(time.Now()))
+	if _, err := uc.Enable(salesCtx, code); err != nil {
+		t.Fatalf("Enable() error = %v", err)
+	}
+	if err := uc.Disable(salesCtx, code); !errors.Is(err, ErrTOTPEnforced) {
+		t.Fatalf("enforced level should not disable, got %v", err)
+	}
+	if _, err := uc.Setup(salesCtx); !errors.Is(err, ErrTOTPAlreadyEnabled) {
+		t.Fatalf("setup when enabled should fail, got %v", err)
+	}
+
+	if _, err := sessions.Start(context.Background(), &AdminUser{ID: 3, Username: "sales"}); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := uc.Reset(salesCtx, 1); !errors.Is(err, ErrNoPermission) {
+		t.Fatalf("non-super reset should be rejected, got %v", err)
+	}
+	if err := uc.Reset(rootCtx, 3); err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+	if repo.states[3].Enabled || repo.states[3].Secret != "" || len(repo.codes[3]) != 0 {
+		t.Fatalf("reset should clear totp, got %+v", repo.states[3])
+	}
+	if sessionRepo.sessions[1].RevokeReason != AdminSessionRevokeTOTPReset {
+		t.Fatalf("reset should revoke sessions, got %+v", sessionRepo.sessions[1])
+	}
+}
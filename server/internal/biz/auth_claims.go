@@ -14,6 +14,9 @@ type AuthClaims struct {
 	UserID   int
 	Username string
 	Role     Role
+	// SessionID/TokenID 来自 token 的 sid/jti，管理员请求据此校验服务端会话。
+	SessionID string
+	TokenID   string
 }
 
 type ctxKeyClaims struct{}
@@ -0,0 +1,20 @@
+package biz
+
+import "context"
+
+// ClientInfo 请求来源，由 service 层从 HTTP 头解析后写入 ctx。
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+type ctxKeyClientInfo struct{}
+
+func NewContextWithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, ctxKeyClientInfo{}, info)
+}
+
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(ctxKeyClientInfo{}).(ClientInfo)
+	return info
+}
@@ -19,14 +19,18 @@ type ERPRecord struct {
 	Payload          map[string]any
 	CreatedByAdminID *int
 	UpdatedByAdminID *int
+	Version          int // 乐观锁版本，每次修改加 1
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
 
 type ERPRepo interface {
 	ListByModule(ctx context.Context, moduleKey string) ([]*ERPRecord, error)
+	// GetByID 按 id 读取模块内的一条记录，记录范围同 ListByModule；不存在或不在范围内时返回 ErrERPRecordNotFound。
+	GetByID(ctx context.Context, moduleKey string, id int) (*ERPRecord, error)
 	Create(ctx context.Context, moduleKey string, payload map[string]any, createdByAdminID int) (*ERPRecord, error)
-	Update(ctx context.Context, moduleKey string, id int, payload map[string]any, updatedByAdminID int) (*ERPRecord, error)
+	// Update 修改记录；expectedVersion > 0 时须与当前版本一致，否则返回 ErrERPVersionConflict。
+	Update(ctx context.Context, moduleKey string, id int, payload map[string]any, expectedVersion, updatedByAdminID int) (*ERPRecord, error)
 	Delete(ctx context.Context, moduleKey string, id int) error
 }
 
@@ -34,6 +38,9 @@ type ERPUsecase struct {
 	repo ERPRepo
 	log  *log.Helper
 	tp   *tracesdk.TracerProvider
+
+	fulfilmentTolerance float64 // 下游累计数量允许超出来源数量的默认百分比
+	minMarginPercent    float64 // 报价单价相对最近采购成本的最低加成百分比
 }
 
 func NewERPUsecase(repo ERPRepo, logger log.Logger, tp *tracesdk.TracerProvider) *ERPUsecase {
@@ -48,11 +55,13 @@ var (
 	ErrERPInvalidModule  = errors.New("invalid module")
 	ErrERPInvalidRecord  = errors.New("invalid record")
 	ErrERPRecordNotFound = errors.New("erp record not found")
+	// ErrERPVersionConflict 记录在读取后已被他人修改。
+	ErrERPVersionConflict = errors.New("erp record version conflict")
 )
 
 func (uc *ERPUsecase) List(ctx context.Context, moduleKey string) ([]map[string]any, error) {
 	var err error
-	moduleKey, err = normalizeERPModuleKey(moduleKey)
+	moduleKey, err = NormalizeERPModuleKey(moduleKey)
 	if err != nil {
 		return nil, err
 	}
@@ -62,38 +71,63 @@ func (uc *ERPUsecase) List(ctx context.Context, moduleKey string) ([]map[string]
 		return nil, err
 	}
 
+	masked := erpAmountMasked(ctx, moduleKey)
 	out := make([]map[string]any, 0, len(records))
 	for _, item := range records {
-		out = append(out, toERPRecordView(item))
+		out = append(out, toERPRecordView(item, masked))
 	}
 	return out, nil
 }
 
 func (uc *ERPUsecase) Create(ctx context.Context, moduleKey string, payload map[string]any, operatorAdminID int) (map[string]any, error) {
 	var err error
-	moduleKey, err = normalizeERPModuleKey(moduleKey)
+	moduleKey, err = NormalizeERPModuleKey(moduleKey)
 	if err != nil {
 		return nil, err
 	}
-	cleanPayload, err := normalizeERPPayload(payload)
+	// 生产单的用料与库存锁定只能由外销合同明细生成（GenerateProductionOrder）
+	if moduleKey == ERPModuleProductionOrders {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "sourceExportCode", Message: "生产单须由外销合同明细生成"}}}
+	}
+	cleanPayload, err := uc.prepareCreate(ctx, moduleKey, payload)
 	if err != nil {
 		return nil, err
 	}
-	cleanPayload, err = applyERPModuleRules(moduleKey, cleanPayload)
+
+	record, err := uc.repo.Create(ctx, moduleKey, cleanPayload, operatorAdminID)
 	if err != nil {
 		return nil, err
 	}
+	return toERPRecordView(record, erpAmountMasked(ctx, moduleKey)), nil
+}
 
-	record, err := uc.repo.Create(ctx, moduleKey, cleanPayload, operatorAdminID)
+// prepareCreate 规范化新建记录的内容，补齐派生字段并完成全部校验，返回待保存的内容。
+func (uc *ERPUsecase) prepareCreate(ctx context.Context, moduleKey string, payload map[string]any) (map[string]any, error) {
+	cleanPayload, err := normalizeERPPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	lookup := uc.recordLookup(ctx)
+	cleanPayload, err = applyERPModuleRules(moduleKey, cleanPayload, lookup)
 	if err != nil {
 		return nil, err
 	}
-	return toERPRecordView(record), nil
+	if err := checkERPHSCodeUnique(moduleKey, 0, cleanPayload, lookup); err != nil {
+		return nil, err
+	}
+	if err := uc.flagERPQuotationMargins(moduleKey, cleanPayload, lookup); err != nil {
+		return nil, err
+	}
+	if err := uc.checkERPFulfilment(ctx, moduleKey, 0, cleanPayload); err != nil {
+		return nil, err
+	}
+	return cleanPayload, nil
 }
 
-func (uc *ERPUsecase) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, operatorAdminID int) (map[string]any, error) {
+// Update 修改记录；expectedVersion 为调用方读取时的版本，0 表示不校验。
+func (uc *ERPUsecase) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, expectedVersion, operatorAdminID int) (map[string]any, error) {
 	var err error
-	moduleKey, err = normalizeERPModuleKey(moduleKey)
+	moduleKey, err = NormalizeERPModuleKey(moduleKey)
 	if err != nil {
 		return nil, err
 	}
@@ -104,30 +138,86 @@ func (uc *ERPUsecase) Update(ctx context.Context, moduleKey string, id int, payl
 	if err != nil {
 		return nil, err
 	}
-	cleanPayload, err = applyERPModuleRules(moduleKey, cleanPayload)
+	stored, err := uc.findRecordByID(ctx, moduleKey, id)
+	if err != nil {
+		return nil, err
+	}
+	masked := erpAmountMasked(ctx, moduleKey)
+	if masked {
+		restoreERPMaskedFields(moduleKey, cleanPayload, stored.Payload)
+	}
+	if moduleKey == ERPModuleProductionOrders {
+		restoreERPProductionFields(cleanPayload, stored.Payload)
+	}
+	lookup := uc.recordLookup(ctx)
+	cleanPayload, err = applyERPModuleRules(moduleKey, cleanPayload, lookup)
 	if err != nil {
 		return nil, err
 	}
+	// 单号、往来单位名称等被引用的键不能改成其他单据无法再引用的值
+	if err := checkERPRecordInUse(moduleKey, stored, cleanPayload, lookup); err != nil {
+		return nil, err
+	}
+	if err := checkERPHSCodeUnique(moduleKey, id, cleanPayload, lookup); err != nil {
+		return nil, err
+	}
+	if err := uc.flagERPQuotationMargins(moduleKey, cleanPayload, lookup); err != nil {
+		return nil, err
+	}
+	if err := uc.checkERPFulfilment(ctx, moduleKey, id, cleanPayload); err != nil {
+		return nil, err
+	}
 
-	record, err := uc.repo.Update(ctx, moduleKey, id, cleanPayload, operatorAdminID)
+	record, err := uc.repo.Update(ctx, moduleKey, id, cleanPayload, expectedVersion, operatorAdminID)
 	if err != nil {
 		return nil, err
 	}
-	return toERPRecordView(record), nil
+	return toERPRecordView(record, masked), nil
 }
 
 func (uc *ERPUsecase) Delete(ctx context.Context, moduleKey string, id int) error {
 	var err error
-	moduleKey, err = normalizeERPModuleKey(moduleKey)
+	moduleKey, err = NormalizeERPModuleKey(moduleKey)
 	if err != nil {
 		return err
 	}
 	if id <= 0 {
 		return ErrBadParam
 	}
+	stored, err := uc.findRecordByID(ctx, moduleKey, id)
+	if err != nil {
+		return err
+	}
+	if moduleKey == ERPModuleProductionOrders {
+		if err := checkERPProductionDeletable(stored); err != nil {
+			return err
+		}
+	}
+	if err := checkERPRecordInUse(moduleKey, stored, nil, uc.recordLookup(ctx)); err != nil {
+		return err
+	}
 	return uc.repo.Delete(ctx, moduleKey, id)
 }
 
+// Get 返回单条记录视图（按记录范围过滤、按权限脱敏），用于版本冲突时回传服务端当前内容。
+func (uc *ERPUsecase) Get(ctx context.Context, moduleKey string, id int) (map[string]any, error) {
+	var err error
+	moduleKey, err = NormalizeERPModuleKey(moduleKey)
+	if err != nil {
+		return nil, err
+	}
+	record, err := uc.findRecordByID(ctx, moduleKey, id)
+	if err != nil {
+		return nil, err
+	}
+	return toERPRecordView(record, erpAmountMasked(ctx, moduleKey)), nil
+}
+
+// findRecordByID 在模块记录（已按记录范围过滤）中查找 id。
+func (uc *ERPUsecase) findRecordByID(ctx context.Context, moduleKey string, id int) (*ERPRecord, error) {
+	return uc.repo.GetByID(ctx, moduleKey, id)
+}
+
 func normalizeERPPayload(input map[string]any) (map[string]any, error) {
 	if input == nil {
 		return nil, ErrERPInvalidRecord
@@ -139,7 +229,7 @@ func normalizeERPPayload(input map[string]any) (map[string]any, error) {
 		if k == "" {
 			continue
 		}
-		if k == "id" || k == "module_key" || k == "created_at" || k == "updated_at" {
+		if k == "id" || k == "module_key" || k == "version" || k == "created_at" || k == "updated_at" {
 			continue
 		}
 		copyMap[k] = value
@@ -157,7 +247,8 @@ func normalizeERPPayload(input map[string]any) (map[string]any, error) {
 	return normalized, nil
 }
 
-func toERPRecordView(item *ERPRecord) map[string]any {
+// toERPRecordView 输出记录视图；maskAmounts 为 true 时隐藏模块敏感字段（见 erpSensitiveFields）。
+func toERPRecordView(item *ERPRecord, maskAmounts bool) map[string]any {
 	if item == nil {
 		return map[string]any{}
 	}
@@ -174,7 +265,11 @@ func toERPRecordView(item *ERPRecord) map[string]any {
 		out["box"] = item.Box
 	}
 	out["module_key"] = item.ModuleKey
+	out["version"] = item.Version
 	out["created_at"] = item.CreatedAt.Unix()
 	out["updated_at"] = item.UpdatedAt.Unix()
+	if maskAmounts {
+		maskERPPayload(item.ModuleKey, out)
+	}
 	return out
 }
@@ -0,0 +1,237 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 审计动作（erp_audit_logs.action）。
+const (
+	ERPAuditCreate = "create"
+	ERPAuditUpdate = "update"
+	ERPAuditDelete = "delete"
+)
+
+const (
+	erpAuditDefaultLimit = 30
+	erpAuditMaxLimit     = 200
+)
+
+// ERPAuditChange 单个字段的变更；字段路径形如 "customerName"、"items[0].unitPrice"。
+// 新增时 Old 为空，删除时 New 为空。
+type ERPAuditChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// ERPAuditEntry 一条审计记录。
+type ERPAuditEntry struct {
+	ID            int
+	Action        string
+	ModuleKey     string
+	RecordID      int
+	Code          string
+	AdminID       *int
+	AdminUsername string
+	RequestID     string
+	IP            string
+	Changes       []ERPAuditChange
+	CreatedAt     time.Time
+}
+
+// ERPAuditFilter 审计查询条件；零值表示不过滤。
+type ERPAuditFilter struct {
+	ModuleKey string
+	RecordID  int
+	Code      string
+	AdminID   int
+	From      time.Time
+	To        time.Time
+	Limit     int
+	Offset    int
+}
+
+type ERPAuditRepo interface {
+	// ListAuditLogs 按时间倒序返回一页记录及总数。
+	ListAuditLogs(ctx context.Context, filter ERPAuditFilter) ([]*ERPAuditEntry, int, error)
+}
+
+// DiffERPPayload 比较两个 payload，返回按字段路径排序的变更。
+// 嵌套对象展开为 "a.b"，对象数组按下标展开为 "items[0].unitPrice"，标量数组整体比较。
+func DiffERPPayload(before, after map[string]any) []ERPAuditChange {
+	oldFields := map[string]any{}
+	newFields := map[string]any{}
+	flattenERPPayload("", before, oldFields)
+	flattenERPPayload("", after, newFields)
+
+	changes := []ERPAuditChange{}
+	for field, oldValue := range oldFields {
+		newValue, ok := newFields[field]
+		if !ok {
+			changes = append(changes, ERPAuditChange{Field: field, Old: oldValue})
+			continue
+		}
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, ERPAuditChange{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+	for field, newValue := range newFields {
+		if _, ok := oldFields[field]; !ok {
+			changes = append(changes, ERPAuditChange{Field: field, New: newValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+func flattenERPPayload(prefix string, value any, out map[string]any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flattenERPPayload(path, item, out)
+		}
+	case []any:
+		objects := len(v) > 0
+		for _, item := range v {
+			if _, ok := item.(map[string]any); !ok {
+				objects = false
+				break
+			}
+		}
+		if !objects {
+			out[prefix] = normalizeERPAuditValue(v)
+			return
+		}
+		for index, item := range v {
+			flattenERPPayload(fmt.Sprintf("%s[%d]", prefix, index), item, out)
+		}
+	default:
+		if prefix != "" {
+			out[prefix] = normalizeERPAuditValue(v)
+		}
+	}
+}
+
+// normalizeERPAuditValue 统一数字类型（int 与 float64 视为同一值），便于比较与序列化。
+func normalizeERPAuditValue(v any) any {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return v
+	}
+	return out
+}
+
+// erpAuditFieldSensitive 判断变更字段是否为模块的金额字段（"items[3].unitPrice" 对应 "items[].unitPrice"）。
+func erpAuditFieldSensitive(moduleKey, field string) bool {
+	var b strings.Builder
+	for i := 0; i < len(field); i++ {
+		if field[i] == '[' {
+			if end := strings.IndexByte(field[i:], ']'); end > 0 {
+				b.WriteString("[]")
+				i += end
+				continue
+			}
+		}
+		b.WriteByte(field[i])
+	}
+	normalized := b.String()
+	for _, path := range erpSensitiveFields[moduleKey] {
+		if normalized == path {
+			return true
+		}
+	}
+	return false
+}
+
+// ERPAuditUsecase 审计查询：超级管理员可查全部；其他管理员须指定模块，并拥有该模块 view 与 view_all，
+// 缺少 view_amounts 时隐藏金额字段的变更。
+type ERPAuditUsecase struct {
+	repo   ERPAuditRepo
+	admins *AdminManageUsecase
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewERPAuditUsecase(repo ERPAuditRepo, admins *AdminManageUsecase, logger log.Logger, tp *tracesdk.TracerProvider) *ERPAuditUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.erp_audit"))
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.erp_audit")
+	} else {
+		tr = otel.Tracer("biz.erp_audit")
+	}
+	return &ERPAuditUsecase{
+		repo:   repo,
+		admins: admins,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *ERPAuditUsecase) Query(ctx context.Context, filter ERPAuditFilter) ([]*ERPAuditEntry, int, error) {
+	_, operator, err := uc.admins.requireAdminAccount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if filter.ModuleKey != "" {
+		if filter.ModuleKey, err = NormalizeERPModuleKey(filter.ModuleKey); err != nil {
+			return nil, 0, err
+		}
+	}
+	maskAmounts := false
+	if operator.Level != AdminLevelSuper {
+		if filter.ModuleKey == "" {
+			return nil, 0, ErrBadParam
+		}
+		perms := EffectiveAdminPermissions(operator)
+		if !perms.Allows(filter.ModuleKey, ERPActionView) || !perms.Allows(filter.ModuleKey, ERPActionViewAll) {
+			return nil, 0, ErrNoPermission
+		}
+		maskAmounts = !perms.Allows(filter.ModuleKey, ERPActionViewAmounts)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = erpAuditDefaultLimit
+	}
+	if filter.Limit > erpAuditMaxLimit {
+		filter.Limit = erpAuditMaxLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	entries, total, err := uc.repo.ListAuditLogs(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	if maskAmounts {
+		for _, entry := range entries {
+			visible := make([]ERPAuditChange, 0, len(entry.Changes))
+			for _, change := range entry.Changes {
+				if !erpAuditFieldSensitive(entry.ModuleKey, change.Field) {
+					visible = append(visible, change)
+				}
+			}
+			entry.Changes = visible
+		}
+	}
+	return entries, total, nil
+}
@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type memERPAuditRepo struct {
+	entries []*ERPAuditEntry
+	filter  ERPAuditFilter
+}
+
+func (r *memERPAuditRepo) ListAuditLogs(ctx context.Context, filter ERPAuditFilter) ([]*ERPAuditEntry, int, error) {
+	r.filter = filter
+	out := make([]*ERPAuditEntry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		copied := *entry
+		copied.Changes = append([]ERPAuditChange(nil), entry.Changes...)
+		out = append(out, &copied)
+	}
+	return out, len(out), nil
+}
+
+func TestDiffERPPayload(t *testing.T) {
+	before := map[string]any{
+		"code":         "CG-001",
+		"supplierName": "工厂A",
+		"tags":         []any{"a", "b"},
+		"items": []any{
+			map[string]any{"productName": "磁钢A", "quantity": 100, "unitPrice": 5},
+		},
+	}
+	after := map[string]any{
+		"code":         "CG-001",
+		"supplierName": "工厂B",
+		"tags":         []any{"a", "b"},
+		"items": []any{
+			map[string]any{"productName": "磁钢A", "quantity": float64(100), "unitPrice": 6},
+			map[string]any{"productName": "磁钢B", "quantity": 10},
+		},
+	}
+	got := DiffERPPayload(before, after)
+	want := []ERPAuditChange{
+		{Field: "items[0].unitPrice", Old: float64(5), New: float64(6)},
+		{Field: "items[1].productName", New: "磁钢B"},
+		{Field: "items[1].quantity", New: float64(10)},
+		{Field: "supplierName", Old: "工厂A", New: "工厂B"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("DiffERPPayload() = %+v, want %+v", got, want)
+	}
+
+	if changes := DiffERPPayload(nil, map[string]any{"code": "CG-002"}); len(changes) != 1 || changes[0].Old != nil || changes[0].New != "CG-002" {
+		t.Fatalf("create diff unexpected: %+v", changes)
+	}
+	if changes := DiffERPPayload(map[string]any{"code": "CG-002"}, nil); len(changes) != 1 || changes[0].Old != "CG-002" || changes[0].New != nil {
+		t.Fatalf("delete diff unexpected: %+v", changes)
+	}
+	if changes := DiffERPPayload(after, after); len(changes) != 0 {
+		t.Fatalf("identical payloads should have no changes: %+v", changes)
+	}
+}
+
+func TestERPAuditUsecase_QueryPermissions(t *testing.T) {
+	viewer := &AdminRole{Grants: map[string][]string{
+		ERPModulePurchaseContracts: {ERPActionView, ERPActionViewAll},
+	}}
+	ownOnly := &AdminRole{Grants: map[string][]string{
+		ERPModulePurchaseContracts: {ERPActionView},
+	}}
+	admins := &memAdminManageRepo{admins: map[int]*AdminAccount{
+		1: {ID: 1, Username: "root", Level: AdminLevelSuper},
+		2: {ID: 2, Username: "auditor", Level: AdminLevelPrimary, RoleBased: true, Roles: []*AdminRole{viewer}},
+		3: {ID: 3, Username: "sales", Level: AdminLevelSecondary, RoleBased: true, Roles: []*AdminRole{ownOnly}},
+	}}
+	logger := log.NewStdLogger(io.Discard)
+	repo := &memERPAuditRepo{entries: []*ERPAuditEntry{{
+		ID:        1,
+		Action:    ERPAuditUpdate,
+		ModuleKey: ERPModulePurchaseContracts,
+		RecordID:  7,
+		Changes: []ERPAuditChange{
+			{Field: "deliveryDate", Old: "2026-02-01", New: "2026-02-15"},
+			{Field: "items[0].unitPrice", Old: float64(5), New: float64(6)},
+			{Field: "totalAmount", Old: float64(500), New: float64(600)},
+		},
+	}}}
+	uc := NewERPAuditUsecase(repo, NewAdminManageUsecase(admins, logger, nil), logger, nil)
+	ctxFor := func(adminID int) context.Context {
+		return NewContextWithClaims(context.Background(), &AuthClaims{UserID: adminID, Role: RoleAdmin})
+	}
+
+	entries, total, err := uc.Query(ctxFor(1), ERPAuditFilter{Limit: 1000})
+	if err != nil || total != 1 || len(entries[0].Changes) != 3 {
+		t.Fatalf("super admin query = %+v, %d, %v", entries, total, err)
+	}
+	if repo.filter.Limit != erpAuditMaxLimit {
+		t.Fatalf("limit should be capped, got %d", repo.filter.Limit)
+	}
+
+	if _, _, err := uc.Query(ctxFor(2), ERPAuditFilter{}); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("non-super query without module should fail, got %v", err)
+	}
+	if _, _, err := uc.Query(ctxFor(3), ERPAuditFilter{ModuleKey: ERPModulePurchaseContracts}); !errors.Is(err, ErrNoPermission) {
+		t.Fatalf("admin without view_all should be denied, got %v", err)
+	}
+	entries, _, err = uc.Query(ctxFor(2), ERPAuditFilter{ModuleKey: ERPModulePurchaseContracts})
+	if err != nil {
+		t.Fatalf("auditor query error = %v", err)
+	}
+	if repo.filter.Limit != erpAuditDefaultLimit {
+		t.Fatalf("limit should default, got %d", repo.filter.Limit)
+	}
+	if len(entries[0].Changes) != 1 || entries[0].Changes[0].Field != "deliveryDate" {
+		t.Fatalf("amount changes should be masked without view_amounts: %+v", entries[0].Changes)
+	}
+}
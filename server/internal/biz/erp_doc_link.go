@@ -0,0 +1,55 @@
+package biz
+
+import (
+	"context"
+	"strings"
+)
+
+// ERPDocLink 单据链路（erp_doc_links），记录上下游单据之间的派生/关联关系。
+type ERPDocLink struct {
+	FromModule   string
+	FromCode     string
+	ToModule     string
+	ToCode       string
+	RelationType string
+}
+
+// ERPDocLinkRepo 由支持单据链路表的仓储实现；未实现时链路只按 payload 中的来源字段推导。
+type ERPDocLinkRepo interface {
+	ListDocLinksByModule(ctx context.Context, moduleKey string) ([]*ERPDocLink, error)
+}
+
+// loadDocLinks 读取与 moduleKey 相关的单据链路并挂到 dataset 上。
+func (uc *ERPUsecase) loadDocLinks(ctx context.Context, ds *erpDataset, moduleKey string) error {
+	linkRepo, ok := uc.repo.(ERPDocLinkRepo)
+	if !ok {
+		return nil
+	}
+	links, err := linkRepo.ListDocLinksByModule(ctx, moduleKey)
+	if err != nil {
+		return err
+	}
+	ds.links = append(ds.links, links...)
+	return nil
+}
+
+// linkedCodes 返回通过单据链路与 (moduleKey, code) 相连的 otherModule 单据编号，不区分方向。
+func (ds *erpDataset) linkedCodes(moduleKey, code, otherModule string) []string {
+	code = strings.TrimSpace(code)
+	if ds == nil || code == "" {
+		return nil
+	}
+	out := []string{}
+	for _, link := range ds.links {
+		if link == nil {
+			continue
+		}
+		switch {
+		case link.FromModule == moduleKey && link.FromCode == code && link.ToModule == otherModule:
+			out = append(out, link.ToCode)
+		case link.ToModule == moduleKey && link.ToCode == code && link.FromModule == otherModule:
+			out = append(out, link.FromCode)
+		}
+	}
+	return out
+}
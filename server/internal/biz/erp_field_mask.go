@@ -0,0 +1,131 @@
+package biz
+
+import (
+	"context"
+	"strings"
+)
+
+// erpSensitiveFields 各模块的金额/价格类字段，缺少 view_amounts 时在视图中隐藏。
+// "items[].unitPrice" 表示明细数组 items 每行的 unitPrice。
+var erpSensitiveFields = map[string][]string{
+	ERPModuleQuotations: {
+		"totalAmount", "items[].unitPrice", "items[].totalPrice",
+		"items[].suggestedPrice", "items[].purchaseCost", "items[].marginPercent",
+	},
+	ERPModuleExportSales:       {"totalAmount", "freightCost", "otherCost", "bankFee", "items[].unitPrice", "items[].totalPrice"},
+	ERPModulePurchaseContracts: {"totalAmount", "items[].unitPrice", "items[].totalPrice"},
+	ERPModuleShipmentDetails:   {"totalAmount", "items[].unitPrice", "items[].totalPrice"},
+	ERPModuleInbound:           {"unitPrice", "amount"},
+	ERPModuleOutbound:          {"unitPrice", "amount"},
+	ERPModuleSettlements:       {"amount", "lines[].unitPrice", "lines[].amount"},
+	ERPModuleBankReceipts:      {"receivedAmount", "bankFee", "allocations[].amount"},
+	ERPModuleSupplierInvoices:  {"invoiceAmount", "taxAmount", "amountExclTax"},
+	ERPModuleSupplierPayments:  {"paymentAmount"},
+	ERPModuleShipmentCosts:     {"amount"},
+	ERPModulePriceLists:        {"items[].unitPrice"},
+}
+
+// ERPSensitiveFields 返回模块的敏感字段。
+func ERPSensitiveFields(moduleKey string) []string {
+	return append([]string(nil), erpSensitiveFields[moduleKey]...)
+}
+
+type ctxKeyERPAmountMask struct{}
+
+// NewContextWithERPAmountMask 标记当前请求不可查看金额：视图隐藏敏感字段，更新时保留已存值。
+func NewContextWithERPAmountMask(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKeyERPAmountMask{}, true)
+}
+
+func erpAmountMasked(ctx context.Context, moduleKey string) bool {
+	if len(erpSensitiveFields[moduleKey]) == 0 {
+		return false
+	}
+	masked, _ := ctx.Value(ctxKeyERPAmountMask{}).(bool)
+	return masked
+}
+
+// splitERPFieldPath 拆分 "items[].unitPrice" 为 ("items", "unitPrice")；顶层字段 list 为空。
+func splitERPFieldPath(path string) (list, field string) {
+	if idx := strings.Index(path, "[]."); idx > 0 {
+		return path[:idx], path[idx+3:]
+	}
+	return "", path
+}
+
+// maskERPPayload 从视图中移除敏感字段；明细行复制后再移除，不影响原记录。
+func maskERPPayload(moduleKey string, view map[string]any) {
+	for _, path := range erpSensitiveFields[moduleKey] {
+		list, field := splitERPFieldPath(path)
+		if list == "" {
+			delete(view, field)
+			continue
+		}
+		rows, ok := view[list].([]any)
+		if !ok {
+			continue
+		}
+		masked := make([]any, 0, len(rows))
+		for _, raw := range rows {
+			row, ok := raw.(map[string]any)
+			if !ok {
+				masked = append(masked, raw)
+				continue
+			}
+			copied := make(map[string]any, len(row))
+			for key, value := range row {
+				if key != field {
+					copied[key] = value
+				}
+			}
+			masked = append(masked, copied)
+		}
+		view[list] = masked
+	}
+}
+
+// restoreERPMaskedFields 用已存值覆盖不可见的敏感字段，避免看不到金额的用户保存时清空或改写。
+// 明细行优先按 lineNo 对应，没有行号时按位置对应；新增行没有已存值则不带该字段。
+func restoreERPMaskedFields(moduleKey string, payload, stored map[string]any) {
+	for _, path := range erpSensitiveFields[moduleKey] {
+		list, field := splitERPFieldPath(path)
+		if list == "" {
+			restoreERPField(payload, stored, field)
+			continue
+		}
+		rows, ok := payload[list].([]any)
+		if !ok {
+			continue
+		}
+		storedRows, _ := stored[list].([]any)
+		byLineNo := map[float64]map[string]any{}
+		for _, raw := range storedRows {
+			if row, ok := raw.(map[string]any); ok {
+				if lineNo, ok := toERPFloat64(row["lineNo"]); ok && lineNo > 0 {
+					byLineNo[lineNo] = row
+				}
+			}
+		}
+		for index, raw := range rows {
+			row, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			var previous map[string]any
+			if lineNo, ok := toERPFloat64(row["lineNo"]); ok && lineNo > 0 {
+				previous = byLineNo[lineNo]
+			} else if index < len(storedRows) {
+				previous, _ = storedRows[index].(map[string]any)
+			}
+			restoreERPField(row, previous, field)
+		}
+	}
+}
+
+func restoreERPField(target, stored map[string]any, field string) {
+	if value, ok := stored[field]; ok {
+		target[field] = value
+		return
+	}
+	delete(target, field)
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecaseMaskAmounts(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作供应商", "工厂A")
+	seedERPRecords(t, repo, ERPModuleExportSales, map[string]any{"code": "XS-001", "customerName": "客户A"})
+
+	created, err := uc.Create(ctx, ERPModulePurchaseContracts, map[string]any{
+		"code": "CG-001", "supplierName": "工厂A", "signDate": "2026-01-10", "salesNo": "XS-001",
+		"deliveryDate": "2026-02-01", "deliveryAddress": "宁波", "invoiceRequired": "是",
+		"items": []any{
+			map[string]any{"productName": "磁钢A", "quantity": 100, "unitPrice": 5},
+			map[string]any{"productName": "磁钢B", "quantity": 10, "unitPrice": 20},
+		},
+	}, 1)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	id := created["id"].(int)
+
+	masked := NewContextWithERPAmountMask(ctx)
+	list, err := uc.List(masked, ERPModulePurchaseContracts)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("masked list failed: %v %v", list, err)
+	}
+	view := list[0]
+	row := view["items"].([]any)[0].(map[string]any)
+	if _, ok := view["totalAmount"]; ok {
+		t.Fatalf("totalAmount should be masked: %v", view)
+	}
+	if _, ok := row["unitPrice"]; ok || row["quantity"] == nil {
+		t.Fatalf("unitPrice should be masked but quantity kept: %v", row)
+	}
+
+	// 看不到单价的用户修改交期并调整数量：单价保留已存值，合计按已存单价重算。
+	view["deliveryDate"] = "2026-02-15"
+	view["items"].([]any)[0].(map[string]any)["quantity"] = 120
+	view["items"].([]any)[1].(map[string]any)["unitPrice"] = 0
+	if _, err := uc.Update(masked, ERPModulePurchaseContracts, id, view, 0, 2); err != nil {
+		t.Fatalf("masked update failed: %v", err)
+	}
+	stored, err := uc.List(ctx, ERPModulePurchaseContracts)
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	items := stored[0]["items"].([]any)
+	if items[0].(map[string]any)["unitPrice"] != float64(5) || items[1].(map[string]any)["unitPrice"] != float64(20) {
+		t.Fatalf("unit prices should be preserved: %v", items)
+	}
+	if total, _ := toERPFloat64(stored[0]["totalAmount"]); total != 800 || stored[0]["deliveryDate"] != "2026-02-15" {
+		t.Fatalf("unexpected stored record: total=%v delivery=%v", stored[0]["totalAmount"], stored[0]["deliveryDate"])
+	}
+}
+
+// 敏感字段须是模块 Schema 中真实存在的字段：按 Schema 属性构造记录，脱敏后全部隐藏，更新时全部按已存值恢复。
+func TestERPSensitiveFieldsMatchSchemas(t *testing.T) {
+	uc := &ERPUsecase{}
+	for moduleKey, paths := range erpSensitiveFields {
+		schema, err := uc.Schema(context.Background(), moduleKey)
+		if err != nil {
+			t.Fatalf("schema %s: %v", moduleKey, err)
+		}
+		stored := map[string]any{}
+		for _, path := range paths {
+			list, field := splitERPFieldPath(path)
+			props, _ := schema["properties"].(map[string]any)
+			if list != "" {
+				listSchema, _ := props[list].(map[string]any)
+				rowSchema, _ := listSchema["items"].(map[string]any)
+				if ref, ok := rowSchema["$ref"].(string); ok {
+					defs, _ := schema["$defs"].(map[string]any)
+					rowSchema, _ = defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any)
+				}
+				props, _ = rowSchema["properties"].(map[string]any)
+			}
+			if _, ok := props[field]; !ok {
+				t.Fatalf("%s sensitive field %s is not in the schema", moduleKey, path)
+			}
+			if list == "" {
+				stored[field] = float64(7)
+				continue
+			}
+			if _, ok := stored[list]; !ok {
+				stored[list] = []any{map[string]any{"lineNo": float64(1), "quantity": float64(3)}}
+			}
+			stored[list].([]any)[0].(map[string]any)[field] = float64(7)
+		}
+
+		view := cloneERPValue(stored).(map[string]any)
+		maskERPPayload(moduleKey, view)
+		for _, path := range paths {
+			list, field := splitERPFieldPath(path)
+			row := view
+			if list != "" {
+				row = view[list].([]any)[0].(map[string]any)
+			}
+			if _, ok := row[field]; ok {
+				t.Fatalf("%s field %s should be masked: %v", moduleKey, path, view)
+			}
+		}
+
+		restoreERPMaskedFields(moduleKey, view, stored)
+		if !reflect.DeepEqual(view, stored) {
+			t.Fatalf("%s restore = %v, want %v", moduleKey, view, stored)
+		}
+	}
+}
@@ -0,0 +1,444 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// erpFulfilmentFlow 来源单据到下游单据的数量履约关系：下游按产品累计的数量不能超过来源数量加容差。
+type erpFulfilmentFlow struct {
+	Source      string
+	Target      string
+	SourceLabel string
+	Label       string
+	consumers   func(ds *erpDataset, source *ERPRecord) []*ERPRecord
+}
+
+var erpFulfilmentFlows = []erpFulfilmentFlow{
+	{Source: ERPModuleExportSales, Target: ERPModulePurchaseContracts, SourceLabel: "外销合同", Label: "采购",
+		consumers: func(ds *erpDataset, source *ERPRecord) []*ERPRecord { return ds.linkedPurchaseContracts(source.Code) }},
+	{Source: ERPModuleExportSales, Target: ERPModuleShipmentDetails, SourceLabel: "外销合同", Label: "出运",
+		consumers: func(ds *erpDataset, source *ERPRecord) []*ERPRecord { return ds.linkedShipments(source.Code) }},
+	{Source: ERPModulePurchaseContracts, Target: ERPModuleInbound, SourceLabel: "采购合同", Label: "入库",
+		consumers: func(ds *erpDataset, source *ERPRecord) []*ERPRecord {
+			return ds.linkedRecords(ERPModulePurchaseContracts, source.Code, ERPModuleInbound, "purchaseCode")
+		}},
+	{Source: ERPModuleShipmentDetails, Target: ERPModuleOutbound, SourceLabel: "出运明细", Label: "出库",
+		consumers: func(ds *erpDataset, source *ERPRecord) []*ERPRecord {
+			return ds.linkedRecords(ERPModuleShipmentDetails, source.Code, ERPModuleOutbound, "shipmentCode")
+		}},
+}
+
+// erpFulfilmentModules 参与数量履约校验的模块（来源或下游）。
+var erpFulfilmentModules = map[string]struct{}{
+	ERPModuleExportSales:       {},
+	ERPModulePurchaseContracts: {},
+	ERPModuleShipmentDetails:   {},
+	ERPModuleInbound:           {},
+	ERPModuleOutbound:          {},
+}
+
+// ERPFulfilment 来源单据按产品汇总的履约进度。
+type ERPFulfilment struct {
+	ModuleKey        string
+	ID               int
+	Code             string
+	TolerancePercent float64
+	Lines            []*ERPFulfilmentLine
+}
+
+// ERPFulfilmentLine 来源单据中一个产品的数量（同一产品的多行合并），MaxQuantity 为含容差的上限。
+type ERPFulfilmentLine struct {
+	LineNos     []int
+	ProductCode string
+	ProductName string
+	Quantity    float64
+	MaxQuantity float64
+	Flows       []*ERPFulfilmentProgress
+}
+
+// ERPFulfilmentProgress 某类下游单据的累计数量；Remaining = Quantity - Fulfilled，负数表示在容差内超出。
+type ERPFulfilmentProgress struct {
+	ModuleKey string
+	Fulfilled float64
+	Remaining float64
+	Documents []string
+}
+
+// erpFulfilmentLine 单据中的一行产品数量；Path 为数量字段路径，表头单品单据（入库、出库）为 quantity。
+type erpFulfilmentLine struct {
+	Path        string
+	LineNo      int
+	ProductCode string
+	ProductName string
+	Quantity    float64
+}
+
+type erpFulfilmentGroup struct {
+	Key         string
+	ProductCode string
+	ProductName string
+	Names       map[string]struct{}
+	LineNos     []int
+	Paths       []string
+	Quantity    float64
+}
+
+type erpFulfilmentUse struct {
+	Record *ERPRecord
+	Path   string
+}
+
+type erpFulfilmentBalance struct {
+	Flow      *erpFulfilmentFlow
+	Source    *ERPRecord
+	Group     *erpFulfilmentGroup
+	Tolerance float64
+	Max       float64
+	Consumed  float64
+	Uses      []erpFulfilmentUse
+}
+
+func (b *erpFulfilmentBalance) key() string {
+	return fmt.Sprintf("%s|%d|%s", b.Flow.Target, b.Source.ID, b.Group.Key)
+}
+
+func (b *erpFulfilmentBalance) over() float64 {
+	return roundERPAmount(b.Consumed - b.Max)
+}
+
+// SetFulfilmentTolerance 注入默认的超量容差（百分比），来源单据填写 tolerancePercent 时以单据为准。
+func (uc *ERPUsecase) SetFulfilmentTolerance(percent float64) {
+	if percent < 0 {
+		percent = 0
+	}
+	uc.fulfilmentTolerance = percent
+}
+
+// Fulfilment 返回来源单据（外销合同、采购合同、出运明细）按产品的累计履约数量与剩余未履约数量。
+// 来源与下游单据均按调用方的记录范围过滤，范围外的单号与数量不出现在结果中；code 为空时返回模块内全部单据。
+func (uc *ERPUsecase) Fulfilment(ctx context.Context, moduleKey, code string) ([]*ERPFulfilment, error) {
+	var err error
+	moduleKey, err = NormalizeERPModuleKey(moduleKey)
+	if err != nil {
+		return nil, err
+	}
+	isSource := false
+	for _, flow := range erpFulfilmentFlows {
+		isSource = isSource || flow.Source == moduleKey
+	}
+	if !isSource {
+		return nil, ErrBadParam
+	}
+
+	sources, err := uc.repo.ListByModule(ctx, moduleKey)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := uc.loadFulfilmentDataset(ctx)
+	if err != nil {
+		return nil, err
+	}
+	balances := map[string]*erpFulfilmentBalance{}
+	for _, balance := range uc.fulfilmentBalances(ds) {
+		balances[balance.key()] = balance
+	}
+
+	code = strings.TrimSpace(code)
+	out := []*ERPFulfilment{}
+	for _, source := range sources {
+		if source == nil || (code != "" && source.Code != code) {
+			continue
+		}
+		report := &ERPFulfilment{
+			ModuleKey:        moduleKey,
+			ID:               source.ID,
+			Code:             source.Code,
+			TolerancePercent: uc.fulfilmentTolerancePercent(source),
+			Lines:            []*ERPFulfilmentLine{},
+		}
+		for _, group := range erpFulfilmentGroups(erpFulfilmentLines(moduleKey, source.Payload)) {
+			line := &ERPFulfilmentLine{
+				LineNos:     group.LineNos,
+				ProductCode: group.ProductCode,
+				ProductName: group.ProductName,
+				Quantity:    roundERPAmount(group.Quantity),
+				MaxQuantity: erpFulfilmentMax(group.Quantity, report.TolerancePercent),
+				Flows:       []*ERPFulfilmentProgress{},
+			}
+			for index := range erpFulfilmentFlows {
+				flow := &erpFulfilmentFlows[index]
+				if flow.Source != moduleKey {
+					continue
+				}
+				progress := &ERPFulfilmentProgress{ModuleKey: flow.Target, Documents: []string{}}
+				probe := &erpFulfilmentBalance{Flow: flow, Source: source, Group: group}
+				if balance, ok := balances[probe.key()]; ok {
+					progress.Fulfilled = roundERPAmount(balance.Consumed)
+					seen := map[int]struct{}{}
+					for _, use := range balance.Uses {
+						if _, ok := seen[use.Record.ID]; ok {
+							continue
+						}
+						seen[use.Record.ID] = struct{}{}
+						progress.Documents = append(progress.Documents, erpReferenceCode(use.Record.Code, use.Record.ID))
+					}
+				}
+				progress.Remaining = roundERPAmount(line.Quantity - progress.Fulfilled)
+				line.Flows = append(line.Flows, progress)
+			}
+			report.Lines = append(report.Lines, line)
+		}
+		out = append(out, report)
+	}
+	if code != "" && len(out) == 0 {
+		return nil, ErrERPRecordNotFound
+	}
+	return out, nil
+}
+
+// checkERPFulfilment 校验保存 payload 后的数量履约：某产品累计数量超过来源上限、且超出量因本次保存新增或扩大时拒绝。
+// 下游单据超量时错误指向本单对应行，来源单据减量时指向来源行；保存前已存在的超量不影响无关修改。
+func (uc *ERPUsecase) checkERPFulfilment(ctx context.Context, moduleKey string, id int, payload map[string]any) error {
+	if _, ok := erpFulfilmentModules[moduleKey]; !ok {
+		return nil
+	}
+	// 超量校验须统计全部记录，不受调用方记录范围限制
+	ds, err := uc.loadFulfilmentDataset(NewContextWithERPScope(ctx, nil))
+	if err != nil {
+		return err
+	}
+	pending := &ERPRecord{ID: id, ModuleKey: moduleKey, Code: erpPayloadString(payload, "code"), Payload: payload}
+
+	before := map[string]float64{}
+	for _, balance := range uc.fulfilmentBalances(ds) {
+		before[balance.key()] = balance.over()
+	}
+	var fields []ERPFieldError
+	for _, balance := range uc.fulfilmentBalances(ds.withRecord(pending)) {
+		over := balance.over()
+		if over <= 0 {
+			continue
+		}
+		if previous, ok := before[balance.key()]; ok && over <= previous {
+			continue
+		}
+		path := ""
+		if balance.Source == pending {
+			path = balance.Group.Paths[0]
+		} else {
+			for _, use := range balance.Uses {
+				if use.Record == pending {
+					path = use.Path
+					break
+				}
+			}
+		}
+		message := fmt.Sprintf("产品 %s 累计%s %s，超过%s %s 的上限 %s（数量 %s，容差 %s%%）",
+			balance.Group.ProductName, balance.Flow.Label, formatERPQuantity(balance.Consumed),
+			balance.Flow.SourceLabel, erpReferenceCode(balance.Source.Code, balance.Source.ID), formatERPQuantity(balance.Max),
+			formatERPQuantity(balance.Group.Quantity), formatERPQuantity(balance.Tolerance))
+		if path != "" {
+			message = "字段 " + path + " " + message
+		}
+		fields = append(fields, ERPFieldError{Path: path, Message: message})
+	}
+	if len(fields) > 0 {
+		return &ERPValidationError{Fields: mergeERPFieldErrors(fields)}
+	}
+	return nil
+}
+
+// loadFulfilmentDataset 按 ctx 的记录范围读取履约计算所需的记录与外销合同相关的单据链路。
+func (uc *ERPUsecase) loadFulfilmentDataset(ctx context.Context) (*erpDataset, error) {
+	ds, err := uc.loadERPDataset(ctx,
+		ERPModuleExportSales, ERPModulePurchaseContracts, ERPModuleShipmentDetails, ERPModuleInbound, ERPModuleOutbound,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.loadDocLinks(ctx, ds, ERPModuleExportSales); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+// fulfilmentBalances 计算每张来源单据每个产品对各类下游单据的累计数量；下游行匹配不到来源产品时不计入。
+func (uc *ERPUsecase) fulfilmentBalances(ds *erpDataset) []*erpFulfilmentBalance {
+	out := []*erpFulfilmentBalance{}
+	for index := range erpFulfilmentFlows {
+		flow := &erpFulfilmentFlows[index]
+		for _, source := range ds.list(flow.Source) {
+			if source == nil {
+				continue
+			}
+			groups := erpFulfilmentGroups(erpFulfilmentLines(flow.Source, source.Payload))
+			if len(groups) == 0 {
+				continue
+			}
+			tolerance := uc.fulfilmentTolerancePercent(source)
+			balances := make(map[*erpFulfilmentGroup]*erpFulfilmentBalance, len(groups))
+			for _, group := range groups {
+				balance := &erpFulfilmentBalance{
+					Flow: flow, Source: source, Group: group, Tolerance: tolerance,
+					Max: erpFulfilmentMax(group.Quantity, tolerance),
+				}
+				balances[group] = balance
+				out = append(out, balance)
+			}
+			for _, consumer := range flow.consumers(ds, source) {
+				for _, line := range erpFulfilmentLines(flow.Target, consumer.Payload) {
+					group := matchERPFulfilmentGroup(groups, line)
+					if group == nil {
+						continue
+					}
+					balance := balances[group]
+					balance.Consumed += line.Quantity
+					balance.Uses = append(balance.Uses, erpFulfilmentUse{Record: consumer, Path: line.Path})
+				}
+			}
+		}
+	}
+	return out
+}
+
+// fulfilmentTolerancePercent 来源单据填写了 tolerancePercent（溢装比例）时以单据为准，否则取配置默认值。
+func (uc *ERPUsecase) fulfilmentTolerancePercent(source *ERPRecord) float64 {
+	if source != nil && !isEmptyERPValue(source.Payload["tolerancePercent"]) {
+		return erpPayloadFloat(source.Payload, "tolerancePercent")
+	}
+	return uc.fulfilmentTolerance
+}
+
+// withRecord 返回以 record 替换同 ID 记录（ID 为 0 时追加）后的 dataset，原 dataset 不变。
+func (ds *erpDataset) withRecord(record *ERPRecord) *erpDataset {
+	out := &erpDataset{records: make(map[string][]*ERPRecord, len(ds.records)), links: ds.links}
+	for moduleKey, rows := range ds.records {
+		out.records[moduleKey] = rows
+	}
+	rows := make([]*ERPRecord, 0, len(ds.records[record.ModuleKey])+1)
+	for _, row := range ds.records[record.ModuleKey] {
+		if row != nil && record.ID > 0 && row.ID == record.ID {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	out.records[record.ModuleKey] = append(rows, record)
+	return out
+}
+
+// erpFulfilmentLines 读取单据的产品数量行：入库、出库为表头单品，其余取 items 并按基本单位数量比较。
+func erpFulfilmentLines(moduleKey string, payload map[string]any) []erpFulfilmentLine {
+	if moduleKey == ERPModuleInbound || moduleKey == ERPModuleOutbound {
+		name := erpPayloadString(payload, "productName")
+		if name == "" {
+			return nil
+		}
+		return []erpFulfilmentLine{{
+			Path:        "quantity",
+			ProductCode: erpPayloadString(payload, "productCode"),
+			ProductName: name,
+			Quantity:    erpPayloadFloat(payload, "quantity"),
+		}}
+	}
+
+	var rows []any
+	switch raw := payload["items"].(type) {
+	case []any:
+		rows = raw
+	case []map[string]any:
+		for _, row := range raw {
+			rows = append(rows, row)
+		}
+	}
+	out := make([]erpFulfilmentLine, 0, len(rows))
+	for index, row := range rows {
+		item, _ := row.(map[string]any)
+		name := erpPayloadString(item, "productName")
+		if name == "" {
+			name = erpPayloadString(item, "productModel")
+		}
+		code := erpPayloadString(item, "productCode")
+		if name == "" && code == "" {
+			continue
+		}
+		out = append(out, erpFulfilmentLine{
+			Path:        fmt.Sprintf("items[%d].quantity", index),
+			LineNo:      int(erpPayloadFloat(item, "lineNo")),
+			ProductCode: code,
+			ProductName: name,
+			Quantity:    erpItemBaseQuantity(item),
+		})
+	}
+	return out
+}
+
+// erpItemBaseQuantity 明细行折算到产品基本单位的数量；未带出 baseQuantity 时取 quantity。
+func erpItemBaseQuantity(item map[string]any) float64 {
+	if base, ok := toERPFloat64(item["baseQuantity"]); ok {
+		return base
+	}
+	return erpPayloadFloat(item, "quantity")
+}
+
+// erpFulfilmentGroups 按产品合并来源行：有产品编码按编码，否则按品名。
+func erpFulfilmentGroups(lines []erpFulfilmentLine) []*erpFulfilmentGroup {
+	out := []*erpFulfilmentGroup{}
+	byKey := map[string]*erpFulfilmentGroup{}
+	for _, line := range lines {
+		key := "name:" + line.ProductName
+		if line.ProductCode != "" {
+			key = "code:" + line.ProductCode
+		}
+		group, ok := byKey[key]
+		if !ok {
+			group = &erpFulfilmentGroup{
+				Key: key, ProductCode: line.ProductCode, ProductName: line.ProductName, Names: map[string]struct{}{},
+			}
+			byKey[key] = group
+			out = append(out, group)
+		}
+		if group.ProductName == "" {
+			group.ProductName = line.ProductName
+		}
+		if line.ProductName != "" {
+			group.Names[line.ProductName] = struct{}{}
+		}
+		if line.LineNo > 0 {
+			group.LineNos = append(group.LineNos, line.LineNo)
+		}
+		group.Paths = append(group.Paths, line.Path)
+		group.Quantity += line.Quantity
+	}
+	return out
+}
+
+// matchERPFulfilmentGroup 下游行优先按产品编码匹配来源产品，编码缺失或未匹配时按品名匹配。
+func matchERPFulfilmentGroup(groups []*erpFulfilmentGroup, line erpFulfilmentLine) *erpFulfilmentGroup {
+	if line.ProductCode != "" {
+		for _, group := range groups {
+			if group.ProductCode == line.ProductCode {
+				return group
+			}
+		}
+	}
+	if line.ProductName == "" {
+		return nil
+	}
+	for _, group := range groups {
+		if _, ok := group.Names[line.ProductName]; ok {
+			return group
+		}
+	}
+	return nil
+}
+
+func erpFulfilmentMax(quantity, tolerance float64) float64 {
+	return roundERPAmount(quantity * (1 + tolerance/100))
+}
+
+func formatERPQuantity(value float64) string {
+	return strconv.FormatFloat(roundERPAmount(value), 'f', -1, 64)
+}
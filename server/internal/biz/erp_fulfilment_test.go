@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecaseFulfilmentGuards(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	uc.SetFulfilmentTolerance(5)
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作客户", "客户A")
+	seedERPPartners(t, repo, "合作供应商", "工厂A")
+	seedERPRecords(t, repo, ERPModuleProducts, map[string]any{"code": "PD-001"})
+
+	sale := map[string]any{
+		"code": "XS-001", "customerName": "客户A", "customerContractNo": "HT-001", "signDate": "2026-01-10",
+		"deliveryDate": "2026-02-10", "transportType": "海运", "orderFlow": "成品采购", "currency": "USD",
+		"items": []any{
+			map[string]any{"lineNo": 1, "productCode": "PD-001", "productName": "磁钢A", "quantity": 300, "unitPrice": 5},
+			map[string]any{"lineNo": 2, "productName": "磁钢B", "quantity": 100, "unitPrice": 2},
+		},
+	}
+	created, err := uc.Create(ctx, ERPModuleExportSales, sale, 1)
+	if err != nil {
+		t.Fatalf("create sale failed: %v", err)
+	}
+	purchase := func(code string, items ...any) map[string]any {
+		return map[string]any{
+			"code": code, "supplierName": "工厂A", "signDate": "2026-01-11", "salesNo": "XS-001",
+			"deliveryDate": "2026-01-25", "deliveryAddress": "宁波", "invoiceRequired": "是", "items": items,
+		}
+	}
+	// 按品名匹配到产品编码行；辅材不在外销合同中，不参与校验
+	if _, err := uc.Create(ctx, ERPModulePurchaseContracts, purchase("CG-001",
+		map[string]any{"productName": "磁钢A", "quantity": 200, "unitPrice": 4},
+		map[string]any{"productName": "辅材", "quantity": 1000, "unitPrice": 1},
+	), 1); err != nil {
+		t.Fatalf("create purchase failed: %v", err)
+	}
+
+	// 累计 320 超过 300 × 105% = 315
+	_, err = uc.Create(ctx, ERPModulePurchaseContracts, purchase("CG-002",
+		map[string]any{"productName": "磁钢B", "quantity": 10, "unitPrice": 1},
+		map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 120, "unitPrice": 4},
+	), 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("over purchase should fail, got %v", err)
+	}
+	want := []ERPFieldError{{
+		Path:    "items[1].quantity",
+		Message: "字段 items[1].quantity 产品 磁钢A 累计采购 320，超过外销合同 XS-001 的上限 315（数量 300，容差 5%）",
+	}}
+	if !reflect.DeepEqual(validationErr.Fields, want) {
+		t.Fatalf("fields = %v, want %v", validationErr.Fields, want)
+	}
+	if _, err := uc.Create(ctx, ERPModulePurchaseContracts, purchase("CG-002",
+		map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 115, "unitPrice": 4},
+	), 1); err != nil {
+		t.Fatalf("purchase within tolerance failed: %v", err)
+	}
+
+	// 来源单据减量同样受限，错误指向来源行
+	sale["items"].([]any)[0].(map[string]any)["quantity"] = 250
+	_, err = uc.Update(ctx, ERPModuleExportSales, created["id"].(int), sale, 0, 1)
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "items[0].quantity" {
+		t.Fatalf("reducing sold quantity should fail, got %v", err)
+	}
+	// 单据填写的溢装比例优先于默认容差
+	sale["items"].([]any)[0].(map[string]any)["quantity"] = 290
+	sale["tolerancePercent"] = 10
+	if _, err := uc.Update(ctx, ERPModuleExportSales, created["id"].(int), sale, 0, 1); err != nil {
+		t.Fatalf("reduce with contract tolerance failed: %v", err)
+	}
+
+	reports, err := uc.Fulfilment(ctx, ERPModuleExportSales, "XS-001")
+	if err != nil || len(reports) != 1 {
+		t.Fatalf("fulfilment failed: %v %v", reports, err)
+	}
+	report := reports[0]
+	if report.TolerancePercent != 10 || len(report.Lines) != 2 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	line := report.Lines[0]
+	if line.ProductCode != "PD-001" || line.Quantity != 290 || line.MaxQuantity != 319 || !reflect.DeepEqual(line.LineNos, []int{1}) {
+		t.Fatalf("unexpected line: %+v", line)
+	}
+	purchased, shipped := line.Flows[0], line.Flows[1]
+	if purchased.ModuleKey != ERPModulePurchaseContracts || purchased.Fulfilled != 315 || purchased.Remaining != -25 ||
+		!reflect.DeepEqual(purchased.Documents, []string{"CG-002", "CG-001"}) {
+		t.Fatalf("unexpected purchase progress: %+v", purchased)
+	}
+	if shipped.ModuleKey != ERPModuleShipmentDetails || shipped.Fulfilled != 0 || shipped.Remaining != 290 {
+		t.Fatalf("unexpected shipment progress: %+v", shipped)
+	}
+	if _, err := uc.Fulfilment(ctx, ERPModuleInbound, ""); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("inbound has no downstream flow, got %v", err)
+	}
+	if _, err := uc.Fulfilment(ctx, ERPModuleExportSales, "XS-404"); !errors.Is(err, ErrERPRecordNotFound) {
+		t.Fatalf("unknown code should fail, got %v", err)
+	}
+}
+
+func TestERPUsecaseFulfilmentHeaderLines(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作供应商", "工厂A")
+	seedERPRecords(t, repo, ERPModuleExportSales, map[string]any{"code": "XS-001"})
+
+	if _, err := uc.Create(ctx, ERPModulePurchaseContracts, map[string]any{
+		"code": "CG-001", "supplierName": "工厂A", "signDate": "2026-01-11", "salesNo": "XS-001",
+		"deliveryDate": "2026-01-25", "deliveryAddress": "宁波", "invoiceRequired": "是",
+		"items": []any{map[string]any{"productName": "磁钢A", "quantity": 100, "unitPrice": 4}},
+	}, 1); err != nil {
+		t.Fatalf("create purchase failed: %v", err)
+	}
+	inbound := map[string]any{
+		"code": "RK-001", "purchaseCode": "CG-001", "productName": "磁钢A", "warehouseName": "杭州一号仓",
+		"location": "A-01-01", "qcStatus": "检验合格", "quantity": 60,
+	}
+	created, err := uc.Create(ctx, ERPModuleInbound, inbound, 1)
+	if err != nil {
+		t.Fatalf("create inbound failed: %v", err)
+	}
+	inbound["code"], inbound["quantity"] = "RK-002", 41
+	_, err = uc.Create(ctx, ERPModuleInbound, inbound, 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "quantity" ||
+		validationErr.Fields[0].Message != "字段 quantity 产品 磁钢A 累计入库 101，超过采购合同 CG-001 的上限 100（数量 100，容差 0%）" {
+		t.Fatalf("over inbound should fail, got %v", err)
+	}
+
+	// 已存在的超量不妨碍无关修改，只拒绝继续增加
+	seedERPRecords(t, repo, ERPModuleInbound, map[string]any{"code": "RK-003", "purchaseCode": "CG-001", "productName": "磁钢A", "quantity": 50})
+	inbound["code"], inbound["quantity"], inbound["location"] = "RK-001", 60, "A-01-02"
+	if _, err := uc.Update(ctx, ERPModuleInbound, created["id"].(int), inbound, 0, 1); err != nil {
+		t.Fatalf("unrelated update should pass, got %v", err)
+	}
+	inbound["quantity"] = 61
+	if _, err := uc.Update(ctx, ERPModuleInbound, created["id"].(int), inbound, 0, 1); !errors.As(err, &validationErr) {
+		t.Fatalf("increasing over quantity should fail, got %v", err)
+	}
+}
@@ -0,0 +1,207 @@
+package biz
+
+import (
+	"fmt"
+	"strings"
+)
+
+// erpDeclarationElementFields 申报要素模板未指定 productField 时，按要素名称取产品资料的字段。
+var erpDeclarationElementFields = map[string]string{
+	"品名":   "cnDesc",
+	"英文品名": "enDesc",
+	"用途":   "usage",
+	"成分含量": "composition",
+	"材质":   "composition",
+	"品牌":   "brand",
+	"型号":   "specCode",
+	"规格型号": "specCode",
+	"图号":   "drawingNo",
+}
+
+// deriveHSCode 海关编码表以 hsCode 作为记录编号，供产品按编码引用。
+func deriveHSCode(payload map[string]any) error {
+	if hsCode := erpPayloadString(payload, "hsCode"); hsCode != "" {
+		payload["code"] = hsCode
+	}
+	return nil
+}
+
+// checkERPHSCodeElements 申报要素模板的要素名称不能重复。
+func checkERPHSCodeElements(payload map[string]any) []ERPFieldError {
+	var errs []ERPFieldError
+	rows, _ := payload["elements"].([]any)
+	seen := map[string]int{}
+	for index, raw := range rows {
+		row, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		name := erpPayloadString(row, "name")
+		if name == "" {
+			continue
+		}
+		if first, ok := seen[name]; ok {
+			path := fmt.Sprintf("elements[%d].name", index)
+			errs = append(errs, ERPFieldError{Path: path, Message: fmt.Sprintf("字段 %s 与 elements[%d] 重复：要素 %s", path, first, name)})
+			continue
+		}
+		seen[name] = index
+	}
+	return errs
+}
+
+// checkERPHSCodeUnique 海关编码表中同一 hsCode 只能有一条记录（含已停用的），id 为修改中的记录。
+func checkERPHSCodeUnique(moduleKey string, id int, payload map[string]any, lookup erpRecordLookup) error {
+	if moduleKey != ERPModuleHSCodes {
+		return nil
+	}
+	hsCode := erpPayloadString(payload, "hsCode")
+	rows, err := lookup(ERPModuleHSCodes)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if row == nil || row.ID == id || erpPayloadString(row.Payload, "hsCode") != hsCode {
+			continue
+		}
+		return &ERPValidationError{Fields: []ERPFieldError{{
+			Path:    "hsCode",
+			Message: fmt.Sprintf("字段 hsCode 海关编码 %s 已存在（记录 #%d）", hsCode, row.ID),
+		}}}
+	}
+	return nil
+}
+
+// applyERPProductDeclaration 按产品海关编码在编码表中的申报要素模板生成 declarationElements；
+// 必填要素没有取值时按字段报错。编码不在编码表中时不处理（由引用校验报错）。
+func applyERPProductDeclaration(payload map[string]any, lookup erpRecordLookup) ([]ERPFieldError, error) {
+	delete(payload, "declarationElements")
+	master, err := findERPHSCode(erpPayloadString(payload, "hsCode"), lookup)
+	if err != nil || master == nil {
+		return nil, err
+	}
+	text, errs := buildERPDeclarationElements(master, payload)
+	if text != "" {
+		payload["declarationElements"] = text
+	}
+	return errs, nil
+}
+
+// applyERPItemDeclarations 为填写了 productCode 的明细行带出产品海关编码，并按编码表模板生成申报要素；
+// 行内已填写的 hsCode、declarationElements 保留，缺少取值的要素留空。
+func applyERPItemDeclarations(payload map[string]any, lookup erpRecordLookup) ([]ERPFieldError, error) {
+	rows, ok := payload["items"].([]any)
+	if !ok {
+		return nil, nil
+	}
+	products, err := lookup(ERPModuleProducts)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		item, ok := row.(map[string]any)
+		if !ok {
+			continue
+		}
+		product := findERPReferenceTarget(products, erpRefProduct, erpPayloadString(item, "productCode"), 0)
+		if product == nil {
+			continue
+		}
+		if isEmptyERPValue(item["hsCode"]) && !isEmptyERPValue(product.Payload["hsCode"]) {
+			item["hsCode"] = product.Payload["hsCode"]
+		}
+		if !isEmptyERPValue(item["declarationElements"]) {
+			continue
+		}
+		master, err := findERPHSCode(erpPayloadString(item, "hsCode"), lookup)
+		if err != nil {
+			return nil, err
+		}
+		if master == nil {
+			continue
+		}
+		if text, _ := buildERPDeclarationElements(master, product.Payload); text != "" {
+			item["declarationElements"] = text
+		}
+	}
+	return nil, nil
+}
+
+func findERPHSCode(hsCode string, lookup erpRecordLookup) (map[string]any, error) {
+	if hsCode == "" {
+		return nil, nil
+	}
+	rows, err := lookup(ERPModuleHSCodes)
+	if err != nil {
+		return nil, err
+	}
+	if row := findERPReferenceTarget(rows, erpRefHSCode, hsCode, 0); row != nil {
+		return row.Payload, nil
+	}
+	return nil, nil
+}
+
+// buildERPDeclarationElements 按模板顺序以 "|" 拼接申报要素，如 "品名|用途|成分含量|品牌|型号"。
+// 每个要素依次取产品 declarationValues 中同名的值、模板 productField（未指定时按要素名称对应）的产品字段、模板默认值；
+// 必填要素没有取值或取值含 "|" 时返回字段错误。
+func buildERPDeclarationElements(master, product map[string]any) (string, []ERPFieldError) {
+	elements, _ := master["elements"].([]any)
+	if len(elements) == 0 {
+		return "", nil
+	}
+	values := map[string]string{}
+	valueIndex := map[string]int{}
+	rows, _ := product["declarationValues"].([]any)
+	for index, raw := range rows {
+		row, _ := raw.(map[string]any)
+		name := erpPayloadString(row, "name")
+		if _, ok := values[name]; name == "" || ok {
+			continue
+		}
+		values[name] = erpPayloadString(row, "value")
+		valueIndex[name] = index
+	}
+
+	hsCode := erpPayloadString(master, "hsCode")
+	var errs []ERPFieldError
+	parts := make([]string, 0, len(elements))
+	for _, raw := range elements {
+		element, _ := raw.(map[string]any)
+		name := erpPayloadString(element, "name")
+		field := erpPayloadString(element, "productField")
+		if field == "" {
+			field = erpDeclarationElementFields[name]
+		}
+
+		value, path := "", "declarationValues"
+		if v, ok := values[name]; ok && v != "" {
+			value, path = v, fmt.Sprintf("declarationValues[%d].value", valueIndex[name])
+		} else if field != "" && erpPayloadString(product, field) != "" {
+			value, path = erpPayloadString(product, field), field
+		} else {
+			value = erpPayloadString(element, "defaultValue")
+			if field != "" {
+				path = field
+			}
+		}
+		required, _ := element["required"].(bool)
+		switch {
+		case value == "" && required:
+			errs = append(errs, ERPFieldError{Path: path, Message: fmt.Sprintf("字段 %s 为必填项：海关编码 %s 的申报要素 %s 没有取值", path, hsCode, name)})
+		case strings.Contains(value, "|"):
+			errs = append(errs, ERPFieldError{Path: path, Message: fmt.Sprintf("字段 %s 申报要素 %s 的取值不能包含 |", path, name)})
+			value = strings.ReplaceAll(value, "|", "/")
+		}
+		parts = append(parts, value)
+	}
+	return strings.Join(parts, "|"), errs
+}
+
+// hsCodeRebateRate 海关编码表中该编码的退税率，未维护或已停用时返回 false。
+func (ds *erpDataset) hsCodeRebateRate(hsCode string) (float64, bool) {
+	row := findERPReferenceTarget(ds.list(ERPModuleHSCodes), erpRefHSCode, hsCode, 0)
+	if row == nil {
+		return 0, false
+	}
+	return toERPFloat64(row.Payload["rebateRate"])
+}
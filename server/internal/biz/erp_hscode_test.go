@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecaseHSCodeDeclarationElements(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作客户", "客户A")
+
+	master, err := uc.Create(ctx, ERPModuleHSCodes, map[string]any{
+		"hsCode": "8505111000", "cnName": "稀土永磁体", "firstUnit": "千克", "secondUnit": "个",
+		"rebateRate": 0.13, "supervisionConditions": "B",
+		"elements": []any{
+			map[string]any{"name": "品牌类型", "defaultValue": "0"},
+			map[string]any{"name": "品名", "required": true},
+			map[string]any{"name": "用途", "required": true},
+			map[string]any{"name": "成分含量"},
+			map[string]any{"name": "品牌", "required": true},
+			map[string]any{"name": "型号", "productField": "drawingNo"},
+		},
+	}, 1)
+	if err != nil {
+		t.Fatalf("create hs code failed: %v", err)
+	}
+	if master["code"] != "8505111000" {
+		t.Fatalf("hs code record code = %v", master["code"])
+	}
+
+	var validationErr *ERPValidationError
+	_, err = uc.Create(ctx, ERPModuleHSCodes, map[string]any{"hsCode": "8505111000", "cnName": "重复", "firstUnit": "千克"}, 1)
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "hsCode" {
+		t.Fatalf("duplicate hs code should fail, got %v", err)
+	}
+	_, err = uc.Create(ctx, ERPModuleHSCodes, map[string]any{
+		"hsCode": "7202999000", "cnName": "铁合金", "firstUnit": "千克",
+		"elements": []any{map[string]any{"name": "品名"}, map[string]any{"name": "品名"}},
+	}, 1)
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "elements[1].name" {
+		t.Fatalf("duplicate element should fail, got %v", err)
+	}
+
+	product := map[string]any{
+		"code": "PD-001", "hsCode": "8505111000", "specCode": "N35", "drawingNo": "D10x5",
+		"cnDesc": "钕铁硼磁钢", "enDesc": "NdFeB magnet", "usage": "电机用", "composition": "钕铁硼",
+	}
+	_, err = uc.Create(ctx, ERPModuleProducts, product, 1)
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("missing brand should fail, got %v", err)
+	}
+	want := []ERPFieldError{{Path: "brand", Message: "字段 brand 为必填项：海关编码 8505111000 的申报要素 品牌 没有取值"}}
+	if !reflect.DeepEqual(validationErr.Fields, want) {
+		t.Fatalf("fields = %v, want %v", validationErr.Fields, want)
+	}
+
+	product["declarationValues"] = []any{map[string]any{"name": "品牌", "value": "无品牌"}}
+	created, err := uc.Create(ctx, ERPModuleProducts, product, 1)
+	if err != nil {
+		t.Fatalf("create product failed: %v", err)
+	}
+	if got := created["declarationElements"]; got != "0|钕铁硼磁钢|电机用|钕铁硼|无品牌|D10x5" {
+		t.Fatalf("declarationElements = %v", got)
+	}
+
+	_, err = uc.Create(ctx, ERPModuleProducts, map[string]any{
+		"code": "PD-002", "hsCode": "8505119000", "specCode": "N40", "cnDesc": "磁钢", "enDesc": "magnet",
+	}, 1)
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Message != "字段 hsCode 引用的海关编码 8505119000 不存在" {
+		t.Fatalf("unknown hs code should fail, got %v", err)
+	}
+
+	shipment, err := uc.Create(ctx, ERPModuleShipmentDetails, map[string]any{
+		"code": "CY-001", "customerName": "客户A", "startPort": "宁波", "destPort": "Hamburg",
+		"shipToAddress": "Germany Warehouse", "transportType": "海运", "arriveCountry": "Germany", "salesOwner": "业务员A",
+		"items": []any{
+			map[string]any{"productCode": "PD-001", "productModel": "磁钢A", "quantity": 100},
+			map[string]any{"productCode": "PD-001", "productModel": "磁钢A", "quantity": 50, "declarationElements": "手工填写"},
+			map[string]any{"productModel": "辅材", "quantity": 10},
+		},
+	}, 1)
+	if err != nil {
+		t.Fatalf("create shipment failed: %v", err)
+	}
+	items := shipment["items"].([]any)
+	first, manual, extra := items[0].(map[string]any), items[1].(map[string]any), items[2].(map[string]any)
+	if first["hsCode"] != "8505111000" || first["declarationElements"] != "0|钕铁硼磁钢|电机用|钕铁硼|无品牌|D10x5" {
+		t.Fatalf("unexpected line: %v", first)
+	}
+	if manual["declarationElements"] != "手工填写" || extra["hsCode"] != nil || extra["declarationElements"] != nil {
+		t.Fatalf("unexpected lines: %v %v", manual, extra)
+	}
+
+	err = uc.Delete(ctx, ERPModuleHSCodes, master["id"].(int))
+	var inUse *ERPRecordInUseError
+	if !errors.As(err, &inUse) || inUse.References[0].ModuleKey != ERPModuleProducts || inUse.References[0].Field != "hsCode" {
+		t.Fatalf("referenced hs code should not be deletable, got %v", err)
+	}
+}
+
+func TestERPRebateRateFallsBackToHSCode(t *testing.T) {
+	ds := &erpDataset{records: map[string][]*ERPRecord{
+		ERPModuleRebateRates: {{ID: 1, Payload: map[string]any{"hsCode": "8505111000", "rebateRate": 0.09, "effectiveFrom": "2026-01-01"}}},
+		ERPModuleHSCodes: {
+			{ID: 2, Code: "8505111000", Payload: map[string]any{"hsCode": "8505111000", "rebateRate": 0.13}},
+			{ID: 3, Code: "7202999000", Payload: map[string]any{"hsCode": "7202999000", "rebateRate": 0.1}},
+			{ID: 4, Code: "7202100000", Payload: map[string]any{"hsCode": "7202100000"}},
+		},
+	}}
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		hsCode string
+		rate   float64
+		found  bool
+	}{
+		{"8505111000", 0.09, true},
+		{"7202999000", 0.1, true},
+		{"7202100000", 0, false},
+		{"9999999999", 0, false},
+	} {
+		rate, found := ds.rebateRate(tc.hsCode, at)
+		if rate != tc.rate || found != tc.found {
+			t.Fatalf("rebateRate(%s) = %v %v, want %v %v", tc.hsCode, rate, found, tc.rate, tc.found)
+		}
+	}
+}
@@ -0,0 +1,453 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ERPAuditMerge 主数据合并：被合并记录、保留记录与改写引用的单据各记一条。
+const ERPAuditMerge = "merge"
+
+// 重复主数据的判定依据。
+const (
+	ERPDuplicateByName     = "name"
+	ERPDuplicateByTaxNo    = "tax_no"
+	ERPDuplicateBySpecCode = "spec_code"
+	ERPDuplicateByHSDesc   = "hs_code_desc"
+)
+
+// ERPDuplicateGroup 疑似重复的一组主数据，Key 为规范化后的比较值。
+type ERPDuplicateGroup struct {
+	ModuleKey string
+	Reason    string
+	Key       string
+	Records   []ERPDuplicateRecord
+}
+
+// ERPDuplicateRecord 组内记录；Name 为往来单位名称或产品中文描述。
+type ERPDuplicateRecord struct {
+	ID   int
+	Code string
+	Name string
+}
+
+// ERPMergePlan 一次合并要写入的全部记录：Records 为保存后的完整内容，仓储须在同一事务内写入并核对 Version。
+// FromCode/ToCode、FromName/ToName 为被合并与保留记录的编码、名称，供仓储改写专表。
+type ERPMergePlan struct {
+	ModuleKey  string
+	SurvivorID int
+	LoserID    int
+	FromCode   string
+	ToCode     string
+	FromName   string
+	ToName     string
+	Records    []*ERPRecord
+}
+
+// ERPMergeRepo 由支持事务的仓储实现；未实现时按记录逐条保存（不保证原子性，仅用于测试替身）。
+type ERPMergeRepo interface {
+	MergeRecords(ctx context.Context, plan *ERPMergePlan, operatorID int) error
+}
+
+// ERPMergeResult 合并结果：References 为改写了引用的单据，Approved 为其中位于已批箱、确认箱的单据，需由审批人复核。
+type ERPMergeResult struct {
+	ModuleKey  string
+	Survivor   map[string]any
+	Loser      map[string]any
+	References []ERPRecordReference
+	Approved   []ERPRecordReference
+}
+
+// erpMasterExtraFields 引用规则之外、同样保存主数据名称或编码的表头字段（如结汇单客户名称、单据上的客户编号）。
+var erpMasterExtraFields = map[string]struct {
+	Names []string
+	Codes []string
+}{
+	ERPModulePartners: {Names: []string{"customerName", "supplierName"}, Codes: []string{"customerCode", "supplierCode"}},
+	ERPModuleProducts: {Codes: []string{"productCode"}},
+}
+
+// erpPartnerNameSuffixes 比较往来单位名称时去掉的公司后缀。
+var (
+	erpPartnerNameSuffixes = map[string]struct{}{
+		"co": {}, "company": {}, "ltd": {}, "limited": {}, "inc": {}, "corp": {}, "corporation": {},
+		"llc": {}, "gmbh": {}, "plc": {}, "sa": {}, "srl": {}, "bv": {},
+	}
+	erpPartnerNameSuffixesCN = []string{"股份有限公司", "有限责任公司", "有限公司", "公司"}
+)
+
+// normalizeERPPartnerName 规范化往来单位名称："XX Co., Ltd" 与 "XX CO LTD" 均为 "xx"。
+func normalizeERPPartnerName(name string) string {
+	tokens := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for len(tokens) > 1 {
+		if _, ok := erpPartnerNameSuffixes[tokens[len(tokens)-1]]; !ok {
+			break
+		}
+		tokens = tokens[:len(tokens)-1]
+	}
+	out := strings.Join(tokens, "")
+	for _, suffix := range erpPartnerNameSuffixesCN {
+		if trimmed := strings.TrimSuffix(out, suffix); trimmed != "" {
+			out = trimmed
+		}
+	}
+	return out
+}
+
+// normalizeERPMasterKey 规范化税号、规格编码等：去掉空白与分隔符并转大写。
+func normalizeERPMasterKey(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, value)
+}
+
+// erpDuplicateKeyer 返回记录按 Reason 比较的规范化值，空值不参与比较。
+type erpDuplicateKeyer struct {
+	Reason string
+	Key    func(payload map[string]any) string
+}
+
+var erpDuplicateKeyers = map[string][]erpDuplicateKeyer{
+	ERPModulePartners: {
+		{Reason: ERPDuplicateByName, Key: func(p map[string]any) string { return normalizeERPPartnerName(erpPayloadString(p, "name")) }},
+		{Reason: ERPDuplicateByTaxNo, Key: func(p map[string]any) string { return normalizeERPMasterKey(erpPayloadString(p, "taxNo")) }},
+	},
+	ERPModuleProducts: {
+		{Reason: ERPDuplicateBySpecCode, Key: func(p map[string]any) string { return normalizeERPMasterKey(erpPayloadString(p, "specCode")) }},
+		{Reason: ERPDuplicateByHSDesc, Key: func(p map[string]any) string {
+			hsCode, desc := normalizeERPMasterKey(erpPayloadString(p, "hsCode")), normalizeERPMasterKey(erpPayloadString(p, "cnDesc"))
+			if hsCode == "" || desc == "" {
+				return ""
+			}
+			return hsCode + "/" + desc
+		}},
+	},
+}
+
+// isERPRecordDisabled 记录已停用（含被合并）；停用记录不再作为引用目标。
+func isERPRecordDisabled(payload map[string]any) bool {
+	disabled, _ := payload["disabled"].(bool)
+	return disabled
+}
+
+// Duplicates 按规范化名称、税号（往来单位）或规格编码、海关编码 + 中文描述（产品）列出疑似重复的主数据，
+// 只统计调用方记录范围内未停用的记录。
+func (uc *ERPUsecase) Duplicates(ctx context.Context, moduleKey string) ([]*ERPDuplicateGroup, error) {
+	keyers, ok := erpDuplicateKeyers[moduleKey]
+	if !ok {
+		return nil, ErrBadParam
+	}
+
+	rows, err := uc.repo.ListByModule(ctx, moduleKey)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+
+	out := []*ERPDuplicateGroup{}
+	for _, keyer := range keyers {
+		groups := map[string]*ERPDuplicateGroup{}
+		var keys []string
+		for _, row := range rows {
+			if row == nil || isERPRecordDisabled(row.Payload) {
+				continue
+			}
+			key := keyer.Key(row.Payload)
+			if key == "" {
+				continue
+			}
+			group, ok := groups[key]
+			if !ok {
+				group = &ERPDuplicateGroup{ModuleKey: moduleKey, Reason: keyer.Reason, Key: key}
+				groups[key] = group
+				keys = append(keys, key)
+			}
+			name := erpPayloadString(row.Payload, "name")
+			if moduleKey == ERPModuleProducts {
+				name = erpPayloadString(row.Payload, "cnDesc")
+			}
+			group.Records = append(group.Records, ERPDuplicateRecord{ID: row.ID, Code: row.Code, Name: name})
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if len(groups[key].Records) > 1 {
+				out = append(out, groups[key])
+			}
+		}
+	}
+	return out, nil
+}
+
+// Merge 把 loserID 合并到 survivorID：全部单据中引用被合并记录的名称、编码改为保留记录，
+// 往来单位的联系人、地址、银行账户并入保留记录（同键保留原值，不设为默认），保留记录为空的字段取被合并记录的值，
+// 被合并记录标记 disabled 与 mergedInto。保留与被合并记录须在调用方记录范围内，引用改写覆盖全部单据（含已审批的）。
+func (uc *ERPUsecase) Merge(ctx context.Context, moduleKey string, survivorID, loserID, operatorID int) (*ERPMergeResult, error) {
+	if (moduleKey != ERPModulePartners && moduleKey != ERPModuleProducts) || survivorID <= 0 || loserID <= 0 || survivorID == loserID {
+		return nil, ErrBadParam
+	}
+	scope, _ := ERPScopeFromContext(ctx, moduleKey)
+	ctx = NewContextWithERPScope(ctx, nil)
+	lookup := uc.recordLookup(ctx)
+	rows, err := lookup(moduleKey)
+	if err != nil {
+		return nil, err
+	}
+	var survivor, loser *ERPRecord
+	for _, row := range rows {
+		switch {
+		case row == nil:
+		case row.ID == survivorID:
+			survivor = row
+		case row.ID == loserID:
+			loser = row
+		}
+	}
+	if survivor == nil || loser == nil || !scope.Allows(survivor) || !scope.Allows(loser) {
+		return nil, ErrERPRecordNotFound
+	}
+	if isERPRecordDisabled(survivor.Payload) || isERPRecordDisabled(loser.Payload) {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "disabled", Message: "已停用的记录不能参与合并"}}}
+	}
+
+	plan := &ERPMergePlan{
+		ModuleKey:  moduleKey,
+		SurvivorID: survivor.ID,
+		LoserID:    loser.ID,
+		FromCode:   loser.Code,
+		ToCode:     survivor.Code,
+		FromName:   erpPayloadString(loser.Payload, "name"),
+		ToName:     erpPayloadString(survivor.Payload, "name"),
+	}
+	survivorPayload := mergeERPMasterPayload(cloneERPValue(survivor.Payload).(map[string]any), loser.Payload)
+	loserPayload := cloneERPValue(loser.Payload).(map[string]any)
+	loserPayload["disabled"] = true
+	loserPayload["mergedInto"] = erpReferenceCode(survivor.Code, survivor.ID)
+
+	refs, updates, err := rewriteERPMasterReferences(moduleKey, survivor, loser, lookup)
+	if err != nil {
+		return nil, err
+	}
+	var approved []ERPRecordReference
+	for _, record := range updates {
+		if box := erpPayloadString(record.Payload, "box"); box == ERPBoxApproved || box == ERPBoxConfirmed {
+			approved = append(approved, ERPRecordReference{ModuleKey: record.ModuleKey, ID: record.ID, Code: record.Code})
+		}
+	}
+	plan.Records = append(plan.Records,
+		&ERPRecord{ID: survivor.ID, ModuleKey: moduleKey, Code: survivor.Code, Payload: survivorPayload, Version: survivor.Version},
+		&ERPRecord{ID: loser.ID, ModuleKey: moduleKey, Code: loser.Code, Payload: loserPayload, Version: loser.Version},
+	)
+	plan.Records = append(plan.Records, updates...)
+
+	if mergeRepo, ok := uc.repo.(ERPMergeRepo); ok {
+		if err := mergeRepo.MergeRecords(ctx, plan, operatorID); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, record := range plan.Records {
+			if _, err := uc.repo.Update(ctx, record.ModuleKey, record.ID, record.Payload, record.Version, operatorID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &ERPMergeResult{ModuleKey: moduleKey, Survivor: survivorPayload, Loser: loserPayload, References: refs, Approved: approved}, nil
+}
+
+// rewriteERPMasterReferences 返回引用了 loser 的单据及改写后的内容；保留记录与被合并记录类型不符
+// （如被合并的客户仍被作为客户引用，保留记录却是供应商）时返回 ERPValidationError。
+func rewriteERPMasterReferences(moduleKey string, survivor, loser *ERPRecord, lookup erpRecordLookup) ([]ERPRecordReference, []*ERPRecord, error) {
+	var refs []ERPRecordReference
+	updated := map[string]*ERPRecord{}
+	var order []string
+	var fields []ERPFieldError
+
+	rewrite := func(referrer string, row *ERPRecord, field, from, to string) {
+		if from == "" || to == "" || from == to {
+			return
+		}
+		id := fmt.Sprintf("%s#%d", referrer, row.ID)
+		record, ok := updated[id]
+		payload := row.Payload
+		if ok {
+			payload = record.Payload
+		}
+		next, changed := rewriteERPFieldValue(payload, field, from, to)
+		if !changed {
+			return
+		}
+		if !ok {
+			record = &ERPRecord{ID: row.ID, ModuleKey: referrer, Code: row.Code, Version: row.Version}
+			updated[id] = record
+			order = append(order, id)
+		}
+		record.Payload = next
+		refs = append(refs, ERPRecordReference{ModuleKey: referrer, ID: row.ID, Code: row.Code, Field: field})
+	}
+
+	extra := erpMasterExtraFields[moduleKey]
+	for _, referrer := range sortedERPModuleKeys() {
+		rows, err := lookup(referrer)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, row := range rows {
+			if row == nil || (referrer == moduleKey && (row.ID == survivor.ID || row.ID == loser.ID)) {
+				continue
+			}
+			for _, rule := range erpModuleRules[referrer].References {
+				for _, target := range rule.Targets {
+					if target.Module != moduleKey {
+						continue
+					}
+					from := erpReferenceKey(loser.Code, loser.Payload, target)
+					if from == "" {
+						continue
+					}
+					to := erpReferenceKey(survivor.Code, survivor.Payload, target)
+					if to == "" {
+						for _, value := range erpReferenceValues(row.Payload, rule.Field) {
+							if value.Value == from {
+								fields = append(fields, ERPFieldError{
+									Path: "partnerType",
+									Message: fmt.Sprintf("%s %s 的 %s 引用了%s %s，保留记录 %s 不是%s",
+										referrer, erpReferenceCode(row.Code, row.ID), value.Path, target.Label, from,
+										erpReferenceCode(survivor.Code, survivor.ID), target.Label),
+								})
+								break
+							}
+						}
+						continue
+					}
+					rewrite(referrer, row, rule.Field, from, to)
+				}
+			}
+			for _, field := range extra.Names {
+				rewrite(referrer, row, field, erpPayloadString(loser.Payload, "name"), erpPayloadString(survivor.Payload, "name"))
+			}
+			for _, field := range extra.Codes {
+				rewrite(referrer, row, field, loser.Code, survivor.Code)
+			}
+		}
+	}
+	if len(fields) > 0 {
+		return nil, nil, &ERPValidationError{Fields: mergeERPFieldErrors(fields)}
+	}
+	out := make([]*ERPRecord, 0, len(order))
+	for _, id := range order {
+		out = append(out, updated[id])
+	}
+	return refs, out, nil
+}
+
+// rewriteERPFieldValue 把 field（支持 list[].field、list[] 写法）中等于 from 的值改为 to，返回改写后的副本。
+func rewriteERPFieldValue(payload map[string]any, field, from, to string) (map[string]any, bool) {
+	list, rest, isList := strings.Cut(field, "[]")
+	if !isList {
+		if erpPayloadString(payload, field) != from {
+			return payload, false
+		}
+		next := copyERPPayload(payload)
+		next[field] = to
+		return next, true
+	}
+
+	rows, ok := payload[list].([]any)
+	if !ok {
+		return payload, false
+	}
+	rest = strings.TrimPrefix(rest, ".")
+	changed := false
+	nextRows := make([]any, len(rows))
+	for index, raw := range rows {
+		nextRows[index] = raw
+		if rest == "" {
+			if value, _ := raw.(string); strings.TrimSpace(value) == from {
+				nextRows[index] = to
+				changed = true
+			}
+			continue
+		}
+		row, ok := raw.(map[string]any)
+		if !ok || erpPayloadString(row, rest) != from {
+			continue
+		}
+		nextRow := copyERPPayload(row)
+		nextRow[rest] = to
+		nextRows[index] = nextRow
+		changed = true
+	}
+	if !changed {
+		return payload, false
+	}
+	next := copyERPPayload(payload)
+	next[list] = nextRows
+	return next, true
+}
+
+// mergeERPMasterPayload 保留记录为空的字段取被合并记录的值；往来单位子列表按键追加缺少的项。
+func mergeERPMasterPayload(survivor, loser map[string]any) map[string]any {
+	for key, value := range loser {
+		switch key {
+		case "code", "name", "box", "disabled", "mergedInto", "contacts", "addresses", "bankAccounts":
+			continue
+		}
+		if isEmptyERPValue(survivor[key]) && !isEmptyERPValue(value) {
+			survivor[key] = cloneERPValue(value)
+		}
+	}
+	for _, list := range erpPartnerLists {
+		loserRows, _ := loser[list.Field].([]any)
+		if len(loserRows) == 0 {
+			continue
+		}
+		rows, _ := survivor[list.Field].([]any)
+		keys := map[string]struct{}{}
+		for _, raw := range rows {
+			if row, ok := raw.(map[string]any); ok {
+				keys[erpPayloadString(row, list.Key)] = struct{}{}
+			}
+		}
+		for _, raw := range loserRows {
+			row, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			if _, exists := keys[erpPayloadString(row, list.Key)]; exists {
+				continue
+			}
+			next := cloneERPValue(row).(map[string]any)
+			delete(next, "isDefault")
+			rows = append(rows, next)
+		}
+		survivor[list.Field] = rows
+	}
+	return survivor
+}
+
+// cloneERPValue 深拷贝 payload 中的对象与数组。
+func cloneERPValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = cloneERPValue(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for index, item := range v {
+			out[index] = cloneERPValue(item)
+		}
+		return out
+	default:
+		return value
+	}
+}
@@ -0,0 +1,190 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestNormalizeERPPartnerName(t *testing.T) {
+	cases := map[string]string{
+		"XX Co., Ltd":          "xx",
+		"XX CO LTD":            "xx",
+		"xx limited":           "xx",
+		"宁波磁材有限公司":             "宁波磁材",
+		"Magnet Trading GmbH":  "magnettrading",
+		"Co":                   "co",
+		" Acme Inc. (Germany)": "acmeincgermany",
+	}
+	for input, want := range cases {
+		if got := normalizeERPPartnerName(input); got != want {
+			t.Fatalf("normalizeERPPartnerName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestERPUsecaseMasterDataMerge(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+
+	partner := func(code, partnerType, name, taxNo string, contacts ...any) int {
+		t.Helper()
+		created, err := uc.Create(ctx, ERPModulePartners, map[string]any{
+			"code": code, "partnerType": partnerType, "name": name, "taxNo": taxNo, "address": "宁波",
+			"paymentCycleDays": 30, "contacts": contacts,
+		}, 1)
+		if err != nil {
+			t.Fatalf("create partner %s failed: %v", code, err)
+		}
+		return created["id"].(int)
+	}
+	survivorID := partner("CS-001", "合作客户", "XX Co., Ltd", "91-330 abc",
+		map[string]any{"name": "张三", "phone": "111", "isDefault": true})
+	loserID := partner("CS-002", "合作客户", "XX CO LTD", "",
+		map[string]any{"name": "张三", "phone": "999"}, map[string]any{"name": "李四", "phone": "222", "isDefault": true})
+	partner("CS-003", "合作客户", "YY Trading", "91330ABC", map[string]any{"name": "王五", "phone": "333"})
+	supplierID := partner("GY-001", "合作供应商", "ZZ Factory", "", map[string]any{"name": "赵六", "phone": "444"})
+
+	groups, err := uc.Duplicates(ctx, ERPModulePartners)
+	if err != nil {
+		t.Fatalf("duplicates failed: %v", err)
+	}
+	if len(groups) != 2 || groups[0].Reason != ERPDuplicateByName || groups[0].Key != "xx" ||
+		len(groups[0].Records) != 2 || groups[0].Records[1].Code != "CS-002" ||
+		groups[1].Reason != ERPDuplicateByTaxNo || groups[1].Key != "91330ABC" {
+		t.Fatalf("unexpected groups: %+v", groups)
+	}
+
+	quotation := map[string]any{
+		"code": "BJ-001", "box": ERPBoxApproved, "customerName": "XX CO LTD", "customerCode": "CS-002", "quotedDate": "2026-01-02", "currency": "USD",
+		"items": []any{map[string]any{"productName": "磁钢A", "quantity": 10, "unitPrice": 5}},
+	}
+	if _, err := uc.Create(ctx, ERPModuleQuotations, quotation, 1); err != nil {
+		t.Fatalf("create quotation failed: %v", err)
+	}
+
+	// 保留或被合并记录不在记录范围内时视为不存在
+	scoped := NewContextWithERPScope(ctx, &ERPRecordScope{AdminIDs: []int{9}})
+	if _, err := uc.Merge(scoped, ERPModulePartners, survivorID, loserID, 1); !errors.Is(err, ErrERPRecordNotFound) {
+		t.Fatalf("merging out-of-scope partners should fail, got %v", err)
+	}
+
+	result, err := uc.Merge(ctx, ERPModulePartners, survivorID, loserID, 1)
+	if err != nil {
+		t.Fatalf("merge failed: %v", err)
+	}
+	want := []ERPRecordReference{
+		{ModuleKey: ERPModuleQuotations, ID: result.References[0].ID, Code: "BJ-001", Field: "customerName"},
+		{ModuleKey: ERPModuleQuotations, ID: result.References[0].ID, Code: "BJ-001", Field: "customerCode"},
+	}
+	if !reflect.DeepEqual(result.References, want) {
+		t.Fatalf("references = %+v, want %+v", result.References, want)
+	}
+	wantApproved := []ERPRecordReference{{ModuleKey: ERPModuleQuotations, ID: result.References[0].ID, Code: "BJ-001"}}
+	if !reflect.DeepEqual(result.Approved, wantApproved) {
+		t.Fatalf("approved = %+v, want %+v", result.Approved, wantApproved)
+	}
+	// 同名联系人保留原值，新增联系人不设为默认
+	wantContacts := []any{
+		map[string]any{"name": "张三", "phone": "111", "isDefault": true},
+		map[string]any{"name": "李四", "phone": "222"},
+	}
+	if !reflect.DeepEqual(result.Survivor["contacts"], wantContacts) {
+		t.Fatalf("contacts = %v, want %v", result.Survivor["contacts"], wantContacts)
+	}
+	if result.Loser["disabled"] != true || result.Loser["mergedInto"] != "CS-001" {
+		t.Fatalf("loser should be disabled: %v", result.Loser)
+	}
+	rows, _ := repo.ListByModule(ctx, ERPModuleQuotations)
+	if rows[0].Payload["customerName"] != "XX Co., Ltd" || rows[0].Payload["customerCode"] != "CS-001" {
+		t.Fatalf("quotation not rewritten: %v", rows[0].Payload)
+	}
+
+	// 被合并记录不再参与重复检查，也不能再被引用
+	groups, _ = uc.Duplicates(ctx, ERPModulePartners)
+	if len(groups) != 1 || groups[0].Reason != ERPDuplicateByTaxNo {
+		t.Fatalf("merged partner should be excluded: %+v", groups)
+	}
+	quotation["code"] = "BJ-002"
+	_, err = uc.Create(ctx, ERPModuleQuotations, quotation, 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Message != "字段 customerName 引用的客户 XX CO LTD 不存在" {
+		t.Fatalf("reference to merged partner should fail, got %v", err)
+	}
+	if _, err := uc.Merge(ctx, ERPModulePartners, survivorID, loserID, 1); !errors.As(err, &validationErr) {
+		t.Fatalf("merging a disabled partner should fail, got %v", err)
+	}
+
+	// 仍被作为客户引用的记录不能并入供应商
+	quotation["code"], quotation["customerName"] = "BJ-003", "XX Co., Ltd"
+	delete(quotation, "customerCode")
+	if _, err := uc.Create(ctx, ERPModuleQuotations, quotation, 1); err != nil {
+		t.Fatalf("create quotation failed: %v", err)
+	}
+	_, err = uc.Merge(ctx, ERPModulePartners, supplierID, survivorID, 1)
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "partnerType" {
+		t.Fatalf("merging customer into supplier should fail, got %v", err)
+	}
+}
+
+func TestERPUsecaseMasterDataMergeProducts(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作客户", "客户A")
+	seedERPRecords(t, repo, ERPModuleProducts,
+		map[string]any{"code": "PD-001", "hsCode": "8505111000", "specCode": "N35-D10", "cnDesc": "钕铁硼磁钢", "enDesc": "NdFeB magnet"},
+		map[string]any{"code": "PD-002", "hsCode": "8505.1110.00", "specCode": "n35 d10", "cnDesc": "钕铁硼磁钢", "enDesc": "NdFeB magnet", "pcsPerCarton": 100},
+	)
+	products, _ := repo.ListByModule(ctx, ERPModuleProducts)
+	ids := map[string]int{}
+	for _, row := range products {
+		ids[row.Code] = row.ID
+	}
+
+	groups, err := uc.Duplicates(ctx, ERPModuleProducts)
+	if err != nil {
+		t.Fatalf("duplicates failed: %v", err)
+	}
+	if len(groups) != 2 || groups[0].Reason != ERPDuplicateBySpecCode || groups[0].Key != "N35D10" ||
+		groups[1].Reason != ERPDuplicateByHSDesc || groups[1].Records[0].Name != "钕铁硼磁钢" {
+		t.Fatalf("unexpected groups: %+v", groups)
+	}
+
+	if _, err := uc.Create(ctx, ERPModuleQuotations, map[string]any{
+		"code": "BJ-001", "customerName": "客户A", "quotedDate": "2026-01-02", "currency": "USD",
+		"items": []any{
+			map[string]any{"productCode": "PD-002", "productName": "磁钢A", "quantity": 10, "unitPrice": 5},
+			map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 5, "unitPrice": 5},
+		},
+	}, 1); err != nil {
+		t.Fatalf("create quotation failed: %v", err)
+	}
+
+	result, err := uc.Merge(ctx, ERPModuleProducts, ids["PD-001"], ids["PD-002"], 1)
+	if err != nil {
+		t.Fatalf("merge failed: %v", err)
+	}
+	if len(result.References) != 1 || result.References[0].Field != "items[].productCode" {
+		t.Fatalf("unexpected references: %+v", result.References)
+	}
+	assertERPNumbers(t, result.Survivor, map[string]float64{"pcsPerCarton": 100})
+	rows, _ := repo.ListByModule(ctx, ERPModuleQuotations)
+	items := rows[0].Payload["items"].([]any)
+	if items[0].(map[string]any)["productCode"] != "PD-001" || items[1].(map[string]any)["productCode"] != "PD-001" {
+		t.Fatalf("items not rewritten: %v", items)
+	}
+
+	if _, err := uc.Merge(ctx, ERPModuleProducts, ids["PD-001"], ids["PD-001"], 1); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("merging a record into itself should fail, got %v", err)
+	}
+	if _, err := uc.Duplicates(ctx, ERPModuleQuotations); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("duplicates of documents should fail, got %v", err)
+	}
+}
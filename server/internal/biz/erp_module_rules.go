@@ -9,17 +9,26 @@ import (
 )
 
 const (
-	ERPModulePartners          = "partners"
-	ERPModuleProducts          = "products"
-	ERPModuleQuotations        = "quotations"
-	ERPModuleExportSales       = "exportSales"
-	ERPModulePurchaseContracts = "purchaseContracts"
-	ERPModuleInbound           = "inbound"
-	ERPModuleInventory         = "inventory"
-	ERPModuleShipmentDetails   = "shipmentDetails"
-	ERPModuleOutbound          = "outbound"
-	ERPModuleSettlements       = "settlements"
-	ERPModuleBankReceipts      = "bankReceipts"
+	ERPModulePartners           = "partners"
+	ERPModuleProducts           = "products"
+	ERPModuleQuotations         = "quotations"
+	ERPModuleExportSales        = "exportSales"
+	ERPModulePurchaseContracts  = "purchaseContracts"
+	ERPModuleInbound            = "inbound"
+	ERPModuleInventory          = "inventory"
+	ERPModuleShipmentDetails    = "shipmentDetails"
+	ERPModuleOutbound           = "outbound"
+	ERPModuleSettlements        = "settlements"
+	ERPModuleBankReceipts       = "bankReceipts"
+	ERPModuleSupplierInvoices   = "supplierInvoices"
+	ERPModuleSupplierPayments   = "supplierPayments"
+	ERPModuleRebateRates        = "rebateRates"
+	ERPModuleRebateDeclarations = "rebateDeclarations"
+	ERPModuleExchangeRates      = "exchangeRates"
+	ERPModuleShipmentCosts      = "shipmentCosts"
+	ERPModulePriceLists         = "priceLists"
+	ERPModuleProductionOrders   = "productionOrders"
+	ERPModuleHSCodes            = "hsCodes"
 )
 
 const (
@@ -31,125 +40,98 @@ const (
 	ERPBoxAuto      = "免批"
 )
 
-type erpNumberRule struct {
-	Min *float64
-}
-
+// erpModuleRule 模块的默认状态箱、派生规则、引用规则、明细行从产品资料带出的字段、选用的客户联系人/地址，
+// 明细行是否按价格表或已接受报价给出建议单价，以及按海关编码表生成申报要素的规则（Declaration）；
+// 字段类型、必填、取值范围等约束见 erp_schemas/<module>.json，Schema 表达不了的跨行约束由 CheckFields 校验。
 type erpModuleRule struct {
-	DefaultBox     string
-	RequiredFields []string
-	NumberRules    map[string]erpNumberRule
-	DeriveFields   func(payload map[string]any) error
+	DefaultBox    string
+	DeriveFields  func(payload map[string]any) error
+	CheckFields   func(payload map[string]any) []ERPFieldError
+	References    []erpReferenceRule
+	ProductItems  []string
+	Parties       []erpPartyRule
+	SuggestPrices bool
+	Declaration   func(payload map[string]any, lookup erpRecordLookup) ([]ERPFieldError, error)
 }
 
-var erpAllowedBoxes = map[string]struct{}{
-	ERPBoxDraft:     {},
-	ERPBoxPending:   {},
-	ERPBoxApproved:  {},
-	ERPBoxClaim:     {},
-	ERPBoxConfirmed: {},
-	ERPBoxAuto:      {},
-}
+var erpItemsProductRef = erpReferenceRule{Field: "items[].productCode", Targets: []erpReferenceTarget{erpRefProduct}}
 
 var erpModuleRules = map[string]erpModuleRule{
-	ERPModulePartners: {
-		DefaultBox: ERPBoxAuto,
-		RequiredFields: []string{
-			"partnerType", "name", "address", "contact", "contactPhone", "paymentCycleDays",
-		},
-		NumberRules: map[string]erpNumberRule{
-			"paymentCycleDays": {Min: numberMin(0)},
-		},
-	},
-	ERPModuleProducts: {
-		DefaultBox: ERPBoxAuto,
-		RequiredFields: []string{
-			"hsCode", "specCode", "cnDesc", "enDesc",
-		},
-	},
-	ERPModuleQuotations: {
-		DefaultBox: ERPBoxDraft,
-		RequiredFields: []string{
-			"customerName", "quotedDate", "currency", "items",
-		},
-		DeriveFields: deriveTotalAmount,
-	},
-	ERPModuleExportSales: {
-		DefaultBox: ERPBoxDraft,
-		RequiredFields: []string{
-			"customerName", "customerContractNo", "signDate", "deliveryDate", "transportType", "orderFlow", "items",
-		},
-		DeriveFields: deriveTotalAmount,
-	},
-	ERPModulePurchaseContracts: {
-		DefaultBox: ERPBoxDraft,
-		RequiredFields: []string{
-			"supplierName", "signDate", "salesNo", "deliveryDate", "deliveryAddress", "invoiceRequired", "items",
-		},
-		DeriveFields: deriveTotalAmount,
-	},
-	ERPModuleInbound: {
-		DefaultBox: ERPBoxDraft,
-		RequiredFields: []string{
-			"purchaseCode", "productName", "warehouseName", "location", "qcStatus", "quantity",
-		},
-		NumberRules: map[string]erpNumberRule{
-			"quantity": {Min: numberMin(0.000001)},
-		},
-	},
-	ERPModuleInventory: {
-		DefaultBox: ERPBoxAuto,
-		RequiredFields: []string{
-			"productName", "warehouseName", "location", "availableQty", "lockedQty",
-		},
-		NumberRules: map[string]erpNumberRule{
-			"availableQty": {Min: numberMin(0)},
-			"lockedQty":    {Min: numberMin(0)},
-		},
-	},
-	ERPModuleShipmentDetails: {
-		DefaultBox: ERPBoxDraft,
-		RequiredFields: []string{
-			"customerName", "startPort", "destPort", "shipToAddress", "transportType", "arriveCountry", "salesOwner", "items",
-		},
-		NumberRules: map[string]erpNumberRule{
-			"totalPackages": {Min: numberMin(0.000001)},
-		},
-		DeriveFields: deriveShipmentTotalPackages,
-	},
-	ERPModuleOutbound: {
-		DefaultBox: ERPBoxAuto,
-		RequiredFields: []string{
-			"shipmentCode", "productName", "quantity", "warehouseName", "location",
-		},
-		NumberRules: map[string]erpNumberRule{
-			"quantity": {Min: numberMin(0.000001)},
-		},
-	},
-	ERPModuleSettlements: {
-		DefaultBox: ERPBoxAuto,
-		RequiredFields: []string{
-			"invoiceNo", "shipDate", "paymentCycleDays", "amount",
-		},
-		NumberRules: map[string]erpNumberRule{
-			"paymentCycleDays": {Min: numberMin(0)},
-			"amount":           {Min: numberMin(0.000001)},
-		},
-		DeriveFields: deriveSettlementReceivableDate,
-	},
-	ERPModuleBankReceipts: {
-		DefaultBox: ERPBoxClaim,
-		RequiredFields: []string{
-			"fundType", "refNo", "receivedAmount", "bankFee", "registerDate",
-		},
-		NumberRules: map[string]erpNumberRule{
-			"receivedAmount": {Min: numberMin(0.000001)},
-			"bankFee":        {Min: numberMin(0)},
-		},
-	},
+	ERPModulePartners: {DefaultBox: ERPBoxAuto, DeriveFields: derivePartnerDefaults, CheckFields: checkERPPartnerLists},
+	ERPModuleProducts: {DefaultBox: ERPBoxAuto, CheckFields: checkERPProductBOM, Declaration: applyERPProductDeclaration, References: []erpReferenceRule{
+		{Field: "hsCode", Targets: []erpReferenceTarget{erpRefHSCode}},
+		{Field: "bom[].materialCode", Targets: []erpReferenceTarget{erpRefProduct}},
+	}},
+	ERPModuleQuotations: {DefaultBox: ERPBoxDraft, DeriveFields: deriveQuotation, CheckFields: checkERPQuotation, ProductItems: erpProductItemUnitFields,
+		Parties: []erpPartyRule{erpPartyContact}, SuggestPrices: true, References: []erpReferenceRule{
+			{Field: "customerName", Targets: []erpReferenceTarget{erpRefCustomer}},
+			{Field: "revisionOf", Targets: []erpReferenceTarget{erpRefQuote}},
+			erpItemsProductRef,
+		}},
+	ERPModuleExportSales: {DefaultBox: ERPBoxDraft, DeriveFields: deriveTotalAmount, ProductItems: erpProductItemUnitFields,
+		Parties: []erpPartyRule{erpPartyContact, erpPartyBilling, erpPartyConsignee}, References: []erpReferenceRule{
+			{Field: "customerName", Targets: []erpReferenceTarget{erpRefCustomer}},
+			{Field: "sourceQuotationCode", Targets: []erpReferenceTarget{erpRefQuote}},
+			erpItemsProductRef,
+		}},
+	ERPModulePurchaseContracts: {DefaultBox: ERPBoxDraft, DeriveFields: deriveTotalAmount, ProductItems: erpProductItemUnitFields, References: []erpReferenceRule{
+		{Field: "supplierName", Targets: []erpReferenceTarget{erpRefSupplier}},
+		{Field: "salesNo", Targets: []erpReferenceTarget{erpRefExport}},
+		{Field: "sourceExportCode", Targets: []erpReferenceTarget{erpRefExport}},
+		erpItemsProductRef,
+	}},
+	ERPModuleInbound: {DefaultBox: ERPBoxDraft, References: []erpReferenceRule{
+		{Field: "purchaseCode", Targets: []erpReferenceTarget{erpRefPurchase}},
+	}},
+	ERPModuleInventory: {DefaultBox: ERPBoxAuto},
+	ERPModuleShipmentDetails: {DefaultBox: ERPBoxDraft, DeriveFields: deriveShipmentPackaging, ProductItems: erpProductItemPackagingFields,
+		Declaration: applyERPItemDeclarations,
+		Parties:     []erpPartyRule{erpPartyContact, erpPartyConsignee, erpPartyNotify, erpPartyBilling}, References: []erpReferenceRule{
+			{Field: "customerName", Targets: []erpReferenceTarget{erpRefCustomer}},
+			{Field: "sourceExportCode", Targets: []erpReferenceTarget{erpRefExport}},
+			erpItemsProductRef,
+		}},
+	ERPModuleOutbound: {DefaultBox: ERPBoxAuto, References: []erpReferenceRule{
+		{Field: "shipmentCode", Targets: []erpReferenceTarget{erpRefShipment}},
+	}},
+	ERPModuleSettlements: {DefaultBox: ERPBoxAuto, DeriveFields: deriveSettlement, References: []erpReferenceRule{
+		{Field: "sourceShipmentCode", Targets: []erpReferenceTarget{erpRefShipment}},
+		{Field: "lines[].shipmentCode", Targets: []erpReferenceTarget{erpRefShipment}},
+	}},
+	ERPModuleBankReceipts: {DefaultBox: ERPBoxClaim, DeriveFields: deriveBankReceiptAllocations, References: []erpReferenceRule{
+		{Field: "allocations[].settlementCode", Targets: []erpReferenceTarget{erpRefSettle}},
+	}},
+	ERPModuleSupplierInvoices: {DefaultBox: ERPBoxAuto, DeriveFields: deriveSupplierInvoiceTax, References: []erpReferenceRule{
+		{Field: "supplierName", Targets: []erpReferenceTarget{erpRefSupplier}},
+		{Field: "purchaseCode", Targets: []erpReferenceTarget{erpRefPurchase}},
+	}},
+	ERPModuleSupplierPayments: {DefaultBox: ERPBoxAuto, DeriveFields: deriveSupplierPayment, References: []erpReferenceRule{
+		{Field: "supplierName", Targets: []erpReferenceTarget{erpRefSupplier}},
+		{Field: "purchaseCode", Targets: []erpReferenceTarget{erpRefPurchase, erpRefCost}},
+	}},
+	ERPModuleRebateRates: {DefaultBox: ERPBoxAuto, DeriveFields: deriveRebateRate},
+	ERPModuleRebateDeclarations: {DefaultBox: ERPBoxAuto, DeriveFields: deriveRebateDeclaration, References: []erpReferenceRule{
+		{Field: "shipmentCode", Targets: []erpReferenceTarget{erpRefShipment}},
+	}},
+	ERPModuleExchangeRates: {DefaultBox: ERPBoxAuto, DeriveFields: deriveExchangeRate},
+	ERPModuleShipmentCosts: {DefaultBox: ERPBoxAuto, DeriveFields: deriveShipmentCost, References: []erpReferenceRule{
+		{Field: "shipmentCodes[]", Targets: []erpReferenceTarget{erpRefShipment}},
+		{Field: "supplierName", Targets: []erpReferenceTarget{erpRefSupplier}},
+	}},
+	ERPModulePriceLists: {DefaultBox: ERPBoxAuto, CheckFields: checkERPPriceList, References: []erpReferenceRule{
+		{Field: "customerName", Targets: []erpReferenceTarget{erpRefCustomer}},
+		erpItemsProductRef,
+	}},
+	ERPModuleProductionOrders: {DefaultBox: ERPBoxAuto, DeriveFields: deriveProductionOrder, CheckFields: checkERPProductionOrder, References: []erpReferenceRule{
+		{Field: "sourceExportCode", Targets: []erpReferenceTarget{erpRefExport}},
+		{Field: "productCode", Targets: []erpReferenceTarget{erpRefProduct}},
+		{Field: "materials[].materialCode", Targets: []erpReferenceTarget{erpRefProduct}},
+	}},
+	ERPModuleHSCodes: {DefaultBox: ERPBoxAuto, DeriveFields: deriveHSCode, CheckFields: checkERPHSCodeElements},
 }
 
-func normalizeERPModuleKey(moduleKey string) (string, error) {
+// NormalizeERPModuleKey 去掉首尾空白并校验模块是否存在；接口层须用同一个值做鉴权、记录范围与业务调用。
+func NormalizeERPModuleKey(moduleKey string) (string, error) {
 	key := strings.TrimSpace(moduleKey)
 	if key == "" {
 		return "", ErrERPInvalidModule
@@ -160,76 +142,76 @@ func normalizeERPModuleKey(moduleKey string) (string, error) {
 	return key, nil
 }
 
-func applyERPModuleRules(moduleKey string, payload map[string]any) (map[string]any, error) {
+// applyERPModuleRules 补齐默认状态箱、转换数字字符串并计算派生字段，再按模块 Schema 与引用规则校验。
+// Schema 或引用校验失败时返回全部不合法字段；通过后才返回派生规则自身的错误。lookup 为 nil 时不校验引用。
+func applyERPModuleRules(moduleKey string, payload map[string]any, lookup erpRecordLookup) (map[string]any, error) {
 	rule, ok := erpModuleRules[moduleKey]
 	if !ok {
 		return nil, ErrERPInvalidModule
 	}
+	schema, ok := erpModuleSchemas[moduleKey]
+	if !ok {
+		return nil, ErrERPInvalidModule
+	}
 
 	normalized := cloneERPPayload(payload)
-
-	if err := applyERPBoxRule(rule, normalized); err != nil {
-		return nil, err
+	applyERPBoxRule(rule, normalized)
+	normalized, _ = coerceERPSchemaNumbers(schema.compiled, normalized).(map[string]any)
+
+	var productFields, partyFields, declarationFields []ERPFieldError
+	if lookup != nil {
+		var err error
+		if productFields, err = applyERPProductItems(normalized, rule.ProductItems, lookup); err != nil {
+			return nil, err
+		}
+		if partyFields, err = applyERPParties(normalized, rule.Parties, lookup); err != nil {
+			return nil, err
+		}
+		if rule.SuggestPrices {
+			if err := applyERPSuggestedPrices(normalized, lookup); err != nil {
+				return nil, err
+			}
+		}
+		if rule.Declaration != nil {
+			if declarationFields, err = rule.Declaration(normalized, lookup); err != nil {
+				return nil, err
+			}
+		}
 	}
+	var deriveErr error
 	if rule.DeriveFields != nil {
-		if err := rule.DeriveFields(normalized); err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrERPInvalidRecord, err)
+		deriveErr = rule.DeriveFields(normalized)
+	}
+	fields := schema.validate(normalized)
+	if rule.CheckFields != nil {
+		fields = mergeERPFieldErrors(fields, rule.CheckFields(normalized))
+	}
+	if lookup != nil {
+		refFields, err := validateERPReferences(rule.References, normalized, lookup)
+		if err != nil {
+			return nil, err
 		}
+		fields = mergeERPFieldErrors(fields, refFields, productFields, partyFields, declarationFields)
 	}
-	if err := validateERPRequiredFields(rule.RequiredFields, normalized); err != nil {
-		return nil, err
+	if len(fields) > 0 {
+		return nil, &ERPValidationError{Fields: fields}
 	}
-	if err := validateERPNumberFields(rule.NumberRules, normalized); err != nil {
-		return nil, err
+	if deriveErr != nil {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Message: deriveErr.Error()}}}
 	}
 
 	return normalized, nil
 }
 
-func applyERPBoxRule(rule erpModuleRule, payload map[string]any) error {
-	boxRaw, hasBox := payload["box"]
-	if !hasBox || isEmptyERPValue(boxRaw) {
-		payload["box"] = rule.DefaultBox
-		return nil
-	}
-
-	box, ok := boxRaw.(string)
-	if !ok {
-		return fmt.Errorf("%w: 字段 box 必须是字符串", ErrERPInvalidRecord)
-	}
-	box = strings.TrimSpace(box)
-	if box == "" {
+// applyERPBoxRule 未填写状态箱时取模块默认值；取值由 Schema 校验。
+func applyERPBoxRule(rule erpModuleRule, payload map[string]any) {
+	if isEmptyERPValue(payload["box"]) {
 		payload["box"] = rule.DefaultBox
-		return nil
-	}
-	if _, ok := erpAllowedBoxes[box]; !ok {
-		return fmt.Errorf("%w: 字段 box 非法", ErrERPInvalidRecord)
+		return
 	}
-	payload["box"] = box
-	return nil
-}
-
-func validateERPRequiredFields(requiredFields []string, payload map[string]any) error {
-	for _, field := range requiredFields {
-		if isEmptyERPValue(payload[field]) {
-			return fmt.Errorf("%w: 缺少必填字段 %s", ErrERPInvalidRecord, field)
-		}
-	}
-	return nil
-}
-
-func validateERPNumberFields(numberRules map[string]erpNumberRule, payload map[string]any) error {
-	for field, rule := range numberRules {
-		value, ok := toERPFloat64(payload[field])
-		if !ok {
-			return fmt.Errorf("%w: 字段 %s 必须是数字", ErrERPInvalidRecord, field)
-		}
-		if rule.Min != nil && value < *rule.Min {
-			return fmt.Errorf("%w: 字段 %s 超出范围", ErrERPInvalidRecord, field)
-		}
-		payload[field] = normalizeERPNumber(value)
+	if box, ok := payload["box"].(string); ok {
+		payload["box"] = strings.TrimSpace(box)
 	}
-	return nil
 }
 
 func deriveTotalAmount(payload map[string]any) error {
@@ -241,22 +223,6 @@ func deriveTotalAmount(payload map[string]any) error {
 	return nil
 }
 
-func deriveShipmentTotalPackages(payload map[string]any) error {
-	items, err := getERPItems(payload["items"])
-	if err != nil {
-		return err
-	}
-
-	currentTotal, ok := toERPFloat64(payload["totalPackages"])
-	if ok && currentTotal > 0 {
-		payload["totalPackages"] = normalizeERPNumber(currentTotal)
-		return nil
-	}
-
-	payload["totalPackages"] = normalizeERPNumber(calcERPItemsQty(items))
-	return nil
-}
-
 func deriveSettlementReceivableDate(payload map[string]any) error {
 	shipDateRaw, ok := payload["shipDate"].(string)
 	if !ok || strings.TrimSpace(shipDateRaw) == "" {
@@ -414,8 +380,3 @@ func isEmptyERPValue(raw any) bool {
 		return false
 	}
 }
-
-func numberMin(value float64) *float64 {
-	v := value
-	return &v
-}
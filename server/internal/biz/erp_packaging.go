@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// erpProductItemUnitFields 各含明细的模块从产品资料带出的字段；出运明细另带出包装规格，供 deriveShipmentPackaging 计算箱数、重量与体积。
+var (
+	erpProductItemUnitFields      = []string{"unit"}
+	erpProductItemPackagingFields = []string{
+		"unit", "pcsPerCarton", "cartonNetWeight", "cartonGrossWeight", "cartonLength", "cartonWidth", "cartonHeight",
+	}
+)
+
+// applyERPProductItems 为填写了 productCode 的明细行带出产品资料：fields 中未填写的字段取产品值，
+// 并按产品的单位换算把 quantity 折算为产品基本单位的 baseQuantity。payload 须是 applyERPModuleRules 复制后的副本；
+// 产品不存在时不处理（由引用校验报错）。
+func applyERPProductItems(payload map[string]any, fields []string, lookup erpRecordLookup) ([]ERPFieldError, error) {
+	rows, ok := payload["items"].([]any)
+	if !ok || len(fields) == 0 {
+		return nil, nil
+	}
+	products, err := lookup(ERPModuleProducts)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []ERPFieldError
+	for index, row := range rows {
+		item, ok := row.(map[string]any)
+		if !ok {
+			continue
+		}
+		// baseQuantity 只由产品换算得出，避免改掉产品编码后残留旧值
+		delete(item, "baseQuantity")
+		code := erpPayloadString(item, "productCode")
+		product := findERPReferenceTarget(products, erpRefProduct, code, 0)
+		if code == "" || product == nil {
+			continue
+		}
+		for _, field := range fields {
+			if isEmptyERPValue(item[field]) && !isEmptyERPValue(product.Payload[field]) {
+				item[field] = product.Payload[field]
+			}
+		}
+
+		factor, ok := erpProductUnitFactor(product.Payload, erpPayloadString(item, "unit"))
+		if !ok {
+			path := fmt.Sprintf("items[%d].unit", index)
+			errs = append(errs, ERPFieldError{
+				Path: path,
+				Message: fmt.Sprintf("字段 %s 产品 %s 没有单位 %s 到 %s 的换算系数",
+					path, code, erpPayloadString(item, "unit"), erpProductBaseUnit(product.Payload)),
+			})
+			continue
+		}
+		if quantity, ok := toERPFloat64(item["quantity"]); ok {
+			item["baseQuantity"] = normalizeERPNumber(roundERPAmount(quantity * factor))
+		}
+	}
+	return errs, nil
+}
+
+// erpProductBaseUnit 产品基本单位，未填写时为 pcs。
+func erpProductBaseUnit(product map[string]any) string {
+	if unit := erpPayloadString(product, "unit"); unit != "" {
+		return unit
+	}
+	return "pcs"
+}
+
+// erpProductUnitFactor 返回 1 个 unit 折合多少基本单位：unit 为空或等于基本单位时为 1，否则取 unitConversions。
+func erpProductUnitFactor(product map[string]any, unit string) (float64, bool) {
+	if unit == "" || strings.EqualFold(unit, erpProductBaseUnit(product)) {
+		return 1, true
+	}
+	conversions, _ := product["unitConversions"].([]any)
+	for _, raw := range conversions {
+		conversion, _ := raw.(map[string]any)
+		if !strings.EqualFold(erpPayloadString(conversion, "unit"), unit) {
+			continue
+		}
+		if factor := erpPayloadFloat(conversion, "factor"); factor > 0 {
+			return factor, true
+		}
+	}
+	return 0, false
+}
+
+// deriveShipmentPackaging 按明细行的包装规格计算箱数、净重、毛重与体积，再汇总总件数。
+// 行内有 pcsPerCarton 时：箱数 = ceil(基本单位数量 / 每箱数量)，净重按每箱净重折算到实际数量，
+// 毛重 = 净重 + 箱数 × 每箱包材重量（毛重 - 净重），体积 = 箱数 × 外箱长宽高（cm）/ 1e6（m³）。
+// 没有包装规格的行保留填写的重量与体积；有任一行算出箱数时总件数为各行箱数之和（未算出的行按填写的箱数，否则按数量），
+// 否则沿用填写的总件数，未填写时为数量合计。
+func deriveShipmentPackaging(payload map[string]any) error {
+	items, err := getERPItems(payload["items"])
+	if err != nil {
+		return err
+	}
+
+	derived := false
+	total := 0.0
+	for _, item := range items {
+		quantity := erpItemBaseQuantity(item)
+		perCarton, _ := toERPFloat64(item["pcsPerCarton"])
+		if perCarton <= 0 || quantity <= 0 {
+			if cartons, ok := toERPFloat64(item["cartons"]); ok && cartons > 0 {
+				total += cartons
+			} else {
+				total += quantity
+			}
+			continue
+		}
+
+		derived = true
+		cartons := math.Ceil(roundERPAmount(quantity / perCarton))
+		item["cartons"] = normalizeERPNumber(cartons)
+		total += cartons
+		netPerCarton, _ := toERPFloat64(item["cartonNetWeight"])
+		grossPerCarton, _ := toERPFloat64(item["cartonGrossWeight"])
+		if netPerCarton > 0 {
+			netWeight := roundERPAmount(quantity / perCarton * netPerCarton)
+			item["netWeight"] = normalizeERPNumber(netWeight)
+			if grossPerCarton >= netPerCarton {
+				item["grossWeight"] = normalizeERPNumber(roundERPAmount(netWeight + cartons*(grossPerCarton-netPerCarton)))
+			}
+		}
+		length, _ := toERPFloat64(item["cartonLength"])
+		width, _ := toERPFloat64(item["cartonWidth"])
+		height, _ := toERPFloat64(item["cartonHeight"])
+		if length > 0 && width > 0 && height > 0 {
+			item["volume"] = normalizeERPNumber(roundERPAmount(cartons * length * width * height / 1e6))
+		}
+	}
+
+	if derived {
+		payload["totalPackages"] = normalizeERPNumber(total)
+		return nil
+	}
+	if currentTotal, ok := toERPFloat64(payload["totalPackages"]); ok && currentTotal > 0 {
+		payload["totalPackages"] = normalizeERPNumber(currentTotal)
+		return nil
+	}
+	payload["totalPackages"] = normalizeERPNumber(calcERPItemsQty(items))
+	return nil
+}
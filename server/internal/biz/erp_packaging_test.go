@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecaseShipmentPackaging(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作客户", "客户A")
+	seedERPRecords(t, repo, ERPModuleProducts, map[string]any{
+		"code": "PD-001", "unit": "pcs", "unitConversions": []any{map[string]any{"unit": "kg", "factor": 250}},
+		"pcsPerCarton": 100, "cartonLength": 40, "cartonWidth": 30, "cartonHeight": 20,
+		"cartonNetWeight": 5, "cartonGrossWeight": 5.8,
+	})
+
+	shipment := map[string]any{
+		"code": "CY-001", "customerName": "客户A", "startPort": "宁波", "destPort": "Hamburg",
+		"shipToAddress": "Germany Warehouse", "transportType": "海运", "arriveCountry": "Germany",
+		"salesOwner": "业务员A", "warehouseShipDate": "2026-02-10",
+		"items": []any{
+			map[string]any{"productCode": "PD-001", "productModel": "磁钢A", "quantity": 2, "unit": "kg", "unitPrice": 5},
+			map[string]any{"productCode": "PD-001", "productModel": "磁钢A", "quantity": "150", "unitPrice": 5, "netWeight": 99},
+			map[string]any{"productModel": "辅材", "quantity": 10, "cartons": 1, "netWeight": 3},
+		},
+	}
+	created, err := uc.Create(ctx, ERPModuleShipmentDetails, shipment, 1)
+	if err != nil {
+		t.Fatalf("create shipment failed: %v", err)
+	}
+	if _, ok := shipment["items"].([]any)[0].(map[string]any)["cartons"]; ok {
+		t.Fatalf("input payload should not be modified")
+	}
+	items := created["items"].([]any)
+	first, second, manual := items[0].(map[string]any), items[1].(map[string]any), items[2].(map[string]any)
+	assertERPNumbers(t, first, map[string]float64{
+		"baseQuantity": 500, "pcsPerCarton": 100, "cartons": 5, "netWeight": 25, "grossWeight": 29, "volume": 0.12,
+	})
+	assertERPNumbers(t, second, map[string]float64{
+		"baseQuantity": 150, "cartons": 2, "netWeight": 7.5, "grossWeight": 9.1, "volume": 0.048,
+	})
+	if second["unit"] != "pcs" || manual["baseQuantity"] != nil {
+		t.Fatalf("unexpected lines: %v %v", second, manual)
+	}
+	assertERPNumbers(t, manual, map[string]float64{"netWeight": 3})
+	assertERPNumbers(t, created, map[string]float64{"totalPackages": 8})
+
+	shipment["code"] = "CY-002"
+	shipment["items"] = []any{map[string]any{"productCode": "PD-001", "productModel": "磁钢A", "quantity": 1, "unit": "箱"}}
+	_, err = uc.Create(ctx, ERPModuleShipmentDetails, shipment, 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("unknown unit should fail, got %v", err)
+	}
+	want := []ERPFieldError{{Path: "items[0].unit", Message: "字段 items[0].unit 产品 PD-001 没有单位 箱 到 pcs 的换算系数"}}
+	if !reflect.DeepEqual(validationErr.Fields, want) {
+		t.Fatalf("fields = %v, want %v", validationErr.Fields, want)
+	}
+}
+
+func TestERPUsecaseFulfilmentUsesBaseUnit(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作客户", "客户A")
+	seedERPPartners(t, repo, "合作供应商", "工厂A")
+	seedERPRecords(t, repo, ERPModuleProducts, map[string]any{
+		"code": "PD-001", "unit": "pcs", "unitConversions": []any{map[string]any{"unit": "kg", "factor": 250}},
+	})
+
+	if _, err := uc.Create(ctx, ERPModuleExportSales, map[string]any{
+		"code": "XS-001", "customerName": "客户A", "customerContractNo": "HT-001", "signDate": "2026-01-10",
+		"deliveryDate": "2026-02-10", "transportType": "海运", "orderFlow": "成品采购", "currency": "USD",
+		"items": []any{map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 500, "unitPrice": 5}},
+	}, 1); err != nil {
+		t.Fatalf("create sale failed: %v", err)
+	}
+	purchase := func(code string, kg float64) map[string]any {
+		return map[string]any{
+			"code": code, "supplierName": "工厂A", "signDate": "2026-01-11", "salesNo": "XS-001",
+			"deliveryDate": "2026-01-25", "deliveryAddress": "宁波", "invoiceRequired": "是",
+			"items": []any{map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": kg, "unit": "kg", "unitPrice": 1000}},
+		}
+	}
+	// 按 kg 采购、按 pcs 销售，折算为基本单位后比较
+	if _, err := uc.Create(ctx, ERPModulePurchaseContracts, purchase("CG-001", 1.2), 1); err != nil {
+		t.Fatalf("create purchase failed: %v", err)
+	}
+	_, err := uc.Create(ctx, ERPModulePurchaseContracts, purchase("CG-002", 1), 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) ||
+		validationErr.Fields[0].Message != "字段 items[0].quantity 产品 磁钢A 累计采购 550，超过外销合同 XS-001 的上限 500（数量 500，容差 0%）" {
+		t.Fatalf("over purchase in kg should fail, got %v", err)
+	}
+}
+
+// assertERPNumbers 按数值比较 payload 字段，忽略 int64/float64 的类型差异。
+func assertERPNumbers(t *testing.T, payload map[string]any, want map[string]float64) {
+	t.Helper()
+	for key, value := range want {
+		if got, ok := toERPFloat64(payload[key]); !ok || got != value {
+			t.Fatalf("%s = %v, want %v", key, payload[key], value)
+		}
+	}
+}
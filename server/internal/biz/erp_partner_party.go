@@ -0,0 +1,213 @@
+package biz
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ERPAddressConsignee = "收货人"
+	ERPAddressNotify    = "通知方"
+	ERPAddressBilling   = "账单"
+)
+
+// erpPartnerList 往来单位下的子列表：Key 为列表内唯一的字段，GroupBy 非空时每组各自最多一个默认项。
+type erpPartnerList struct {
+	Field   string
+	Key     string
+	GroupBy string
+	Label   string
+}
+
+var erpPartnerLists = []erpPartnerList{
+	{Field: "contacts", Key: "name", Label: "联系人"},
+	{Field: "addresses", Key: "label", GroupBy: "addressType", Label: "地址"},
+	{Field: "bankAccounts", Key: "accountNo", GroupBy: "currency", Label: "银行账户"},
+}
+
+// erpPartyRule 单据选用客户的联系人或地址：Field 填写联系人姓名或地址标签，为空时取客户的默认项；
+// 选中后按 Fill（单据字段 → 子项字段）覆盖联系方式等字段，地址另把整条内容快照到 Snapshot，供单证打印。
+type erpPartyRule struct {
+	Field       string
+	List        string
+	AddressType string
+	Fill        map[string]string
+	Snapshot    string
+}
+
+var (
+	erpPartyContact = erpPartyRule{Field: "contactName", List: "contacts",
+		Fill: map[string]string{"contactTel": "phone", "contactEmail": "email"}}
+	erpPartyConsignee = erpPartyRule{Field: "consigneeAddressLabel", List: "addresses", AddressType: ERPAddressConsignee,
+		Fill: map[string]string{"shipToAddress": "address"}, Snapshot: "consigneeParty"}
+	erpPartyNotify = erpPartyRule{Field: "notifyAddressLabel", List: "addresses", AddressType: ERPAddressNotify,
+		Snapshot: "notifyParty"}
+	erpPartyBilling = erpPartyRule{Field: "billingAddressLabel", List: "addresses", AddressType: ERPAddressBilling,
+		Snapshot: "billingParty"}
+)
+
+// erpPartySnapshotFields 地址快照保留的字段。
+var erpPartySnapshotFields = []string{"label", "addressType", "partyName", "address", "country", "contact", "phone"}
+
+// checkERPPartnerLists 校验往来单位子列表：键不能重复，同一列表（或同一分组）最多一个默认项。
+func checkERPPartnerLists(payload map[string]any) []ERPFieldError {
+	var errs []ERPFieldError
+	for _, list := range erpPartnerLists {
+		rows, _ := payload[list.Field].([]any)
+		keys := map[string]int{}
+		defaults := map[string]int{}
+		for index, raw := range rows {
+			row, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			if key := erpPayloadString(row, list.Key); key != "" {
+				if first, ok := keys[key]; ok {
+					path := fmt.Sprintf("%s[%d].%s", list.Field, index, list.Key)
+					errs = append(errs, ERPFieldError{Path: path, Message: fmt.Sprintf("字段 %s 与 %s[%d] 重复：%s", path, list.Field, first, key)})
+				} else {
+					keys[key] = index
+				}
+			}
+			if isDefault, _ := row["isDefault"].(bool); !isDefault {
+				continue
+			}
+			group := erpPayloadString(row, list.GroupBy)
+			if first, ok := defaults[group]; ok {
+				path := fmt.Sprintf("%s[%d].isDefault", list.Field, index)
+				label := list.Label
+				if group != "" {
+					label = group + label
+				}
+				errs = append(errs, ERPFieldError{Path: path, Message: fmt.Sprintf("字段 %s 与 %s[%d] 重复设为默认%s", path, list.Field, first, label)})
+			} else {
+				defaults[group] = index
+			}
+		}
+	}
+	return errs
+}
+
+// derivePartnerDefaults 联系人、地址未填写时取默认联系人与默认账单地址，兼容只读单个联系人/地址的旧单据与模板。
+func derivePartnerDefaults(payload map[string]any) error {
+	if contact := findERPPartnerDefault(payload, "contacts", ""); contact != nil && isEmptyERPValue(payload["contact"]) {
+		payload["contact"] = contact["name"]
+		if isEmptyERPValue(payload["contactPhone"]) {
+			payload["contactPhone"] = contact["phone"]
+		}
+		if isEmptyERPValue(payload["email"]) && !isEmptyERPValue(contact["email"]) {
+			payload["email"] = contact["email"]
+		}
+	}
+	if address := findERPPartnerDefault(payload, "addresses", ERPAddressBilling); address != nil && isEmptyERPValue(payload["address"]) {
+		payload["address"] = address["address"]
+	}
+	return nil
+}
+
+// findERPPartnerDefault 返回子列表中的默认项：addressType 非空时只在该类型中查找；没有标记默认且只有一项时取该项。
+func findERPPartnerDefault(partner map[string]any, list, addressType string) map[string]any {
+	var candidates []map[string]any
+	rows, _ := partner[list].([]any)
+	for _, raw := range rows {
+		row, ok := raw.(map[string]any)
+		if !ok || (addressType != "" && erpPayloadString(row, "addressType") != addressType) {
+			continue
+		}
+		if isDefault, _ := row["isDefault"].(bool); isDefault {
+			return row
+		}
+		candidates = append(candidates, row)
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	return nil
+}
+
+// findERPPartnerEntry 按联系人姓名或地址标签查找子项。
+func findERPPartnerEntry(partner map[string]any, rule erpPartyRule, key string) map[string]any {
+	rows, _ := partner[rule.List].([]any)
+	for _, raw := range rows {
+		row, ok := raw.(map[string]any)
+		if !ok || (rule.AddressType != "" && erpPayloadString(row, "addressType") != rule.AddressType) {
+			continue
+		}
+		if erpPayloadString(row, erpPartyEntryKey(rule)) == key {
+			return row
+		}
+	}
+	return nil
+}
+
+func erpPartyEntryKey(rule erpPartyRule) string {
+	if rule.List == "contacts" {
+		return "name"
+	}
+	return "label"
+}
+
+// applyERPParties 按 customerName 找到客户，为单据带出选用（或默认）的联系人与地址。
+// 客户不存在时不处理（由引用校验报错）；客户未维护联系人/地址子列表时单据填写的联系人按自由文本保留。
+func applyERPParties(payload map[string]any, rules []erpPartyRule, lookup erpRecordLookup) ([]ERPFieldError, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	name := erpPayloadString(payload, "customerName")
+	if name == "" {
+		return nil, nil
+	}
+	partners, err := lookup(ERPModulePartners)
+	if err != nil {
+		return nil, err
+	}
+	partner := findERPReferenceTarget(partners, erpRefCustomer, name, 0)
+	if partner == nil {
+		return nil, nil
+	}
+
+	var errs []ERPFieldError
+	for _, rule := range rules {
+		if rule.Snapshot != "" {
+			delete(payload, rule.Snapshot)
+		}
+		rows, _ := partner.Payload[rule.List].([]any)
+		if len(rows) == 0 {
+			continue
+		}
+		key := erpPayloadString(payload, rule.Field)
+		var entry map[string]any
+		if key == "" {
+			if entry = findERPPartnerDefault(partner.Payload, rule.List, rule.AddressType); entry == nil {
+				continue
+			}
+			payload[rule.Field] = entry[erpPartyEntryKey(rule)]
+		} else if entry = findERPPartnerEntry(partner.Payload, rule, key); entry == nil {
+			label := "联系人"
+			if rule.AddressType != "" {
+				label = rule.AddressType + "地址"
+			}
+			errs = append(errs, ERPFieldError{
+				Path:    rule.Field,
+				Message: fmt.Sprintf("字段 %s 客户 %s 没有%s %s", rule.Field, name, label, key),
+			})
+			continue
+		}
+
+		for field, source := range rule.Fill {
+			if !isEmptyERPValue(entry[source]) {
+				payload[field] = entry[source]
+			}
+		}
+		if rule.Snapshot != "" {
+			snapshot := map[string]any{}
+			for _, field := range erpPartySnapshotFields {
+				if value, ok := entry[field].(string); ok && strings.TrimSpace(value) != "" {
+					snapshot[field] = value
+				}
+			}
+			payload[rule.Snapshot] = snapshot
+		}
+	}
+	return errs, nil
+}
@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecasePartnerLists(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+
+	partner := map[string]any{
+		"code": "CS-001", "partnerType": "合作客户", "name": "客户A", "paymentCycleDays": 30,
+		"contacts": []any{
+			map[string]any{"name": "张三", "role": "采购", "phone": "111", "isDefault": true},
+			map[string]any{"name": "李四", "role": "财务", "phone": "222", "isDefault": true},
+		},
+		"addresses": []any{
+			map[string]any{"label": "WH1", "addressType": "收货人", "address": "Warehouse 1", "isDefault": true},
+			map[string]any{"label": "WH1", "addressType": "通知方", "address": "Notify", "isDefault": true},
+		},
+		"bankAccounts": []any{
+			map[string]any{"bankName": "HSBC", "accountNo": "001", "currency": "USD", "isDefault": true},
+			map[string]any{"bankName": "BOC", "accountNo": "002", "currency": "EUR", "isDefault": true},
+		},
+	}
+	_, err := uc.Create(ctx, ERPModulePartners, partner, 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	// 地址按类型各自一个默认，银行账户按币种各自一个默认
+	want := []ERPFieldError{
+		{Path: "address", Message: "缺少必填字段 address"},
+		{Path: "addresses[1].label", Message: "字段 addresses[1].label 与 addresses[0] 重复：WH1"},
+		{Path: "contacts[1].isDefault", Message: "字段 contacts[1].isDefault 与 contacts[0] 重复设为默认联系人"},
+	}
+	if !reflect.DeepEqual(validationErr.Fields, want) {
+		t.Fatalf("fields = %v, want %v", validationErr.Fields, want)
+	}
+
+	partner["contacts"].([]any)[0].(map[string]any)["isDefault"] = false
+	partner["addresses"] = []any{
+		map[string]any{"label": "HQ", "addressType": "账单", "partyName": "Customer A GmbH", "address": "Billing St 1"},
+		map[string]any{"label": "WH1", "addressType": "收货人", "address": "Warehouse 1", "country": "Germany", "isDefault": true},
+		map[string]any{"label": "WH2", "addressType": "收货人", "address": "Warehouse 2", "country": "Germany"},
+		map[string]any{"label": "NP", "addressType": "通知方", "partyName": "Agent", "address": "Notify Rd 9"},
+	}
+	created, err := uc.Create(ctx, ERPModulePartners, partner, 1)
+	if err != nil {
+		t.Fatalf("create partner failed: %v", err)
+	}
+	if created["contact"] != "李四" || created["contactPhone"] != "222" || created["address"] != "Billing St 1" {
+		t.Fatalf("legacy fields should follow defaults: %v", created)
+	}
+
+	shipment := map[string]any{
+		"code": "CY-001", "customerName": "客户A", "startPort": "宁波", "destPort": "Hamburg",
+		"transportType": "海运", "arriveCountry": "Germany", "salesOwner": "业务员A", "warehouseShipDate": "2026-02-10",
+		"items": []any{map[string]any{"productModel": "磁钢A", "quantity": 10, "unitPrice": 5}},
+	}
+	saved, err := uc.Create(ctx, ERPModuleShipmentDetails, shipment, 1)
+	if err != nil {
+		t.Fatalf("create shipment failed: %v", err)
+	}
+	if saved["contactName"] != "李四" || saved["contactTel"] != "222" || saved["consigneeAddressLabel"] != "WH1" ||
+		saved["shipToAddress"] != "Warehouse 1" || saved["notifyAddressLabel"] != "NP" || saved["billingAddressLabel"] != "HQ" {
+		t.Fatalf("defaults not applied: %v", saved)
+	}
+	wantParty := map[string]any{"label": "NP", "addressType": "通知方", "partyName": "Agent", "address": "Notify Rd 9"}
+	if !reflect.DeepEqual(saved["notifyParty"], wantParty) {
+		t.Fatalf("notifyParty = %v, want %v", saved["notifyParty"], wantParty)
+	}
+
+	// 选用非默认项时覆盖联系方式与收货地址
+	shipment["code"], shipment["contactName"], shipment["consigneeAddressLabel"] = "CY-002", "张三", "WH2"
+	saved, err = uc.Create(ctx, ERPModuleShipmentDetails, shipment, 1)
+	if err != nil {
+		t.Fatalf("create shipment with chosen party failed: %v", err)
+	}
+	if saved["contactTel"] != "111" || saved["shipToAddress"] != "Warehouse 2" ||
+		saved["consigneeParty"].(map[string]any)["label"] != "WH2" {
+		t.Fatalf("chosen party not applied: %v", saved)
+	}
+
+	shipment["code"], shipment["contactName"], shipment["consigneeAddressLabel"] = "CY-003", "王五", "NP"
+	_, err = uc.Create(ctx, ERPModuleShipmentDetails, shipment, 1)
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("unknown party should fail, got %v", err)
+	}
+	want = []ERPFieldError{
+		{Path: "consigneeAddressLabel", Message: "字段 consigneeAddressLabel 客户 客户A 没有收货人地址 NP"},
+		{Path: "contactName", Message: "字段 contactName 客户 客户A 没有联系人 王五"},
+		{Path: "shipToAddress", Message: "缺少必填字段 shipToAddress"},
+	}
+	if !reflect.DeepEqual(validationErr.Fields, want) {
+		t.Fatalf("fields = %v, want %v", validationErr.Fields, want)
+	}
+}
@@ -0,0 +1,466 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// erpDefaultVATRate 增值税专票默认税率（13%），发票未填写 taxRate 时使用。
+const erpDefaultVATRate = 0.13
+
+// ERPPayableEntry 应付台账行：一张已入库的入库单按采购单价形成一笔应付，
+// 或一张出运费用单形成一笔应付（此时 PurchaseCode 为费用单号，InboundDate 为费用日期）。
+type ERPPayableEntry struct {
+	PurchaseCode string
+	SupplierName string
+	Currency     string
+	CostType     string
+	InboundCode  string
+	EntryNo      string
+	ProductName  string
+	Quantity     float64
+	UnitPrice    float64
+	Amount       float64
+	PaidAmount   float64
+	InboundDate  time.Time
+	DueDate      time.Time
+}
+
+// ERPPayable 按采购合同（或出运费用单）汇总的应付情况，金额以 Currency 计。
+type ERPPayable struct {
+	PurchaseCode      string
+	SourceModule      string
+	SupplierName      string
+	Currency          string
+	InvoiceRequired   bool
+	PaymentCycleDays  int
+	ContractAmount    float64
+	PayableAmount     float64
+	InvoicedAmount    float64
+	UninvoicedAmount  float64
+	PaidAmount        float64
+	OutstandingAmount float64
+	InvoiceNos        []string
+	Entries           []*ERPPayableEntry
+}
+
+type ERPPayableFilter struct {
+	SupplierName string
+	PurchaseCode string
+}
+
+// ERPAPAgingRow 按供应商的应付账龄，金额按到期日距统计日的逾期天数分段。
+type ERPAPAgingRow struct {
+	SupplierName     string
+	NotDue           float64
+	Overdue1To30     float64
+	Overdue31To60    float64
+	Overdue61To90    float64
+	OverdueOver90    float64
+	OutstandingTotal float64
+	UnappliedPayment float64
+}
+
+// ERPAPAgingReport 账龄以人民币统计，外币应付与未核销付款按统计日汇率折算；缺少汇率的币种列入 MissingCurrencies 且不计入。
+type ERPAPAgingReport struct {
+	AsOf              time.Time
+	Rows              []*ERPAPAgingRow
+	Totals            *ERPAPAgingRow
+	MissingCurrencies []string
+}
+
+// Payables 由已入库数量与出运费用单生成应付台账，并合并专票登记与付款记录。
+func (uc *ERPUsecase) Payables(ctx context.Context, filter ERPPayableFilter) ([]*ERPPayable, error) {
+	ds, err := uc.loadERPDataset(ctx,
+		ERPModulePartners,
+		ERPModulePurchaseContracts,
+		ERPModuleInbound,
+		ERPModuleSupplierInvoices,
+		ERPModuleSupplierPayments,
+		ERPModuleShipmentCosts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	payables, _ := buildERPPayables(ds)
+
+	supplierName := strings.TrimSpace(filter.SupplierName)
+	purchaseCode := strings.TrimSpace(filter.PurchaseCode)
+	out := make([]*ERPPayable, 0, len(payables))
+	for _, item := range payables {
+		if supplierName != "" && item.SupplierName != supplierName {
+			continue
+		}
+		if purchaseCode != "" && item.PurchaseCode != purchaseCode {
+			continue
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// APAging 统计截至 asOf 的供应商应付账龄，用于月度工厂付款安排。
+func (uc *ERPUsecase) APAging(ctx context.Context, asOf time.Time, supplierName string) (*ERPAPAgingReport, error) {
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	asOf = time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+
+	ds, err := uc.loadERPDataset(ctx,
+		ERPModulePartners,
+		ERPModulePurchaseContracts,
+		ERPModuleInbound,
+		ERPModuleSupplierInvoices,
+		ERPModuleSupplierPayments,
+		ERPModuleShipmentCosts,
+		ERPModuleExchangeRates,
+	)
+	if err != nil {
+		return nil, err
+	}
+	payables, unapplied := buildERPPayables(ds)
+
+	supplierName = strings.TrimSpace(supplierName)
+	rows := map[string]*ERPAPAgingRow{}
+	rowOf := func(name string) *ERPAPAgingRow {
+		row, ok := rows[name]
+		if !ok {
+			row = &ERPAPAgingRow{SupplierName: name}
+			rows[name] = row
+		}
+		return row
+	}
+
+	report := &ERPAPAgingReport{
+		AsOf:              asOf,
+		Totals:            &ERPAPAgingRow{},
+		MissingCurrencies: []string{},
+	}
+	missing := map[string]struct{}{}
+	for _, payable := range payables {
+		if supplierName != "" && payable.SupplierName != supplierName {
+			continue
+		}
+		rate, ok := ds.exchangeRate(payable.Currency, asOf)
+		if !ok {
+			if _, seen := missing[payable.Currency]; !seen {
+				missing[payable.Currency] = struct{}{}
+				report.MissingCurrencies = append(report.MissingCurrencies, payable.Currency)
+			}
+			continue
+		}
+		row := rowOf(payable.SupplierName)
+		for _, entry := range payable.Entries {
+			open := (entry.Amount - entry.PaidAmount) * rate
+			if open <= 0 {
+				continue
+			}
+			overdueDays := int(asOf.Sub(entry.DueDate).Hours() / 24)
+			switch {
+			case overdueDays <= 0:
+				row.NotDue += open
+			case overdueDays <= 30:
+				row.Overdue1To30 += open
+			case overdueDays <= 60:
+				row.Overdue31To60 += open
+			case overdueDays <= 90:
+				row.Overdue61To90 += open
+			default:
+				row.OverdueOver90 += open
+			}
+			row.OutstandingTotal += open
+		}
+	}
+	names := make([]string, 0, len(unapplied))
+	for name := range unapplied {
+		if supplierName == "" || name == supplierName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		currencies := make([]string, 0, len(unapplied[name]))
+		for currency := range unapplied[name] {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+		for _, currency := range currencies {
+			amount := unapplied[name][currency]
+			rate, ok := ds.exchangeRate(currency, asOf)
+			if !ok {
+				if _, seen := missing[currency]; !seen {
+					missing[currency] = struct{}{}
+					report.MissingCurrencies = append(report.MissingCurrencies, currency)
+				}
+				continue
+			}
+			rowOf(name).UnappliedPayment += amount * rate
+		}
+	}
+
+	report.Rows = make([]*ERPAPAgingRow, 0, len(rows))
+	for _, row := range rows {
+		roundERPAPAgingRow(row)
+		report.Rows = append(report.Rows, row)
+		report.Totals.NotDue += row.NotDue
+		report.Totals.Overdue1To30 += row.Overdue1To30
+		report.Totals.Overdue31To60 += row.Overdue31To60
+		report.Totals.Overdue61To90 += row.Overdue61To90
+		report.Totals.OverdueOver90 += row.OverdueOver90
+		report.Totals.OutstandingTotal += row.OutstandingTotal
+		report.Totals.UnappliedPayment += row.UnappliedPayment
+	}
+	roundERPAPAgingRow(report.Totals)
+	sort.Slice(report.Rows, func(i, j int) bool {
+		return report.Rows[i].SupplierName < report.Rows[j].SupplierName
+	})
+	return report, nil
+}
+
+// buildERPPayables 生成全部采购合同与出运费用单的应付，返回值二为各供应商按币种未能核销的付款余额。
+//
+// 付款按付款日期依次核销：指定采购合同（或费用单）的付款先按到期日核销该单据，
+// 未指定单据或核销后仍有余额的，再按到期日核销同一供应商的其余应付；只核销与付款币种相同的应付。
+func buildERPPayables(ds *erpDataset) ([]*ERPPayable, map[string]map[string]float64) {
+	inboundByPurchase := map[string][]*ERPRecord{}
+	for _, item := range ds.list(ERPModuleInbound) {
+		if !isERPInboundReceived(item) {
+			continue
+		}
+		code := erpPayloadString(item.Payload, "purchaseCode")
+		if code == "" {
+			code = erpPayloadString(item.Payload, "sourcePurchaseCode")
+		}
+		if code == "" {
+			continue
+		}
+		inboundByPurchase[code] = append(inboundByPurchase[code], item)
+	}
+
+	payables := make([]*ERPPayable, 0, len(ds.list(ERPModulePurchaseContracts)))
+	byCode := map[string]*ERPPayable{}
+	for _, contract := range ds.list(ERPModulePurchaseContracts) {
+		if contract == nil || contract.Code == "" {
+			continue
+		}
+		payable := buildERPPayable(ds, contract, inboundByPurchase[contract.Code])
+		payables = append(payables, payable)
+		byCode[payable.PurchaseCode] = payable
+	}
+	for _, payable := range buildERPShipmentCostPayables(ds) {
+		if _, exists := byCode[payable.PurchaseCode]; exists {
+			continue
+		}
+		payables = append(payables, payable)
+		byCode[payable.PurchaseCode] = payable
+	}
+
+	for _, invoice := range ds.list(ERPModuleSupplierInvoices) {
+		payable := byCode[erpPayloadString(invoice.Payload, "purchaseCode")]
+		if payable == nil {
+			continue
+		}
+		payable.InvoicedAmount += erpPayloadFloat(invoice.Payload, "invoiceAmount")
+		if invoiceNo := erpPayloadString(invoice.Payload, "invoiceNo"); invoiceNo != "" {
+			payable.InvoiceNos = append(payable.InvoiceNos, invoiceNo)
+		}
+	}
+
+	supplierEntries := map[string][]*ERPPayableEntry{}
+	for _, payable := range payables {
+		supplierEntries[payable.SupplierName] = append(supplierEntries[payable.SupplierName], payable.Entries...)
+	}
+	for _, entries := range supplierEntries {
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].DueDate.Before(entries[j].DueDate)
+		})
+	}
+
+	unapplied := map[string]map[string]float64{}
+	payments := append([]*ERPRecord(nil), ds.list(ERPModuleSupplierPayments)...)
+	sort.SliceStable(payments, func(i, j int) bool {
+		return erpRecordDate(payments[i], "paymentDate").Before(erpRecordDate(payments[j], "paymentDate"))
+	})
+	for _, payment := range payments {
+		amount := erpPayloadFloat(payment.Payload, "paymentAmount")
+		currency := erpCostCurrency(payment.Payload)
+		supplierName := erpPayloadString(payment.Payload, "supplierName")
+		if payable := byCode[erpPayloadString(payment.Payload, "purchaseCode")]; payable != nil {
+			supplierName = payable.SupplierName
+			amount = allocateERPPayment(payable.Entries, currency, amount, byCode)
+		}
+		amount = allocateERPPayment(supplierEntries[supplierName], currency, amount, byCode)
+		if amount > 0.0001 {
+			if unapplied[supplierName] == nil {
+				unapplied[supplierName] = map[string]float64{}
+			}
+			unapplied[supplierName][currency] += amount
+		}
+	}
+
+	for _, payable := range payables {
+		if payable.InvoiceRequired && payable.PayableAmount > payable.InvoicedAmount {
+			payable.UninvoicedAmount = payable.PayableAmount - payable.InvoicedAmount
+		}
+		payable.OutstandingAmount = payable.PayableAmount - payable.PaidAmount
+		payable.ContractAmount = roundERPAmount(payable.ContractAmount)
+		payable.PayableAmount = roundERPAmount(payable.PayableAmount)
+		payable.InvoicedAmount = roundERPAmount(payable.InvoicedAmount)
+		payable.UninvoicedAmount = roundERPAmount(payable.UninvoicedAmount)
+		payable.PaidAmount = roundERPAmount(payable.PaidAmount)
+		payable.OutstandingAmount = roundERPAmount(payable.OutstandingAmount)
+	}
+	for _, byCurrency := range unapplied {
+		for currency, amount := range byCurrency {
+			byCurrency[currency] = roundERPAmount(amount)
+		}
+	}
+	return payables, unapplied
+}
+
+func buildERPPayable(ds *erpDataset, contract *ERPRecord, inbounds []*ERPRecord) *ERPPayable {
+	payload := contract.Payload
+	supplierName := erpPayloadString(payload, "supplierName")
+	payable := &ERPPayable{
+		PurchaseCode:    contract.Code,
+		SourceModule:    ERPModulePurchaseContracts,
+		SupplierName:    supplierName,
+		Currency:        erpCostCurrency(payload),
+		InvoiceRequired: erpPayloadString(payload, "invoiceRequired") == "是",
+		ContractAmount:  erpPayloadFloat(payload, "totalAmount"),
+		InvoiceNos:      []string{},
+		Entries:         []*ERPPayableEntry{},
+	}
+
+	if days, ok := toERPFloat64(payload["paymentCycleDays"]); ok {
+		payable.PaymentCycleDays = int(days)
+	} else if partner := ds.findPartner(supplierName); partner != nil {
+		payable.PaymentCycleDays = int(erpPayloadFloat(partner.Payload, "paymentCycleDays"))
+	}
+
+	items, _ := getERPItems(payload["items"])
+	for _, inbound := range inbounds {
+		productName := erpPayloadString(inbound.Payload, "productName")
+		quantity := erpPayloadFloat(inbound.Payload, "quantity")
+		unitPrice := erpPurchaseUnitPrice(items, productName)
+		inboundDate := erpRecordDate(inbound, "inboundDate")
+		entry := &ERPPayableEntry{
+			PurchaseCode: contract.Code,
+			SupplierName: supplierName,
+			Currency:     payable.Currency,
+			InboundCode:  inbound.Code,
+			EntryNo:      erpPayloadString(inbound.Payload, "entryNo"),
+			ProductName:  productName,
+			Quantity:     quantity,
+			UnitPrice:    unitPrice,
+			Amount:       roundERPAmount(quantity * unitPrice),
+			InboundDate:  inboundDate,
+			DueDate:      inboundDate.AddDate(0, 0, payable.PaymentCycleDays),
+		}
+		payable.Entries = append(payable.Entries, entry)
+		payable.PayableAmount += entry.Amount
+	}
+	sort.SliceStable(payable.Entries, func(i, j int) bool {
+		return payable.Entries[i].DueDate.Before(payable.Entries[j].DueDate)
+	})
+	return payable
+}
+
+// allocateERPPayment 按 entries 顺序核销同币种台账行并累计到所属单据，返回未核销完的金额。
+func allocateERPPayment(entries []*ERPPayableEntry, currency string, amount float64, byCode map[string]*ERPPayable) float64 {
+	for _, entry := range entries {
+		if amount <= 0 {
+			break
+		}
+		if entry.Currency != currency {
+			continue
+		}
+		open := entry.Amount - entry.PaidAmount
+		if open <= 0 {
+			continue
+		}
+		applied := open
+		if amount < open {
+			applied = amount
+		}
+		entry.PaidAmount = roundERPAmount(entry.PaidAmount + applied)
+		if payable := byCode[entry.PurchaseCode]; payable != nil {
+			payable.PaidAmount += applied
+		}
+		amount -= applied
+	}
+	return amount
+}
+
+// isERPInboundReceived 判断入库通知是否已实际入库：质检合格且已生成入库单。
+func isERPInboundReceived(record *ERPRecord) bool {
+	if record == nil {
+		return false
+	}
+	if erpPayloadString(record.Payload, "qcStatus") != "检验合格" {
+		return false
+	}
+	if applied, _ := record.Payload["inboundApplied"].(bool); applied {
+		return true
+	}
+	return erpPayloadString(record.Payload, "entryNo") != ""
+}
+
+// erpPurchaseUnitPrice 按产品名称匹配采购合同明细单价；
+// 匹配不到时，单行合同取该行单价，多行合同取合同均价。
+func erpPurchaseUnitPrice(items []map[string]any, productName string) float64 {
+	for _, item := range items {
+		name, _ := item["productName"].(string)
+		if strings.TrimSpace(name) == productName && productName != "" {
+			price, _ := toERPFloat64(item["unitPrice"])
+			return price
+		}
+	}
+	if len(items) == 1 {
+		price, _ := toERPFloat64(items[0]["unitPrice"])
+		return price
+	}
+	qty := calcERPItemsQty(items)
+	if qty <= 0 {
+		return 0
+	}
+	return calcERPItemsTotal(items) / qty
+}
+
+func deriveSupplierInvoiceTax(payload map[string]any) error {
+	amount, ok := toERPFloat64(payload["invoiceAmount"])
+	if !ok {
+		return nil
+	}
+	rate := erpDefaultVATRate
+	if raw, exists := payload["taxRate"]; exists && !isEmptyERPValue(raw) {
+		value, ok := toERPFloat64(raw)
+		if !ok || value < 0 || value >= 1 {
+			return fmt.Errorf("字段 taxRate 超出范围")
+		}
+		rate = value
+	}
+	taxAmount := roundERPAmount(amount * rate / (1 + rate))
+	payload["taxRate"] = normalizeERPNumber(rate)
+	payload["taxAmount"] = normalizeERPNumber(taxAmount)
+	payload["amountExclTax"] = normalizeERPNumber(roundERPAmount(amount - taxAmount))
+	return nil
+}
+
+func deriveSupplierPayment(payload map[string]any) error {
+	payload["currency"] = erpCostCurrency(payload)
+	return nil
+}
+
+func roundERPAPAgingRow(row *ERPAPAgingRow) {
+	row.NotDue = roundERPAmount(row.NotDue)
+	row.Overdue1To30 = roundERPAmount(row.Overdue1To30)
+	row.Overdue31To60 = roundERPAmount(row.Overdue31To60)
+	row.Overdue61To90 = roundERPAmount(row.Overdue61To90)
+	row.OverdueOver90 = roundERPAmount(row.OverdueOver90)
+	row.OutstandingTotal = roundERPAmount(row.OutstandingTotal)
+	row.UnappliedPayment = roundERPAmount(row.UnappliedPayment)
+}
@@ -0,0 +1,197 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecasePayablesAndAging(t *testing.T) {
+	repo := newMemERPRepo()
+	logger := log.NewStdLogger(io.Discard)
+	uc := NewERPUsecase(repo, logger, tracesdk.NewTracerProvider())
+	ctx := context.Background()
+
+	mustCreate := func(moduleKey string, payload map[string]any) {
+		t.Helper()
+		if _, err := uc.Create(ctx, moduleKey, payload, 1); err != nil {
+			t.Fatalf("create %s failed: %v", moduleKey, err)
+		}
+	}
+
+	seedERPRecords(t, repo, ERPModuleExportSales, map[string]any{"code": "XS-001", "customerName": "客户A"})
+	mustCreate("partners", map[string]any{
+		"partnerType":      "合作供应商",
+		"name":             "工厂A",
+		"address":          "浙江宁波",
+		"contact":          "李四",
+		"contactPhone":     "13900002222",
+		"paymentCycleDays": 30,
+	})
+	mustCreate("purchaseContracts", map[string]any{
+		"code":            "CG-001",
+		"supplierName":    "工厂A",
+		"signDate":        "2026-01-05",
+		"salesNo":         "XS-001",
+		"deliveryDate":    "2026-01-20",
+		"deliveryAddress": "杭州一号仓",
+		"invoiceRequired": "是",
+		"items": []any{
+			map[string]any{"productName": "产品1", "quantity": 100, "unitPrice": 10},
+			map[string]any{"productName": "产品2", "quantity": 50, "unitPrice": 20},
+		},
+	})
+	mustCreate("inbound", map[string]any{
+		"code":           "RK-001",
+		"purchaseCode":   "CG-001",
+		"productName":    "产品1",
+		"warehouseName":  "杭州一号仓",
+		"location":       "A-01-01",
+		"qcStatus":       "检验合格",
+		"quantity":       100,
+		"inboundApplied": true,
+		"inboundDate":    "2026-01-20",
+	})
+	mustCreate("inbound", map[string]any{
+		"code":          "RK-002",
+		"purchaseCode":  "CG-001",
+		"productName":   "产品2",
+		"warehouseName": "杭州一号仓",
+		"location":      "A-01-02",
+		"qcStatus":      "待检验",
+		"quantity":      50,
+	})
+	mustCreate("supplierInvoices", map[string]any{
+		"supplierName":  "工厂A",
+		"purchaseCode":  "CG-001",
+		"invoiceNo":     "3300261130-00001",
+		"invoiceAmount": 1130,
+		"invoiceDate":   "2026-01-25",
+	})
+	mustCreate("supplierPayments", map[string]any{
+		"supplierName":  "工厂A",
+		"purchaseCode":  "CG-001",
+		"paymentAmount": 400,
+		"paymentDate":   "2026-02-01",
+	})
+
+	payables, err := uc.Payables(ctx, ERPPayableFilter{SupplierName: "工厂A"})
+	if err != nil {
+		t.Fatalf("payables failed: %v", err)
+	}
+	if len(payables) != 1 {
+		t.Fatalf("expected 1 payable, got %d", len(payables))
+	}
+	payable := payables[0]
+	if payable.PayableAmount != 1000 {
+		t.Fatalf("payable amount should only count received inbound, got %v", payable.PayableAmount)
+	}
+	if payable.PaidAmount != 400 || payable.OutstandingAmount != 600 {
+		t.Fatalf("unexpected paid/outstanding: %v/%v", payable.PaidAmount, payable.OutstandingAmount)
+	}
+	if payable.InvoicedAmount != 1130 || payable.UninvoicedAmount != 0 {
+		t.Fatalf("unexpected invoiced/uninvoiced: %v/%v", payable.InvoicedAmount, payable.UninvoicedAmount)
+	}
+	if len(payable.Entries) != 1 || payable.Entries[0].DueDate.Format("2006-01-02") != "2026-02-19" {
+		t.Fatalf("due date should follow supplier payment cycle, got %+v", payable.Entries)
+	}
+
+	report, err := uc.APAging(ctx, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), "")
+	if err != nil {
+		t.Fatalf("ap aging failed: %v", err)
+	}
+	if len(report.Rows) != 1 {
+		t.Fatalf("expected 1 aging row, got %d", len(report.Rows))
+	}
+	row := report.Rows[0]
+	if row.Overdue31To60 != 600 || row.OutstandingTotal != 600 {
+		t.Fatalf("unexpected aging row: %+v", row)
+	}
+
+	mustCreate("supplierPayments", map[string]any{
+		"supplierName":  "工厂A",
+		"paymentAmount": 800,
+		"paymentDate":   "2026-03-01",
+	})
+	report, err = uc.APAging(ctx, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), "工厂A")
+	if err != nil {
+		t.Fatalf("ap aging failed: %v", err)
+	}
+	if report.Totals.OutstandingTotal != 0 || report.Totals.UnappliedPayment != 200 {
+		t.Fatalf("overpayment should be reported as unapplied, got %+v", report.Totals)
+	}
+}
+
+func TestERPSupplierInvoiceDerivesTax(t *testing.T) {
+	repo := newMemERPRepo()
+	logger := log.NewStdLogger(io.Discard)
+	uc := NewERPUsecase(repo, logger, tracesdk.NewTracerProvider())
+	seedERPPartners(t, repo, "合作供应商", "工厂A")
+
+	invoice, err := uc.Create(context.Background(), "supplierInvoices", map[string]any{
+		"supplierName":  "工厂A",
+		"invoiceNo":     "3300261130-00002",
+		"invoiceAmount": 113,
+		"invoiceDate":   "2026-02-01",
+	}, 1)
+	if err != nil {
+		t.Fatalf("create invoice failed: %v", err)
+	}
+	if invoice["taxAmount"] != int64(13) || invoice["amountExclTax"] != int64(100) {
+		t.Fatalf("unexpected tax split: %v/%v", invoice["taxAmount"], invoice["amountExclTax"])
+	}
+
+	_, err = uc.Create(context.Background(), "supplierInvoices", map[string]any{
+		"supplierName":  "工厂A",
+		"invoiceNo":     "3300261130-00003",
+		"invoiceAmount": 113,
+		"invoiceDate":   "not-a-date",
+	}, 1)
+	if err == nil {
+		t.Fatalf("invalid invoiceDate should be rejected")
+	}
+}
+
+func TestERPAPAgingConvertsForeignUnappliedPayment(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+
+	mustCreate := func(moduleKey string, payload map[string]any) {
+		t.Helper()
+		if _, err := uc.Create(ctx, moduleKey, payload, 1); err != nil {
+			t.Fatalf("create %s failed: %v", moduleKey, err)
+		}
+	}
+
+	mustCreate("exchangeRates", map[string]any{"currency": "USD", "rateToCNY": 7, "effectiveDate": "2026-01-01"})
+	mustCreate("exchangeRates", map[string]any{"currency": "USD", "rateToCNY": 7.2, "effectiveDate": "2026-03-15"})
+	mustCreate("partners", map[string]any{
+		"partnerType": "合作供应商", "name": "工厂B", "address": "宁波", "contact": "王",
+		"contactPhone": "1", "paymentCycleDays": 30,
+	})
+	mustCreate("supplierPayments", map[string]any{
+		"supplierName": "工厂B", "paymentAmount": 100, "currency": "USD", "paymentDate": "2026-03-01",
+	})
+	mustCreate("supplierPayments", map[string]any{
+		"supplierName": "工厂B", "paymentAmount": 50, "currency": "EUR", "paymentDate": "2026-03-02",
+	})
+
+	report, err := uc.APAging(ctx, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), "工厂B")
+	if err != nil {
+		t.Fatalf("ap aging failed: %v", err)
+	}
+	if len(report.Rows) != 1 || report.Rows[0].UnappliedPayment != 720 {
+		t.Fatalf("usd overpayment should convert at as-of rate, got %+v", report.Rows)
+	}
+	if report.Totals.UnappliedPayment != 720 {
+		t.Fatalf("unexpected totals: %+v", report.Totals)
+	}
+	if len(report.MissingCurrencies) != 1 || report.MissingCurrencies[0] != "EUR" {
+		t.Fatalf("currency without rate should be reported missing, got %v", report.MissingCurrencies)
+	}
+}
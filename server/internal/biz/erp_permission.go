@@ -0,0 +1,244 @@
+package biz
+
+import "strings"
+
+// ERP 接口权限动作，角色按模块授予。
+const (
+	ERPActionView        = "view"
+	ERPActionCreate      = "create"
+	ERPActionEdit        = "edit"
+	ERPActionDelete      = "delete"
+	ERPActionSubmit      = "submit"
+	ERPActionApprove     = "approve"
+	ERPActionPrint       = "print"
+	ERPActionExport      = "export"
+	ERPActionViewAmounts = "view_amounts"
+	// ERPActionViewAll 查看全部记录；缺少时销售类模块只能看到本人及下级的记录（见 ERPRecordScopeFor）。
+	ERPActionViewAll = "view_all"
+)
+
+var erpActionOrder = []string{
+	ERPActionView,
+	ERPActionCreate,
+	ERPActionEdit,
+	ERPActionDelete,
+	ERPActionSubmit,
+	ERPActionApprove,
+	ERPActionPrint,
+	ERPActionExport,
+	ERPActionViewAmounts,
+	ERPActionViewAll,
+}
+
+var erpActionSet = func() map[string]struct{} {
+	m := make(map[string]struct{}, len(erpActionOrder))
+	for _, action := range erpActionOrder {
+		m[action] = struct{}{}
+	}
+	return m
+}()
+
+// ERPActions 返回全部动作（固定顺序）。
+func ERPActions() []string {
+	return append([]string(nil), erpActionOrder...)
+}
+
+// erpModuleMenuKeys 模块 → 控制其接口访问的菜单 key。
+var erpModuleMenuKeys = map[string]string{
+	ERPModulePartners:           "/master/partners",
+	ERPModuleProducts:           "/master/products",
+	ERPModuleQuotations:         "/sales/quotations",
+	ERPModuleExportSales:        "/sales/export",
+	ERPModulePurchaseContracts:  "/purchase/contracts",
+	ERPModuleInbound:            "/warehouse/inbound",
+	ERPModuleInventory:          "/warehouse/inventory",
+	ERPModuleShipmentDetails:    "/shipping/details",
+	ERPModuleOutbound:           "/warehouse/outbound",
+	ERPModuleSettlements:        "/finance/settlements",
+	ERPModuleBankReceipts:       "/finance/bank-receipts",
+	ERPModuleSupplierInvoices:   "/finance/payables",
+	ERPModuleSupplierPayments:   "/finance/payables",
+	ERPModuleShipmentCosts:      "/finance/payables",
+	ERPModuleRebateRates:        "/finance/rebates",
+	ERPModuleRebateDeclarations: "/finance/rebates",
+	ERPModuleExchangeRates:      "/finance/settlements",
+	ERPModulePriceLists:         "/sales/quotations",
+	ERPModuleProductionOrders:   "/warehouse/inventory",
+	ERPModuleHSCodes:            "/master/products",
+}
+
+// ERPModuleMenuKey 返回模块对应的菜单 key。
+func ERPModuleMenuKey(moduleKey string) (string, error) {
+	key, err := NormalizeERPModuleKey(moduleKey)
+	if err != nil {
+		return "", err
+	}
+	menuKey, ok := erpModuleMenuKeys[key]
+	if !ok {
+		return "", ErrERPInvalidModule
+	}
+	return menuKey, nil
+}
+
+// ERPRecordAction 根据接口方法与提交内容判断权限动作：保存到待批箱视为提交，保存到已批箱/确认箱视为审批。
+func ERPRecordAction(method string, record map[string]any) string {
+	switch method {
+	case "list", "history", "diff", "schema", "fulfilment":
+		return ERPActionView
+	case "restore":
+		return ERPActionEdit
+	case "delete":
+		return ERPActionDelete
+	case "revise":
+		return ERPActionCreate
+	case "create", "update":
+		box, _ := record["box"].(string)
+		switch strings.TrimSpace(box) {
+		case ERPBoxPending:
+			return ERPActionSubmit
+		case ERPBoxApproved, ERPBoxConfirmed:
+			return ERPActionApprove
+		}
+		if method == "create" {
+			return ERPActionCreate
+		}
+		return ERPActionEdit
+	default:
+		return ""
+	}
+}
+
+// AdminPermissions 管理员的有效权限。
+//
+// 超级管理员拥有全部权限；按角色鉴权的管理员取所持角色授权的并集；
+// 其余管理员沿用菜单权限：拥有菜单即拥有该菜单下模块的全部动作。
+type AdminPermissions struct {
+	all    bool
+	grants map[string]map[string]struct{}
+	menus  []string
+}
+
+// EffectiveAdminPermissions 计算管理员的有效权限。
+func EffectiveAdminPermissions(admin *AdminAccount) *AdminPermissions {
+	perms := &AdminPermissions{grants: map[string]map[string]struct{}{}}
+	if admin == nil {
+		return perms
+	}
+	if admin.Level == AdminLevelSuper {
+		perms.all = true
+		perms.menus = AllAdminMenuPermissions()
+		return perms
+	}
+
+	grant := func(moduleKey string, actions ...string) {
+		set, ok := perms.grants[moduleKey]
+		if !ok {
+			set = map[string]struct{}{}
+			perms.grants[moduleKey] = set
+		}
+		for _, action := range actions {
+			set[action] = struct{}{}
+		}
+	}
+
+	if !admin.RoleBased {
+		perms.menus = EffectiveAdminMenuPermissions(admin.Level, admin.MenuPermissions)
+		menuSet := make(map[string]struct{}, len(perms.menus))
+		for _, menuKey := range perms.menus {
+			menuSet[menuKey] = struct{}{}
+		}
+		for moduleKey, menuKey := range erpModuleMenuKeys {
+			if _, ok := menuSet[menuKey]; ok {
+				grant(moduleKey, erpActionOrder...)
+			}
+		}
+		return perms
+	}
+
+	canPrint := false
+	for _, role := range admin.Roles {
+		if role == nil {
+			continue
+		}
+		for moduleKey, actions := range role.Grants {
+			grant(moduleKey, actions...)
+			for _, action := range actions {
+				if action == ERPActionPrint {
+					canPrint = true
+				}
+			}
+		}
+	}
+	menuSet := map[string]struct{}{"/dashboard": {}}
+	for moduleKey, menuKey := range erpModuleMenuKeys {
+		if perms.Allows(moduleKey, ERPActionView) {
+			menuSet[menuKey] = struct{}{}
+		}
+	}
+	if canPrint {
+		menuSet["/docs/print-center"] = struct{}{}
+	}
+	if perms.Allows(ERPModuleExportSales, ERPActionViewAmounts) {
+		menuSet["/reports/profit"] = struct{}{}
+	}
+	perms.menus = make([]string, 0, len(menuSet))
+	for _, item := range adminMenuPermissionOptions {
+		if _, ok := menuSet[item.Key]; ok {
+			perms.menus = append(perms.menus, item.Key)
+		}
+	}
+	return perms
+}
+
+// Allows 判断是否可对模块执行 action。
+func (p *AdminPermissions) Allows(moduleKey, action string) bool {
+	if p == nil {
+		return false
+	}
+	if p.all {
+		return true
+	}
+	_, ok := p.grants[moduleKey][action]
+	return ok
+}
+
+// MenuKeys 返回可见菜单 key（按菜单配置顺序）。
+func (p *AdminPermissions) MenuKeys() []string {
+	if p == nil {
+		return []string{}
+	}
+	return append([]string{}, p.menus...)
+}
+
+// AdminMenuPermissionsFor 返回管理员可见菜单，用于登录与 me 接口。
+func AdminMenuPermissionsFor(admin *AdminAccount) []string {
+	return EffectiveAdminPermissions(admin).MenuKeys()
+}
+
+// AuthorizeAdminMenu 校验管理员有效菜单权限中包含 menuKey。
+func AuthorizeAdminMenu(admin *AdminAccount, menuKey string) error {
+	if admin == nil {
+		return ErrForbidden
+	}
+	for _, item := range EffectiveAdminPermissions(admin).MenuKeys() {
+		if item == menuKey {
+			return nil
+		}
+	}
+	return ErrNoPermission
+}
+
+// AuthorizeERPModule 校验管理员对模块执行 action 的权限。
+func AuthorizeERPModule(admin *AdminAccount, moduleKey, action string) error {
+	if admin == nil {
+		return ErrForbidden
+	}
+	key, err := NormalizeERPModuleKey(moduleKey)
+	if err != nil {
+		return err
+	}
+	if !EffectiveAdminPermissions(admin).Allows(key, action) {
+		return ErrNoPermission
+	}
+	return nil
+}
@@ -0,0 +1,327 @@
+package biz
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// 建议单价来源。
+const (
+	ERPPriceSourceList      = "priceList"
+	ERPPriceSourceQuotation = "quotation"
+)
+
+// erpPriceSuggestion 按基本单位计的建议单价及来源单据。
+type erpPriceSuggestion struct {
+	UnitPrice  float64
+	Source     string
+	SourceCode string
+}
+
+// erpPriceQuote 报价单表头中决定价格的条件。
+type erpPriceQuote struct {
+	Code      string
+	Customer  string
+	Currency  string
+	PriceTerm string
+	Date      time.Time
+}
+
+// checkERPPriceList 校验价格表：失效日期不早于生效日期，同一产品的起订数量不能重复。
+func checkERPPriceList(payload map[string]any) []ERPFieldError {
+	var errs []ERPFieldError
+	validFrom, validTo := erpPayloadDate(payload, "validFrom"), erpPayloadDate(payload, "validTo")
+	if !validFrom.IsZero() && !validTo.IsZero() && validTo.Before(validFrom) {
+		errs = append(errs, ERPFieldError{Path: "validTo", Message: "字段 validTo 早于生效日期 validFrom"})
+	}
+	rows, _ := payload["items"].([]any)
+	seen := map[string]int{}
+	for index, raw := range rows {
+		row, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		code := erpPayloadString(row, "productCode")
+		if code == "" {
+			continue
+		}
+		key := fmt.Sprintf("%s|%v", code, erpPayloadFloat(row, "minQuantity"))
+		if first, ok := seen[key]; ok {
+			path := fmt.Sprintf("items[%d].minQuantity", index)
+			errs = append(errs, ERPFieldError{Path: path, Message: fmt.Sprintf("字段 %s 与 items[%d] 重复：产品 %s 起订数量 %v", path, first, code, erpPayloadFloat(row, "minQuantity"))})
+			continue
+		}
+		seen[key] = index
+	}
+	return errs
+}
+
+// applyERPSuggestedPrices 为报价明细行给出建议单价（suggestedPrice/priceSource/priceSourceCode）：
+// 先取客户专属价格表，再取默认价格表（customerName 为空），均无时取该客户最近一张已接受报价（已被外销合同引用）中的单价；
+// 价格表按币种、价格条款、报价日期在有效期内筛选，按基本单位数量取满足起订数量的最高档。
+// 未填写单价的行直接使用建议单价。
+func applyERPSuggestedPrices(payload map[string]any, lookup erpRecordLookup) error {
+	rows, _ := payload["items"].([]any)
+	if len(rows) == 0 {
+		return nil
+	}
+	quote := erpPriceQuote{
+		Code:      erpPayloadString(payload, "code"),
+		Customer:  erpPayloadString(payload, "customerName"),
+		Currency:  erpPayloadString(payload, "currency"),
+		PriceTerm: erpPayloadString(payload, "priceTerm"),
+		Date:      erpPayloadDate(payload, "quotedDate"),
+	}
+	for _, raw := range rows {
+		item, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		delete(item, "suggestedPrice")
+		delete(item, "priceSource")
+		delete(item, "priceSourceCode")
+		code := erpPayloadString(item, "productCode")
+		if code == "" || quote.Customer == "" || quote.Currency == "" {
+			continue
+		}
+		baseQuantity := erpItemBaseQuantity(item)
+		suggestion, err := suggestERPPrice(quote, code, baseQuantity, lookup)
+		if err != nil {
+			return err
+		}
+		if suggestion == nil {
+			continue
+		}
+		price := suggestion.UnitPrice
+		// 明细行单位不是基本单位时，按本行的基本单位数量折算为每明细单位的单价
+		if quantity := erpPayloadFloat(item, "quantity"); quantity > 0 && baseQuantity > 0 {
+			price = price * baseQuantity / quantity
+		}
+		item["suggestedPrice"] = normalizeERPNumber(roundERPAmount(price))
+		item["priceSource"] = suggestion.Source
+		item["priceSourceCode"] = suggestion.SourceCode
+		if isEmptyERPValue(item["unitPrice"]) {
+			item["unitPrice"] = item["suggestedPrice"]
+		}
+	}
+	return nil
+}
+
+func suggestERPPrice(quote erpPriceQuote, productCode string, baseQuantity float64, lookup erpRecordLookup) (*erpPriceSuggestion, error) {
+	lists, err := lookup(ERPModulePriceLists)
+	if err != nil {
+		return nil, err
+	}
+	for _, customer := range []string{quote.Customer, ""} {
+		if suggestion := findERPPriceListPrice(lists, quote, customer, productCode, baseQuantity); suggestion != nil {
+			return suggestion, nil
+		}
+	}
+	return findERPAcceptedQuotationPrice(quote, productCode, lookup)
+}
+
+// findERPPriceListPrice 在 customer 的价格表中查找；多张同时有效时取生效日期最晚的一张。
+func findERPPriceListPrice(lists []*ERPRecord, quote erpPriceQuote, customer, productCode string, baseQuantity float64) *erpPriceSuggestion {
+	var (
+		best      *erpPriceSuggestion
+		bestStart time.Time
+	)
+	for _, list := range lists {
+		if list == nil || isERPRecordDisabled(list.Payload) || erpPayloadString(list.Payload, "customerName") != customer ||
+			erpPayloadString(list.Payload, "currency") != quote.Currency {
+			continue
+		}
+		if quote.PriceTerm != "" && erpPayloadString(list.Payload, "priceTerm") != quote.PriceTerm {
+			continue
+		}
+		validFrom, validTo := erpPayloadDate(list.Payload, "validFrom"), erpPayloadDate(list.Payload, "validTo")
+		if !quote.Date.IsZero() && (validFrom.After(quote.Date) || (!validTo.IsZero() && validTo.Before(quote.Date))) {
+			continue
+		}
+		if best != nil && !validFrom.After(bestStart) {
+			continue
+		}
+		rows, _ := list.Payload["items"].([]any)
+		tier, found := -1.0, false
+		var price float64
+		for _, raw := range rows {
+			row, ok := raw.(map[string]any)
+			if !ok || erpPayloadString(row, "productCode") != productCode {
+				continue
+			}
+			minQuantity := erpPayloadFloat(row, "minQuantity")
+			if minQuantity > baseQuantity || minQuantity <= tier {
+				continue
+			}
+			tier, found, price = minQuantity, true, erpPayloadFloat(row, "unitPrice")
+		}
+		if found {
+			best = &erpPriceSuggestion{UnitPrice: price, Source: ERPPriceSourceList, SourceCode: erpReferenceCode(list.Code, list.ID)}
+			bestStart = validFrom
+		}
+	}
+	return best
+}
+
+// findERPAcceptedQuotationPrice 取该客户同币种、最近报价日期的已接受报价中该产品的单价（折算为基本单位）。
+func findERPAcceptedQuotationPrice(quote erpPriceQuote, productCode string, lookup erpRecordLookup) (*erpPriceSuggestion, error) {
+	sales, err := lookup(ERPModuleExportSales)
+	if err != nil {
+		return nil, err
+	}
+	accepted := map[string]struct{}{}
+	for _, sale := range sales {
+		if sale == nil {
+			continue
+		}
+		if code := erpPayloadString(sale.Payload, "sourceQuotationCode"); code != "" {
+			accepted[code] = struct{}{}
+		}
+	}
+	if len(accepted) == 0 {
+		return nil, nil
+	}
+	quotations, err := lookup(ERPModuleQuotations)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		best     *erpPriceSuggestion
+		bestDate time.Time
+		bestID   int
+	)
+	for _, row := range quotations {
+		if row == nil || row.Code == "" || row.Code == quote.Code {
+			continue
+		}
+		if _, ok := accepted[row.Code]; !ok ||
+			erpPayloadString(row.Payload, "customerName") != quote.Customer || erpPayloadString(row.Payload, "currency") != quote.Currency {
+			continue
+		}
+		date := erpPayloadDate(row.Payload, "quotedDate")
+		if best != nil && (date.Before(bestDate) || (date.Equal(bestDate) && row.ID < bestID)) {
+			continue
+		}
+		items, _ := row.Payload["items"].([]any)
+		for _, raw := range items {
+			item, ok := raw.(map[string]any)
+			if !ok || erpPayloadString(item, "productCode") != productCode {
+				continue
+			}
+			price, ok := toERPFloat64(item["unitPrice"])
+			if !ok || price <= 0 {
+				continue
+			}
+			best = &erpPriceSuggestion{UnitPrice: erpBaseUnitPrice(item, price), Source: ERPPriceSourceQuotation, SourceCode: row.Code}
+			bestDate, bestID = date, row.ID
+			break
+		}
+	}
+	return best, nil
+}
+
+// erpBaseUnitPrice 把明细单位的单价折算为基本单位单价。
+func erpBaseUnitPrice(item map[string]any, price float64) float64 {
+	quantity, baseQuantity := erpPayloadFloat(item, "quantity"), erpItemBaseQuantity(item)
+	if quantity > 0 && baseQuantity > 0 {
+		return price * quantity / baseQuantity
+	}
+	return price
+}
+
+// SetMinMarginPercent 注入报价最低毛利（按最近采购成本加成的百分比），低于时在报价明细上标记 lowMargin。
+func (uc *ERPUsecase) SetMinMarginPercent(percent float64) {
+	uc.minMarginPercent = percent
+}
+
+// flagERPQuotationMargins 按产品最近一张采购合同的单价（人民币、基本单位）计算报价明细的毛利：
+// 报价单价按报价日期汇率折算为人民币，低于 采购成本 ×（1 + 最低毛利%）时标记 lowMargin，表头 lowMargin 汇总。
+// 没有采购记录或缺少汇率的行不标记。
+func (uc *ERPUsecase) flagERPQuotationMargins(moduleKey string, payload map[string]any, lookup erpRecordLookup) error {
+	if moduleKey != ERPModuleQuotations {
+		return nil
+	}
+	delete(payload, "lowMargin")
+	rows, _ := payload["items"].([]any)
+	if len(rows) == 0 {
+		return nil
+	}
+	purchases, err := lookup(ERPModulePurchaseContracts)
+	if err != nil {
+		return err
+	}
+	rates, err := lookup(ERPModuleExchangeRates)
+	if err != nil {
+		return err
+	}
+	ds := &erpDataset{records: map[string][]*ERPRecord{ERPModuleExchangeRates: rates}}
+	date := erpPayloadDate(payload, "quotedDate")
+	if date.IsZero() {
+		date = time.Now()
+	}
+	rate, rateFound := ds.exchangeRate(erpPayloadString(payload, "currency"), date)
+
+	low := false
+	for _, raw := range rows {
+		item, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		delete(item, "purchaseCost")
+		delete(item, "marginPercent")
+		delete(item, "lowMargin")
+		code := erpPayloadString(item, "productCode")
+		price, hasPrice := toERPFloat64(item["unitPrice"])
+		if code == "" || !hasPrice || !rateFound {
+			continue
+		}
+		cost := latestERPPurchaseCost(purchases, code)
+		if cost <= 0 {
+			continue
+		}
+		priceCNY := erpBaseUnitPrice(item, price) * rate
+		item["purchaseCost"] = normalizeERPNumber(roundERPAmount(cost))
+		item["marginPercent"] = normalizeERPNumber(math.Round((priceCNY/cost-1)*10000) / 100)
+		if priceCNY < cost*(1+uc.minMarginPercent/100) {
+			item["lowMargin"] = true
+			low = true
+		}
+	}
+	if low {
+		payload["lowMargin"] = true
+	}
+	return nil
+}
+
+// latestERPPurchaseCost 返回产品在签约日期最晚的采购合同中的基本单位单价（人民币），没有时返回 0。
+func latestERPPurchaseCost(purchases []*ERPRecord, productCode string) float64 {
+	var (
+		cost     float64
+		bestDate time.Time
+		bestID   int
+	)
+	for _, row := range purchases {
+		if row == nil {
+			continue
+		}
+		date := erpPayloadDate(row.Payload, "signDate")
+		if cost > 0 && (date.Before(bestDate) || (date.Equal(bestDate) && row.ID < bestID)) {
+			continue
+		}
+		items, _ := row.Payload["items"].([]any)
+		for _, raw := range items {
+			item, ok := raw.(map[string]any)
+			if !ok || erpPayloadString(item, "productCode") != productCode {
+				continue
+			}
+			price, ok := toERPFloat64(item["unitPrice"])
+			if !ok || price <= 0 {
+				continue
+			}
+			cost, bestDate, bestID = erpBaseUnitPrice(item, price), date, row.ID
+			break
+		}
+	}
+	return cost
+}
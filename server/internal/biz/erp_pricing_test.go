@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecasePriceSuggestions(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	uc.SetMinMarginPercent(20)
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作客户", "客户A", "客户B")
+	seedERPRecords(t, repo, ERPModuleProducts, map[string]any{
+		"code": "PD-001", "unit": "pcs", "unitConversions": []any{map[string]any{"unit": "kg", "factor": 250}},
+	})
+	seedERPRecords(t, repo, ERPModuleExchangeRates, map[string]any{"currency": "USD", "rateToCNY": 7, "effectiveDate": "2026-01-01"})
+	seedERPRecords(t, repo, ERPModulePurchaseContracts,
+		map[string]any{"code": "CG-001", "signDate": "2026-01-05", "items": []any{map[string]any{"productCode": "PD-001", "quantity": 1000, "unitPrice": 2}}},
+		map[string]any{"code": "CG-002", "signDate": "2026-02-01", "items": []any{map[string]any{"productCode": "PD-001", "quantity": 2, "unit": "kg", "baseQuantity": 500, "unitPrice": 625}}},
+	)
+
+	list := map[string]any{
+		"code": "PL-DEF", "name": "默认价格表", "currency": "USD", "priceTerm": "FOB", "validFrom": "2026-01-01", "validTo": "2025-12-31",
+		"items": []any{
+			map[string]any{"productCode": "PD-001", "unitPrice": 0.5},
+			map[string]any{"productCode": "PD-001", "minQuantity": 0, "unitPrice": 0.48},
+		},
+	}
+	_, err := uc.Create(ctx, ERPModulePriceLists, list, 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	want := []ERPFieldError{
+		{Path: "items[1].minQuantity", Message: "字段 items[1].minQuantity 与 items[0] 重复：产品 PD-001 起订数量 0"},
+		{Path: "validTo", Message: "字段 validTo 早于生效日期 validFrom"},
+	}
+	if !reflect.DeepEqual(validationErr.Fields, want) {
+		t.Fatalf("fields = %v, want %v", validationErr.Fields, want)
+	}
+	delete(list, "validTo")
+	list["items"].([]any)[1].(map[string]any)["minQuantity"] = 1000
+	list["items"].([]any)[1].(map[string]any)["unitPrice"] = 0.45
+	if _, err := uc.Create(ctx, ERPModulePriceLists, list, 1); err != nil {
+		t.Fatalf("create default price list failed: %v", err)
+	}
+	if _, err := uc.Create(ctx, ERPModulePriceLists, map[string]any{
+		"code": "PL-A", "name": "客户A 价格表", "customerName": "客户A", "currency": "USD", "priceTerm": "FOB",
+		"validFrom": "2026-01-01", "validTo": "2026-06-30",
+		"items": []any{map[string]any{"productCode": "PD-001", "unitPrice": 0.4}},
+	}, 1); err != nil {
+		t.Fatalf("create customer price list failed: %v", err)
+	}
+
+	quote := func(code, customer, currency, date string, items ...any) map[string]any {
+		t.Helper()
+		created, err := uc.Create(ctx, ERPModuleQuotations, map[string]any{
+			"code": code, "customerName": customer, "quotedDate": date, "currency": currency, "priceTerm": "FOB", "items": items,
+		}, 1)
+		if err != nil {
+			t.Fatalf("create quotation %s failed: %v", code, err)
+		}
+		return created
+	}
+
+	// 客户专属价格表优先，按 kg 报价时折算为每 kg 单价；0.4 USD ≈ 2.8 元，低于 2.5 元成本加 20%
+	created := quote("QT-001", "客户A", "USD", "2026-03-01",
+		map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 2, "unit": "kg"},
+		map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 100, "unitPrice": 0.5},
+	)
+	first := created["items"].([]any)[0].(map[string]any)
+	second := created["items"].([]any)[1].(map[string]any)
+	assertERPNumbers(t, first, map[string]float64{"suggestedPrice": 100, "unitPrice": 100, "purchaseCost": 2.5, "marginPercent": 12})
+	if first["priceSource"] != ERPPriceSourceList || first["priceSourceCode"] != "PL-A" || first["lowMargin"] != true {
+		t.Fatalf("unexpected first line: %v", first)
+	}
+	assertERPNumbers(t, second, map[string]float64{"suggestedPrice": 0.4, "unitPrice": 0.5, "marginPercent": 40})
+	if second["lowMargin"] != nil || created["lowMargin"] != true {
+		t.Fatalf("low margin flags wrong: %v", created)
+	}
+	assertERPNumbers(t, created, map[string]float64{"totalAmount": 250})
+
+	// 默认价格表按数量取档；客户价格表过期后回落到默认价格表
+	created = quote("QT-002", "客户B", "USD", "2026-03-01", map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 1200})
+	line := created["items"].([]any)[0].(map[string]any)
+	assertERPNumbers(t, line, map[string]float64{"unitPrice": 0.45})
+	if line["priceSourceCode"] != "PL-DEF" || created["lowMargin"] != nil {
+		t.Fatalf("unexpected default list line: %v", created)
+	}
+	created = quote("QT-003", "客户A", "USD", "2026-08-01", map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 10})
+	assertERPNumbers(t, created["items"].([]any)[0].(map[string]any), map[string]float64{"unitPrice": 0.5})
+
+	// 没有适用价格表时取该客户最近一张已接受报价
+	quote("QT-E1", "客户A", "EUR", "2026-02-01", map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 10, "unitPrice": 0.6})
+	quote("QT-E2", "客户A", "EUR", "2026-03-01", map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 10, "unitPrice": 0.7})
+	seedERPRecords(t, repo, ERPModuleExportSales, map[string]any{"code": "XS-001", "sourceQuotationCode": "QT-E1"})
+	created = quote("QT-004", "客户A", "EUR", "2026-04-01", map[string]any{"productCode": "PD-001", "productName": "磁钢A", "quantity": 10})
+	line = created["items"].([]any)[0].(map[string]any)
+	assertERPNumbers(t, line, map[string]float64{"unitPrice": 0.6})
+	if line["priceSource"] != ERPPriceSourceQuotation || line["priceSourceCode"] != "QT-E1" || line["marginPercent"] != nil {
+		t.Fatalf("unexpected accepted quotation line (no EUR rate, no margin): %v", line)
+	}
+}
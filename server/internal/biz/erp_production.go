@@ -0,0 +1,801 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// ERPOrderFlowProduction 外销合同订单流向：内部生产的明细行可生成生产单。
+const ERPOrderFlowProduction = "内部生产"
+
+// 生产单状态。
+const (
+	ERPProductionStatusReleased   = "已下达"
+	ERPProductionStatusInProgress = "生产中"
+	ERPProductionStatusCompleted  = "已完工"
+	ERPProductionStatusCancelled  = "已取消"
+)
+
+// 库存流水业务类型（erp_stock_transactions.biz_type）。
+const (
+	ERPStockBizLock    = "锁定"
+	ERPStockBizUnlock  = "解锁"
+	ERPStockBizIssue   = "出库"
+	ERPStockBizReceive = "入库"
+)
+
+// ERPProductionTemplateKey 生产加工申请单模板 key。
+const ERPProductionTemplateKey = "production"
+
+// ErrERPStockUnsupported 仓储不支持库存余额，不能办理生产单的锁定、领料与完工入库。
+var ErrERPStockUnsupported = errors.New("erp stock is not supported")
+
+// erpProductionEditableFields 生产单可通过 erp.update 修改的字段；用料、领料、完工与状态只由生产接口维护。
+var erpProductionEditableFields = []string{"box", "plannedDate", "remark"}
+
+// ERPStockBalance 库存余额（erp_stock_balances）。
+type ERPStockBalance struct {
+	ID           int
+	ProductCode  string
+	WarehouseID  int
+	LocationID   int
+	LotNo        string
+	AvailableQty float64
+	LockedQty    float64
+}
+
+// ERPStockMove 一次库存变动：锁定为可用转锁定，解锁相反，领料（出库）扣减锁定数量，完工入库增加可用数量。
+// LineNo 为业务明细行号（从 0 开始），Path 为库存不足或货位不可用时报错的字段。
+type ERPStockMove struct {
+	BizType        string
+	LineNo         int
+	ProductCode    string
+	WarehouseID    int
+	LocationID     int
+	LotNo          string
+	AvailableDelta float64
+	LockedDelta    float64
+	Path           string
+}
+
+// ERPProductionPlan 一次生产单操作要写入的内容：Record.ID 为 0 时新建，否则按 Record.Version 更新；
+// Moves 按顺序变动库存并以生产单号写入流水；Links 为新增的单据链路。
+type ERPProductionPlan struct {
+	Record *ERPRecord
+	Moves  []ERPStockMove
+	Links  []*ERPDocLink
+}
+
+// ERPProductionRepo 由支持库存表的仓储实现，须在同一事务内保存生产单、变动库存并写入单据链路；
+// 可用或锁定数量将为负、入库货位不存在或已停用时整体回滚并返回 ERPValidationError。
+type ERPProductionRepo interface {
+	// ListStockBalances 返回物料的库存余额，按入库先后（ID 升序）排列。
+	ListStockBalances(ctx context.Context, productCodes []string) ([]*ERPStockBalance, error)
+	SaveProduction(ctx context.Context, plan *ERPProductionPlan, operatorID int) (*ERPRecord, error)
+}
+
+// ERPProductionIssue 领料行：LineNo 为用料行号，Quantity 为 0 时领用该行全部已锁定数量。
+type ERPProductionIssue struct {
+	LineNo   int
+	Quantity float64
+}
+
+// ERPProductionCompletion 完工入库：成品按 Quantity 入库到指定仓库货位，CompletedDate 为空时取当天。
+type ERPProductionCompletion struct {
+	CompletedDate string
+	Quantity      float64
+	WarehouseID   int
+	LocationID    int
+	LotNo         string
+}
+
+// ERPProductionPrint 生产加工申请单打印数据。
+type ERPProductionPrint struct {
+	TemplateKey string
+	Title       string
+	Fields      map[string]any
+	Materials   []map[string]any
+}
+
+// erpProductionRequiredQty 物料需求数量 = 生产数量 × 单位用量 × (1 + 损耗率%)。
+func erpProductionRequiredQty(quantity float64, material map[string]any) float64 {
+	return roundERPAmount(quantity * erpPayloadFloat(material, "bomQuantity") * (1 + erpPayloadFloat(material, "lossRate")/100))
+}
+
+// deriveProductionOrder 计算各物料需求数量，按锁定与领料记录汇总已锁定、已领用与缺料数量，按完工记录汇总完工数量；
+// 状态缺省为已下达，已完工或已取消的生产单不再缺料。
+func deriveProductionOrder(payload map[string]any) error {
+	materials, err := getERPItemsField(payload, "materials")
+	if err != nil {
+		return err
+	}
+	issues, err := getERPItemsField(payload, "issues")
+	if err != nil {
+		return err
+	}
+	completions, err := getERPItemsField(payload, "completions")
+	if err != nil {
+		return err
+	}
+	if erpPayloadString(payload, "status") == "" {
+		payload["status"] = ERPProductionStatusReleased
+	}
+	status := erpPayloadString(payload, "status")
+	closed := status == ERPProductionStatusCompleted || status == ERPProductionStatusCancelled
+
+	issued := map[int]float64{}
+	for _, row := range issues {
+		issued[int(erpPayloadFloat(row, "lineNo"))] += erpPayloadFloat(row, "quantity")
+	}
+	quantity := erpPayloadFloat(payload, "quantity")
+	for _, material := range materials {
+		reservations, err := getERPItemsField(material, "reservations")
+		if err != nil {
+			return err
+		}
+		reserved := 0.0
+		for _, row := range reservations {
+			reserved += erpPayloadFloat(row, "quantity")
+		}
+		required := erpProductionRequiredQty(quantity, material)
+		lineIssued := issued[int(erpPayloadFloat(material, "lineNo"))]
+		shortage := 0.0
+		if !closed {
+			shortage = math.Max(0, roundERPAmount(required-lineIssued-reserved))
+		}
+		material["requiredQty"] = normalizeERPNumber(required)
+		material["reservedQty"] = normalizeERPNumber(roundERPAmount(reserved))
+		material["issuedQty"] = normalizeERPNumber(roundERPAmount(lineIssued))
+		material["shortageQty"] = normalizeERPNumber(shortage)
+	}
+
+	completed := 0.0
+	for _, row := range completions {
+		completed += erpPayloadFloat(row, "quantity")
+	}
+	payload["completedQty"] = normalizeERPNumber(roundERPAmount(completed))
+	return nil
+}
+
+// checkERPProductionOrder 校验生产单：用料行号不能重复（领料记录按行号对应用料）。
+func checkERPProductionOrder(payload map[string]any) []ERPFieldError {
+	var errs []ERPFieldError
+	rows, _ := payload["materials"].([]any)
+	seen := map[int]int{}
+	for index, raw := range rows {
+		row, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		lineNo := int(erpPayloadFloat(row, "lineNo"))
+		if first, ok := seen[lineNo]; ok {
+			path := fmt.Sprintf("materials[%d].lineNo", index)
+			errs = append(errs, ERPFieldError{Path: path, Message: fmt.Sprintf("字段 %s 与 materials[%d] 重复：行号 %d", path, first, lineNo)})
+			continue
+		}
+		seen[lineNo] = index
+	}
+	return errs
+}
+
+// checkERPProductBOM 校验产品物料清单：不能以产品自身为物料，同一物料不能重复。
+func checkERPProductBOM(payload map[string]any) []ERPFieldError {
+	var errs []ERPFieldError
+	rows, _ := payload["bom"].([]any)
+	code := erpPayloadString(payload, "code")
+	seen := map[string]int{}
+	for index, raw := range rows {
+		row, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		material := erpPayloadString(row, "materialCode")
+		if material == "" {
+			continue
+		}
+		path := fmt.Sprintf("bom[%d].materialCode", index)
+		if material == code {
+			errs = append(errs, ERPFieldError{Path: path, Message: fmt.Sprintf("字段 %s 不能是产品自身", path)})
+			continue
+		}
+		if first, ok := seen[material]; ok {
+			errs = append(errs, ERPFieldError{Path: path, Message: fmt.Sprintf("字段 %s 与 bom[%d] 重复：物料 %s", path, first, material)})
+			continue
+		}
+		seen[material] = index
+	}
+	return errs
+}
+
+// restoreERPProductionFields 通用修改只接受 erpProductionEditableFields，其余字段取已保存的内容。
+func restoreERPProductionFields(payload, stored map[string]any) {
+	next, _ := cloneERPValue(stored).(map[string]any)
+	for _, key := range erpProductionEditableFields {
+		if value, ok := payload[key]; ok {
+			next[key] = value
+		} else {
+			delete(next, key)
+		}
+	}
+	for key := range payload {
+		delete(payload, key)
+	}
+	for key, value := range next {
+		payload[key] = value
+	}
+}
+
+// checkERPProductionDeletable 生产单仍锁定库存，或已有领料、完工入库记录时不能删除。
+func checkERPProductionDeletable(stored *ERPRecord) error {
+	issues, _ := stored.Payload["issues"].([]any)
+	completions, _ := stored.Payload["completions"].([]any)
+	if len(issues) > 0 || len(completions) > 0 {
+		return &ERPValidationError{Fields: []ERPFieldError{{Path: "status", Message: "生产单已有领料或完工入库记录，不能删除"}}}
+	}
+	materials, _ := getERPItemsField(stored.Payload, "materials")
+	for _, material := range materials {
+		if reservations, _ := material["reservations"].([]any); len(reservations) > 0 {
+			return &ERPValidationError{Fields: []ERPFieldError{{Path: "status", Message: "生产单仍锁定库存，请先取消生产单"}}}
+		}
+	}
+	return nil
+}
+
+func (uc *ERPUsecase) productionRepo() (ERPProductionRepo, error) {
+	repo, ok := uc.repo.(ERPProductionRepo)
+	if !ok {
+		return nil, ErrERPStockUnsupported
+	}
+	return repo, nil
+}
+
+// GenerateProductionOrder 由外销合同第 lineNo 行生成生产单并按物料清单锁定原材料库存。
+// 外销合同按调用方的记录范围读取，须为内部生产；quantity 为 0 时取该行未下达生产的基本单位数量，
+// 累计下达数量（已取消的计完工数量）不能超过该行数量加溢装容差。库存不足时部分锁定，缺料数量记在用料行上。
+func (uc *ERPUsecase) GenerateProductionOrder(ctx context.Context, exportCode string, lineNo int, quantity float64, plannedDate string, operatorID int) (map[string]any, error) {
+	repo, err := uc.productionRepo()
+	if err != nil {
+		return nil, err
+	}
+	exportCode = strings.TrimSpace(exportCode)
+	if exportCode == "" || lineNo <= 0 || quantity < 0 {
+		return nil, ErrBadParam
+	}
+	sales, err := uc.repo.ListByModule(ctx, ERPModuleExportSales)
+	if err != nil {
+		return nil, err
+	}
+	var sale *ERPRecord
+	for _, row := range sales {
+		if row != nil && row.Code == exportCode {
+			sale = row
+			break
+		}
+	}
+	if sale == nil {
+		return nil, ErrERPRecordNotFound
+	}
+	if flow := erpPayloadString(sale.Payload, "orderFlow"); flow != ERPOrderFlowProduction {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "orderFlow",
+			Message: fmt.Sprintf("外销合同 %s 订单流向为%s，只有内部生产的明细可生成生产单", exportCode, flow)}}}
+	}
+	item, index := erpExportSaleLine(sale.Payload, lineNo)
+	if item == nil {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "items", Message: fmt.Sprintf("外销合同 %s 没有第 %d 行", exportCode, lineNo)}}}
+	}
+	itemPath := fmt.Sprintf("items[%d].productCode", index)
+	productCode := erpPayloadString(item, "productCode")
+	if productCode == "" {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: itemPath, Message: fmt.Sprintf("字段 %s 为必填项：生成生产单须指定产品", itemPath)}}}
+	}
+
+	lookup := uc.recordLookup(ctx)
+	products, err := lookup(ERPModuleProducts)
+	if err != nil {
+		return nil, err
+	}
+	product := findERPReferenceTarget(products, erpRefProduct, productCode, 0)
+	if product == nil {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: itemPath, Message: fmt.Sprintf("字段 %s 引用的产品 %s 不存在或已停用", itemPath, productCode)}}}
+	}
+	bom, err := getERPItemsField(product.Payload, "bom")
+	if err != nil {
+		return nil, err
+	}
+	if len(bom) == 0 {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: itemPath, Message: fmt.Sprintf("产品 %s 未维护物料清单 bom", productCode)}}}
+	}
+
+	orders, err := lookup(ERPModuleProductionOrders)
+	if err != nil {
+		return nil, err
+	}
+	codes := map[string]struct{}{}
+	planned := 0.0
+	for _, order := range orders {
+		if order == nil {
+			continue
+		}
+		codes[order.Code] = struct{}{}
+		if erpPayloadString(order.Payload, "sourceExportCode") != exportCode || int(erpPayloadFloat(order.Payload, "exportLineNo")) != lineNo {
+			continue
+		}
+		if erpPayloadString(order.Payload, "status") == ERPProductionStatusCancelled {
+			planned += erpPayloadFloat(order.Payload, "completedQty")
+		} else {
+			planned += erpPayloadFloat(order.Payload, "quantity")
+		}
+	}
+	lineQty := erpItemBaseQuantity(item)
+	maxQty := erpFulfilmentMax(lineQty, uc.fulfilmentTolerancePercent(sale))
+	if quantity == 0 {
+		quantity = roundERPAmount(lineQty - planned)
+	}
+	if quantity <= 0 || roundERPAmount(planned+quantity) > maxQty {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "quantity",
+			Message: fmt.Sprintf("外销合同 %s 第 %d 行数量 %s，已下达生产 %s，本次 %s 超出可下达数量（含容差最多 %s）",
+				exportCode, lineNo, formatERPQuantity(lineQty), formatERPQuantity(planned), formatERPQuantity(quantity), formatERPQuantity(maxQty))}}}
+	}
+
+	base := fmt.Sprintf("SC-%s-%d", exportCode, lineNo)
+	code := base
+	for n := 2; ; n++ {
+		if _, ok := codes[code]; !ok {
+			break
+		}
+		code = fmt.Sprintf("%s-%d", base, n)
+	}
+
+	materials := make([]any, 0, len(bom))
+	for i, row := range bom {
+		materialCode := erpPayloadString(row, "materialCode")
+		material := map[string]any{
+			"lineNo":       i + 1,
+			"materialCode": materialCode,
+			"bomQuantity":  row["quantity"],
+		}
+		if !isEmptyERPValue(row["lossRate"]) {
+			material["lossRate"] = row["lossRate"]
+		}
+		name := erpPayloadString(row, "materialName")
+		if target := findERPReferenceTarget(products, erpRefProduct, materialCode, 0); target != nil {
+			if name == "" {
+				name = erpPayloadString(target.Payload, "cnDesc")
+			}
+			material["unit"] = erpProductBaseUnit(target.Payload)
+		}
+		if name != "" {
+			material["materialName"] = name
+		}
+		materials = append(materials, material)
+	}
+	if plannedDate = strings.TrimSpace(plannedDate); plannedDate == "" {
+		plannedDate = erpPayloadString(sale.Payload, "deliveryDate")
+	}
+	payload := map[string]any{
+		"code":             code,
+		"sourceExportCode": exportCode,
+		"exportLineNo":     lineNo,
+		"customerName":     erpPayloadString(sale.Payload, "customerName"),
+		"productCode":      productCode,
+		"productName":      erpPayloadString(item, "productName"),
+		"quantity":         normalizeERPNumber(quantity),
+		"unit":             erpProductBaseUnit(product.Payload),
+		"status":           ERPProductionStatusReleased,
+		"materials":        materials,
+	}
+	if plannedDate != "" {
+		payload["plannedDate"] = plannedDate
+	}
+
+	moves, err := uc.reserveProductionMaterials(ctx, repo, payload)
+	if err != nil {
+		return nil, err
+	}
+	links := []*ERPDocLink{{
+		FromModule: ERPModuleExportSales, FromCode: exportCode,
+		ToModule: ERPModuleProductionOrders, ToCode: code, RelationType: "derived",
+	}}
+	return uc.saveProductionOrder(ctx, repo, &ERPRecord{ModuleKey: ERPModuleProductionOrders, Code: code}, payload, moves, links, operatorID)
+}
+
+// ReserveProductionMaterials 为仍缺料的物料补充锁定库存；没有可锁定的库存时原样返回生产单。
+func (uc *ERPUsecase) ReserveProductionMaterials(ctx context.Context, id, expectedVersion, operatorID int) (map[string]any, error) {
+	repo, stored, payload, err := uc.loadProductionOrder(ctx, id, expectedVersion, "锁定库存")
+	if err != nil {
+		return nil, err
+	}
+	moves, err := uc.reserveProductionMaterials(ctx, repo, payload)
+	if err != nil {
+		return nil, err
+	}
+	if len(moves) == 0 {
+		return toERPRecordView(stored, false), nil
+	}
+	return uc.saveProductionOrder(ctx, repo, stored, payload, moves, nil, operatorID)
+}
+
+// IssueProductionMaterials 按已锁定的库存领料：逐条扣减锁定数量并记入领料记录，生产单转为生产中。
+// lines 为空时领用全部已锁定物料；领用数量不能超过该行已锁定数量。
+func (uc *ERPUsecase) IssueProductionMaterials(ctx context.Context, id, expectedVersion int, lines []ERPProductionIssue, issuedDate string, operatorID int) (map[string]any, error) {
+	repo, stored, payload, err := uc.loadProductionOrder(ctx, id, expectedVersion, "领料")
+	if err != nil {
+		return nil, err
+	}
+	materials, err := getERPItemsField(payload, "materials")
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		for _, material := range materials {
+			lines = append(lines, ERPProductionIssue{LineNo: int(erpPayloadFloat(material, "lineNo"))})
+		}
+	}
+	if issuedDate = strings.TrimSpace(issuedDate); issuedDate == "" {
+		issuedDate = time.Now().Format("2006-01-02")
+	}
+
+	issues, _ := payload["issues"].([]any)
+	var moves []ERPStockMove
+	var fields []ERPFieldError
+	for _, line := range lines {
+		index := -1
+		for i, material := range materials {
+			if int(erpPayloadFloat(material, "lineNo")) == line.LineNo {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			fields = append(fields, ERPFieldError{Path: "materials", Message: fmt.Sprintf("生产单没有第 %d 行用料", line.LineNo)})
+			continue
+		}
+		material := materials[index]
+		reservations, err := getERPItemsField(material, "reservations")
+		if err != nil {
+			return nil, err
+		}
+		reserved := 0.0
+		for _, row := range reservations {
+			reserved += erpPayloadFloat(row, "quantity")
+		}
+		want := line.Quantity
+		if want == 0 {
+			want = reserved
+		}
+		path := fmt.Sprintf("materials[%d].reservedQty", index)
+		if want < 0 || roundERPAmount(want) > roundERPAmount(reserved) {
+			fields = append(fields, ERPFieldError{Path: path, Message: fmt.Sprintf("物料 %s 已锁定 %s，不足领用 %s",
+				erpPayloadString(material, "materialCode"), formatERPQuantity(reserved), formatERPQuantity(want))})
+			continue
+		}
+
+		kept := make([]any, 0, len(reservations))
+		for _, row := range reservations {
+			take := math.Min(want, erpPayloadFloat(row, "quantity"))
+			if take > 0 {
+				want = roundERPAmount(want - take)
+				moves = append(moves, erpProductionStockMove(ERPStockBizIssue, index, material, row, 0, -take, path))
+				issues = append(issues, map[string]any{
+					"issuedDate":   issuedDate,
+					"lineNo":       material["lineNo"],
+					"materialCode": material["materialCode"],
+					"warehouseId":  row["warehouseId"],
+					"locationId":   row["locationId"],
+					"lotNo":        erpPayloadString(row, "lotNo"),
+					"quantity":     normalizeERPNumber(roundERPAmount(take)),
+				})
+			}
+			if left := roundERPAmount(erpPayloadFloat(row, "quantity") - take); left > 0 {
+				row["quantity"] = normalizeERPNumber(left)
+				kept = append(kept, row)
+			}
+		}
+		material["reservations"] = kept
+	}
+	if len(fields) > 0 {
+		return nil, &ERPValidationError{Fields: fields}
+	}
+	if len(moves) == 0 {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "materials", Message: "没有可领用的已锁定物料，请先锁定库存"}}}
+	}
+	payload["issues"] = issues
+	payload["status"] = ERPProductionStatusInProgress
+	return uc.saveProductionOrder(ctx, repo, stored, payload, moves, nil, operatorID)
+}
+
+// CompleteProduction 完工入库：成品按完工数量增加入库货位的可用库存。累计完工数量不能超过生产数量；
+// 全部完工时生产单转为已完工并解锁剩余的锁定库存，否则为生产中。
+func (uc *ERPUsecase) CompleteProduction(ctx context.Context, id, expectedVersion int, completion ERPProductionCompletion, operatorID int) (map[string]any, error) {
+	repo, stored, payload, err := uc.loadProductionOrder(ctx, id, expectedVersion, "完工入库")
+	if err != nil {
+		return nil, err
+	}
+	quantity := erpPayloadFloat(payload, "quantity")
+	completed := erpPayloadFloat(payload, "completedQty")
+	if completion.Quantity <= 0 || roundERPAmount(completed+completion.Quantity) > quantity {
+		return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "quantity",
+			Message: fmt.Sprintf("生产数量 %s，已完工 %s，本次完工 %s 超出未完工数量",
+				formatERPQuantity(quantity), formatERPQuantity(completed), formatERPQuantity(completion.Quantity))}}}
+	}
+	if completion.CompletedDate = strings.TrimSpace(completion.CompletedDate); completion.CompletedDate == "" {
+		completion.CompletedDate = time.Now().Format("2006-01-02")
+	}
+
+	completions, _ := payload["completions"].([]any)
+	row := map[string]any{
+		"completedDate": completion.CompletedDate,
+		"warehouseId":   completion.WarehouseID,
+		"locationId":    completion.LocationID,
+		"lotNo":         strings.TrimSpace(completion.LotNo),
+		"quantity":      normalizeERPNumber(roundERPAmount(completion.Quantity)),
+	}
+	path := fmt.Sprintf("completions[%d].locationId", len(completions))
+	moves := []ERPStockMove{{
+		BizType: ERPStockBizReceive, ProductCode: erpPayloadString(payload, "productCode"),
+		WarehouseID: completion.WarehouseID, LocationID: completion.LocationID, LotNo: erpPayloadString(row, "lotNo"),
+		AvailableDelta: completion.Quantity, Path: path,
+	}}
+	payload["completions"] = append(completions, row)
+	payload["status"] = ERPProductionStatusInProgress
+	if roundERPAmount(completed+completion.Quantity) >= quantity {
+		payload["status"] = ERPProductionStatusCompleted
+		released, err := releaseERPProductionReservations(payload)
+		if err != nil {
+			return nil, err
+		}
+		moves = append(moves, released...)
+	}
+	return uc.saveProductionOrder(ctx, repo, stored, payload, moves, nil, operatorID)
+}
+
+// CancelProduction 取消生产单并解锁全部锁定库存；已领用的物料不退回。
+func (uc *ERPUsecase) CancelProduction(ctx context.Context, id, expectedVersion, operatorID int) (map[string]any, error) {
+	repo, stored, payload, err := uc.loadProductionOrder(ctx, id, expectedVersion, "取消")
+	if err != nil {
+		return nil, err
+	}
+	moves, err := releaseERPProductionReservations(payload)
+	if err != nil {
+		return nil, err
+	}
+	payload["status"] = ERPProductionStatusCancelled
+	return uc.saveProductionOrder(ctx, repo, stored, payload, moves, nil, operatorID)
+}
+
+// ProductionPrintData 生产加工申请单打印数据：生产单表头、来源外销合同的客户合同号与交期、产品规格，以及用料明细。
+func (uc *ERPUsecase) ProductionPrintData(ctx context.Context, id int) (*ERPProductionPrint, error) {
+	if id <= 0 {
+		return nil, ErrBadParam
+	}
+	order, err := uc.findRecordByID(ctx, ERPModuleProductionOrders, id)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	for _, key := range []string{
+		"code", "sourceExportCode", "exportLineNo", "customerName", "productCode", "productName",
+		"quantity", "unit", "plannedDate", "status", "completedQty", "remark",
+	} {
+		if value, ok := order.Payload[key]; ok {
+			fields[key] = value
+		}
+	}
+	lookup := uc.recordLookup(ctx)
+	sales, err := lookup(ERPModuleExportSales)
+	if err != nil {
+		return nil, err
+	}
+	if sale := findERPReferenceTarget(sales, erpRefExport, erpPayloadString(order.Payload, "sourceExportCode"), 0); sale != nil {
+		fields["customerContractNo"] = erpPayloadString(sale.Payload, "customerContractNo")
+		fields["deliveryDate"] = erpPayloadString(sale.Payload, "deliveryDate")
+	}
+	products, err := lookup(ERPModuleProducts)
+	if err != nil {
+		return nil, err
+	}
+	if product := findERPReferenceTarget(products, erpRefProduct, erpPayloadString(order.Payload, "productCode"), 0); product != nil {
+		for _, key := range []string{"specCode", "drawingNo", "cnDesc", "enDesc"} {
+			fields[key] = erpPayloadString(product.Payload, key)
+		}
+	}
+
+	rows, err := getERPItemsField(order.Payload, "materials")
+	if err != nil {
+		return nil, err
+	}
+	materials := make([]map[string]any, 0, len(rows))
+	for _, row := range rows {
+		material := map[string]any{}
+		for _, key := range []string{"lineNo", "materialCode", "materialName", "unit", "bomQuantity", "lossRate", "requiredQty", "reservedQty", "issuedQty", "shortageQty"} {
+			if value, ok := row[key]; ok {
+				material[key] = value
+			}
+		}
+		materials = append(materials, material)
+	}
+	return &ERPProductionPrint{TemplateKey: ERPProductionTemplateKey, Title: "生产加工申请单", Fields: fields, Materials: materials}, nil
+}
+
+// loadProductionOrder 读取生产单并核对版本，返回仓储、原记录与可修改的副本；已完工或已取消的生产单不能再办理 action。
+func (uc *ERPUsecase) loadProductionOrder(ctx context.Context, id, expectedVersion int, action string) (ERPProductionRepo, *ERPRecord, map[string]any, error) {
+	repo, err := uc.productionRepo()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if id <= 0 {
+		return nil, nil, nil, ErrBadParam
+	}
+	stored, err := uc.findRecordByID(ctx, ERPModuleProductionOrders, id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if expectedVersion > 0 && stored.Version != expectedVersion {
+		return nil, nil, nil, ErrERPVersionConflict
+	}
+	status := erpPayloadString(stored.Payload, "status")
+	if status == ERPProductionStatusCompleted || status == ERPProductionStatusCancelled {
+		return nil, nil, nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "status", Message: fmt.Sprintf("生产单状态为%s，不能%s", status, action)}}}
+	}
+	payload, _ := cloneERPValue(stored.Payload).(map[string]any)
+	return repo, stored, payload, nil
+}
+
+// saveProductionOrder 按模块规则派生并校验生产单，再连同库存变动与单据链路一起保存。
+func (uc *ERPUsecase) saveProductionOrder(ctx context.Context, repo ERPProductionRepo, stored *ERPRecord, payload map[string]any, moves []ERPStockMove, links []*ERPDocLink, operatorID int) (map[string]any, error) {
+	clean, err := applyERPModuleRules(ERPModuleProductionOrders, payload, uc.recordLookup(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for i := range moves {
+		moves[i].AvailableDelta = roundERPAmount(moves[i].AvailableDelta)
+		moves[i].LockedDelta = roundERPAmount(moves[i].LockedDelta)
+	}
+	record, err := repo.SaveProduction(ctx, &ERPProductionPlan{
+		Record: &ERPRecord{ID: stored.ID, ModuleKey: ERPModuleProductionOrders, Code: getPayloadCode(clean), Payload: clean, Version: stored.Version},
+		Moves:  moves,
+		Links:  links,
+	}, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	return toERPRecordView(record, false), nil
+}
+
+// reserveProductionMaterials 按先入先出从可用库存为各物料锁定缺料数量（需求 - 已领用 - 已锁定），
+// 同一仓库货位批次的锁定合并为一条；库存不足时部分锁定。
+func (uc *ERPUsecase) reserveProductionMaterials(ctx context.Context, repo ERPProductionRepo, payload map[string]any) ([]ERPStockMove, error) {
+	materials, err := getERPItemsField(payload, "materials")
+	if err != nil {
+		return nil, err
+	}
+	issues, err := getERPItemsField(payload, "issues")
+	if err != nil {
+		return nil, err
+	}
+	issued := map[int]float64{}
+	for _, row := range issues {
+		issued[int(erpPayloadFloat(row, "lineNo"))] += erpPayloadFloat(row, "quantity")
+	}
+	codes := make([]string, 0, len(materials))
+	for _, material := range materials {
+		codes = append(codes, erpPayloadString(material, "materialCode"))
+	}
+	balances, err := repo.ListStockBalances(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+	available := make(map[int]float64, len(balances))
+	for _, balance := range balances {
+		available[balance.ID] = balance.AvailableQty
+	}
+
+	quantity := erpPayloadFloat(payload, "quantity")
+	var moves []ERPStockMove
+	for index, material := range materials {
+		reservations, err := getERPItemsField(material, "reservations")
+		if err != nil {
+			return nil, err
+		}
+		need := erpProductionRequiredQty(quantity, material) - issued[int(erpPayloadFloat(material, "lineNo"))]
+		for _, row := range reservations {
+			need -= erpPayloadFloat(row, "quantity")
+		}
+		need = roundERPAmount(need)
+		code := erpPayloadString(material, "materialCode")
+		path := fmt.Sprintf("materials[%d].reservedQty", index)
+		for _, balance := range balances {
+			if need <= 0 {
+				break
+			}
+			if balance.ProductCode != code {
+				continue
+			}
+			take := roundERPAmount(math.Min(need, available[balance.ID]))
+			if take <= 0 {
+				continue
+			}
+			available[balance.ID] -= take
+			need = roundERPAmount(need - take)
+
+			var reservation map[string]any
+			for _, row := range reservations {
+				if int(erpPayloadFloat(row, "warehouseId")) == balance.WarehouseID && int(erpPayloadFloat(row, "locationId")) == balance.LocationID &&
+					erpPayloadString(row, "lotNo") == balance.LotNo {
+					reservation = row
+					break
+				}
+			}
+			if reservation == nil {
+				reservation = map[string]any{"warehouseId": balance.WarehouseID, "locationId": balance.LocationID, "lotNo": balance.LotNo, "quantity": 0}
+				reservations = append(reservations, reservation)
+			}
+			reservation["quantity"] = normalizeERPNumber(roundERPAmount(erpPayloadFloat(reservation, "quantity") + take))
+			moves = append(moves, erpProductionStockMove(ERPStockBizLock, index, material, reservation, -take, take, path))
+		}
+		rows := make([]any, 0, len(reservations))
+		for _, row := range reservations {
+			rows = append(rows, row)
+		}
+		material["reservations"] = rows
+	}
+	return moves, nil
+}
+
+// releaseERPProductionReservations 清空各物料的锁定记录，返回解锁的库存变动。
+func releaseERPProductionReservations(payload map[string]any) ([]ERPStockMove, error) {
+	materials, err := getERPItemsField(payload, "materials")
+	if err != nil {
+		return nil, err
+	}
+	var moves []ERPStockMove
+	for index, material := range materials {
+		reservations, err := getERPItemsField(material, "reservations")
+		if err != nil {
+			return nil, err
+		}
+		path := fmt.Sprintf("materials[%d].reservedQty", index)
+		for _, row := range reservations {
+			if quantity := erpPayloadFloat(row, "quantity"); quantity > 0 {
+				moves = append(moves, erpProductionStockMove(ERPStockBizUnlock, index, material, row, quantity, -quantity, path))
+			}
+		}
+		material["reservations"] = []any{}
+	}
+	return moves, nil
+}
+
+func erpProductionStockMove(bizType string, index int, material, lot map[string]any, availableDelta, lockedDelta float64, path string) ERPStockMove {
+	return ERPStockMove{
+		BizType:        bizType,
+		LineNo:         index,
+		ProductCode:    erpPayloadString(material, "materialCode"),
+		WarehouseID:    int(erpPayloadFloat(lot, "warehouseId")),
+		LocationID:     int(erpPayloadFloat(lot, "locationId")),
+		LotNo:          erpPayloadString(lot, "lotNo"),
+		AvailableDelta: availableDelta,
+		LockedDelta:    lockedDelta,
+		Path:           path,
+	}
+}
+
+// erpExportSaleLine 返回外销合同中行号为 lineNo 的明细及其下标；明细未填写行号时按第 lineNo 行（从 1 开始）。
+func erpExportSaleLine(payload map[string]any, lineNo int) (map[string]any, int) {
+	rows, _ := payload["items"].([]any)
+	for index, raw := range rows {
+		item, ok := raw.(map[string]any)
+		if ok && int(erpPayloadFloat(item, "lineNo")) == lineNo {
+			return item, index
+		}
+	}
+	if lineNo <= len(rows) {
+		if item, ok := rows[lineNo-1].(map[string]any); ok && isEmptyERPValue(item["lineNo"]) {
+			return item, lineNo - 1
+		}
+	}
+	return nil, -1
+}
@@ -0,0 +1,295 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// memERPProductionRepo 在内存仓储上模拟库存余额与单据链路：库存不足时整体不写入。
+type memERPProductionRepo struct {
+	*memERPRepo
+	balances []*ERPStockBalance
+	links    []*ERPDocLink
+}
+
+func (r *memERPProductionRepo) ListStockBalances(ctx context.Context, productCodes []string) ([]*ERPStockBalance, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*ERPStockBalance
+	for _, balance := range r.balances {
+		for _, code := range productCodes {
+			if balance.ProductCode == code {
+				copied := *balance
+				out = append(out, &copied)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func (r *memERPProductionRepo) SaveProduction(ctx context.Context, plan *ERPProductionPlan, operatorID int) (*ERPRecord, error) {
+	r.mu.Lock()
+	next := make([]*ERPStockBalance, 0, len(r.balances))
+	for _, balance := range r.balances {
+		copied := *balance
+		next = append(next, &copied)
+	}
+	for _, move := range plan.Moves {
+		var balance *ERPStockBalance
+		for _, row := range next {
+			if row.ProductCode == move.ProductCode && row.WarehouseID == move.WarehouseID && row.LocationID == move.LocationID && row.LotNo == move.LotNo {
+				balance = row
+			}
+		}
+		if balance == nil {
+			balance = &ERPStockBalance{ID: len(next) + 1, ProductCode: move.ProductCode, WarehouseID: move.WarehouseID, LocationID: move.LocationID, LotNo: move.LotNo}
+			next = append(next, balance)
+		}
+		balance.AvailableQty = roundERPAmount(balance.AvailableQty + move.AvailableDelta)
+		balance.LockedQty = roundERPAmount(balance.LockedQty + move.LockedDelta)
+		if balance.AvailableQty < 0 || balance.LockedQty < 0 {
+			r.mu.Unlock()
+			return nil, &ERPValidationError{Fields: []ERPFieldError{{Path: move.Path, Message: "库存不足"}}}
+		}
+	}
+	r.mu.Unlock()
+
+	var record *ERPRecord
+	var err error
+	if plan.Record.ID == 0 {
+		record, err = r.Create(ctx, plan.Record.ModuleKey, plan.Record.Payload, operatorID)
+	} else {
+		record, err = r.Update(ctx, plan.Record.ModuleKey, plan.Record.ID, plan.Record.Payload, plan.Record.Version, operatorID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.balances = next
+	r.links = append(r.links, plan.Links...)
+	return record, nil
+}
+
+// stock 返回余额中 (产品, 仓库, 货位, 批次) 的可用与锁定数量。
+func (r *memERPProductionRepo) stock(code string, warehouseID, locationID int, lotNo string) [2]float64 {
+	for _, balance := range r.balances {
+		if balance.ProductCode == code && balance.WarehouseID == warehouseID && balance.LocationID == locationID && balance.LotNo == lotNo {
+			return [2]float64{balance.AvailableQty, balance.LockedQty}
+		}
+	}
+	return [2]float64{}
+}
+
+func TestERPProductBOMValidation(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPRecords(t, repo, ERPModuleProducts, map[string]any{"code": "RM-1", "hsCode": "7202999000", "specCode": "N35", "cnDesc": "钕铁硼毛坯", "enDesc": "NdFeB blank"})
+
+	_, err := uc.Create(ctx, ERPModuleProducts, map[string]any{
+		"code": "FG-1", "hsCode": "8505111000", "specCode": "D10", "cnDesc": "磁钢", "enDesc": "magnet",
+		"bom": []any{
+			map[string]any{"materialCode": "FG-1", "quantity": 1},
+			map[string]any{"materialCode": "RM-1", "quantity": 2},
+			map[string]any{"materialCode": "RM-1", "quantity": 3},
+			map[string]any{"materialCode": "RM-404", "quantity": 1},
+		},
+	}, 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	paths := map[string]bool{}
+	for _, field := range validationErr.Fields {
+		paths[field.Path] = true
+	}
+	for _, path := range []string{"bom[0].materialCode", "bom[2].materialCode", "bom[3].materialCode"} {
+		if !paths[path] {
+			t.Fatalf("missing error for %s: %v", path, validationErr.Fields)
+		}
+	}
+	if paths["bom[1].materialCode"] {
+		t.Fatalf("valid bom line should pass: %v", validationErr.Fields)
+	}
+}
+
+func TestERPUsecaseProductionOrders(t *testing.T) {
+	repo := &memERPProductionRepo{memERPRepo: newMemERPRepo()}
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPRecords(t, repo, ERPModuleProducts,
+		map[string]any{"code": "RM-1", "hsCode": "7202999000", "specCode": "N35", "cnDesc": "钕铁硼毛坯", "enDesc": "NdFeB blank", "unit": "kg"},
+		map[string]any{"code": "RM-2", "hsCode": "7202999000", "specCode": "NI", "cnDesc": "镀镍液", "enDesc": "nickel", "unit": "L"},
+		map[string]any{"code": "FG-1", "hsCode": "8505111000", "specCode": "D10", "cnDesc": "磁钢", "enDesc": "magnet", "bom": []any{
+			map[string]any{"materialCode": "RM-1", "quantity": 2, "lossRate": 10},
+			map[string]any{"materialCode": "RM-2", "quantity": 1},
+		}},
+	)
+	seedERPRecords(t, repo, ERPModuleExportSales,
+		map[string]any{"code": "XS-001", "customerName": "客户A", "customerContractNo": "HT-001", "deliveryDate": "2026-11-30", "orderFlow": ERPOrderFlowProduction,
+			"items": []any{
+				map[string]any{"lineNo": 1, "productCode": "FG-1", "productName": "磁钢", "quantity": 10},
+				map[string]any{"lineNo": 2, "productCode": "FG-1", "productName": "磁钢", "quantity": 5},
+			}},
+		map[string]any{"code": "XS-002", "orderFlow": "成品采购", "items": []any{map[string]any{"productCode": "FG-1", "quantity": 1}}},
+	)
+	repo.balances = []*ERPStockBalance{
+		{ID: 1, ProductCode: "RM-1", WarehouseID: 1, LocationID: 1, LotNo: "L1", AvailableQty: 15},
+		{ID: 2, ProductCode: "RM-1", WarehouseID: 1, LocationID: 2, AvailableQty: 20},
+		{ID: 3, ProductCode: "RM-2", WarehouseID: 1, LocationID: 1, AvailableQty: 4},
+	}
+
+	var validationErr *ERPValidationError
+	if _, err := uc.GenerateProductionOrder(ctx, "XS-002", 1, 0, "", 1); !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "orderFlow" {
+		t.Fatalf("purchase flow should not generate production orders, got %v", err)
+	}
+
+	// 需求：RM-1 = 10 × 2 × 1.1 = 22，先入先出锁定 15 + 7；RM-2 = 10，只有 4，缺料 6
+	order, err := uc.GenerateProductionOrder(ctx, "XS-001", 1, 0, "", 1)
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+	if order["code"] != "SC-XS-001-1" || order["status"] != ERPProductionStatusReleased || order["plannedDate"] != "2026-11-30" ||
+		order["productCode"] != "FG-1" || order["customerName"] != "客户A" {
+		t.Fatalf("unexpected order: %v", order)
+	}
+	assertERPNumbers(t, order, map[string]float64{"quantity": 10, "exportLineNo": 1, "completedQty": 0})
+	materials := order["materials"].([]any)
+	assertERPNumbers(t, materials[0].(map[string]any), map[string]float64{"requiredQty": 22, "reservedQty": 22, "shortageQty": 0})
+	assertERPNumbers(t, materials[1].(map[string]any), map[string]float64{"requiredQty": 10, "reservedQty": 4, "shortageQty": 6})
+	if materials[0].(map[string]any)["unit"] != "kg" || materials[0].(map[string]any)["materialName"] != "钕铁硼毛坯" {
+		t.Fatalf("material not filled from product: %v", materials[0])
+	}
+	if got := repo.stock("RM-1", 1, 1, "L1"); got != [2]float64{0, 15} {
+		t.Fatalf("RM-1 L1 = %v", got)
+	}
+	if got := repo.stock("RM-1", 1, 2, ""); got != [2]float64{13, 7} {
+		t.Fatalf("RM-1 loc 2 = %v", got)
+	}
+	wantLinks := []*ERPDocLink{{FromModule: ERPModuleExportSales, FromCode: "XS-001", ToModule: ERPModuleProductionOrders, ToCode: "SC-XS-001-1", RelationType: "derived"}}
+	if !reflect.DeepEqual(repo.links, wantLinks) {
+		t.Fatalf("unexpected links: %+v", repo.links)
+	}
+	if _, err := uc.GenerateProductionOrder(ctx, "XS-001", 1, 1, "", 1); !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "quantity" {
+		t.Fatalf("line fully released should fail, got %v", err)
+	}
+
+	// 通用接口不能新建生产单，修改只接受计划日期与备注
+	if _, err := uc.Create(ctx, ERPModuleProductionOrders, map[string]any{"code": "SC-X"}, 1); !errors.As(err, &validationErr) {
+		t.Fatalf("generic create should fail, got %v", err)
+	}
+	id := order["id"].(int)
+	edited, _ := cloneERPValue(order).(map[string]any)
+	edited["remark"], edited["quantity"], edited["materials"] = "加急", 100, []any{}
+	updated, err := uc.Update(ctx, ERPModuleProductionOrders, id, edited, 0, 1)
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	assertERPNumbers(t, updated, map[string]float64{"quantity": 10})
+	if updated["remark"] != "加急" || len(updated["materials"].([]any)) != 2 {
+		t.Fatalf("system fields should be kept: %v", updated)
+	}
+	if err := uc.Delete(ctx, ERPModuleProductionOrders, id); !errors.As(err, &validationErr) {
+		t.Fatalf("deleting an order holding stock should fail, got %v", err)
+	}
+
+	// 补充库存后补锁
+	repo.balances = append(repo.balances, &ERPStockBalance{ID: 4, ProductCode: "RM-2", WarehouseID: 2, LocationID: 3, AvailableQty: 10})
+	if _, err := uc.ReserveProductionMaterials(ctx, id, 1, 1); !errors.Is(err, ErrERPVersionConflict) {
+		t.Fatalf("stale version should conflict, got %v", err)
+	}
+	order, err = uc.ReserveProductionMaterials(ctx, id, 0, 1)
+	if err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	assertERPNumbers(t, order["materials"].([]any)[1].(map[string]any), map[string]float64{"reservedQty": 10, "shortageQty": 0})
+	if got := repo.stock("RM-2", 2, 3, ""); got != [2]float64{4, 6} {
+		t.Fatalf("RM-2 top-up = %v", got)
+	}
+
+	if _, err := uc.IssueProductionMaterials(ctx, id, 0, []ERPProductionIssue{{LineNo: 2, Quantity: 11}}, "", 1); !errors.As(err, &validationErr) ||
+		validationErr.Fields[0].Path != "materials[1].reservedQty" {
+		t.Fatalf("issuing more than reserved should fail, got %v", err)
+	}
+	order, err = uc.IssueProductionMaterials(ctx, id, 0, []ERPProductionIssue{{LineNo: 1, Quantity: 20}}, "2026-10-20", 1)
+	if err != nil {
+		t.Fatalf("issue failed: %v", err)
+	}
+	if order["status"] != ERPProductionStatusInProgress || len(order["issues"].([]any)) != 2 {
+		t.Fatalf("unexpected order after issue: %v", order)
+	}
+	assertERPNumbers(t, order["materials"].([]any)[0].(map[string]any), map[string]float64{"reservedQty": 2, "issuedQty": 20, "shortageQty": 0})
+	if got := repo.stock("RM-1", 1, 1, "L1"); got != [2]float64{0, 0} {
+		t.Fatalf("RM-1 L1 after issue = %v", got)
+	}
+
+	if _, err := uc.CompleteProduction(ctx, id, 0, ERPProductionCompletion{Quantity: 11, WarehouseID: 3, LocationID: 9}, 1); !errors.As(err, &validationErr) {
+		t.Fatalf("over-completion should fail, got %v", err)
+	}
+	order, err = uc.CompleteProduction(ctx, id, 0, ERPProductionCompletion{CompletedDate: "2026-11-01", Quantity: 4, WarehouseID: 3, LocationID: 9, LotNo: "FG-A"}, 1)
+	if err != nil {
+		t.Fatalf("complete failed: %v", err)
+	}
+	assertERPNumbers(t, order, map[string]float64{"completedQty": 4})
+	if order["status"] != ERPProductionStatusInProgress || repo.stock("FG-1", 3, 9, "FG-A") != [2]float64{4, 0} {
+		t.Fatalf("partial completion not posted: %v", order)
+	}
+	// 全部完工后解锁剩余锁定库存
+	order, err = uc.CompleteProduction(ctx, id, 0, ERPProductionCompletion{Quantity: 6, WarehouseID: 3, LocationID: 9, LotNo: "FG-A"}, 1)
+	if err != nil {
+		t.Fatalf("final completion failed: %v", err)
+	}
+	if order["status"] != ERPProductionStatusCompleted || repo.stock("FG-1", 3, 9, "FG-A") != [2]float64{10, 0} {
+		t.Fatalf("completion not posted: %v", order)
+	}
+	if got := repo.stock("RM-1", 1, 2, ""); got != [2]float64{15, 0} {
+		t.Fatalf("RM-1 remaining reservation not released: %v", got)
+	}
+	if got := repo.stock("RM-2", 2, 3, ""); got != [2]float64{10, 0} {
+		t.Fatalf("RM-2 reservation not released: %v", got)
+	}
+	if _, err := uc.CancelProduction(ctx, id, 0, 1); !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "status" {
+		t.Fatalf("cancelling a completed order should fail, got %v", err)
+	}
+
+	doc, err := uc.ProductionPrintData(ctx, id)
+	if err != nil {
+		t.Fatalf("print data failed: %v", err)
+	}
+	if doc.TemplateKey != ERPProductionTemplateKey || doc.Fields["customerContractNo"] != "HT-001" || doc.Fields["specCode"] != "D10" || len(doc.Materials) != 2 {
+		t.Fatalf("unexpected print data: %+v", doc)
+	}
+
+	// 取消释放锁定库存，未领料的生产单可删除
+	second, err := uc.GenerateProductionOrder(ctx, "XS-001", 2, 0, "2026-12-01", 1)
+	if err != nil {
+		t.Fatalf("generate line 2 failed: %v", err)
+	}
+	if second["code"] != "SC-XS-001-2" || repo.stock("RM-1", 1, 2, "") != [2]float64{4, 11} {
+		t.Fatalf("unexpected second order: %v stock %v", second, repo.stock("RM-1", 1, 2, ""))
+	}
+	cancelled, err := uc.CancelProduction(ctx, second["id"].(int), 0, 1)
+	if err != nil || cancelled["status"] != ERPProductionStatusCancelled || repo.stock("RM-1", 1, 2, "") != [2]float64{15, 0} {
+		t.Fatalf("cancel = %v, err %v", cancelled, err)
+	}
+	if err := uc.Delete(ctx, ERPModuleProductionOrders, second["id"].(int)); err != nil {
+		t.Fatalf("cancelled order without issues should be deletable: %v", err)
+	}
+	// 外销合同被生产单引用，不能删除
+	sales, _ := repo.ListByModule(ctx, ERPModuleExportSales)
+	for _, sale := range sales {
+		if sale.Code == "XS-001" {
+			if err := uc.Delete(ctx, ERPModuleExportSales, sale.ID); !errors.Is(err, ErrERPRecordInUse) {
+				t.Fatalf("export sale referenced by production order should not be deletable, got %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// erpDefaultSaleCurrency 外销合同及来源报价均未填写币种时的默认币种。
+const erpDefaultSaleCurrency = "USD"
+
+// ERPOrderProfit 单个外销合同的毛利测算。
+//
+// 收入、运杂费、银行扣费以销售币种计；采购成本、物流费用与预计退税以人民币计；
+// 毛利 = 收入 - 采购成本 - 运杂费 - 其他费用 - 银行扣费 - 物流费用 + 预计退税，分别折算为销售币种与人民币。
+// 物流费用为出运费用单分摊到关联出运明细的金额。
+type ERPOrderProfit struct {
+	ExportCode     string
+	CustomerName   string
+	SalesOwner     string
+	SignDate       time.Time
+	Currency       string
+	ExchangeRate   float64
+	RateFound      bool
+	Revenue        float64
+	RevenueCNY     float64
+	PurchaseCost   float64
+	FreightCost    float64
+	OtherCost      float64
+	BankFee        float64
+	LogisticsCost  float64
+	ExpectedRebate float64
+	GrossMargin    float64
+	GrossMarginCNY float64
+	MarginRate     float64
+	PurchaseCodes  []string
+	ShipmentCodes  []string
+	CostCodes      []string
+	// MissingCurrencies 缺少汇率的币种（销售币种或费用单币种），非空时不计算人民币毛利。
+	MissingCurrencies []string
+}
+
+type ERPOrderProfitFilter struct {
+	CustomerName string
+	SalesOwner   string
+	DateFrom     time.Time
+	DateTo       time.Time
+}
+
+type ERPOrderProfitReport struct {
+	Rows                []*ERPOrderProfit
+	TotalRevenueCNY     float64
+	TotalGrossMarginCNY float64
+	MissingCurrencies   []string
+}
+
+// OrderProfit 按外销合同计算毛利，期间按签约日期过滤（含首尾）。
+// ctx 绑定了外销合同记录范围时只返回范围内的合同；出运、费用等关联单据按全部记录计算，避免毛利因范围缺失而失真。
+func (uc *ERPUsecase) OrderProfit(ctx context.Context, filter ERPOrderProfitFilter) (*ERPOrderProfitReport, error) {
+	if !filter.DateFrom.IsZero() && !filter.DateTo.IsZero() && filter.DateTo.Before(filter.DateFrom) {
+		return nil, ErrBadParam
+	}
+
+	scope, _ := ERPScopeFromContext(ctx, ERPModuleExportSales)
+	ctx = NewContextWithERPScope(ctx, nil)
+	ds, err := uc.loadERPDataset(ctx,
+		ERPModuleProducts,
+		ERPModuleQuotations,
+		ERPModuleExportSales,
+		ERPModulePurchaseContracts,
+		ERPModuleShipmentDetails,
+		ERPModuleSettlements,
+		ERPModuleBankReceipts,
+		ERPModuleRebateRates,
+		ERPModuleRebateDeclarations,
+		ERPModuleExchangeRates,
+		ERPModuleShipmentCosts,
+		ERPModuleHSCodes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.loadDocLinks(ctx, ds, ERPModuleExportSales); err != nil {
+		return nil, err
+	}
+
+	customerName := strings.TrimSpace(filter.CustomerName)
+	salesOwner := strings.TrimSpace(filter.SalesOwner)
+	report := &ERPOrderProfitReport{
+		Rows:              []*ERPOrderProfit{},
+		MissingCurrencies: []string{},
+	}
+	missing := map[string]struct{}{}
+	for _, sale := range ds.list(ERPModuleExportSales) {
+		if sale == nil || sale.Code == "" || !scope.Allows(sale) {
+			continue
+		}
+		profit := buildERPOrderProfit(ds, sale)
+		if customerName != "" && profit.CustomerName != customerName {
+			continue
+		}
+		if salesOwner != "" && profit.SalesOwner != salesOwner {
+			continue
+		}
+		if !filter.DateFrom.IsZero() && profit.SignDate.Before(filter.DateFrom) {
+			continue
+		}
+		if !filter.DateTo.IsZero() && profit.SignDate.After(filter.DateTo) {
+			continue
+		}
+		report.Rows = append(report.Rows, profit)
+		if !profit.RateFound {
+			for _, currency := range profit.MissingCurrencies {
+				if _, seen := missing[currency]; !seen {
+					missing[currency] = struct{}{}
+					report.MissingCurrencies = append(report.MissingCurrencies, currency)
+				}
+			}
+			continue
+		}
+		report.TotalRevenueCNY += profit.RevenueCNY
+		report.TotalGrossMarginCNY += profit.GrossMarginCNY
+	}
+	report.TotalRevenueCNY = roundERPAmount(report.TotalRevenueCNY)
+	report.TotalGrossMarginCNY = roundERPAmount(report.TotalGrossMarginCNY)
+	return report, nil
+}
+
+func buildERPOrderProfit(ds *erpDataset, sale *ERPRecord) *ERPOrderProfit {
+	payload := sale.Payload
+	profit := &ERPOrderProfit{
+		ExportCode:        sale.Code,
+		CustomerName:      erpPayloadString(payload, "customerName"),
+		SalesOwner:        erpPayloadString(payload, "salesOwner"),
+		SignDate:          erpRecordDate(sale, "signDate"),
+		Currency:          erpSaleCurrency(ds, sale),
+		PurchaseCodes:     []string{},
+		ShipmentCodes:     []string{},
+		CostCodes:         []string{},
+		MissingCurrencies: []string{},
+	}
+
+	if total, ok := toERPFloat64(payload["totalAmount"]); ok {
+		profit.Revenue = total
+	} else {
+		items, _ := getERPItems(payload["items"])
+		profit.Revenue = calcERPItemsTotal(items)
+	}
+	profit.FreightCost = erpPayloadFloat(payload, "freightCost")
+	profit.OtherCost = erpPayloadFloat(payload, "otherCost")
+
+	for _, contract := range ds.linkedPurchaseContracts(sale.Code) {
+		profit.PurchaseCodes = append(profit.PurchaseCodes, contract.Code)
+		if total, ok := toERPFloat64(contract.Payload["totalAmount"]); ok {
+			profit.PurchaseCost += total
+			continue
+		}
+		items, _ := getERPItems(contract.Payload["items"])
+		profit.PurchaseCost += calcERPItemsTotal(items)
+	}
+
+	refs := map[string]struct{}{sale.Code: {}}
+	for _, key := range []string{"customerContractNo", "orderNo"} {
+		if value := erpPayloadString(payload, key); value != "" {
+			refs[value] = struct{}{}
+		}
+	}
+	for _, shipment := range ds.linkedShipments(sale.Code) {
+		profit.ShipmentCodes = append(profit.ShipmentCodes, shipment.Code)
+		refs[shipment.Code] = struct{}{}
+		if profit.SalesOwner == "" {
+			profit.SalesOwner = erpPayloadString(shipment.Payload, "salesOwner")
+		}
+		profit.ExpectedRebate += estimateERPShipmentRebate(ds, shipment).ExpectedRebate
+		logisticsCost, costCodes, missing := ds.shipmentCostsCNY(shipment.Code)
+		profit.LogisticsCost += logisticsCost
+		profit.CostCodes = appendERPUniqueStrings(profit.CostCodes, costCodes...)
+		profit.MissingCurrencies = appendERPUniqueStrings(profit.MissingCurrencies, missing...)
+	}
+	for _, settlement := range ds.list(ERPModuleSettlements) {
+		if settlement == nil || settlement.Code == "" {
+			continue
+		}
+		if _, ok := refs[erpPayloadString(settlement.Payload, "invoiceNo")]; ok {
+			refs[settlement.Code] = struct{}{}
+		}
+	}
+	for _, receipt := range ds.list(ERPModuleBankReceipts) {
+		if receipt == nil || receipt.Box != ERPBoxConfirmed {
+			continue
+		}
+		if _, ok := refs[erpPayloadString(receipt.Payload, "refNo")]; ok {
+			profit.BankFee += erpPayloadFloat(receipt.Payload, "bankFee")
+		}
+	}
+
+	profit.ExchangeRate, profit.RateFound = ds.exchangeRate(profit.Currency, profit.SignDate)
+	if !profit.RateFound {
+		profit.MissingCurrencies = appendERPUniqueStrings([]string{profit.Currency}, profit.MissingCurrencies...)
+	}
+	profit.RateFound = len(profit.MissingCurrencies) == 0
+	saleCosts := profit.FreightCost + profit.OtherCost + profit.BankFee
+	if profit.RateFound {
+		profit.RevenueCNY = profit.Revenue * profit.ExchangeRate
+		profit.GrossMarginCNY = profit.RevenueCNY - profit.PurchaseCost - saleCosts*profit.ExchangeRate -
+			profit.LogisticsCost + profit.ExpectedRebate
+		profit.GrossMargin = profit.GrossMarginCNY / profit.ExchangeRate
+		if profit.Revenue > 0 {
+			profit.MarginRate = roundERPAmount(profit.GrossMargin / profit.Revenue)
+		}
+	}
+
+	profit.Revenue = roundERPAmount(profit.Revenue)
+	profit.RevenueCNY = roundERPAmount(profit.RevenueCNY)
+	profit.PurchaseCost = roundERPAmount(profit.PurchaseCost)
+	profit.BankFee = roundERPAmount(profit.BankFee)
+	profit.LogisticsCost = roundERPAmount(profit.LogisticsCost)
+	profit.ExpectedRebate = roundERPAmount(profit.ExpectedRebate)
+	profit.GrossMargin = roundERPAmount(profit.GrossMargin)
+	profit.GrossMarginCNY = roundERPAmount(profit.GrossMarginCNY)
+	return profit
+}
+
+// erpSaleCurrency 外销合同币种：合同自身 currency 优先，其次取来源报价单币种。
+func erpSaleCurrency(ds *erpDataset, sale *ERPRecord) string {
+	if currency := erpPayloadString(sale.Payload, "currency"); currency != "" {
+		return strings.ToUpper(currency)
+	}
+	quotation := ds.findByCode(ERPModuleQuotations, erpPayloadString(sale.Payload, "sourceQuotationCode"))
+	if quotation != nil {
+		if currency := erpPayloadString(quotation.Payload, "currency"); currency != "" {
+			return strings.ToUpper(currency)
+		}
+	}
+	return erpDefaultSaleCurrency
+}
+
+// exchangeRate 返回 1 单位 currency 折合人民币的汇率，取 at 当天或之前最近生效的一条。
+func (ds *erpDataset) exchangeRate(currency string, at time.Time) (float64, bool) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "CNY" || currency == "RMB" {
+		return 1, true
+	}
+	var (
+		found    bool
+		rate     float64
+		bestDate time.Time
+	)
+	for _, item := range ds.list(ERPModuleExchangeRates) {
+		if item == nil || strings.ToUpper(erpPayloadString(item.Payload, "currency")) != currency {
+			continue
+		}
+		effective := erpPayloadDate(item.Payload, "effectiveDate")
+		if effective.After(at) {
+			continue
+		}
+		if !found || effective.After(bestDate) {
+			found = true
+			bestDate = effective
+			rate = erpPayloadFloat(item.Payload, "rateToCNY")
+		}
+	}
+	return rate, found
+}
+
+func deriveExchangeRate(payload map[string]any) error {
+	currency := strings.ToUpper(erpPayloadString(payload, "currency"))
+	if currency != "" {
+		payload["currency"] = currency
+	}
+	if currency == "CNY" || currency == "RMB" {
+		return fmt.Errorf("字段 currency 无需维护人民币汇率")
+	}
+	return nil
+}
+
+// appendERPUniqueStrings 追加 values 中尚未出现在 list 的值，保持原有顺序。
+func appendERPUniqueStrings(list []string, values ...string) []string {
+	for _, value := range values {
+		exists := false
+		for _, item := range list {
+			if item == value {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			list = append(list, value)
+		}
+	}
+	return list
+}
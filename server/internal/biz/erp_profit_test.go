@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type memERPRepoWithLinks struct {
+	*memERPRepo
+	links []*ERPDocLink
+}
+
+func (r *memERPRepoWithLinks) ListDocLinksByModule(ctx context.Context, moduleKey string) ([]*ERPDocLink, error) {
+	out := []*ERPDocLink{}
+	for _, link := range r.links {
+		if link.FromModule == moduleKey || link.ToModule == moduleKey {
+			copyLink := *link
+			out = append(out, &copyLink)
+		}
+	}
+	return out, nil
+}
+
+func TestERPUsecaseOrderProfit(t *testing.T) {
+	repo := &memERPRepoWithLinks{
+		memERPRepo: newMemERPRepo(),
+		links: []*ERPDocLink{
+			{FromModule: "exportSales", FromCode: "XS-001", ToModule: "purchaseContracts", ToCode: "CG-002", RelationType: "derived"},
+		},
+	}
+	logger := log.NewStdLogger(io.Discard)
+	uc := NewERPUsecase(repo, logger, tracesdk.NewTracerProvider())
+	ctx := context.Background()
+
+	mustCreate := func(moduleKey string, payload map[string]any) {
+		t.Helper()
+		if _, err := uc.Create(ctx, moduleKey, payload, 1); err != nil {
+			t.Fatalf("create %s failed: %v", moduleKey, err)
+		}
+	}
+
+	seedERPPartners(t, repo, "合作客户", "客户A")
+	seedERPPartners(t, repo, "合作供应商", "工厂A", "包装厂")
+	// CG-002 的 salesNo 指向其他订单，只通过链路表关联到 XS-001
+	seedERPRecords(t, repo, ERPModuleExportSales, map[string]any{"code": "XS-002", "customerName": "客户C", "signDate": "2026-03-01"})
+	mustCreate("exchangeRates", map[string]any{"currency": "usd", "rateToCNY": 7, "effectiveDate": "2026-01-01"})
+	mustCreate("hsCodes", map[string]any{"hsCode": "85051110", "cnName": "永磁体", "firstUnit": "千克"})
+	mustCreate("products", map[string]any{
+		"hsCode": "85051110", "specCode": "SPEC-001", "cnDesc": "钕铁硼磁钢", "enDesc": "NdFeB Magnet",
+	})
+	mustCreate("rebateRates", map[string]any{"hsCode": "85051110", "rebateRate": 0.13, "effectiveFrom": "2026-01-01"})
+	mustCreate("quotations", map[string]any{
+		"code": "QT-001", "customerName": "客户A", "quotedDate": "2026-01-02", "currency": "USD",
+		"items": []any{map[string]any{"productName": "磁钢A", "quantity": 40, "unitPrice": 25}},
+	})
+	mustCreate("exportSales", map[string]any{
+		"code":                "XS-001",
+		"customerName":        "客户A",
+		"customerContractNo":  "HT-001",
+		"signDate":            "2026-01-10",
+		"deliveryDate":        "2026-02-10",
+		"transportType":       "海运",
+		"orderFlow":           "成品采购",
+		"sourceQuotationCode": "QT-001",
+		"freightCost":         50,
+		"items":               []any{map[string]any{"productName": "磁钢A", "quantity": 40, "unitPrice": 25}},
+	})
+	mustCreate("purchaseContracts", map[string]any{
+		"code": "CG-001", "supplierName": "工厂A", "signDate": "2026-01-11", "salesNo": "XS-001",
+		"deliveryDate": "2026-01-25", "deliveryAddress": "杭州一号仓", "invoiceRequired": "是",
+		"sourceExportCode": "XS-001",
+		"items":            []any{map[string]any{"productName": "磁钢A", "specCode": "SPEC-001", "quantity": 40, "unitPrice": 113}},
+	})
+	mustCreate("purchaseContracts", map[string]any{
+		"code": "CG-002", "supplierName": "包装厂", "signDate": "2026-01-11", "salesNo": "XS-002",
+		"deliveryDate": "2026-01-25", "deliveryAddress": "杭州一号仓", "invoiceRequired": "否",
+		"items": []any{map[string]any{"productName": "纸箱", "quantity": 100, "unitPrice": 3}},
+	})
+	mustCreate("shipmentDetails", map[string]any{
+		"code": "CY-001", "customerName": "客户A", "startPort": "宁波", "destPort": "Hamburg",
+		"shipToAddress": "Germany Warehouse", "transportType": "海运", "arriveCountry": "Germany",
+		"salesOwner": "业务员A", "warehouseShipDate": "2026-02-10", "sourceExportCode": "XS-001",
+		"items": []any{map[string]any{"productModel": "磁钢A", "quantity": 40}},
+	})
+	mustCreate("bankReceipts", map[string]any{
+		"fundType": "客户货款尾款", "refNo": "CY-001", "receivedAmount": 980, "bankFee": 20,
+		"registerDate": "2026-03-01", "box": "确认箱",
+	})
+	mustCreate("bankReceipts", map[string]any{
+		"fundType": "客户货款尾款", "refNo": "CY-001", "receivedAmount": 10, "bankFee": 5,
+		"registerDate": "2026-03-02",
+	})
+
+	report, err := uc.OrderProfit(ctx, ERPOrderProfitFilter{
+		SalesOwner: "业务员A",
+		DateFrom:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:     time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("order profit failed: %v", err)
+	}
+	if len(report.Rows) != 1 {
+		t.Fatalf("expected 1 profit row, got %d", len(report.Rows))
+	}
+	row := report.Rows[0]
+	if row.Currency != "USD" || row.ExchangeRate != 7 {
+		t.Fatalf("currency should come from quotation with rate 7, got %s/%v", row.Currency, row.ExchangeRate)
+	}
+	// 采购成本包含链路表关联的 CG-002；只统计确认箱水单的银行扣费。
+	if row.PurchaseCost != 4820 || row.BankFee != 20 || row.ExpectedRebate != 520 {
+		t.Fatalf("unexpected cost components: %+v", row)
+	}
+	// 7000 - 4820 - (50 + 20) × 7 + 520 = 2210
+	if row.GrossMarginCNY != 2210 || row.GrossMargin != 315.7143 {
+		t.Fatalf("unexpected margin: %v CNY / %v USD", row.GrossMarginCNY, row.GrossMargin)
+	}
+
+	report, err = uc.OrderProfit(ctx, ERPOrderProfitFilter{CustomerName: "客户B"})
+	if err != nil {
+		t.Fatalf("order profit failed: %v", err)
+	}
+	if len(report.Rows) != 0 {
+		t.Fatalf("customer filter should exclude other customers, got %d", len(report.Rows))
+	}
+
+	// 记录范围只过滤外销合同，关联单据仍按全部记录计算
+	report, err = uc.OrderProfit(NewContextWithERPScope(ctx, &ERPRecordScope{AdminIDs: []int{9}, OwnerNames: []string{"业务员A"}}), ERPOrderProfitFilter{})
+	if err != nil {
+		t.Fatalf("order profit failed: %v", err)
+	}
+	if len(report.Rows) != 0 {
+		t.Fatalf("out-of-scope sales should be hidden, got %d", len(report.Rows))
+	}
+	report, err = uc.OrderProfit(NewContextWithERPScope(ctx, &ERPRecordScope{AdminIDs: []int{1}}), ERPOrderProfitFilter{})
+	if err != nil {
+		t.Fatalf("order profit failed: %v", err)
+	}
+	var scoped *ERPOrderProfit
+	for _, item := range report.Rows {
+		if item.ExportCode == "XS-001" {
+			scoped = item
+		}
+	}
+	if scoped == nil || scoped.GrossMarginCNY != 2210 {
+		t.Fatalf("scoped report should keep full margin, got %+v", scoped)
+	}
+}
@@ -0,0 +1,185 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+)
+
+// erpDataset 缓存一次计算内读取的各模块记录，供台账/报表类跨单据计算复用。
+type erpDataset struct {
+	records map[string][]*ERPRecord
+	links   []*ERPDocLink
+
+	shipmentCosts map[string][]*ERPShipmentItemCost
+}
+
+func (uc *ERPUsecase) loadERPDataset(ctx context.Context, moduleKeys ...string) (*erpDataset, error) {
+	ds := &erpDataset{records: make(map[string][]*ERPRecord, len(moduleKeys))}
+	for _, moduleKey := range moduleKeys {
+		if _, ok := ds.records[moduleKey]; ok {
+			continue
+		}
+		rows, err := uc.repo.ListByModule(ctx, moduleKey)
+		if err != nil {
+			return nil, err
+		}
+		ds.records[moduleKey] = rows
+	}
+	return ds, nil
+}
+
+func (ds *erpDataset) list(moduleKey string) []*ERPRecord {
+	if ds == nil {
+		return nil
+	}
+	return ds.records[moduleKey]
+}
+
+func (ds *erpDataset) findByCode(moduleKey, code string) *ERPRecord {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil
+	}
+	for _, item := range ds.list(moduleKey) {
+		if item != nil && item.Code == code {
+			return item
+		}
+	}
+	return nil
+}
+
+// findPartner 按名称查找往来单位，名称比较忽略首尾空白。
+func (ds *erpDataset) findPartner(name string) *ERPRecord {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil
+	}
+	for _, item := range ds.list(ERPModulePartners) {
+		if item != nil && strings.TrimSpace(erpPayloadString(item.Payload, "name")) == name {
+			return item
+		}
+	}
+	return nil
+}
+
+func erpPayloadString(payload map[string]any, key string) string {
+	if payload == nil {
+		return ""
+	}
+	value, _ := payload[key].(string)
+	return strings.TrimSpace(value)
+}
+
+func erpPayloadFloat(payload map[string]any, key string) float64 {
+	if payload == nil {
+		return 0
+	}
+	value, _ := toERPFloat64(payload[key])
+	return value
+}
+
+// erpPayloadDate 依次尝试 keys 中的日期字段，均无效时返回零值。
+func erpPayloadDate(payload map[string]any, keys ...string) time.Time {
+	for _, key := range keys {
+		raw := erpPayloadString(payload, key)
+		if raw == "" {
+			continue
+		}
+		if parsed, err := parseERPDate(raw); err == nil {
+			return parsed
+		}
+	}
+	return time.Time{}
+}
+
+// erpRecordDate 优先取业务日期字段，缺省时回退到记录创建日期。
+func erpRecordDate(record *ERPRecord, keys ...string) time.Time {
+	if record == nil {
+		return time.Time{}
+	}
+	if parsed := erpPayloadDate(record.Payload, keys...); !parsed.IsZero() {
+		return parsed
+	}
+	created := record.CreatedAt
+	return time.Date(created.Year(), created.Month(), created.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func roundERPAmount(value float64) float64 {
+	return math.Round(value*10000) / 10000
+}
+
+// linkedPurchaseContracts 返回关联到外销合同的采购合同：
+// sourceExportCode 或 salesNo 指向该外销合同，或在单据链路中与其相连。
+func (ds *erpDataset) linkedPurchaseContracts(exportCode string) []*ERPRecord {
+	return ds.linkedRecords(ERPModuleExportSales, exportCode, ERPModulePurchaseContracts, "sourceExportCode", "salesNo")
+}
+
+// linkedShipments 返回由外销合同生成的出运明细。
+func (ds *erpDataset) linkedShipments(exportCode string) []*ERPRecord {
+	return ds.linkedRecords(ERPModuleExportSales, exportCode, ERPModuleShipmentDetails, "sourceExportCode")
+}
+
+func (ds *erpDataset) linkedRecords(moduleKey, code, otherModule string, sourceFields ...string) []*ERPRecord {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil
+	}
+	viaLinks := map[string]struct{}{}
+	for _, linked := range ds.linkedCodes(moduleKey, code, otherModule) {
+		viaLinks[linked] = struct{}{}
+	}
+	out := []*ERPRecord{}
+	for _, item := range ds.list(otherModule) {
+		if item == nil {
+			continue
+		}
+		if _, ok := viaLinks[item.Code]; ok && item.Code != "" {
+			out = append(out, item)
+			continue
+		}
+		for _, field := range sourceFields {
+			if erpPayloadString(item.Payload, field) == code {
+				out = append(out, item)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// productHSCode 解析采购/出运明细对应的 HS 编码：明细自带 hsCode 优先，
+// 其次按 specCode 匹配产品资料，最后按产品名称匹配产品的规格/中英文品名。
+func (ds *erpDataset) productHSCode(item map[string]any) string {
+	if hsCode := erpPayloadString(item, "hsCode"); hsCode != "" {
+		return hsCode
+	}
+	specCode := erpPayloadString(item, "specCode")
+	productName := erpPayloadString(item, "productName")
+	if productName == "" {
+		productName = erpPayloadString(item, "productModel")
+	}
+	for _, product := range ds.list(ERPModuleProducts) {
+		if product == nil {
+			continue
+		}
+		if specCode != "" && erpPayloadString(product.Payload, "specCode") == specCode {
+			return erpPayloadString(product.Payload, "hsCode")
+		}
+	}
+	if productName == "" {
+		return ""
+	}
+	for _, product := range ds.list(ERPModuleProducts) {
+		if product == nil {
+			continue
+		}
+		for _, key := range []string{"specCode", "cnDesc", "enDesc"} {
+			if erpPayloadString(product.Payload, key) == productName {
+				return erpPayloadString(product.Payload, "hsCode")
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,398 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 报价单跟进状态（quoteStatus）。
+const (
+	ERPQuoteStatusOpen    = "跟进中"
+	ERPQuoteStatusWon     = "已成交"
+	ERPQuoteStatusLost    = "未成交"
+	ERPQuoteStatusExpired = "已过期"
+	ERPQuoteStatusRevised = "已修订"
+)
+
+// deriveQuotation 计算合计金额；填写有效期天数时按报价日期推算失效日期 validUntil；
+// 状态缺省为跟进中，非未成交状态清除丢单原因。
+func deriveQuotation(payload map[string]any) error {
+	if err := deriveTotalAmount(payload); err != nil {
+		return err
+	}
+	if days, ok := toERPFloat64(payload["validPeriod"]); ok && days > 0 {
+		if quoted := erpPayloadDate(payload, "quotedDate"); !quoted.IsZero() {
+			payload["validUntil"] = quoted.AddDate(0, 0, int(days)).Format("2006-01-02")
+		}
+	}
+	if erpPayloadString(payload, "quoteStatus") == "" {
+		payload["quoteStatus"] = ERPQuoteStatusOpen
+	}
+	if erpPayloadString(payload, "quoteStatus") != ERPQuoteStatusLost {
+		delete(payload, "lostReason")
+		delete(payload, "lostRemark")
+	}
+	return nil
+}
+
+// checkERPQuotation 校验报价单：失效日期不早于报价日期，未成交须填写丢单原因，修订单须注明修订自哪张报价单。
+func checkERPQuotation(payload map[string]any) []ERPFieldError {
+	var errs []ERPFieldError
+	quoted, validUntil := erpPayloadDate(payload, "quotedDate"), erpPayloadDate(payload, "validUntil")
+	if !quoted.IsZero() && !validUntil.IsZero() && validUntil.Before(quoted) {
+		errs = append(errs, ERPFieldError{Path: "validUntil", Message: "字段 validUntil 早于报价日期 quotedDate"})
+	}
+	if erpPayloadString(payload, "quoteStatus") == ERPQuoteStatusLost && erpPayloadString(payload, "lostReason") == "" {
+		errs = append(errs, ERPFieldError{Path: "lostReason", Message: "字段 lostReason 为必填项：未成交报价须填写丢单原因"})
+	}
+	if _, ok := toERPFloat64(payload["revisionNo"]); ok && erpPayloadString(payload, "revisionOf") == "" {
+		errs = append(errs, ERPFieldError{Path: "revisionOf", Message: "字段 revisionOf 为必填项：修订单须注明原报价单号"})
+	}
+	return errs
+}
+
+// erpQuotationRoot 返回报价单所属修订链的原报价单号。
+func erpQuotationRoot(record *ERPRecord) string {
+	if root := erpPayloadString(record.Payload, "revisionOf"); root != "" {
+		return root
+	}
+	return record.Code
+}
+
+// ERPQuotationReviseRepo 由支持事务的仓储实现，须在同一事务内新建修订单（记 create 审计）并按 Version 保存原报价单，
+// 原报价单已被修改时整体回滚；未实现时先保存原报价单再新建修订单（不保证原子性，仅用于测试替身）。
+type ERPQuotationReviseRepo interface {
+	ReviseRecord(ctx context.Context, revision, previous *ERPRecord, operatorID int) (created, updated *ERPRecord, err error)
+}
+
+// Revise 修订报价单：复制为新单号 <原单号>-R<n>，报价日期取当天、状态为跟进中、回到默认状态箱，
+// 原报价单标记为已修订并以 supersededBy 指向新单，历史单据保留不变；新单与原单在同一事务内保存。
+// 只有跟进中或已过期的报价单可以修订；expectedVersion 为原报价单读取时的版本，0 表示不校验。
+func (uc *ERPUsecase) Revise(ctx context.Context, moduleKey string, id, expectedVersion, operatorAdminID int) (revision, previous map[string]any, err error) {
+	moduleKey, err = NormalizeERPModuleKey(moduleKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if moduleKey != ERPModuleQuotations || id <= 0 {
+		return nil, nil, ErrBadParam
+	}
+	stored, err := uc.findRecordByID(ctx, moduleKey, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if expectedVersion > 0 && stored.Version != expectedVersion {
+		return nil, nil, ErrERPVersionConflict
+	}
+	if stored.Code == "" {
+		return nil, nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "code", Message: "未编号的报价单不能修订"}}}
+	}
+	status := erpPayloadString(stored.Payload, "quoteStatus")
+	if status != "" && status != ERPQuoteStatusOpen && status != ERPQuoteStatusExpired {
+		return nil, nil, &ERPValidationError{Fields: []ERPFieldError{{Path: "quoteStatus", Message: fmt.Sprintf("报价单状态为%s，不能修订", status)}}}
+	}
+
+	quotations, err := uc.recordLookup(ctx)(ERPModuleQuotations)
+	if err != nil {
+		return nil, nil, err
+	}
+	root := erpQuotationRoot(stored)
+	next := 1
+	for _, row := range quotations {
+		if row == nil || erpPayloadString(row.Payload, "revisionOf") != root {
+			continue
+		}
+		if no, ok := toERPFloat64(row.Payload["revisionNo"]); ok && int(no) >= next {
+			next = int(no) + 1
+		}
+	}
+
+	payload, _ := cloneERPValue(stored.Payload).(map[string]any)
+	for _, key := range []string{"box", "lostReason", "lostRemark", "supersededBy", "lowMargin"} {
+		delete(payload, key)
+	}
+	// 有效期按新报价日期重算；没有有效期天数时失效日期需重新填写
+	delete(payload, "validUntil")
+	payload["code"] = fmt.Sprintf("%s-R%d", root, next)
+	payload["revisionOf"] = root
+	payload["revisionNo"] = next
+	payload["quotedDate"] = time.Now().Format("2006-01-02")
+	payload["quoteStatus"] = ERPQuoteStatusOpen
+	payload, err = uc.prepareCreate(ctx, moduleKey, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	before, _ := cloneERPValue(stored.Payload).(map[string]any)
+	before["quoteStatus"] = ERPQuoteStatusRevised
+	before["supersededBy"] = payload["code"]
+	previousRecord := &ERPRecord{ID: stored.ID, ModuleKey: moduleKey, Code: stored.Code, Payload: before, Version: stored.Version}
+
+	var created, updated *ERPRecord
+	if reviseRepo, ok := uc.repo.(ERPQuotationReviseRepo); ok {
+		created, updated, err = reviseRepo.ReviseRecord(ctx, &ERPRecord{ModuleKey: moduleKey, Payload: payload}, previousRecord, operatorAdminID)
+	} else if updated, err = uc.repo.Update(ctx, moduleKey, id, before, stored.Version, operatorAdminID); err == nil {
+		created, err = uc.repo.Create(ctx, moduleKey, payload, operatorAdminID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	masked := erpAmountMasked(ctx, moduleKey)
+	return toERPRecordView(created, masked), toERPRecordView(updated, masked), nil
+}
+
+// ERPQuotationStatusChanges 一次状态刷新中变更的报价单号。
+type ERPQuotationStatusChanges struct {
+	Won     []string
+	Expired []string
+}
+
+// RefreshQuotationStatuses 由定时任务调用：已被外销合同引用（sourceQuotationCode）的跟进中/已过期报价单改为已成交，
+// 失效日期早于 today 的跟进中报价单改为已过期。记录在读取后被修改时跳过，留待下次处理。
+func (uc *ERPUsecase) RefreshQuotationStatuses(ctx context.Context, today time.Time) (*ERPQuotationStatusChanges, error) {
+	lookup := uc.recordLookup(ctx)
+	sales, err := lookup(ERPModuleExportSales)
+	if err != nil {
+		return nil, err
+	}
+	accepted := erpAcceptedQuotations(sales)
+	quotations, err := lookup(ERPModuleQuotations)
+	if err != nil {
+		return nil, err
+	}
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+
+	changes := &ERPQuotationStatusChanges{}
+	for _, row := range quotations {
+		if row == nil {
+			continue
+		}
+		status := erpPayloadString(row.Payload, "quoteStatus")
+		if status != "" && status != ERPQuoteStatusOpen && status != ERPQuoteStatusExpired {
+			continue
+		}
+		next := ""
+		if _, ok := accepted[row.Code]; ok && row.Code != "" {
+			next = ERPQuoteStatusWon
+		} else if validUntil := erpPayloadDate(row.Payload, "validUntil"); status != ERPQuoteStatusExpired && !validUntil.IsZero() && validUntil.Before(today) {
+			next = ERPQuoteStatusExpired
+		}
+		if next == "" {
+			continue
+		}
+		payload, _ := cloneERPValue(row.Payload).(map[string]any)
+		payload["quoteStatus"] = next
+		if _, err := uc.repo.Update(ctx, ERPModuleQuotations, row.ID, payload, row.Version, 0); err != nil {
+			if errors.Is(err, ErrERPVersionConflict) || errors.Is(err, ErrERPRecordNotFound) {
+				continue
+			}
+			return changes, err
+		}
+		code := erpReferenceCode(row.Code, row.ID)
+		if next == ERPQuoteStatusWon {
+			changes.Won = append(changes.Won, code)
+		} else {
+			changes.Expired = append(changes.Expired, code)
+		}
+	}
+	return changes, nil
+}
+
+// erpAcceptedQuotations 返回被外销合同引用的报价单号及最早的签约日期（无签约日期时为零值）。
+func erpAcceptedQuotations(sales []*ERPRecord) map[string]time.Time {
+	out := map[string]time.Time{}
+	for _, sale := range sales {
+		if sale == nil {
+			continue
+		}
+		code := erpPayloadString(sale.Payload, "sourceQuotationCode")
+		if code == "" {
+			continue
+		}
+		signDate := erpPayloadDate(sale.Payload, "signDate")
+		if first, ok := out[code]; !ok || (!signDate.IsZero() && (first.IsZero() || signDate.Before(first))) {
+			out[code] = signDate
+		}
+	}
+	return out
+}
+
+// ERPQuotationConversion 报价转化统计。修订链（原报价单及其全部修订单）计为一次报价：
+// 任一单据被外销合同引用或标记为已成交即为成交，否则按最新修订单的状态计为未成交、已过期或跟进中；
+// 成交率 = 成交数 / 报价数 × 100；成交天数为原报价日期到最早引用它的外销合同签约日期的天数，缺少日期的不计入平均值。
+type ERPQuotationConversion struct {
+	Key           string
+	QuoteCount    int
+	RevisionCount int
+	WonCount      int
+	LostCount     int
+	ExpiredCount  int
+	OpenCount     int
+	WinRate       float64
+	AvgDaysToWin  float64
+
+	daysTotal float64
+	daysCount int
+}
+
+type ERPQuotationConversionFilter struct {
+	CustomerName string
+	SalesOwner   string
+	DateFrom     time.Time
+	DateTo       time.Time
+}
+
+// ERPQuotationLostReason 丢单原因及次数。
+type ERPQuotationLostReason struct {
+	Reason string
+	Count  int
+}
+
+type ERPQuotationConversionReport struct {
+	ByCustomer   []*ERPQuotationConversion
+	BySalesOwner []*ERPQuotationConversion
+	Total        *ERPQuotationConversion
+	LostReasons  []*ERPQuotationLostReason
+}
+
+// erpQuotationChain 一条修订链：Latest 为修订号最大的单据，客户、业务员以它为准。
+type erpQuotationChain struct {
+	Members []*ERPRecord
+	Latest  *ERPRecord
+	Date    time.Time
+}
+
+// QuotationConversion 按客户与业务员统计报价转化，期间按原报价日期过滤（含首尾）。
+func (uc *ERPUsecase) QuotationConversion(ctx context.Context, filter ERPQuotationConversionFilter) (*ERPQuotationConversionReport, error) {
+	if !filter.DateFrom.IsZero() && !filter.DateTo.IsZero() && filter.DateTo.Before(filter.DateFrom) {
+		return nil, ErrBadParam
+	}
+	ds, err := uc.loadERPDataset(ctx, ERPModuleQuotations, ERPModuleExportSales)
+	if err != nil {
+		return nil, err
+	}
+	accepted := erpAcceptedQuotations(ds.list(ERPModuleExportSales))
+
+	chains := map[string]*erpQuotationChain{}
+	var roots []string
+	for _, row := range ds.list(ERPModuleQuotations) {
+		if row == nil {
+			continue
+		}
+		root := erpReferenceCode(erpQuotationRoot(row), row.ID)
+		chain, ok := chains[root]
+		if !ok {
+			chain = &erpQuotationChain{}
+			chains[root] = chain
+			roots = append(roots, root)
+		}
+		chain.Members = append(chain.Members, row)
+		if date := erpRecordDate(row, "quotedDate"); chain.Date.IsZero() || date.Before(chain.Date) {
+			chain.Date = date
+		}
+		if chain.Latest == nil || erpPayloadFloat(row.Payload, "revisionNo") > erpPayloadFloat(chain.Latest.Payload, "revisionNo") {
+			chain.Latest = row
+		}
+	}
+	sort.Strings(roots)
+
+	byCustomer := map[string]*ERPQuotationConversion{}
+	bySales := map[string]*ERPQuotationConversion{}
+	report := &ERPQuotationConversionReport{Total: &ERPQuotationConversion{}}
+	lost := map[string]int{}
+	for _, root := range roots {
+		chain := chains[root]
+		customer := erpPayloadString(chain.Latest.Payload, "customerName")
+		owner := erpPayloadString(chain.Latest.Payload, "salesOwner")
+		if (filter.CustomerName != "" && customer != filter.CustomerName) ||
+			(filter.SalesOwner != "" && owner != filter.SalesOwner) ||
+			(!filter.DateFrom.IsZero() && chain.Date.Before(filter.DateFrom)) ||
+			(!filter.DateTo.IsZero() && chain.Date.After(filter.DateTo)) {
+			continue
+		}
+
+		won, wonDate := false, time.Time{}
+		for _, member := range chain.Members {
+			if signDate, ok := accepted[member.Code]; ok && member.Code != "" {
+				won = true
+				if !signDate.IsZero() && (wonDate.IsZero() || signDate.Before(wonDate)) {
+					wonDate = signDate
+				}
+			} else if erpPayloadString(member.Payload, "quoteStatus") == ERPQuoteStatusWon {
+				won = true
+			}
+		}
+		status := erpPayloadString(chain.Latest.Payload, "quoteStatus")
+		if !won && status == ERPQuoteStatusLost {
+			lost[erpPayloadString(chain.Latest.Payload, "lostReason")]++
+		}
+
+		for _, conv := range []*ERPQuotationConversion{
+			report.Total, erpConversionRow(byCustomer, &report.ByCustomer, customer), erpConversionRow(bySales, &report.BySalesOwner, owner),
+		} {
+			conv.QuoteCount++
+			conv.RevisionCount += len(chain.Members) - 1
+			switch {
+			case won:
+				conv.WonCount++
+				if !wonDate.IsZero() && !chain.Date.IsZero() && !wonDate.Before(chain.Date) {
+					conv.daysTotal += wonDate.Sub(chain.Date).Hours() / 24
+					conv.daysCount++
+				}
+			case status == ERPQuoteStatusLost:
+				conv.LostCount++
+			case status == ERPQuoteStatusExpired:
+				conv.ExpiredCount++
+			default:
+				conv.OpenCount++
+			}
+		}
+	}
+
+	for _, conv := range append(append([]*ERPQuotationConversion{report.Total}, report.ByCustomer...), report.BySalesOwner...) {
+		if conv.QuoteCount > 0 {
+			conv.WinRate = math.Round(float64(conv.WonCount)/float64(conv.QuoteCount)*10000) / 100
+		}
+		if conv.daysCount > 0 {
+			conv.AvgDaysToWin = math.Round(conv.daysTotal/float64(conv.daysCount)*100) / 100
+		}
+	}
+	sortERPConversions(report.ByCustomer)
+	sortERPConversions(report.BySalesOwner)
+	for reason, count := range lost {
+		report.LostReasons = append(report.LostReasons, &ERPQuotationLostReason{Reason: reason, Count: count})
+	}
+	sort.Slice(report.LostReasons, func(i, j int) bool {
+		a, b := report.LostReasons[i], report.LostReasons[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Reason < b.Reason
+	})
+	return report, nil
+}
+
+func erpConversionRow(rows map[string]*ERPQuotationConversion, list *[]*ERPQuotationConversion, key string) *ERPQuotationConversion {
+	key = strings.TrimSpace(key)
+	if row, ok := rows[key]; ok {
+		return row
+	}
+	row := &ERPQuotationConversion{Key: key}
+	rows[key] = row
+	*list = append(*list, row)
+	return row
+}
+
+// sortERPConversions 按报价数降序，相同时按名称排序。
+func sortERPConversions(rows []*ERPQuotationConversion) {
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].QuoteCount != rows[j].QuoteCount {
+			return rows[i].QuoteCount > rows[j].QuoteCount
+		}
+		return rows[i].Key < rows[j].Key
+	})
+}
@@ -0,0 +1,155 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecaseQuotationRevisions(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作客户", "客户A")
+
+	quotation := map[string]any{
+		"code": "QT-001", "customerName": "客户A", "quotedDate": "2026-03-01", "currency": "USD", "validPeriod": 30,
+		"salesOwner": "张三", "items": []any{map[string]any{"productName": "磁钢A", "quantity": 10, "unitPrice": 5}},
+	}
+	created, err := uc.Create(ctx, ERPModuleQuotations, quotation, 1)
+	if err != nil {
+		t.Fatalf("create quotation failed: %v", err)
+	}
+	if created["validUntil"] != "2026-03-31" || created["quoteStatus"] != ERPQuoteStatusOpen {
+		t.Fatalf("validUntil/quoteStatus not derived: %v", created)
+	}
+	rootID := created["id"].(int)
+
+	// 未成交须填写丢单原因；失效日期不能早于报价日期
+	created["quoteStatus"], created["validPeriod"], created["validUntil"] = ERPQuoteStatusLost, 0, "2026-02-01"
+	_, err = uc.Update(ctx, ERPModuleQuotations, rootID, created, 0, 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	want := []ERPFieldError{
+		{Path: "lostReason", Message: "字段 lostReason 为必填项：未成交报价须填写丢单原因"},
+		{Path: "validUntil", Message: "字段 validUntil 早于报价日期 quotedDate"},
+	}
+	if !reflect.DeepEqual(validationErr.Fields, want) {
+		t.Fatalf("fields = %v, want %v", validationErr.Fields, want)
+	}
+
+	revision, previous, err := uc.Revise(ctx, ERPModuleQuotations, rootID, 1, 1)
+	if err != nil {
+		t.Fatalf("revise failed: %v", err)
+	}
+	today := time.Now().Format("2006-01-02")
+	assertERPNumbers(t, revision, map[string]float64{"revisionNo": 1})
+	if revision["code"] != "QT-001-R1" || revision["revisionOf"] != "QT-001" ||
+		revision["quotedDate"] != today || revision["validUntil"] != time.Now().AddDate(0, 0, 30).Format("2006-01-02") ||
+		revision["box"] != ERPBoxDraft || revision["salesOwner"] != "张三" {
+		t.Fatalf("unexpected revision: %v", revision)
+	}
+	if previous["quoteStatus"] != ERPQuoteStatusRevised || previous["supersededBy"] != "QT-001-R1" || previous["quotedDate"] != "2026-03-01" {
+		t.Fatalf("previous quotation not superseded: %v", previous)
+	}
+	if _, _, err := uc.Revise(ctx, ERPModuleQuotations, rootID, 0, 1); !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "quoteStatus" {
+		t.Fatalf("revising a superseded quotation should fail, got %v", err)
+	}
+
+	// 再次修订沿用原单号编号
+	revision, _, err = uc.Revise(ctx, ERPModuleQuotations, revision["id"].(int), 0, 1)
+	if err != nil || revision["code"] != "QT-001-R2" || revision["revisionOf"] != "QT-001" {
+		t.Fatalf("second revision = %v, err %v", revision, err)
+	}
+	assertERPNumbers(t, revision, map[string]float64{"revisionNo": 2})
+	if _, _, err := uc.Revise(ctx, ERPModuleQuotations, revision["id"].(int), 99, 1); !errors.Is(err, ErrERPVersionConflict) {
+		t.Fatalf("stale version should conflict, got %v", err)
+	}
+	// 原报价单仍被修订单引用，不能删除
+	if err := uc.Delete(ctx, ERPModuleQuotations, rootID); !errors.Is(err, ErrERPRecordInUse) {
+		t.Fatalf("deleting a revised quotation should fail, got %v", err)
+	}
+	rows, _ := repo.ListByModule(ctx, ERPModuleQuotations)
+	if len(rows) != 3 {
+		t.Fatalf("revision history should be kept, got %d quotations", len(rows))
+	}
+}
+
+func TestERPUsecaseQuotationStatusAndConversion(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPRecords(t, repo, ERPModuleQuotations,
+		map[string]any{"code": "QT-001", "customerName": "客户A", "salesOwner": "张三", "quotedDate": "2026-03-01", "quoteStatus": ERPQuoteStatusRevised},
+		map[string]any{"code": "QT-001-R1", "customerName": "客户A", "salesOwner": "张三", "quotedDate": "2026-03-05", "validUntil": "2026-03-20",
+			"revisionOf": "QT-001", "revisionNo": 1, "quoteStatus": ERPQuoteStatusOpen},
+		map[string]any{"code": "QT-002", "customerName": "客户A", "salesOwner": "李四", "quotedDate": "2026-03-02", "validUntil": "2026-03-10"},
+		map[string]any{"code": "QT-003", "customerName": "客户B", "salesOwner": "张三", "quotedDate": "2026-03-03", "validUntil": "2026-04-30",
+			"quoteStatus": ERPQuoteStatusLost, "lostReason": "价格"},
+		map[string]any{"code": "QT-004", "customerName": "客户B", "salesOwner": "张三", "quotedDate": "2026-03-04", "quoteStatus": ERPQuoteStatusOpen},
+		map[string]any{"code": "QT-005", "customerName": "客户C", "salesOwner": "李四", "quotedDate": "2026-03-10", "quoteStatus": ERPQuoteStatusWon},
+	)
+	seedERPRecords(t, repo, ERPModuleExportSales,
+		map[string]any{"code": "XS-001", "sourceQuotationCode": "QT-001-R1", "signDate": "2026-03-21"},
+		map[string]any{"code": "XS-002", "sourceQuotationCode": "QT-001-R1", "signDate": "2026-03-31"},
+	)
+
+	changes, err := uc.RefreshQuotationStatuses(ctx, time.Date(2026, 3, 25, 15, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("refresh failed: %v", err)
+	}
+	if !reflect.DeepEqual(changes.Won, []string{"QT-001-R1"}) || !reflect.DeepEqual(changes.Expired, []string{"QT-002"}) {
+		t.Fatalf("unexpected changes: %+v", changes)
+	}
+	rows, _ := repo.ListByModule(ctx, ERPModuleQuotations)
+	status := map[string]any{}
+	for _, row := range rows {
+		status[row.Code] = row.Payload["quoteStatus"]
+	}
+	if status["QT-001"] != ERPQuoteStatusRevised || status["QT-001-R1"] != ERPQuoteStatusWon || status["QT-002"] != ERPQuoteStatusExpired ||
+		status["QT-003"] != ERPQuoteStatusLost || status["QT-004"] != ERPQuoteStatusOpen {
+		t.Fatalf("unexpected statuses: %v", status)
+	}
+	if changes, _ = uc.RefreshQuotationStatuses(ctx, time.Date(2026, 3, 26, 0, 0, 0, 0, time.UTC)); len(changes.Won)+len(changes.Expired) != 0 {
+		t.Fatalf("second refresh should be a no-op: %+v", changes)
+	}
+
+	report, err := uc.QuotationConversion(ctx, ERPQuotationConversionFilter{})
+	if err != nil {
+		t.Fatalf("conversion failed: %v", err)
+	}
+	// QT-001 与修订单计为一次报价，20 天成交；QT-005 手工标记成交，没有外销合同不计天数
+	if *report.Total != (ERPQuotationConversion{QuoteCount: 5, RevisionCount: 1, WonCount: 2, LostCount: 1, ExpiredCount: 1, OpenCount: 1,
+		WinRate: 40, AvgDaysToWin: 20, daysTotal: 20, daysCount: 1}) {
+		t.Fatalf("unexpected total: %+v", report.Total)
+	}
+	if len(report.BySalesOwner) != 2 || report.BySalesOwner[0].Key != "张三" || report.BySalesOwner[0].QuoteCount != 3 ||
+		report.BySalesOwner[0].WinRate != 33.33 || report.BySalesOwner[1].Key != "李四" || report.BySalesOwner[1].WinRate != 50 {
+		t.Fatalf("unexpected sales owner rows: %+v %+v", report.BySalesOwner[0], report.BySalesOwner[1])
+	}
+	if len(report.ByCustomer) != 3 || report.ByCustomer[0].Key != "客户A" || report.ByCustomer[0].WonCount != 1 ||
+		report.ByCustomer[0].ExpiredCount != 1 || report.ByCustomer[0].AvgDaysToWin != 20 {
+		t.Fatalf("unexpected customer rows: %+v", report.ByCustomer[0])
+	}
+	if len(report.LostReasons) != 1 || *report.LostReasons[0] != (ERPQuotationLostReason{Reason: "价格", Count: 1}) {
+		t.Fatalf("unexpected lost reasons: %+v", report.LostReasons)
+	}
+
+	report, _ = uc.QuotationConversion(ctx, ERPQuotationConversionFilter{SalesOwner: "张三", DateFrom: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)})
+	if report.Total.QuoteCount != 2 || report.Total.WonCount != 0 {
+		t.Fatalf("filter by owner and original quoted date failed: %+v", report.Total)
+	}
+	if _, err := uc.QuotationConversion(ctx, ERPQuotationConversionFilter{
+		DateFrom: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), DateTo: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("inverted period should fail, got %v", err)
+	}
+}
@@ -0,0 +1,353 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	ERPRebateStatusPending  = "待申报"
+	ERPRebateStatusDeclared = "已申报"
+	ERPRebateStatusApproved = "已审核"
+	ERPRebateStatusRefunded = "已退税"
+	ERPRebateStatusRejected = "不予退税"
+)
+
+var erpRebateStatuses = map[string]struct{}{
+	ERPRebateStatusPending:  {},
+	ERPRebateStatusDeclared: {},
+	ERPRebateStatusApproved: {},
+	ERPRebateStatusRefunded: {},
+	ERPRebateStatusRejected: {},
+}
+
+// ERPRebateLine 单个采购明细对应的退税测算。
+type ERPRebateLine struct {
+	PurchaseCode   string
+	ProductName    string
+	HSCode         string
+	Quantity       float64
+	UnitPrice      float64
+	PurchaseCost   float64
+	RebateRate     float64
+	RateFound      bool
+	ExpectedRebate float64
+}
+
+// ERPRebateEstimate 出运明细的预计退税与申报跟踪。
+//
+// 预计退税 = 含税采购成本 / (1 + 增值税率) × 退税率，只统计 invoiceRequired=是 的采购合同。
+type ERPRebateEstimate struct {
+	ShipmentCode      string
+	ExportCode        string
+	CustomerName      string
+	ShipDate          time.Time
+	PurchaseCost      float64
+	ExpectedRebate    float64
+	MissingHSCodes    []string
+	DeclarationStatus string
+	CustomsEntryNos   []string
+	ReceivedAmount    float64
+	Lines             []*ERPRebateLine
+}
+
+// ERPRebateMonthRow 按月汇总的预计与实收退税：预计/已申报按出运月份，实收按到账月份。
+type ERPRebateMonthRow struct {
+	Month          string
+	ShipmentCount  int
+	ExpectedRebate float64
+	DeclaredRebate float64
+	ReceivedRebate float64
+}
+
+var erpRebateModules = []string{
+	ERPModuleProducts,
+	ERPModulePurchaseContracts,
+	ERPModuleShipmentDetails,
+	ERPModuleRebateRates,
+	ERPModuleRebateDeclarations,
+	ERPModuleHSCodes,
+}
+
+// RebateEstimates 测算出运明细的预计退税；shipmentCode 为空时返回全部出运明细。
+func (uc *ERPUsecase) RebateEstimates(ctx context.Context, shipmentCode string) ([]*ERPRebateEstimate, error) {
+	ds, err := uc.loadERPDataset(ctx, erpRebateModules...)
+	if err != nil {
+		return nil, err
+	}
+
+	shipmentCode = strings.TrimSpace(shipmentCode)
+	out := []*ERPRebateEstimate{}
+	for _, shipment := range ds.list(ERPModuleShipmentDetails) {
+		if shipment == nil || (shipmentCode != "" && shipment.Code != shipmentCode) {
+			continue
+		}
+		out = append(out, estimateERPShipmentRebate(ds, shipment))
+	}
+	if shipmentCode != "" && len(out) == 0 {
+		return nil, ErrERPRecordNotFound
+	}
+	return out, nil
+}
+
+// RebateReport 按月汇总预计退税与实收退税，monthFrom/monthTo 格式为 YYYY-MM，可为空。
+func (uc *ERPUsecase) RebateReport(ctx context.Context, monthFrom, monthTo string) ([]*ERPRebateMonthRow, error) {
+	monthFrom = strings.TrimSpace(monthFrom)
+	monthTo = strings.TrimSpace(monthTo)
+	for _, month := range []string{monthFrom, monthTo} {
+		if month == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01", month); err != nil {
+			return nil, ErrBadParam
+		}
+	}
+
+	ds, err := uc.loadERPDataset(ctx, erpRebateModules...)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := map[string]*ERPRebateMonthRow{}
+	rowOf := func(month string) *ERPRebateMonthRow {
+		row, ok := rows[month]
+		if !ok {
+			row = &ERPRebateMonthRow{Month: month}
+			rows[month] = row
+		}
+		return row
+	}
+
+	for _, shipment := range ds.list(ERPModuleShipmentDetails) {
+		if shipment == nil {
+			continue
+		}
+		estimate := estimateERPShipmentRebate(ds, shipment)
+		row := rowOf(estimate.ShipDate.Format("2006-01"))
+		row.ShipmentCount++
+		row.ExpectedRebate += estimate.ExpectedRebate
+		switch estimate.DeclarationStatus {
+		case ERPRebateStatusDeclared, ERPRebateStatusApproved, ERPRebateStatusRefunded:
+			row.DeclaredRebate += estimate.ExpectedRebate
+		}
+	}
+	for _, declaration := range ds.list(ERPModuleRebateDeclarations) {
+		if declaration == nil {
+			continue
+		}
+		amount := erpPayloadFloat(declaration.Payload, "receivedAmount")
+		if amount <= 0 {
+			continue
+		}
+		row := rowOf(erpRecordDate(declaration, "receivedDate").Format("2006-01"))
+		row.ReceivedRebate += amount
+	}
+
+	out := make([]*ERPRebateMonthRow, 0, len(rows))
+	for month, row := range rows {
+		if monthFrom != "" && month < monthFrom {
+			continue
+		}
+		if monthTo != "" && month > monthTo {
+			continue
+		}
+		row.ExpectedRebate = roundERPAmount(row.ExpectedRebate)
+		row.DeclaredRebate = roundERPAmount(row.DeclaredRebate)
+		row.ReceivedRebate = roundERPAmount(row.ReceivedRebate)
+		out = append(out, row)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Month < out[j].Month })
+	return out, nil
+}
+
+// estimateERPShipmentRebate 按出运明细匹配采购明细计算采购成本：
+// 出运明细 productModel 与采购明细 productName/specCode 一致时按出运数量计；
+// 一条都匹配不上时，按本次出运数量占该外销合同全部出运数量的比例分摊采购合同。
+func estimateERPShipmentRebate(ds *erpDataset, shipment *ERPRecord) *ERPRebateEstimate {
+	payload := shipment.Payload
+	estimate := &ERPRebateEstimate{
+		ShipmentCode:    shipment.Code,
+		ExportCode:      erpPayloadString(payload, "sourceExportCode"),
+		CustomerName:    erpPayloadString(payload, "customerName"),
+		ShipDate:        erpRecordDate(shipment, "warehouseShipDate"),
+		MissingHSCodes:  []string{},
+		CustomsEntryNos: []string{},
+		Lines:           []*ERPRebateLine{},
+	}
+
+	shipItems, _ := getERPItems(payload["items"])
+	shippedQty := map[string]float64{}
+	for _, item := range shipItems {
+		model := erpPayloadString(item, "productModel")
+		if model == "" {
+			model = erpPayloadString(item, "productName")
+		}
+		qty, _ := toERPFloat64(item["quantity"])
+		shippedQty[model] += qty
+	}
+
+	type candidate struct {
+		contract *ERPRecord
+		item     map[string]any
+	}
+	candidates := []candidate{}
+	matched := false
+	for _, contract := range ds.linkedPurchaseContracts(estimate.ExportCode) {
+		if erpPayloadString(contract.Payload, "invoiceRequired") != "是" {
+			continue
+		}
+		items, _ := getERPItems(contract.Payload["items"])
+		for _, item := range items {
+			candidates = append(candidates, candidate{contract: contract, item: item})
+			if shippedQty[erpPayloadString(item, "productName")] > 0 || shippedQty[erpPayloadString(item, "specCode")] > 0 {
+				matched = true
+			}
+		}
+	}
+
+	ratio := 1.0
+	if !matched {
+		ratio = erpShipmentShareOfExport(ds, shipment, calcERPItemsQty(shipItems))
+	}
+
+	missing := map[string]struct{}{}
+	for _, c := range candidates {
+		unitPrice, _ := toERPFloat64(c.item["unitPrice"])
+		quantity, _ := toERPFloat64(c.item["quantity"])
+		if matched {
+			quantity = shippedQty[erpPayloadString(c.item, "productName")]
+			if quantity <= 0 {
+				quantity = shippedQty[erpPayloadString(c.item, "specCode")]
+			}
+			if quantity <= 0 {
+				continue
+			}
+		} else {
+			quantity *= ratio
+		}
+
+		line := &ERPRebateLine{
+			PurchaseCode: c.contract.Code,
+			ProductName:  erpPayloadString(c.item, "productName"),
+			HSCode:       ds.productHSCode(c.item),
+			Quantity:     roundERPAmount(quantity),
+			UnitPrice:    unitPrice,
+			PurchaseCost: roundERPAmount(quantity * unitPrice),
+		}
+		line.RebateRate, line.RateFound = ds.rebateRate(line.HSCode, estimate.ShipDate)
+		if line.RateFound {
+			line.ExpectedRebate = roundERPAmount(line.PurchaseCost / (1 + erpDefaultVATRate) * line.RebateRate)
+		} else if _, seen := missing[line.HSCode]; !seen {
+			missing[line.HSCode] = struct{}{}
+			estimate.MissingHSCodes = append(estimate.MissingHSCodes, line.HSCode)
+		}
+		estimate.PurchaseCost += line.PurchaseCost
+		estimate.ExpectedRebate += line.ExpectedRebate
+		estimate.Lines = append(estimate.Lines, line)
+	}
+	estimate.PurchaseCost = roundERPAmount(estimate.PurchaseCost)
+	estimate.ExpectedRebate = roundERPAmount(estimate.ExpectedRebate)
+
+	var latest *ERPRecord
+	for _, declaration := range ds.list(ERPModuleRebateDeclarations) {
+		if declaration == nil || erpPayloadString(declaration.Payload, "shipmentCode") != shipment.Code {
+			continue
+		}
+		if entryNo := erpPayloadString(declaration.Payload, "customsEntryNo"); entryNo != "" {
+			estimate.CustomsEntryNos = append(estimate.CustomsEntryNos, entryNo)
+		}
+		estimate.ReceivedAmount += erpPayloadFloat(declaration.Payload, "receivedAmount")
+		if latest == nil || declaration.UpdatedAt.After(latest.UpdatedAt) {
+			latest = declaration
+		}
+	}
+	estimate.ReceivedAmount = roundERPAmount(estimate.ReceivedAmount)
+	estimate.DeclarationStatus = ERPRebateStatusPending
+	if latest != nil {
+		if status := erpPayloadString(latest.Payload, "declarationStatus"); status != "" {
+			estimate.DeclarationStatus = status
+		}
+	}
+	return estimate
+}
+
+// erpShipmentShareOfExport 计算出运数量占同一外销合同全部出运数量的比例。
+func erpShipmentShareOfExport(ds *erpDataset, shipment *ERPRecord, shipmentQty float64) float64 {
+	total := 0.0
+	for _, item := range ds.linkedShipments(erpPayloadString(shipment.Payload, "sourceExportCode")) {
+		items, _ := getERPItems(item.Payload["items"])
+		total += calcERPItemsQty(items)
+	}
+	if total <= 0 || shipmentQty <= 0 {
+		return 1
+	}
+	return shipmentQty / total
+}
+
+// rebateRate 查找 HS 编码在指定日期生效的退税率，多条生效时取生效日期最近的一条；
+// 退税率表中没有生效记录时取海关编码表的退税率。
+func (ds *erpDataset) rebateRate(hsCode string, at time.Time) (float64, bool) {
+	if hsCode == "" {
+		return 0, false
+	}
+	var (
+		found    bool
+		rate     float64
+		bestFrom time.Time
+	)
+	for _, item := range ds.list(ERPModuleRebateRates) {
+		if item == nil || erpPayloadString(item.Payload, "hsCode") != hsCode {
+			continue
+		}
+		from := erpPayloadDate(item.Payload, "effectiveFrom")
+		if from.After(at) {
+			continue
+		}
+		if to := erpPayloadDate(item.Payload, "effectiveTo"); !to.IsZero() && to.Before(at) {
+			continue
+		}
+		if !found || from.After(bestFrom) {
+			found = true
+			bestFrom = from
+			rate = erpPayloadFloat(item.Payload, "rebateRate")
+		}
+	}
+	if !found {
+		return ds.hsCodeRebateRate(hsCode)
+	}
+	return rate, found
+}
+
+func deriveRebateRate(payload map[string]any) error {
+	if rate, ok := toERPFloat64(payload["rebateRate"]); ok && rate > 1 {
+		return fmt.Errorf("字段 rebateRate 需填写小数比例（如 0.13）")
+	}
+	from := erpPayloadDate(payload, "effectiveFrom")
+	to := erpPayloadDate(payload, "effectiveTo")
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return fmt.Errorf("字段 effectiveTo 不能早于 effectiveFrom")
+	}
+	return nil
+}
+
+func deriveRebateDeclaration(payload map[string]any) error {
+	status := erpPayloadString(payload, "declarationStatus")
+	if status == "" {
+		status = ERPRebateStatusPending
+	}
+	if _, ok := erpRebateStatuses[status]; !ok {
+		return fmt.Errorf("字段 declarationStatus 非法")
+	}
+	payload["declarationStatus"] = status
+	if raw, exists := payload["receivedAmount"]; exists && !isEmptyERPValue(raw) {
+		amount, ok := toERPFloat64(raw)
+		if !ok || amount < 0 {
+			return fmt.Errorf("字段 receivedAmount 超出范围")
+		}
+		payload["receivedAmount"] = normalizeERPNumber(amount)
+	}
+	return nil
+}
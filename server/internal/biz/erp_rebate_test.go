@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecaseRebateEstimateAndReport(t *testing.T) {
+	repo := newMemERPRepo()
+	logger := log.NewStdLogger(io.Discard)
+	uc := NewERPUsecase(repo, logger, tracesdk.NewTracerProvider())
+	ctx := context.Background()
+
+	mustCreate := func(moduleKey string, payload map[string]any) {
+		t.Helper()
+		if _, err := uc.Create(ctx, moduleKey, payload, 1); err != nil {
+			t.Fatalf("create %s failed: %v", moduleKey, err)
+		}
+	}
+
+	seedERPPartners(t, repo, "合作客户", "客户A")
+	seedERPPartners(t, repo, "合作供应商", "工厂A", "工厂B")
+	seedERPRecords(t, repo, ERPModuleExportSales, map[string]any{"code": "XS-001", "customerName": "客户A"})
+	mustCreate("hsCodes", map[string]any{"hsCode": "85051110", "cnName": "永磁体", "firstUnit": "千克"})
+	mustCreate("products", map[string]any{
+		"hsCode": "85051110", "specCode": "SPEC-001", "cnDesc": "钕铁硼磁钢", "enDesc": "NdFeB Magnet",
+	})
+	mustCreate("rebateRates", map[string]any{
+		"hsCode": "85051110", "rebateRate": 0.09, "effectiveFrom": "2025-01-01", "effectiveTo": "2025-12-31",
+	})
+	mustCreate("rebateRates", map[string]any{
+		"hsCode": "85051110", "rebateRate": 0.13, "effectiveFrom": "2026-01-01",
+	})
+	mustCreate("purchaseContracts", map[string]any{
+		"code":             "CG-001",
+		"supplierName":     "工厂A",
+		"signDate":         "2026-01-05",
+		"salesNo":          "XS-001",
+		"deliveryDate":     "2026-01-20",
+		"deliveryAddress":  "杭州一号仓",
+		"invoiceRequired":  "是",
+		"sourceExportCode": "XS-001",
+		"items": []any{
+			map[string]any{"productName": "磁钢A", "specCode": "SPEC-001", "quantity": 100, "unitPrice": 113},
+		},
+	})
+	mustCreate("purchaseContracts", map[string]any{
+		"code":             "CG-002",
+		"supplierName":     "工厂B",
+		"signDate":         "2026-01-05",
+		"salesNo":          "XS-001",
+		"deliveryDate":     "2026-01-20",
+		"deliveryAddress":  "杭州一号仓",
+		"invoiceRequired":  "否",
+		"sourceExportCode": "XS-001",
+		"items": []any{
+			map[string]any{"productName": "辅材", "quantity": 10, "unitPrice": 50},
+		},
+	})
+	mustCreate("shipmentDetails", map[string]any{
+		"code":              "CY-001",
+		"customerName":      "客户A",
+		"startPort":         "宁波",
+		"destPort":          "Hamburg",
+		"shipToAddress":     "Germany Warehouse",
+		"transportType":     "海运",
+		"arriveCountry":     "Germany",
+		"salesOwner":        "业务员A",
+		"warehouseShipDate": "2026-02-10",
+		"sourceExportCode":  "XS-001",
+		"items": []any{
+			map[string]any{"productModel": "磁钢A", "quantity": 60},
+		},
+	})
+	mustCreate("rebateDeclarations", map[string]any{
+		"customsEntryNo":    "310420260000001",
+		"shipmentCode":      "CY-001",
+		"declarationStatus": "已退税",
+		"receivedAmount":    700,
+		"receivedDate":      "2026-04-15",
+	})
+
+	estimates, err := uc.RebateEstimates(ctx, "CY-001")
+	if err != nil {
+		t.Fatalf("rebate estimates failed: %v", err)
+	}
+	if len(estimates) != 1 {
+		t.Fatalf("expected 1 estimate, got %d", len(estimates))
+	}
+	estimate := estimates[0]
+	// 60 × 113 = 6780 含税成本，不含税 6000，退税率 13% → 780。
+	if estimate.PurchaseCost != 6780 || estimate.ExpectedRebate != 780 {
+		t.Fatalf("unexpected estimate cost/rebate: %v/%v", estimate.PurchaseCost, estimate.ExpectedRebate)
+	}
+	if estimate.DeclarationStatus != ERPRebateStatusRefunded || estimate.ReceivedAmount != 700 {
+		t.Fatalf("unexpected declaration tracking: %+v", estimate)
+	}
+
+	rows, err := uc.RebateReport(ctx, "2026-01", "2026-12")
+	if err != nil {
+		t.Fatalf("rebate report failed: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected ship month and received month rows, got %d", len(rows))
+	}
+	if rows[0].Month != "2026-02" || rows[0].ExpectedRebate != 780 || rows[0].DeclaredRebate != 780 {
+		t.Fatalf("unexpected ship month row: %+v", rows[0])
+	}
+	if rows[1].Month != "2026-04" || rows[1].ReceivedRebate != 700 {
+		t.Fatalf("unexpected received month row: %+v", rows[1])
+	}
+
+	if _, err := uc.RebateReport(ctx, "2026/01", ""); err != ErrBadParam {
+		t.Fatalf("invalid month should be ErrBadParam, got %v", err)
+	}
+}
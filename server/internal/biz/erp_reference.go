@@ -0,0 +1,263 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrERPRecordInUse 记录仍被其他单据引用，不能删除或修改被引用的字段。
+var ErrERPRecordInUse = errors.New("erp record is referenced")
+
+// erpReferenceRule 字段引用其他模块的记录。Field 中的 "[]" 表示数组元素，如 lines[].shipmentCode、shipmentCodes[]；
+// 字段为空时不校验（是否必填由 Schema 决定），值须匹配任一 Targets。
+type erpReferenceRule struct {
+	Field   string
+	Targets []erpReferenceTarget
+}
+
+// erpReferenceTarget 被引用的模块：Key 为 "code" 时匹配单号，否则匹配 payload 字段；Accept 非空时记录还须满足条件。
+type erpReferenceTarget struct {
+	Module string
+	Key    string
+	Label  string
+	Accept func(payload map[string]any) bool
+}
+
+var (
+	erpRefCustomer = erpReferenceTarget{Module: ERPModulePartners, Key: "name", Label: "客户", Accept: isERPCustomer}
+	erpRefSupplier = erpReferenceTarget{Module: ERPModulePartners, Key: "name", Label: "供应商", Accept: isERPSupplier}
+	erpRefProduct  = erpReferenceTarget{Module: ERPModuleProducts, Key: "code", Label: "产品"}
+	erpRefQuote    = erpReferenceTarget{Module: ERPModuleQuotations, Key: "code", Label: "报价单"}
+	erpRefExport   = erpReferenceTarget{Module: ERPModuleExportSales, Key: "code", Label: "外销合同"}
+	erpRefPurchase = erpReferenceTarget{Module: ERPModulePurchaseContracts, Key: "code", Label: "采购合同"}
+	erpRefShipment = erpReferenceTarget{Module: ERPModuleShipmentDetails, Key: "code", Label: "出运明细"}
+	erpRefSettle   = erpReferenceTarget{Module: ERPModuleSettlements, Key: "code", Label: "结汇单"}
+	erpRefCost     = erpReferenceTarget{Module: ERPModuleShipmentCosts, Key: "code", Label: "出运费用单"}
+	erpRefHSCode   = erpReferenceTarget{Module: ERPModuleHSCodes, Key: "hsCode", Label: "海关编码"}
+)
+
+func isERPSupplier(payload map[string]any) bool {
+	return erpPayloadString(payload, "partnerType") == "合作供应商"
+}
+
+func isERPCustomer(payload map[string]any) bool {
+	return !isERPSupplier(payload)
+}
+
+// ERPRecordReference 引用某条记录的单据，Field 为引用字段（如 lines[].shipmentCode）。
+type ERPRecordReference struct {
+	ModuleKey string
+	ID        int
+	Code      string
+	Field     string
+}
+
+// ERPRecordInUseError 记录被引用，References 为全部引用方；errors.Is(err, ErrERPRecordInUse) 成立。
+type ERPRecordInUseError struct {
+	References []ERPRecordReference
+}
+
+func (e *ERPRecordInUseError) Error() string {
+	refs := make([]string, 0, len(e.References))
+	for _, ref := range e.References {
+		refs = append(refs, ref.ModuleKey+" "+erpReferenceCode(ref.Code, ref.ID))
+	}
+	return ErrERPRecordInUse.Error() + ": " + strings.Join(refs, ", ")
+}
+
+func (e *ERPRecordInUseError) Unwrap() error {
+	return ErrERPRecordInUse
+}
+
+// erpRecordLookup 按模块读取记录，供引用校验使用。
+type erpRecordLookup func(moduleKey string) ([]*ERPRecord, error)
+
+// recordLookup 返回不受记录范围限制的读取函数（引用关系按全部记录判断），同一次调用内按模块缓存。
+func (uc *ERPUsecase) recordLookup(ctx context.Context) erpRecordLookup {
+	ctx = NewContextWithERPScope(ctx, nil)
+	cache := map[string][]*ERPRecord{}
+	return func(moduleKey string) ([]*ERPRecord, error) {
+		if rows, ok := cache[moduleKey]; ok {
+			return rows, nil
+		}
+		rows, err := uc.repo.ListByModule(ctx, moduleKey)
+		if err != nil {
+			return nil, err
+		}
+		cache[moduleKey] = rows
+		return rows, nil
+	}
+}
+
+// validateERPReferences 校验 payload 中的引用字段，返回全部找不到引用目标的字段。
+func validateERPReferences(rules []erpReferenceRule, payload map[string]any, lookup erpRecordLookup) ([]ERPFieldError, error) {
+	var fields []ERPFieldError
+	for _, rule := range rules {
+		for _, value := range erpReferenceValues(payload, rule.Field) {
+			found := false
+			labels := make([]string, 0, len(rule.Targets))
+			for _, target := range rule.Targets {
+				labels = append(labels, target.Label)
+				rows, err := lookup(target.Module)
+				if err != nil {
+					return nil, err
+				}
+				if findERPReferenceTarget(rows, target, value.Value, 0) != nil {
+					found = true
+					break
+				}
+			}
+			if !found {
+				fields = append(fields, ERPFieldError{
+					Path:    value.Path,
+					Message: fmt.Sprintf("字段 %s 引用的%s %s 不存在", value.Path, strings.Join(labels, "或"), value.Value),
+				})
+			}
+		}
+	}
+	return fields, nil
+}
+
+// checkERPRecordInUse 检查 before 被引用的键（单号、往来单位名称）在 after 中是否仍然有效；after 为 nil 表示删除。
+// 失效的键仍被其他单据引用、且没有其他记录提供相同的键时返回 ERPRecordInUseError。
+func checkERPRecordInUse(moduleKey string, before *ERPRecord, after map[string]any, lookup erpRecordLookup) error {
+	var refs []ERPRecordReference
+	seen := map[string]struct{}{}
+	for _, referrer := range sortedERPModuleKeys() {
+		for _, rule := range erpModuleRules[referrer].References {
+			for _, target := range rule.Targets {
+				if target.Module != moduleKey {
+					continue
+				}
+				key := erpReferenceKey(before.Code, before.Payload, target)
+				if key == "" || (after != nil && erpReferenceKey(getPayloadCode(after), after, target) == key) {
+					continue
+				}
+				targets, err := lookup(moduleKey)
+				if err != nil {
+					return err
+				}
+				if findERPReferenceTarget(targets, target, key, before.ID) != nil {
+					continue
+				}
+				rows, err := lookup(referrer)
+				if err != nil {
+					return err
+				}
+				for _, row := range rows {
+					if row == nil || (referrer == moduleKey && row.ID == before.ID) {
+						continue
+					}
+					for _, value := range erpReferenceValues(row.Payload, rule.Field) {
+						if value.Value != key {
+							continue
+						}
+						id := fmt.Sprintf("%s#%d#%s", referrer, row.ID, rule.Field)
+						if _, ok := seen[id]; !ok {
+							seen[id] = struct{}{}
+							refs = append(refs, ERPRecordReference{ModuleKey: referrer, ID: row.ID, Code: row.Code, Field: rule.Field})
+						}
+						break
+					}
+				}
+			}
+		}
+	}
+	if len(refs) > 0 {
+		return &ERPRecordInUseError{References: refs}
+	}
+	return nil
+}
+
+type erpReferenceValue struct {
+	Path  string
+	Value string
+}
+
+// erpReferenceValues 读取引用字段的全部非空值，Path 为带下标的字段路径。
+func erpReferenceValues(payload map[string]any, field string) []erpReferenceValue {
+	list, rest, isList := strings.Cut(field, "[]")
+	if !isList {
+		if value := erpPayloadString(payload, field); value != "" {
+			return []erpReferenceValue{{Path: field, Value: value}}
+		}
+		return nil
+	}
+
+	var rows []any
+	switch raw := payload[list].(type) {
+	case []any:
+		rows = raw
+	case []map[string]any:
+		for _, row := range raw {
+			rows = append(rows, row)
+		}
+	}
+	rest = strings.TrimPrefix(rest, ".")
+	var out []erpReferenceValue
+	for index, row := range rows {
+		path := fmt.Sprintf("%s[%d]", list, index)
+		var value string
+		if rest == "" {
+			value, _ = row.(string)
+			value = strings.TrimSpace(value)
+		} else {
+			item, _ := row.(map[string]any)
+			value = erpPayloadString(item, rest)
+			path += "." + rest
+		}
+		if value != "" {
+			out = append(out, erpReferenceValue{Path: path, Value: value})
+		}
+	}
+	return out
+}
+
+// findERPReferenceTarget 在 rows 中查找键为 key 且满足 target 条件的记录，跳过 excludeID 与已停用的记录。
+func findERPReferenceTarget(rows []*ERPRecord, target erpReferenceTarget, key string, excludeID int) *ERPRecord {
+	for _, row := range rows {
+		if row == nil || (excludeID > 0 && row.ID == excludeID) || isERPRecordDisabled(row.Payload) {
+			continue
+		}
+		if erpReferenceKey(row.Code, row.Payload, target) == key {
+			return row
+		}
+	}
+	return nil
+}
+
+// erpReferenceKey 返回记录作为 target 被引用时的键；不满足 Accept 时返回空。
+func erpReferenceKey(code string, payload map[string]any, target erpReferenceTarget) string {
+	if target.Accept != nil && !target.Accept(payload) {
+		return ""
+	}
+	if target.Key == "code" {
+		if code = strings.TrimSpace(code); code != "" {
+			return code
+		}
+	}
+	return erpPayloadString(payload, target.Key)
+}
+
+func getPayloadCode(payload map[string]any) string {
+	return erpPayloadString(payload, "code")
+}
+
+func erpReferenceCode(code string, id int) string {
+	if code != "" {
+		return code
+	}
+	return fmt.Sprintf("#%d", id)
+}
+
+func sortedERPModuleKeys() []string {
+	keys := make([]string, 0, len(erpModuleRules))
+	for key := range erpModuleRules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// seedERPRecords 直接写入仓储（不经校验），用于准备被引用的往来单位、外销合同等。
+func seedERPRecords(t *testing.T, repo ERPRepo, moduleKey string, payloads ...map[string]any) {
+	t.Helper()
+	for _, payload := range payloads {
+		if _, err := repo.Create(context.Background(), moduleKey, payload, 1); err != nil {
+			t.Fatalf("seed %s failed: %v", moduleKey, err)
+		}
+	}
+}
+
+// seedERPPartners 写入指定类型的往来单位。
+func seedERPPartners(t *testing.T, repo ERPRepo, partnerType string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		seedERPRecords(t, repo, ERPModulePartners, map[string]any{
+			"partnerType": partnerType, "name": name, "address": "宁波", "contact": "张三",
+			"contactPhone": "13800000000", "paymentCycleDays": 30, "box": ERPBoxAuto,
+		})
+	}
+}
+
+func TestERPUsecaseReferenceValidation(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作客户", "客户A")
+	seedERPPartners(t, repo, "合作供应商", "工厂A")
+
+	_, err := uc.Create(ctx, ERPModuleExportSales, map[string]any{
+		"code": "XS-001", "customerName": "工厂A", "customerContractNo": "HT-001", "signDate": "2026-01-10",
+		"deliveryDate": "2026-02-10", "transportType": "海运", "orderFlow": "成品采购", "currency": "USD",
+		"sourceQuotationCode": "QT-404",
+		"items":               []any{map[string]any{"productName": "磁钢A", "productCode": "PD-404", "quantity": 10, "unitPrice": 5}},
+	}, 1)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	want := []ERPFieldError{
+		{Path: "customerName", Message: "字段 customerName 引用的客户 工厂A 不存在"},
+		{Path: "items[0].productCode", Message: "字段 items[0].productCode 引用的产品 PD-404 不存在"},
+		{Path: "sourceQuotationCode", Message: "字段 sourceQuotationCode 引用的报价单 QT-404 不存在"},
+	}
+	if !reflect.DeepEqual(validationErr.Fields, want) {
+		t.Fatalf("fields = %v, want %v", validationErr.Fields, want)
+	}
+
+	// 付款单可引用采购合同或出运费用单
+	seedERPRecords(t, repo, ERPModuleShipmentCosts, map[string]any{"code": "FY-001"})
+	if _, err := uc.Create(ctx, ERPModuleSupplierPayments, map[string]any{
+		"supplierName": "工厂A", "purchaseCode": "FY-001", "paymentAmount": 100, "paymentDate": "2026-02-01",
+	}, 1); err != nil {
+		t.Fatalf("payment referencing shipment cost failed: %v", err)
+	}
+	_, err = uc.Create(ctx, ERPModuleSupplierPayments, map[string]any{
+		"supplierName": "工厂A", "purchaseCode": "CG-404", "paymentAmount": 100, "paymentDate": "2026-02-01",
+	}, 1)
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Message != "字段 purchaseCode 引用的采购合同或出运费用单 CG-404 不存在" {
+		t.Fatalf("missing purchase code should fail, got %v", err)
+	}
+}
+
+func TestERPUsecaseReferencedRecordProtected(t *testing.T) {
+	repo := newMemERPRepo()
+	uc := NewERPUsecase(repo, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作客户", "客户A")
+
+	shipment := map[string]any{
+		"code": "CY-001", "customerName": "客户A", "startPort": "宁波", "destPort": "Hamburg",
+		"shipToAddress": "Germany Warehouse", "transportType": "海运", "arriveCountry": "Germany",
+		"salesOwner": "业务员A", "warehouseShipDate": "2026-02-10",
+		"items": []any{map[string]any{"productModel": "磁钢A", "quantity": 10, "unitPrice": 5}},
+	}
+	created, err := uc.Create(ctx, ERPModuleShipmentDetails, shipment, 1)
+	if err != nil {
+		t.Fatalf("create shipment failed: %v", err)
+	}
+	shipmentID := created["id"].(int)
+	outbound, err := uc.Create(ctx, ERPModuleOutbound, map[string]any{
+		"code": "CK-001", "shipmentCode": "CY-001", "productName": "磁钢A", "warehouseName": "杭州一号仓",
+		"location": "A-01-01", "quantity": 10,
+	}, 1)
+	if err != nil {
+		t.Fatalf("create outbound failed: %v", err)
+	}
+	settlement, err := uc.GenerateSettlement(ctx, "CY-001", 1)
+	if err != nil {
+		t.Fatalf("generate settlement failed: %v", err)
+	}
+
+	err = uc.Delete(ctx, ERPModuleShipmentDetails, shipmentID)
+	var inUse *ERPRecordInUseError
+	if !errors.As(err, &inUse) || !errors.Is(err, ErrERPRecordInUse) {
+		t.Fatalf("delete referenced shipment should fail, got %v", err)
+	}
+	wantRefs := []ERPRecordReference{
+		{ModuleKey: ERPModuleOutbound, ID: outbound["id"].(int), Code: "CK-001", Field: "shipmentCode"},
+		{ModuleKey: ERPModuleSettlements, ID: settlement["id"].(int), Code: "JH-001", Field: "sourceShipmentCode"},
+		{ModuleKey: ERPModuleSettlements, ID: settlement["id"].(int), Code: "JH-001", Field: "lines[].shipmentCode"},
+	}
+	if !reflect.DeepEqual(inUse.References, wantRefs) {
+		t.Fatalf("references = %+v, want %+v", inUse.References, wantRefs)
+	}
+
+	// 修改单号同样会使引用失效；其余字段可以修改
+	shipment["code"] = "CY-009"
+	if _, err := uc.Update(ctx, ERPModuleShipmentDetails, shipmentID, shipment, 0, 1); !errors.Is(err, ErrERPRecordInUse) {
+		t.Fatalf("renaming referenced shipment should fail, got %v", err)
+	}
+	shipment["code"] = "CY-001"
+	shipment["destPort"] = "Rotterdam"
+	if _, err := uc.Update(ctx, ERPModuleShipmentDetails, shipmentID, shipment, 0, 1); err != nil {
+		t.Fatalf("update unrelated field failed: %v", err)
+	}
+
+	// 客户改为供应商后不能再作为客户被引用
+	partners, _ := repo.ListByModule(ctx, ERPModulePartners)
+	partner := cloneMap(partners[0].Payload)
+	partner["partnerType"] = "合作供应商"
+	if _, err := uc.Update(ctx, ERPModulePartners, partners[0].ID, partner, 0, 1); !errors.Is(err, ErrERPRecordInUse) {
+		t.Fatalf("changing referenced partner type should fail, got %v", err)
+	}
+
+	// 引用方删除后即可删除
+	if err := uc.Delete(ctx, ERPModuleOutbound, outbound["id"].(int)); err != nil {
+		t.Fatalf("delete outbound failed: %v", err)
+	}
+	if err := uc.Delete(ctx, ERPModuleSettlements, settlement["id"].(int)); err != nil {
+		t.Fatalf("delete settlement failed: %v", err)
+	}
+	if err := uc.Delete(ctx, ERPModuleShipmentDetails, shipmentID); err != nil {
+		t.Fatalf("delete shipment failed: %v", err)
+	}
+}
@@ -0,0 +1,348 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrERPRevisionNotFound = errors.New("erp revision not found")
+
+// 明细行变更状态（ERPRevisionItemChange.Status）。
+const (
+	ERPRevisionItemAdded   = "added"
+	ERPRevisionItemRemoved = "removed"
+	ERPRevisionItemChanged = "changed"
+)
+
+// ERPRevision 记录的一个版本。历史版本在被修改前保存；当前内容也作为一个版本返回（Current 为 true），
+// 版本号为最大历史版本号 + 1。
+type ERPRevision struct {
+	Revision       int
+	Code           string
+	Payload        map[string]any
+	SavedByAdminID *int
+	SavedAt        time.Time
+	Current        bool
+}
+
+// ERPRevisionItemChange 明细行级变更。行优先按 lineNo 对应，没有行号时按位置对应；
+// Index 为行在新版本中的位置，删除的行为旧版本中的位置。
+type ERPRevisionItemChange struct {
+	List    string
+	Index   int
+	LineNo  int
+	Status  string
+	Changes []ERPAuditChange
+}
+
+// ERPRevisionDiff 两个版本间的差异：Fields 为表头字段，Items 为明细行。
+type ERPRevisionDiff struct {
+	From   int
+	To     int
+	Fields []ERPAuditChange
+	Items  []ERPRevisionItemChange
+}
+
+type ERPRevisionRepo interface {
+	// ListRevisions 按版本号倒序返回记录的历史版本（不含当前内容）。
+	ListRevisions(ctx context.Context, moduleKey string, recordID int) ([]*ERPRevision, error)
+}
+
+// ERPRevisionUsecase 记录版本历史、比较与恢复。记录可见性沿用 ERPUsecase 的记录范围，
+// 缺少 view_amounts 时隐藏金额字段。
+type ERPRevisionUsecase struct {
+	repo    ERPRevisionRepo
+	records *ERPUsecase
+	log     *log.Helper
+	tracer  trace.Tracer
+}
+
+func NewERPRevisionUsecase(repo ERPRevisionRepo, records *ERPUsecase, logger log.Logger, tp *tracesdk.TracerProvider) *ERPRevisionUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.erp_revision"))
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.erp_revision")
+	} else {
+		tr = otel.Tracer("biz.erp_revision")
+	}
+	return &ERPRevisionUsecase{
+		repo:    repo,
+		records: records,
+		log:     helper,
+		tracer:  tr,
+	}
+}
+
+// History 返回全部版本（含当前内容），按版本号倒序。
+func (uc *ERPRevisionUsecase) History(ctx context.Context, moduleKey string, id int) ([]*ERPRevision, error) {
+	moduleKey, revisions, err := uc.load(ctx, moduleKey, id)
+	if err != nil {
+		return nil, err
+	}
+	if erpAmountMasked(ctx, moduleKey) {
+		for _, revision := range revisions {
+			maskERPPayload(moduleKey, revision.Payload)
+		}
+	}
+	return revisions, nil
+}
+
+// Revision 返回指定版本（未脱敏），供恢复前校验权限。
+func (uc *ERPRevisionUsecase) Revision(ctx context.Context, moduleKey string, id, revision int) (*ERPRevision, error) {
+	_, revisions, err := uc.load(ctx, moduleKey, id)
+	if err != nil {
+		return nil, err
+	}
+	return findERPRevision(revisions, revision)
+}
+
+// Diff 比较 from 与 to 两个版本（均可为当前版本号）。
+func (uc *ERPRevisionUsecase) Diff(ctx context.Context, moduleKey string, id, from, to int) (*ERPRevisionDiff, error) {
+	moduleKey, revisions, err := uc.load(ctx, moduleKey, id)
+	if err != nil {
+		return nil, err
+	}
+	before, err := findERPRevision(revisions, from)
+	if err != nil {
+		return nil, err
+	}
+	after, err := findERPRevision(revisions, to)
+	if err != nil {
+		return nil, err
+	}
+	diff := DiffERPRevisions(before.Payload, after.Payload)
+	diff.From, diff.To = from, to
+	if erpAmountMasked(ctx, moduleKey) {
+		maskERPRevisionDiff(moduleKey, diff)
+	}
+	return diff, nil
+}
+
+// Restore 以指定历史版本的内容修改记录：当前内容保存为新的历史版本，恢复结果成为新的当前版本。
+// expectedVersion 同 ERPUsecase.Update。
+func (uc *ERPRevisionUsecase) Restore(ctx context.Context, moduleKey string, id, revision, expectedVersion, operatorAdminID int) (map[string]any, error) {
+	target, err := uc.Revision(ctx, moduleKey, id, revision)
+	if err != nil {
+		return nil, err
+	}
+	if target.Current {
+		return nil, ErrBadParam
+	}
+	return uc.records.Update(ctx, moduleKey, id, target.Payload, expectedVersion, operatorAdminID)
+}
+
+// load 返回记录的全部版本；记录不在当前记录范围内时返回 ErrERPRecordNotFound。
+func (uc *ERPRevisionUsecase) load(ctx context.Context, moduleKey string, id int) (string, []*ERPRevision, error) {
+	var err error
+	moduleKey, err = NormalizeERPModuleKey(moduleKey)
+	if err != nil {
+		return "", nil, err
+	}
+	if id <= 0 {
+		return "", nil, ErrBadParam
+	}
+	record, err := uc.records.findRecordByID(ctx, moduleKey, id)
+	if err != nil {
+		return "", nil, err
+	}
+	history, err := uc.repo.ListRevisions(ctx, moduleKey, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	next := 1
+	for _, revision := range history {
+		if revision.Revision >= next {
+			next = revision.Revision + 1
+		}
+	}
+	savedBy := record.UpdatedByAdminID
+	if savedBy == nil {
+		savedBy = record.CreatedByAdminID
+	}
+	current := &ERPRevision{
+		Revision:       next,
+		Code:           record.Code,
+		Payload:        copyERPPayload(record.Payload),
+		SavedByAdminID: savedBy,
+		SavedAt:        record.UpdatedAt,
+		Current:        true,
+	}
+	return moduleKey, append([]*ERPRevision{current}, history...), nil
+}
+
+func findERPRevision(revisions []*ERPRevision, revision int) (*ERPRevision, error) {
+	for _, item := range revisions {
+		if item.Revision == revision {
+			return item, nil
+		}
+	}
+	return nil, ErrERPRevisionNotFound
+}
+
+func copyERPPayload(payload map[string]any) map[string]any {
+	out := make(map[string]any, len(payload))
+	for key, value := range payload {
+		out[key] = value
+	}
+	return out
+}
+
+// DiffERPRevisions 比较两个版本的 payload：对象数组（如 items）按行比较，其余字段同 DiffERPPayload。
+func DiffERPRevisions(before, after map[string]any) *ERPRevisionDiff {
+	// 一侧为对象数组、另一侧为空数组或缺失时按明细行比较，否则按字段比较。
+	lists := map[string]struct{}{}
+	for _, payload := range []map[string]any{before, after} {
+		for key, value := range payload {
+			if _, ok := erpObjectRows(value); ok {
+				lists[key] = struct{}{}
+			}
+		}
+	}
+	for key := range lists {
+		_, beforeOK := erpObjectRows(before[key])
+		_, afterOK := erpObjectRows(after[key])
+		if (!beforeOK && !erpEmptyList(before[key])) || (!afterOK && !erpEmptyList(after[key])) {
+			delete(lists, key)
+		}
+	}
+	beforeFields := map[string]any{}
+	afterFields := map[string]any{}
+	for key, value := range before {
+		if _, ok := lists[key]; !ok {
+			beforeFields[key] = value
+		}
+	}
+	for key, value := range after {
+		if _, ok := lists[key]; !ok {
+			afterFields[key] = value
+		}
+	}
+
+	diff := &ERPRevisionDiff{
+		Fields: DiffERPPayload(beforeFields, afterFields),
+		Items:  []ERPRevisionItemChange{},
+	}
+	names := make([]string, 0, len(lists))
+	for key := range lists {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		beforeRows, _ := erpObjectRows(before[name])
+		afterRows, _ := erpObjectRows(after[name])
+		diff.Items = append(diff.Items, diffERPRows(name, beforeRows, afterRows)...)
+	}
+	return diff
+}
+
+func diffERPRows(list string, beforeRows, afterRows []map[string]any) []ERPRevisionItemChange {
+	rowKey := func(row map[string]any, index int) string {
+		if lineNo, ok := toERPFloat64(row["lineNo"]); ok && lineNo > 0 {
+			return fmt.Sprintf("L%v", lineNo)
+		}
+		return fmt.Sprintf("#%d", index)
+	}
+	beforeByKey := make(map[string]int, len(beforeRows))
+	for index, row := range beforeRows {
+		beforeByKey[rowKey(row, index)] = index
+	}
+
+	out := []ERPRevisionItemChange{}
+	matched := make(map[int]struct{}, len(beforeRows))
+	for index, row := range afterRows {
+		change := ERPRevisionItemChange{List: list, Index: index, LineNo: erpRowLineNo(row)}
+		if previous, ok := beforeByKey[rowKey(row, index)]; ok {
+			matched[previous] = struct{}{}
+			change.Status = ERPRevisionItemChanged
+			change.Changes = DiffERPPayload(beforeRows[previous], row)
+			if len(change.Changes) == 0 {
+				continue
+			}
+		} else {
+			change.Status = ERPRevisionItemAdded
+			change.Changes = DiffERPPayload(nil, row)
+		}
+		out = append(out, change)
+	}
+	for index, row := range beforeRows {
+		if _, ok := matched[index]; ok {
+			continue
+		}
+		out = append(out, ERPRevisionItemChange{
+			List:    list,
+			Index:   index,
+			LineNo:  erpRowLineNo(row),
+			Status:  ERPRevisionItemRemoved,
+			Changes: DiffERPPayload(row, nil),
+		})
+	}
+	return out
+}
+
+// erpObjectRows 判断 value 是否为非空对象数组（明细行）。
+func erpObjectRows(value any) ([]map[string]any, bool) {
+	items, ok := value.([]any)
+	if !ok || len(items) == 0 {
+		return nil, false
+	}
+	rows := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		row, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		rows = append(rows, row)
+	}
+	return rows, true
+}
+
+func erpEmptyList(value any) bool {
+	if value == nil {
+		return true
+	}
+	items, ok := value.([]any)
+	return ok && len(items) == 0
+}
+
+func erpRowLineNo(row map[string]any) int {
+	if lineNo, ok := toERPFloat64(row["lineNo"]); ok && lineNo > 0 {
+		return int(lineNo)
+	}
+	return 0
+}
+
+// maskERPRevisionDiff 去掉金额字段的变更；只剩金额变化的明细行整体隐藏。
+func maskERPRevisionDiff(moduleKey string, diff *ERPRevisionDiff) {
+	fields := make([]ERPAuditChange, 0, len(diff.Fields))
+	for _, change := range diff.Fields {
+		if !erpAuditFieldSensitive(moduleKey, change.Field) {
+			fields = append(fields, change)
+		}
+	}
+	diff.Fields = fields
+
+	items := make([]ERPRevisionItemChange, 0, len(diff.Items))
+	for _, item := range diff.Items {
+		changes := make([]ERPAuditChange, 0, len(item.Changes))
+		for _, change := range item.Changes {
+			if !erpAuditFieldSensitive(moduleKey, item.List+"[]."+change.Field) {
+				changes = append(changes, change)
+			}
+		}
+		if item.Status == ERPRevisionItemChanged && len(changes) == 0 {
+			continue
+		}
+		item.Changes = changes
+		items = append(items, item)
+	}
+	diff.Items = items
+}
@@ -0,0 +1,158 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// memERPRevisionRepo 在修改前保存历史版本，模拟 data 层的事务内快照。
+type memERPRevisionRepo struct {
+	*memERPRepo
+	revisions map[int][]*ERPRevision
+}
+
+func (r *memERPRevisionRepo) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, expectedVersion, updatedByAdminID int) (*ERPRecord, error) {
+	records, _ := r.ListByModule(ctx, moduleKey)
+	for _, record := range records {
+		if record.ID == id {
+			r.revisions[id] = append([]*ERPRevision{{
+				Revision:       len(r.revisions[id]) + 1,
+				Code:           record.Code,
+				Payload:        record.Payload,
+				SavedByAdminID: record.UpdatedByAdminID,
+				SavedAt:        record.UpdatedAt,
+			}}, r.revisions[id]...)
+		}
+	}
+	return r.memERPRepo.Update(ctx, moduleKey, id, payload, expectedVersion, updatedByAdminID)
+}
+
+func (r *memERPRevisionRepo) ListRevisions(ctx context.Context, moduleKey string, recordID int) ([]*ERPRevision, error) {
+	return r.revisions[recordID], nil
+}
+
+func TestDiffERPRevisions(t *testing.T) {
+	before := map[string]any{
+		"code": "BJ-001", "customerName": "客户A", "tags": []any{"a"},
+		"items": []any{
+			map[string]any{"lineNo": 1, "productName": "磁钢A", "quantity": 100},
+			map[string]any{"lineNo": 2, "productName": "磁钢B", "quantity": 10},
+			map[string]any{"lineNo": 3, "productName": "磁钢C", "quantity": 5},
+		},
+	}
+	after := map[string]any{
+		"code": "BJ-001", "customerName": "客户B", "tags": []any{"a", "b"},
+		"items": []any{
+			map[string]any{"lineNo": 1, "productName": "磁钢A", "quantity": 100},
+			map[string]any{"lineNo": 3, "productName": "磁钢C", "quantity": 8},
+			map[string]any{"lineNo": 4, "productName": "磁钢D", "quantity": 1},
+		},
+	}
+	diff := DiffERPRevisions(normalizeERPAuditValue(before).(map[string]any), normalizeERPAuditValue(after).(map[string]any))
+	wantFields := []ERPAuditChange{
+		{Field: "customerName", Old: "客户A", New: "客户B"},
+		{Field: "tags", Old: []any{"a"}, New: []any{"a", "b"}},
+	}
+	if !reflect.DeepEqual(diff.Fields, wantFields) {
+		t.Fatalf("fields = %+v", diff.Fields)
+	}
+	if len(diff.Items) != 3 {
+		t.Fatalf("items = %+v", diff.Items)
+	}
+	changed, added, removed := diff.Items[0], diff.Items[1], diff.Items[2]
+	if changed.Status != ERPRevisionItemChanged || changed.LineNo != 3 || changed.Index != 1 ||
+		!reflect.DeepEqual(changed.Changes, []ERPAuditChange{{Field: "quantity", Old: float64(5), New: float64(8)}}) {
+		t.Fatalf("changed row = %+v", changed)
+	}
+	if added.Status != ERPRevisionItemAdded || added.LineNo != 4 || len(added.Changes) != 3 {
+		t.Fatalf("added row = %+v", added)
+	}
+	if removed.Status != ERPRevisionItemRemoved || removed.LineNo != 2 || removed.Index != 1 {
+		t.Fatalf("removed row = %+v", removed)
+	}
+
+	// 没有行号时按位置对应；明细清空视为逐行删除
+	diff = DiffERPRevisions(
+		map[string]any{"items": []any{map[string]any{"productName": "A"}}},
+		map[string]any{"items": []any{}},
+	)
+	if len(diff.Fields) != 0 || len(diff.Items) != 1 || diff.Items[0].Status != ERPRevisionItemRemoved {
+		t.Fatalf("cleared items diff = %+v", diff)
+	}
+}
+
+func TestERPRevisionUsecase_HistoryDiffRestore(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	repo := &memERPRevisionRepo{memERPRepo: newMemERPRepo(), revisions: map[int][]*ERPRevision{}}
+	records := NewERPUsecase(repo, logger, tracesdk.NewTracerProvider())
+	uc := NewERPRevisionUsecase(repo, records, logger, nil)
+	ctx := context.Background()
+	seedERPPartners(t, repo, "合作供应商", "工厂A")
+	seedERPRecords(t, repo, ERPModuleExportSales, map[string]any{"code": "XS-001", "customerName": "客户A"})
+
+	payload := map[string]any{
+		"code": "CG-001", "supplierName": "工厂A", "signDate": "2026-01-10", "salesNo": "XS-001",
+		"deliveryDate": "2026-02-01", "deliveryAddress": "宁波", "invoiceRequired": "是",
+		"items": []any{map[string]any{"productName": "磁钢A", "quantity": 100, "unitPrice": 5}},
+	}
+	created, err := records.Create(ctx, ERPModulePurchaseContracts, payload, 1)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	id := created["id"].(int)
+	payload["deliveryDate"] = "2026-02-15"
+	payload["items"] = []any{map[string]any{"productName": "磁钢A", "quantity": 100, "unitPrice": 6}}
+	if _, err := records.Update(ctx, ERPModulePurchaseContracts, id, payload, 0, 2); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	history, err := uc.History(ctx, ERPModulePurchaseContracts, id)
+	if err != nil || len(history) != 2 {
+		t.Fatalf("History() = %v, %v", history, err)
+	}
+	if !history[0].Current || history[0].Revision != 2 || history[1].Revision != 1 || *history[1].SavedByAdminID != 1 {
+		t.Fatalf("unexpected history %+v %+v", history[0], history[1])
+	}
+
+	diff, err := uc.Diff(ctx, ERPModulePurchaseContracts, id, 1, 2)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if len(diff.Items) != 1 || diff.Items[0].Status != ERPRevisionItemChanged {
+		t.Fatalf("unexpected item diff %+v", diff.Items)
+	}
+	masked, err := uc.Diff(NewContextWithERPAmountMask(ctx), ERPModulePurchaseContracts, id, 1, 2)
+	if err != nil {
+		t.Fatalf("masked Diff() error = %v", err)
+	}
+	if len(masked.Items) != 0 || len(masked.Fields) != 1 || masked.Fields[0].Field != "deliveryDate" {
+		t.Fatalf("amount changes should be masked: %+v", masked)
+	}
+	if _, err := uc.Diff(ctx, ERPModulePurchaseContracts, id, 1, 9); !errors.Is(err, ErrERPRevisionNotFound) {
+		t.Fatalf("unknown revision should fail, got %v", err)
+	}
+
+	if _, err := uc.Restore(ctx, ERPModulePurchaseContracts, id, 2, 0, 3); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("restoring current revision should fail, got %v", err)
+	}
+	restored, err := uc.Restore(ctx, ERPModulePurchaseContracts, id, 1, 0, 3)
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if restored["deliveryDate"] != "2026-02-01" {
+		t.Fatalf("unexpected restored record %v", restored)
+	}
+	history, _ = uc.History(ctx, ERPModulePurchaseContracts, id)
+	if len(history) != 3 || history[0].Revision != 3 {
+		t.Fatalf("restore should add a revision, got %d", len(history))
+	}
+	if diff, _ := uc.Diff(ctx, ERPModulePurchaseContracts, id, 1, 3); len(diff.Fields) != 0 || len(diff.Items) != 0 {
+		t.Fatalf("restored content should equal revision 1: %+v", diff)
+	}
+}
@@ -0,0 +1,328 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+)
+
+// erpSchemaFS 各模块的 JSON Schema，文件名为 <module_key>.json。
+//
+//go:embed erp_schemas/*.json
+var erpSchemaFS embed.FS
+
+// erpModuleSchema 模块 Schema：raw 为原始文档（erp.schema 原样返回给前端），compiled 用于校验与数字转换。
+type erpModuleSchema struct {
+	raw      []byte
+	compiled *jsonschema.Schema
+}
+
+// erpModuleSchemas 启动时加载并编译，缺少或无法编译的 Schema 直接 panic。
+var erpModuleSchemas = mustLoadERPModuleSchemas()
+
+// ERPFieldError 单个字段的校验错误；Path 形如 items[0].quantity，派生规则的错误 Path 为空。
+type ERPFieldError struct {
+	Path    string
+	Message string
+}
+
+// ERPValidationError 记录内容校验失败，Fields 为全部不合法字段；errors.Is(err, ErrERPInvalidRecord) 成立。
+type ERPValidationError struct {
+	Fields []ERPFieldError
+}
+
+func (e *ERPValidationError) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Message)
+	}
+	return ErrERPInvalidRecord.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *ERPValidationError) Unwrap() error {
+	return ErrERPInvalidRecord
+}
+
+// Schema 返回模块的 JSON Schema，供前端渲染表单与提交前校验。
+func (uc *ERPUsecase) Schema(ctx context.Context, moduleKey string) (map[string]any, error) {
+	moduleKey, err := NormalizeERPModuleKey(moduleKey)
+	if err != nil {
+		return nil, err
+	}
+	schema, ok := erpModuleSchemas[moduleKey]
+	if !ok {
+		return nil, ErrERPInvalidModule
+	}
+	doc := map[string]any{}
+	if err := json.Unmarshal(schema.raw, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func mustLoadERPModuleSchemas() map[string]*erpModuleSchema {
+	schemas, err := loadERPModuleSchemas()
+	if err != nil {
+		panic(err)
+	}
+	return schemas
+}
+
+func loadERPModuleSchemas() (map[string]*erpModuleSchema, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat()
+	// 日期沿用 parseERPDate 的格式（2006-01-02、RFC3339、2006/01/02），而非标准 full-date
+	compiler.RegisterFormat(&jsonschema.Format{Name: "date", Validate: validateERPDateFormat})
+
+	out := make(map[string]*erpModuleSchema, len(erpModuleRules))
+	for moduleKey := range erpModuleRules {
+		raw, err := erpSchemaFS.ReadFile("erp_schemas/" + moduleKey + ".json")
+		if err != nil {
+			return nil, fmt.Errorf("erp schema %s: %w", moduleKey, err)
+		}
+		doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+		if err != nil {
+			return nil, fmt.Errorf("erp schema %s: %w", moduleKey, err)
+		}
+		url := "erp://schemas/" + moduleKey + ".json"
+		if err := compiler.AddResource(url, doc); err != nil {
+			return nil, fmt.Errorf("erp schema %s: %w", moduleKey, err)
+		}
+		compiled, err := compiler.Compile(url)
+		if err != nil {
+			return nil, fmt.Errorf("erp schema %s: %w", moduleKey, err)
+		}
+		out[moduleKey] = &erpModuleSchema{raw: raw, compiled: compiled}
+	}
+	return out, nil
+}
+
+func validateERPDateFormat(value any) error {
+	raw, ok := value.(string)
+	if !ok {
+		return nil
+	}
+	_, err := parseERPDate(raw)
+	return err
+}
+
+// validate 校验 payload 并返回全部不合法字段（按路径排序）。空值（nil、空白字符串、空数组/对象）视为未填写，
+// 与 isEmptyERPValue 一致。
+func (s *erpModuleSchema) validate(payload map[string]any) []ERPFieldError {
+	err := s.compiled.Validate(erpSchemaInstance(payload))
+	if err == nil {
+		return nil
+	}
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []ERPFieldError{{Message: err.Error()}}
+	}
+
+	return mergeERPFieldErrors(collectERPSchemaErrors(validationErr, nil))
+}
+
+// mergeERPFieldErrors 合并字段错误，按路径排序并去重。
+func mergeERPFieldErrors(groups ...[]ERPFieldError) []ERPFieldError {
+	var fields []ERPFieldError
+	for _, group := range groups {
+		fields = append(fields, group...)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		if fields[i].Path != fields[j].Path {
+			return fields[i].Path < fields[j].Path
+		}
+		return fields[i].Message < fields[j].Message
+	})
+	out := make([]ERPFieldError, 0, len(fields))
+	for index, field := range fields {
+		if index > 0 && field == fields[index-1] {
+			continue
+		}
+		out = append(out, field)
+	}
+	return out
+}
+
+func collectERPSchemaErrors(err *jsonschema.ValidationError, out []ERPFieldError) []ERPFieldError {
+	if len(err.Causes) > 0 {
+		for _, cause := range err.Causes {
+			out = collectERPSchemaErrors(cause, out)
+		}
+		return out
+	}
+
+	path := erpSchemaPath(err.InstanceLocation)
+	fail := func(format string, args ...any) []ERPFieldError {
+		return append(out, ERPFieldError{Path: path, Message: "字段 " + path + " " + fmt.Sprintf(format, args...)})
+	}
+	switch k := err.ErrorKind.(type) {
+	case *kind.Required:
+		for _, missing := range k.Missing {
+			field := erpSchemaPath(append(append([]string{}, err.InstanceLocation...), missing))
+			out = append(out, ERPFieldError{Path: field, Message: "缺少必填字段 " + field})
+		}
+		return out
+	case *kind.Type:
+		return fail("必须是%s", erpSchemaTypeName(k.Want))
+	case *kind.Enum:
+		options := make([]string, 0, len(k.Want))
+		for _, option := range k.Want {
+			options = append(options, fmt.Sprint(option))
+		}
+		return fail("取值非法，可选：%s", strings.Join(options, "、"))
+	case *kind.Format:
+		if k.Want == "date" {
+			return fail("日期格式非法")
+		}
+		return fail("格式非法")
+	case *kind.Pattern:
+		return fail("格式非法")
+	case *kind.MaxLength:
+		return fail("长度不能超过 %d", k.Want)
+	case *kind.MinLength:
+		return fail("长度不能少于 %d", k.Want)
+	case *kind.MinItems:
+		return fail("至少需要 %d 项", k.Want)
+	case *kind.MaxItems:
+		return fail("最多 %d 项", k.Want)
+	case *kind.Minimum, *kind.Maximum, *kind.ExclusiveMinimum, *kind.ExclusiveMaximum:
+		return fail("超出范围")
+	default:
+		return fail("非法")
+	}
+}
+
+// erpSchemaPath 将实例位置转为字段路径，如 [items 0 quantity] → items[0].quantity。
+func erpSchemaPath(location []string) string {
+	var sb strings.Builder
+	for _, token := range location {
+		if _, err := strconv.Atoi(token); err == nil {
+			sb.WriteString("[" + token + "]")
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteString(".")
+		}
+		sb.WriteString(token)
+	}
+	return sb.String()
+}
+
+func erpSchemaTypeName(types []string) string {
+	names := make([]string, 0, len(types))
+	for _, typ := range types {
+		switch typ {
+		case "number":
+			names = append(names, "数字")
+		case "integer":
+			names = append(names, "整数")
+		case "string":
+			names = append(names, "字符串")
+		case "boolean":
+			names = append(names, "布尔值")
+		case "array":
+			names = append(names, "数组")
+		case "object":
+			names = append(names, "对象")
+		default:
+			names = append(names, typ)
+		}
+	}
+	return strings.Join(names, "或")
+}
+
+// erpSchemaInstance 返回用于校验的副本：去掉空值，数组统一为 []any。
+func erpSchemaInstance(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			if isEmptyERPValue(item) {
+				continue
+			}
+			out[key] = erpSchemaInstance(item)
+		}
+		return out
+	case []map[string]any:
+		out := make([]any, 0, len(v))
+		for _, item := range v {
+			out = append(out, erpSchemaInstance(item))
+		}
+		return out
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, item := range v {
+			out = append(out, erpSchemaInstance(item))
+		}
+		return out
+	case []string:
+		out := make([]any, 0, len(v))
+		for _, item := range v {
+			out = append(out, item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// coerceERPSchemaNumbers 将 Schema 声明为数字的字段（含明细行）中的数字字符串转为数字，如 "12.5" → 12.5；
+// 无法解析的保留原值，由校验报错。返回新的 map/数组，不修改入参。
+func coerceERPSchemaNumbers(schema *jsonschema.Schema, value any) any {
+	for schema != nil && schema.Ref != nil {
+		schema = schema.Ref
+	}
+	if schema == nil {
+		return value
+	}
+
+	switch v := value.(type) {
+	case string:
+		if schema.Types == nil {
+			return value
+		}
+		for _, typ := range schema.Types.ToStrings() {
+			if typ != "number" && typ != "integer" {
+				continue
+			}
+			if number, ok := toERPFloat64(v); ok {
+				return normalizeERPNumber(number)
+			}
+		}
+		return value
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			if property, ok := schema.Properties[key]; ok {
+				out[key] = coerceERPSchemaNumbers(property, item)
+			} else {
+				out[key] = item
+			}
+		}
+		return out
+	case []any:
+		items := schema.Items2020
+		if items == nil {
+			items, _ = schema.Items.(*jsonschema.Schema)
+		}
+		out := make([]any, 0, len(v))
+		for _, item := range v {
+			out = append(out, coerceERPSchemaNumbers(items, item))
+		}
+		return out
+	default:
+		return value
+	}
+}
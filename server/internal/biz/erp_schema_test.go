@@ -0,0 +1,119 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestERPModuleSchemasLoaded(t *testing.T) {
+	uc := &ERPUsecase{}
+	for moduleKey := range erpModuleRules {
+		schema, err := uc.Schema(context.Background(), moduleKey)
+		if err != nil {
+			t.Fatalf("schema %s: %v", moduleKey, err)
+		}
+		if schema["type"] != "object" || schema["properties"] == nil {
+			t.Fatalf("schema %s invalid: %v", moduleKey, schema)
+		}
+	}
+	if _, err := uc.Schema(context.Background(), "unknown"); !errors.Is(err, ErrERPInvalidModule) {
+		t.Fatalf("unknown module should fail, got %v", err)
+	}
+}
+
+func TestApplyERPModuleRules_SchemaErrors(t *testing.T) {
+	_, err := applyERPModuleRules(ERPModuleQuotations, map[string]any{
+		"customerName": "客户A",
+		"quotedDate":   "2026-13-45",
+		"currency":     "JPY",
+		"box":          "回收站",
+		"items": []any{
+			map[string]any{"productName": "磁钢A", "quantity": "abc", "unitPrice": 5},
+			map[string]any{"quantity": 10, "unitPrice": -1},
+		},
+	}, nil)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) || !errors.Is(err, ErrERPInvalidRecord) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	got := map[string]string{}
+	for _, field := range validationErr.Fields {
+		got[field.Path] = field.Message
+	}
+	want := map[string]string{
+		"box":                  "字段 box 取值非法，可选：草稿箱、待批箱、已批箱、招领箱、确认箱、免批",
+		"currency":             "字段 currency 取值非法，可选：USD、EUR、CNY",
+		"quotedDate":           "字段 quotedDate 日期格式非法",
+		"items[0].quantity":    "字段 items[0].quantity 必须是数字",
+		"items[1].productName": "缺少必填字段 items[1].productName",
+		"items[1].unitPrice":   "字段 items[1].unitPrice 超出范围",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("fields = %v, want %v", got, want)
+	}
+	for path, message := range want {
+		if got[path] != message {
+			t.Fatalf("field %s = %q, want %q (all: %v)", path, got[path], message, got)
+		}
+	}
+}
+
+func TestApplyERPModuleRules_CoerceNumbers(t *testing.T) {
+	items := []any{map[string]any{"productName": "磁钢A", "quantity": " 10 ", "unitPrice": "2.5"}}
+	normalized, err := applyERPModuleRules(ERPModuleQuotations, map[string]any{
+		"customerName": "客户A",
+		"quotedDate":   "2026/01/02",
+		"currency":     "USD",
+		"items":        items,
+	}, nil)
+	if err != nil {
+		t.Fatalf("apply rules failed: %v", err)
+	}
+	row := normalized["items"].([]any)[0].(map[string]any)
+	if row["quantity"] != int64(10) || row["unitPrice"] != 2.5 {
+		t.Fatalf("numeric strings should be coerced, got %v", row)
+	}
+	if normalized["totalAmount"] != int64(25) || normalized["box"] != ERPBoxDraft {
+		t.Fatalf("derived fields invalid: %v", normalized)
+	}
+	if items[0].(map[string]any)["quantity"] != " 10 " {
+		t.Fatalf("input payload should not be modified")
+	}
+
+	// 必填按空值判断：空白字符串视为未填写
+	_, err = applyERPModuleRules(ERPModulePartners, map[string]any{
+		"partnerType": "合作客户", "name": " ", "address": "杭州", "contact": "张三",
+		"contactPhone": "138", "paymentCycleDays": "30",
+	}, nil)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Fields) != 1 || validationErr.Fields[0].Path != "name" {
+		t.Fatalf("blank name should be reported, got %v", err)
+	}
+}
+
+func TestApplyERPModuleRules_DerivedRequired(t *testing.T) {
+	// 结汇单有明细行时金额由行合计派生，无需填写
+	normalized, err := applyERPModuleRules(ERPModuleSettlements, map[string]any{
+		"invoiceNo": "INV-001", "shipDate": "2026-02-01", "paymentCycleDays": 30,
+		"lines": []any{map[string]any{"productModel": "磁钢A", "quantity": 10, "unitPrice": 5}},
+	}, nil)
+	if err != nil || normalized["amount"] != int64(50) {
+		t.Fatalf("settlement with lines: %v %v", normalized, err)
+	}
+	_, err = applyERPModuleRules(ERPModuleSettlements, map[string]any{
+		"invoiceNo": "INV-001", "shipDate": "2026-02-01", "paymentCycleDays": 30,
+	}, nil)
+	var validationErr *ERPValidationError
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "amount" {
+		t.Fatalf("settlement without lines should require amount, got %v", err)
+	}
+
+	// Schema 通过后返回派生规则自身的错误
+	_, err = applyERPModuleRules(ERPModuleExchangeRates, map[string]any{
+		"currency": "cny", "rateToCNY": 1, "effectiveDate": "2026-01-01",
+	}, nil)
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Message != "字段 currency 无需维护人民币汇率" {
+		t.Fatalf("derive error expected, got %v", err)
+	}
+}
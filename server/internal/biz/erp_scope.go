@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"strings"
+)
+
+// erpScopedModules 按归属过滤记录的模块（客户与销售单据）。
+var erpScopedModules = map[string]struct{}{
+	ERPModulePartners:        {},
+	ERPModuleQuotations:      {},
+	ERPModuleExportSales:     {},
+	ERPModuleShipmentDetails: {},
+}
+
+// ERPRecordScope 记录可见范围：创建人属于 AdminIDs，或 payload.salesOwner 属于 OwnerNames。
+type ERPRecordScope struct {
+	AdminIDs   []int
+	OwnerNames []string
+}
+
+type ctxKeyERPScope struct{}
+
+// NewContextWithERPScope 绑定记录范围，ERPRepo 的列表、更新、删除按此过滤。
+func NewContextWithERPScope(ctx context.Context, scope *ERPRecordScope) context.Context {
+	return context.WithValue(ctx, ctxKeyERPScope{}, scope)
+}
+
+// ERPScopeFromContext 返回模块适用的记录范围；ok=false 表示不限。
+func ERPScopeFromContext(ctx context.Context, moduleKey string) (*ERPRecordScope, bool) {
+	if _, scoped := erpScopedModules[moduleKey]; !scoped {
+		return nil, false
+	}
+	scope, ok := ctx.Value(ctxKeyERPScope{}).(*ERPRecordScope)
+	return scope, ok && scope != nil
+}
+
+// ERPRecordScopeFor 计算管理员对模块的记录范围：拥有 view_all 时返回 nil（不限），
+// 否则限定为本人及下级（按 parent_id 递归）创建或作为业务员的记录。
+func ERPRecordScopeFor(admin *AdminAccount, moduleKey string, admins []*AdminAccount) *ERPRecordScope {
+	if admin == nil {
+		return nil
+	}
+	if _, scoped := erpScopedModules[moduleKey]; !scoped {
+		return nil
+	}
+	if EffectiveAdminPermissions(admin).Allows(moduleKey, ERPActionViewAll) {
+		return nil
+	}
+
+	children := map[int][]*AdminAccount{}
+	for _, item := range admins {
+		if item != nil && item.ParentID != nil {
+			children[*item.ParentID] = append(children[*item.ParentID], item)
+		}
+	}
+	scope := &ERPRecordScope{}
+	seen := map[int]struct{}{}
+	queue := []*AdminAccount{admin}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[current.ID]; ok {
+			continue
+		}
+		seen[current.ID] = struct{}{}
+		scope.AdminIDs = append(scope.AdminIDs, current.ID)
+		if name := strings.TrimSpace(current.Username); name != "" {
+			scope.OwnerNames = append(scope.OwnerNames, name)
+		}
+		queue = append(queue, children[current.ID]...)
+	}
+	return scope
+}
+
+// Allows 判断记录是否在范围内，供无法下推查询条件的场景使用。
+func (s *ERPRecordScope) Allows(record *ERPRecord) bool {
+	if s == nil {
+		return true
+	}
+	if record == nil {
+		return false
+	}
+	if record.CreatedByAdminID != nil {
+		for _, id := range s.AdminIDs {
+			if *record.CreatedByAdminID == id {
+				return true
+			}
+		}
+	}
+	owner := strings.TrimSpace(erpPayloadString(record.Payload, "salesOwner"))
+	if owner == "" {
+		return false
+	}
+	for _, name := range s.OwnerNames {
+		if owner == name {
+			return true
+		}
+	}
+	return false
+}
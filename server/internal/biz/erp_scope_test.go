@@ -0,0 +1,42 @@
+package biz
+
+import "testing"
+
+func TestERPRecordScopeFor(t *testing.T) {
+	var sales, finance *AdminRole
+	for _, role := range BuiltinAdminRoles() {
+		switch role.Key {
+		case "sales":
+			sales = role
+		case "finance":
+			finance = role
+		}
+	}
+	leadID := 2
+	lead := &AdminAccount{ID: 2, Username: "lead", Level: AdminLevelPrimary, RoleBased: true, Roles: []*AdminRole{sales}}
+	alice := &AdminAccount{ID: 3, Username: "alice", Level: AdminLevelSecondary, ParentID: &leadID, RoleBased: true, Roles: []*AdminRole{sales}}
+	admins := []*AdminAccount{lead, alice, {ID: 4, Username: "bob", Level: AdminLevelSecondary}}
+
+	scope := ERPRecordScopeFor(lead, ERPModuleExportSales, admins)
+	if scope == nil || len(scope.AdminIDs) != 2 || scope.OwnerNames[1] != "alice" {
+		t.Fatalf("lead scope should include subordinates, got %+v", scope)
+	}
+	if ERPRecordScopeFor(lead, ERPModuleProducts, admins) != nil {
+		t.Fatalf("products should not be scoped")
+	}
+	if ERPRecordScopeFor(&AdminAccount{ID: 5, RoleBased: true, Roles: []*AdminRole{finance}}, ERPModuleExportSales, admins) != nil {
+		t.Fatalf("finance role should view all export sales")
+	}
+	if ERPRecordScopeFor(&AdminAccount{ID: 6, Level: AdminLevelSecondary, MenuPermissions: []string{"/sales/export"}}, ERPModuleExportSales, admins) != nil {
+		t.Fatalf("legacy menu admin should keep full visibility")
+	}
+
+	aliceScope := ERPRecordScopeFor(alice, ERPModuleExportSales, admins)
+	bobID := 4
+	if aliceScope.Allows(&ERPRecord{CreatedByAdminID: &bobID, Payload: map[string]any{}}) {
+		t.Fatalf("record created by bob should be out of scope")
+	}
+	if !aliceScope.Allows(&ERPRecord{CreatedByAdminID: &bobID, Payload: map[string]any{"salesOwner": "alice"}}) {
+		t.Fatalf("record owned by alice should be in scope")
+	}
+}
@@ -0,0 +1,344 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	ERPReceivableStatusPending = "pending"
+	ERPReceivableStatusPartial = "partial"
+	ERPReceivableStatusClosed  = "closed"
+)
+
+// ERPSettlementLineStatus 结汇明细行的收汇状态（按产品/出运行）。
+type ERPSettlementLineStatus struct {
+	LineNo            int
+	ShipmentCode      string
+	ShipmentLineNo    int
+	ProductModel      string
+	Quantity          float64
+	UnitPrice         float64
+	Amount            float64
+	ReceivedAmount    float64
+	OutstandingAmount float64
+	Status            string
+}
+
+// ERPSettlementReceivable 结汇单的收汇状态。
+type ERPSettlementReceivable struct {
+	SettlementCode    string
+	InvoiceNo         string
+	CustomerName      string
+	ReceivableDate    time.Time
+	Amount            float64
+	ReceivedAmount    float64
+	OutstandingAmount float64
+	Status            string
+	Lines             []*ERPSettlementLineStatus
+}
+
+type ERPReceivableFilter struct {
+	SettlementCode string
+	CustomerName   string
+}
+
+// GenerateSettlement 由出运明细生成结汇单，每个出运行生成一条结汇明细行。
+func (uc *ERPUsecase) GenerateSettlement(ctx context.Context, shipmentCode string, operatorAdminID int) (map[string]any, error) {
+	shipmentCode = strings.TrimSpace(shipmentCode)
+	if shipmentCode == "" {
+		return nil, ErrBadParam
+	}
+	ds, err := uc.loadERPDataset(ctx,
+		ERPModulePartners,
+		ERPModuleQuotations,
+		ERPModuleExportSales,
+		ERPModuleShipmentDetails,
+		ERPModuleSettlements,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	shipment := ds.findByCode(ERPModuleShipmentDetails, shipmentCode)
+	if shipment == nil {
+		return nil, ErrERPRecordNotFound
+	}
+	for _, item := range ds.list(ERPModuleSettlements) {
+		if item != nil && erpPayloadString(item.Payload, "invoiceNo") == shipmentCode {
+			return nil, fmt.Errorf("%w: 出运明细 %s 已生成结汇单 %s", ErrERPInvalidRecord, shipmentCode, item.Code)
+		}
+	}
+
+	customerName := erpPayloadString(shipment.Payload, "customerName")
+	paymentCycleDays := 0
+	if partner := ds.findPartner(customerName); partner != nil {
+		paymentCycleDays = int(erpPayloadFloat(partner.Payload, "paymentCycleDays"))
+	}
+	shipDate := erpPayloadString(shipment.Payload, "warehouseShipDate")
+	if shipDate == "" {
+		shipDate = time.Now().Format("2006-01-02")
+	}
+	currency := erpDefaultSaleCurrency
+	if sale := ds.findByCode(ERPModuleExportSales, erpPayloadString(shipment.Payload, "sourceExportCode")); sale != nil {
+		currency = erpSaleCurrency(ds, sale)
+	}
+
+	items, err := getERPItems(shipment.Payload["items"])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrERPInvalidRecord, err)
+	}
+	lines := make([]any, 0, len(items))
+	for index, item := range items {
+		productModel := erpPayloadString(item, "productModel")
+		if productModel == "" {
+			productModel = erpPayloadString(item, "productName")
+		}
+		lines = append(lines, map[string]any{
+			"lineNo":         index + 1,
+			"shipmentCode":   shipment.Code,
+			"shipmentLineNo": index + 1,
+			"productModel":   productModel,
+			"quantity":       item["quantity"],
+			"unitPrice":      item["unitPrice"],
+		})
+	}
+
+	code := "JH-" + shipment.Code
+	if strings.HasPrefix(shipment.Code, "CY") {
+		code = "JH" + strings.TrimPrefix(shipment.Code, "CY")
+	}
+	return uc.Create(ctx, ERPModuleSettlements, map[string]any{
+		"code":               code,
+		"invoiceNo":          shipment.Code,
+		"customerName":       customerName,
+		"currency":           currency,
+		"shipDate":           shipDate,
+		"paymentCycleDays":   paymentCycleDays,
+		"lines":              lines,
+		"sourceShipmentCode": shipment.Code,
+	}, operatorAdminID)
+}
+
+// Receivables 汇总结汇单及明细行的收汇状态。
+//
+// 收汇来源为确认箱水单：水单 allocations 指定 settlementCode + lineNo 的直接核销到行，
+// 只指定 settlementCode 的按行号顺序核销；未填写 allocations 的水单按 refNo 匹配结汇单号或发票号整单核销。
+func (uc *ERPUsecase) Receivables(ctx context.Context, filter ERPReceivableFilter) ([]*ERPSettlementReceivable, error) {
+	ds, err := uc.loadERPDataset(ctx, ERPModuleSettlements, ERPModuleBankReceipts)
+	if err != nil {
+		return nil, err
+	}
+
+	receivables := []*ERPSettlementReceivable{}
+	byCode := map[string]*ERPSettlementReceivable{}
+	byInvoice := map[string]*ERPSettlementReceivable{}
+	for _, settlement := range ds.list(ERPModuleSettlements) {
+		if settlement == nil || settlement.Code == "" {
+			continue
+		}
+		receivable := buildERPSettlementReceivable(settlement)
+		receivables = append(receivables, receivable)
+		byCode[receivable.SettlementCode] = receivable
+		if receivable.InvoiceNo != "" {
+			byInvoice[receivable.InvoiceNo] = receivable
+		}
+	}
+
+	for _, receipt := range ds.list(ERPModuleBankReceipts) {
+		if receipt == nil || receipt.Box != ERPBoxConfirmed {
+			continue
+		}
+		allocations, _ := getERPItemsField(receipt.Payload, "allocations")
+		if len(allocations) == 0 {
+			refNo := erpPayloadString(receipt.Payload, "refNo")
+			receivable := byCode[refNo]
+			if receivable == nil {
+				receivable = byInvoice[refNo]
+			}
+			if receivable != nil {
+				applyERPReceipt(receivable, 0, erpPayloadFloat(receipt.Payload, "receivedAmount"))
+			}
+			continue
+		}
+		for _, allocation := range allocations {
+			receivable := byCode[erpPayloadString(allocation, "settlementCode")]
+			if receivable == nil {
+				continue
+			}
+			lineNo, _ := toERPFloat64(allocation["lineNo"])
+			amount, _ := toERPFloat64(allocation["amount"])
+			applyERPReceipt(receivable, int(lineNo), amount)
+		}
+	}
+
+	settlementCode := strings.TrimSpace(filter.SettlementCode)
+	customerName := strings.TrimSpace(filter.CustomerName)
+	out := make([]*ERPSettlementReceivable, 0, len(receivables))
+	for _, receivable := range receivables {
+		if settlementCode != "" && receivable.SettlementCode != settlementCode {
+			continue
+		}
+		if customerName != "" && receivable.CustomerName != customerName {
+			continue
+		}
+		finishERPSettlementReceivable(receivable)
+		out = append(out, receivable)
+	}
+	return out, nil
+}
+
+func buildERPSettlementReceivable(settlement *ERPRecord) *ERPSettlementReceivable {
+	payload := settlement.Payload
+	receivable := &ERPSettlementReceivable{
+		SettlementCode: settlement.Code,
+		InvoiceNo:      erpPayloadString(payload, "invoiceNo"),
+		CustomerName:   erpPayloadString(payload, "customerName"),
+		ReceivableDate: erpPayloadDate(payload, "receivableDate"),
+		Amount:         erpPayloadFloat(payload, "amount"),
+		Lines:          []*ERPSettlementLineStatus{},
+	}
+	lines, _ := getERPItemsField(payload, "lines")
+	for _, line := range lines {
+		lineNo, _ := toERPFloat64(line["lineNo"])
+		shipmentLineNo, _ := toERPFloat64(line["shipmentLineNo"])
+		quantity, _ := toERPFloat64(line["quantity"])
+		unitPrice, _ := toERPFloat64(line["unitPrice"])
+		amount, _ := toERPFloat64(line["amount"])
+		receivable.Lines = append(receivable.Lines, &ERPSettlementLineStatus{
+			LineNo:         int(lineNo),
+			ShipmentCode:   erpPayloadString(line, "shipmentCode"),
+			ShipmentLineNo: int(shipmentLineNo),
+			ProductModel:   erpPayloadString(line, "productModel"),
+			Quantity:       quantity,
+			UnitPrice:      unitPrice,
+			Amount:         amount,
+		})
+	}
+	return receivable
+}
+
+// applyERPReceipt 将收汇金额核销到指定行；lineNo 为 0 时按行号顺序核销，行全部收齐后的余额计入整单。
+func applyERPReceipt(receivable *ERPSettlementReceivable, lineNo int, amount float64) {
+	if amount <= 0 {
+		return
+	}
+	receivable.ReceivedAmount += amount
+	if lineNo > 0 {
+		for _, line := range receivable.Lines {
+			if line.LineNo == lineNo {
+				line.ReceivedAmount += amount
+				return
+			}
+		}
+		return
+	}
+	for _, line := range receivable.Lines {
+		if amount <= 0 {
+			return
+		}
+		open := line.Amount - line.ReceivedAmount
+		if open <= 0 {
+			continue
+		}
+		applied := open
+		if amount < open {
+			applied = amount
+		}
+		line.ReceivedAmount += applied
+		amount -= applied
+	}
+}
+
+func finishERPSettlementReceivable(receivable *ERPSettlementReceivable) {
+	receivable.ReceivedAmount = roundERPAmount(receivable.ReceivedAmount)
+	receivable.OutstandingAmount = roundERPAmount(receivable.Amount - receivable.ReceivedAmount)
+	receivable.Status = erpReceivableStatus(receivable.Amount, receivable.ReceivedAmount)
+	for _, line := range receivable.Lines {
+		line.ReceivedAmount = roundERPAmount(line.ReceivedAmount)
+		line.OutstandingAmount = roundERPAmount(line.Amount - line.ReceivedAmount)
+		line.Status = erpReceivableStatus(line.Amount, line.ReceivedAmount)
+	}
+}
+
+func erpReceivableStatus(amount, received float64) string {
+	switch {
+	case received <= 0:
+		return ERPReceivableStatusPending
+	case received+0.0001 < amount:
+		return ERPReceivableStatusPartial
+	default:
+		return ERPReceivableStatusClosed
+	}
+}
+
+// deriveSettlement 补齐结汇明细行（行号、行金额），有明细行时结汇金额取明细合计，并计算应收日期。
+func deriveSettlement(payload map[string]any) error {
+	if raw, exists := payload["lines"]; exists && !isEmptyERPValue(raw) {
+		lines, err := getERPItemsField(payload, "lines")
+		if err != nil {
+			return err
+		}
+		total := 0.0
+		normalized := make([]any, 0, len(lines))
+		for index, line := range lines {
+			row := cloneERPPayload(line)
+			if lineNo, ok := toERPFloat64(row["lineNo"]); !ok || lineNo <= 0 {
+				row["lineNo"] = index + 1
+			}
+			amount, ok := toERPFloat64(row["amount"])
+			if !ok {
+				qty, _ := toERPFloat64(row["quantity"])
+				price, _ := toERPFloat64(row["unitPrice"])
+				amount = qty * price
+			}
+			if amount < 0 {
+				return fmt.Errorf("字段 lines[%d].amount 超出范围", index)
+			}
+			row["amount"] = normalizeERPNumber(amount)
+			total += amount
+			normalized = append(normalized, row)
+		}
+		payload["lines"] = normalized
+		payload["amount"] = normalizeERPNumber(total)
+	}
+	return deriveSettlementReceivableDate(payload)
+}
+
+// deriveBankReceiptAllocations 校验水单认领分摊：每行需指定结汇单号与正数金额，合计不超过收汇金额。
+func deriveBankReceiptAllocations(payload map[string]any) error {
+	raw, exists := payload["allocations"]
+	if !exists || isEmptyERPValue(raw) {
+		return nil
+	}
+	allocations, err := getERPItemsField(payload, "allocations")
+	if err != nil {
+		return err
+	}
+	total := 0.0
+	for index, allocation := range allocations {
+		if erpPayloadString(allocation, "settlementCode") == "" {
+			return fmt.Errorf("字段 allocations[%d].settlementCode 不能为空", index)
+		}
+		amount, ok := toERPFloat64(allocation["amount"])
+		if !ok || amount <= 0 {
+			return fmt.Errorf("字段 allocations[%d].amount 超出范围", index)
+		}
+		total += amount
+	}
+	if received, ok := toERPFloat64(payload["receivedAmount"]); ok && total > received+0.0001 {
+		return fmt.Errorf("字段 allocations 合计超过收汇金额")
+	}
+	return nil
+}
+
+// getERPItemsField 读取 payload 中的明细数组字段，格式错误时提示实际字段名。
+func getERPItemsField(payload map[string]any, field string) ([]map[string]any, error) {
+	rows, err := getERPItems(payload[field])
+	if err != nil {
+		return nil, fmt.Errorf("字段 %s 格式非法", field)
+	}
+	return rows, nil
+}
@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecaseGenerateSettlementAndReceivables(t *testing.T) {
+	repo := newMemERPRepo()
+	logger := log.NewStdLogger(io.Discard)
+	uc := NewERPUsecase(repo, logger, tracesdk.NewTracerProvider())
+	ctx := context.Background()
+
+	if _, err := uc.Create(ctx, "partners", map[string]any{
+		"partnerType": "合作客户", "name": "客户A", "address": "Hamburg", "contact": "Tom",
+		"contactPhone": "+49-1", "paymentCycleDays": 60,
+	}, 1); err != nil {
+		t.Fatalf("create partner failed: %v", err)
+	}
+	if _, err := uc.Create(ctx, "shipmentDetails", map[string]any{
+		"code": "CY-001", "customerName": "客户A", "startPort": "宁波", "destPort": "Hamburg",
+		"shipToAddress": "Germany Warehouse", "transportType": "海运", "arriveCountry": "Germany",
+		"salesOwner": "业务员A", "warehouseShipDate": "2026-02-10",
+		"items": []any{
+			map[string]any{"productModel": "磁钢A", "quantity": 100, "unitPrice": 5},
+			map[string]any{"productModel": "磁钢B", "quantity": 50, "unitPrice": 10},
+		},
+	}, 1); err != nil {
+		t.Fatalf("create shipment failed: %v", err)
+	}
+
+	settlement, err := uc.GenerateSettlement(ctx, "CY-001", 1)
+	if err != nil {
+		t.Fatalf("generate settlement failed: %v", err)
+	}
+	if settlement["code"] != "JH-001" || settlement["invoiceNo"] != "CY-001" {
+		t.Fatalf("unexpected settlement header: %v/%v", settlement["code"], settlement["invoiceNo"])
+	}
+	if settlement["amount"] != int64(1000) || settlement["receivableDate"] != "2026-04-11" {
+		t.Fatalf("unexpected amount/receivableDate: %v/%v", settlement["amount"], settlement["receivableDate"])
+	}
+	lines, _ := settlement["lines"].([]any)
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 settlement lines, got %d", len(lines))
+	}
+
+	if _, err := uc.GenerateSettlement(ctx, "CY-001", 1); !errors.Is(err, ErrERPInvalidRecord) {
+		t.Fatalf("duplicate generation should be rejected, got %v", err)
+	}
+
+	if _, err := uc.Create(ctx, "bankReceipts", map[string]any{
+		"fundType": "客户货款尾款", "refNo": "CY-001", "receivedAmount": 500, "bankFee": 15,
+		"registerDate": "2026-03-01", "box": "确认箱",
+		"allocations": []any{
+			map[string]any{"settlementCode": "JH-001", "lineNo": 2, "amount": 500},
+		},
+	}, 1); err != nil {
+		t.Fatalf("create bank receipt failed: %v", err)
+	}
+	if _, err := uc.Create(ctx, "bankReceipts", map[string]any{
+		"fundType": "客户货款尾款", "refNo": "CY-001", "receivedAmount": 200, "bankFee": 15,
+		"registerDate": "2026-03-05", "box": "确认箱",
+		"allocations": []any{
+			map[string]any{"settlementCode": "JH-001", "amount": 200},
+		},
+	}, 1); err != nil {
+		t.Fatalf("create bank receipt failed: %v", err)
+	}
+	if _, err := uc.Create(ctx, "bankReceipts", map[string]any{
+		"fundType": "客户货款尾款", "refNo": "CY-001", "receivedAmount": 100, "bankFee": 0,
+		"registerDate": "2026-03-06",
+		"allocations": []any{
+			map[string]any{"settlementCode": "JH-001", "amount": 150},
+		},
+	}, 1); !errors.Is(err, ErrERPInvalidRecord) {
+		t.Fatalf("allocations over received amount should be rejected, got %v", err)
+	}
+
+	receivables, err := uc.Receivables(ctx, ERPReceivableFilter{SettlementCode: "JH-001"})
+	if err != nil {
+		t.Fatalf("receivables failed: %v", err)
+	}
+	if len(receivables) != 1 {
+		t.Fatalf("expected 1 receivable, got %d", len(receivables))
+	}
+	receivable := receivables[0]
+	if receivable.ReceivedAmount != 700 || receivable.OutstandingAmount != 300 || receivable.Status != ERPReceivableStatusPartial {
+		t.Fatalf("unexpected settlement status: %+v", receivable)
+	}
+	if receivable.Lines[0].ReceivedAmount != 200 || receivable.Lines[0].Status != ERPReceivableStatusPartial {
+		t.Fatalf("unexpected line 1 status: %+v", receivable.Lines[0])
+	}
+	if receivable.Lines[1].ReceivedAmount != 500 || receivable.Lines[1].Status != ERPReceivableStatusClosed {
+		t.Fatalf("unexpected line 2 status: %+v", receivable.Lines[1])
+	}
+}
@@ -0,0 +1,382 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	ERPShipmentCostBasisVolume = "体积"
+	ERPShipmentCostBasisWeight = "重量"
+	ERPShipmentCostBasisValue  = "货值"
+)
+
+var erpShipmentCostBases = map[string]struct{}{
+	ERPShipmentCostBasisVolume: {},
+	ERPShipmentCostBasisWeight: {},
+	ERPShipmentCostBasisValue:  {},
+}
+
+var erpShipmentCostTypes = map[string]struct{}{
+	"货代运费": {},
+	"港杂费":  {},
+	"保险费":  {},
+	"快递费":  {},
+	"其他费用": {},
+}
+
+// erpDefaultCostCurrency 费用单、付款未填写币种时按人民币处理。
+const erpDefaultCostCurrency = "CNY"
+
+// ERPShipmentCostShare 一张费用单分摊到某个出运行的金额。
+type ERPShipmentCostShare struct {
+	CostCode     string
+	CostType     string
+	SupplierName string
+	Currency     string
+	Amount       float64
+	AmountCNY    float64
+	RateFound    bool
+}
+
+// ERPShipmentItemCost 出运明细行承担的物流费用。
+type ERPShipmentItemCost struct {
+	ShipmentCode string
+	LineNo       int
+	ProductModel string
+	Quantity     float64
+	Volume       float64
+	Weight       float64
+	Value        float64
+	TotalCNY     float64
+	Costs        []*ERPShipmentCostShare
+}
+
+// ERPShipmentCostReport 单个出运明细的费用分摊结果。
+type ERPShipmentCostReport struct {
+	ShipmentCode      string
+	CostCodes         []string
+	TotalCNY          float64
+	MissingCurrencies []string
+	Items             []*ERPShipmentItemCost
+}
+
+// ShipmentCostAllocation 返回出运明细各行分摊到的物流费用。
+func (uc *ERPUsecase) ShipmentCostAllocation(ctx context.Context, shipmentCode string) (*ERPShipmentCostReport, error) {
+	shipmentCode = strings.TrimSpace(shipmentCode)
+	if shipmentCode == "" {
+		return nil, ErrBadParam
+	}
+	ds, err := uc.loadERPDataset(ctx,
+		ERPModuleShipmentDetails,
+		ERPModuleShipmentCosts,
+		ERPModuleExchangeRates,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if ds.findByCode(ERPModuleShipmentDetails, shipmentCode) == nil {
+		return nil, ErrERPRecordNotFound
+	}
+
+	items := ds.shipmentItemCosts()[shipmentCode]
+	if items == nil {
+		items = []*ERPShipmentItemCost{}
+	}
+	total, codes, missing := ds.shipmentCostsCNY(shipmentCode)
+	report := &ERPShipmentCostReport{
+		ShipmentCode:      shipmentCode,
+		CostCodes:         codes,
+		TotalCNY:          total,
+		MissingCurrencies: missing,
+		Items:             items,
+	}
+	report.TotalCNY = roundERPAmount(report.TotalCNY)
+	return report, nil
+}
+
+// shipmentItemCosts 将全部费用单分摊到关联出运明细的各行，结果按出运单号分组并在同一次计算内缓存。
+//
+// 一张费用单关联多个出运明细时，先把这些出运行合并，再按分摊依据统一分摊；
+// 分摊依据合计为 0 时退回按数量分摊，数量也为 0 时平均分摊。尾差计入最后一行。
+func (ds *erpDataset) shipmentItemCosts() map[string][]*ERPShipmentItemCost {
+	if ds.shipmentCosts != nil {
+		return ds.shipmentCosts
+	}
+	out := map[string][]*ERPShipmentItemCost{}
+	rowsOf := func(shipment *ERPRecord) []*ERPShipmentItemCost {
+		if cached, ok := out[shipment.Code]; ok {
+			return cached
+		}
+		items, _ := getERPItems(shipment.Payload["items"])
+		list := make([]*ERPShipmentItemCost, 0, len(items))
+		for index, item := range items {
+			productModel := erpPayloadString(item, "productModel")
+			if productModel == "" {
+				productModel = erpPayloadString(item, "productName")
+			}
+			weight := erpPayloadFloat(item, "grossWeight")
+			if weight <= 0 {
+				weight = erpPayloadFloat(item, "netWeight")
+			}
+			quantity := erpPayloadFloat(item, "quantity")
+			value, ok := toERPFloat64(item["totalPrice"])
+			if !ok {
+				value = quantity * erpPayloadFloat(item, "unitPrice")
+			}
+			list = append(list, &ERPShipmentItemCost{
+				ShipmentCode: shipment.Code,
+				LineNo:       index + 1,
+				ProductModel: productModel,
+				Quantity:     quantity,
+				Volume:       erpPayloadFloat(item, "volume"),
+				Weight:       weight,
+				Value:        value,
+				Costs:        []*ERPShipmentCostShare{},
+			})
+		}
+		out[shipment.Code] = list
+		return list
+	}
+
+	for _, cost := range ds.list(ERPModuleShipmentCosts) {
+		if cost == nil {
+			continue
+		}
+		pool := []*ERPShipmentItemCost{}
+		for _, code := range erpShipmentCostCodes(cost.Payload) {
+			if shipment := ds.findByCode(ERPModuleShipmentDetails, code); shipment != nil {
+				pool = append(pool, rowsOf(shipment)...)
+			}
+		}
+		if len(pool) == 0 {
+			continue
+		}
+
+		currency := erpCostCurrency(cost.Payload)
+		rate, rateFound := ds.exchangeRate(currency, erpRecordDate(cost, "costDate"))
+		amount := erpPayloadFloat(cost.Payload, "amount")
+		weights := erpShipmentCostWeights(pool, erpPayloadString(cost.Payload, "allocationBasis"))
+		remaining := amount
+		for index, row := range pool {
+			share := roundERPAmount(amount * weights[index])
+			if index == len(pool)-1 {
+				share = roundERPAmount(remaining)
+			}
+			remaining -= share
+			entry := &ERPShipmentCostShare{
+				CostCode:     cost.Code,
+				CostType:     erpPayloadString(cost.Payload, "costType"),
+				SupplierName: erpPayloadString(cost.Payload, "supplierName"),
+				Currency:     currency,
+				Amount:       share,
+				RateFound:    rateFound,
+			}
+			if rateFound {
+				entry.AmountCNY = roundERPAmount(share * rate)
+				row.TotalCNY += entry.AmountCNY
+			}
+			row.Costs = append(row.Costs, entry)
+		}
+	}
+	for _, list := range out {
+		for _, row := range list {
+			row.TotalCNY = roundERPAmount(row.TotalCNY)
+		}
+	}
+	ds.shipmentCosts = out
+	return out
+}
+
+// erpShipmentCostWeights 返回 pool 中各行按分摊依据的占比。
+func erpShipmentCostWeights(pool []*ERPShipmentItemCost, basis string) []float64 {
+	pick := func(row *ERPShipmentItemCost) float64 {
+		switch basis {
+		case ERPShipmentCostBasisVolume:
+			return row.Volume
+		case ERPShipmentCostBasisWeight:
+			return row.Weight
+		default:
+			return row.Value
+		}
+	}
+	weights := make([]float64, len(pool))
+	for _, measure := range []func(*ERPShipmentItemCost) float64{
+		pick,
+		func(row *ERPShipmentItemCost) float64 { return row.Quantity },
+	} {
+		total := 0.0
+		for _, row := range pool {
+			if value := measure(row); value > 0 {
+				total += value
+			}
+		}
+		if total <= 0 {
+			continue
+		}
+		for index, row := range pool {
+			if value := measure(row); value > 0 {
+				weights[index] = value / total
+			}
+		}
+		return weights
+	}
+	for index := range weights {
+		weights[index] = 1 / float64(len(pool))
+	}
+	return weights
+}
+
+// shipmentCostsCNY 汇总出运明细承担的物流费用（人民币），返回缺少汇率的币种。
+func (ds *erpDataset) shipmentCostsCNY(shipmentCode string) (float64, []string, []string) {
+	total := 0.0
+	codes := []string{}
+	missing := []string{}
+	seenCodes := map[string]struct{}{}
+	seenMissing := map[string]struct{}{}
+	for _, row := range ds.shipmentItemCosts()[shipmentCode] {
+		for _, share := range row.Costs {
+			if _, ok := seenCodes[share.CostCode]; !ok {
+				seenCodes[share.CostCode] = struct{}{}
+				codes = append(codes, share.CostCode)
+			}
+			if !share.RateFound {
+				if _, ok := seenMissing[share.Currency]; !ok {
+					seenMissing[share.Currency] = struct{}{}
+					missing = append(missing, share.Currency)
+				}
+				continue
+			}
+			total += share.AmountCNY
+		}
+	}
+	return total, codes, missing
+}
+
+// buildERPShipmentCostPayables 每张费用单按费用日期 + 付款周期形成一笔对物流供应商的应付。
+func buildERPShipmentCostPayables(ds *erpDataset) []*ERPPayable {
+	payables := []*ERPPayable{}
+	for _, cost := range ds.list(ERPModuleShipmentCosts) {
+		if cost == nil || cost.Code == "" {
+			continue
+		}
+		supplierName := erpPayloadString(cost.Payload, "supplierName")
+		payable := &ERPPayable{
+			PurchaseCode:    cost.Code,
+			SourceModule:    ERPModuleShipmentCosts,
+			SupplierName:    supplierName,
+			Currency:        erpCostCurrency(cost.Payload),
+			InvoiceRequired: false,
+			InvoiceNos:      []string{},
+		}
+		if days, ok := toERPFloat64(cost.Payload["paymentCycleDays"]); ok {
+			payable.PaymentCycleDays = int(days)
+		} else if partner := ds.findPartner(supplierName); partner != nil {
+			payable.PaymentCycleDays = int(erpPayloadFloat(partner.Payload, "paymentCycleDays"))
+		}
+		amount := roundERPAmount(erpPayloadFloat(cost.Payload, "amount"))
+		costDate := erpRecordDate(cost, "costDate")
+		payable.ContractAmount = amount
+		payable.PayableAmount = amount
+		payable.Entries = []*ERPPayableEntry{{
+			PurchaseCode: cost.Code,
+			SupplierName: supplierName,
+			Currency:     payable.Currency,
+			CostType:     erpPayloadString(cost.Payload, "costType"),
+			Amount:       amount,
+			InboundDate:  costDate,
+			DueDate:      costDate.AddDate(0, 0, payable.PaymentCycleDays),
+		}}
+		if invoiceNo := erpPayloadString(cost.Payload, "invoiceNo"); invoiceNo != "" {
+			payable.InvoiceNos = append(payable.InvoiceNos, invoiceNo)
+			payable.InvoicedAmount = amount
+		}
+		payables = append(payables, payable)
+	}
+	return payables
+}
+
+// erpShipmentCostCodes 读取费用单关联的出运单号，兼容数组与逗号分隔字符串。
+func erpShipmentCostCodes(payload map[string]any) []string {
+	codes := []string{}
+	seen := map[string]struct{}{}
+	add := func(raw string) {
+		for _, part := range strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == '，' }) {
+			code := strings.TrimSpace(part)
+			if code == "" {
+				continue
+			}
+			if _, ok := seen[code]; ok {
+				continue
+			}
+			seen[code] = struct{}{}
+			codes = append(codes, code)
+		}
+	}
+	switch value := payload["shipmentCodes"].(type) {
+	case []any:
+		for _, item := range value {
+			if code, ok := item.(string); ok {
+				add(code)
+			}
+		}
+	case []string:
+		for _, code := range value {
+			add(code)
+		}
+	case string:
+		add(value)
+	}
+	return codes
+}
+
+// erpCostCurrency 读取 payload 币种并统一为大写，未填写时为人民币。
+func erpCostCurrency(payload map[string]any) string {
+	currency := strings.ToUpper(erpPayloadString(payload, "currency"))
+	if currency == "" || currency == "RMB" {
+		return erpDefaultCostCurrency
+	}
+	return currency
+}
+
+func deriveShipmentCost(payload map[string]any) error {
+	if _, exists := payload["shipmentCodes"]; !exists {
+		if code := erpPayloadString(payload, "shipmentCode"); code != "" {
+			payload["shipmentCodes"] = code
+		}
+	}
+	codes := erpShipmentCostCodes(payload)
+	if len(codes) == 0 {
+		delete(payload, "shipmentCodes")
+	} else {
+		normalized := make([]any, 0, len(codes))
+		for _, code := range codes {
+			normalized = append(normalized, code)
+		}
+		payload["shipmentCodes"] = normalized
+	}
+	delete(payload, "shipmentCode")
+
+	if costType := erpPayloadString(payload, "costType"); costType != "" {
+		if _, ok := erpShipmentCostTypes[costType]; !ok {
+			return fmt.Errorf("字段 costType 非法")
+		}
+	}
+	basis := erpPayloadString(payload, "allocationBasis")
+	if basis == "" {
+		basis = ERPShipmentCostBasisValue
+	}
+	if _, ok := erpShipmentCostBases[basis]; !ok {
+		return fmt.Errorf("字段 allocationBasis 非法")
+	}
+	payload["allocationBasis"] = basis
+	payload["currency"] = erpCostCurrency(payload)
+	if raw, exists := payload["paymentCycleDays"]; exists && !isEmptyERPValue(raw) {
+		days, ok := toERPFloat64(raw)
+		if !ok || days < 0 {
+			return fmt.Errorf("字段 paymentCycleDays 超出范围")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestERPUsecaseShipmentCostAllocation(t *testing.T) {
+	repo := newMemERPRepo()
+	logger := log.NewStdLogger(io.Discard)
+	uc := NewERPUsecase(repo, logger, tracesdk.NewTracerProvider())
+	ctx := context.Background()
+
+	mustCreate := func(moduleKey string, payload map[string]any) {
+		t.Helper()
+		if _, err := uc.Create(ctx, moduleKey, payload, 1); err != nil {
+			t.Fatalf("create %s failed: %v", moduleKey, err)
+		}
+	}
+
+	seedERPPartners(t, repo, "合作客户", "客户A", "客户B")
+	mustCreate("exchangeRates", map[string]any{"currency": "USD", "rateToCNY": 7, "effectiveDate": "2026-01-01"})
+	mustCreate("partners", map[string]any{
+		"partnerType": "合作供应商", "name": "货代A", "address": "宁波", "contact": "王",
+		"contactPhone": "1", "paymentCycleDays": 30,
+	})
+	mustCreate("exportSales", map[string]any{
+		"code": "XS-001", "customerName": "客户A", "customerContractNo": "HT-001", "signDate": "2026-01-10",
+		"deliveryDate": "2026-02-10", "transportType": "海运", "orderFlow": "成品采购", "currency": "USD",
+		"items": []any{map[string]any{"productName": "磁钢A", "quantity": 100, "unitPrice": 10}},
+	})
+	mustCreate("shipmentDetails", map[string]any{
+		"code": "CY-001", "customerName": "客户A", "startPort": "宁波", "destPort": "Hamburg",
+		"shipToAddress": "Germany Warehouse", "transportType": "海运", "arriveCountry": "Germany",
+		"salesOwner": "业务员A", "warehouseShipDate": "2026-02-10", "sourceExportCode": "XS-001",
+		"items": []any{
+			map[string]any{"productModel": "磁钢A", "quantity": 60, "unitPrice": 10, "volume": 3, "grossWeight": 100},
+			map[string]any{"productModel": "磁钢B", "quantity": 40, "unitPrice": 10, "volume": 1, "grossWeight": 300},
+		},
+	})
+	mustCreate("shipmentDetails", map[string]any{
+		"code": "CY-002", "customerName": "客户B", "startPort": "宁波", "destPort": "Hamburg",
+		"shipToAddress": "Germany Warehouse", "transportType": "海运", "arriveCountry": "Germany",
+		"salesOwner": "业务员B", "warehouseShipDate": "2026-02-10",
+		"items": []any{map[string]any{"productModel": "磁钢C", "quantity": 10, "volume": 4}},
+	})
+	// 海运费两票拼柜按体积分摊：CY-001 两行 3:1，CY-002 占 4。
+	mustCreate("shipmentCosts", map[string]any{
+		"code": "FY-001", "shipmentCodes": "CY-001, CY-002", "costType": "货代运费", "supplierName": "货代A",
+		"currency": "usd", "amount": 800, "costDate": "2026-02-12", "allocationBasis": "体积",
+	})
+	mustCreate("shipmentCosts", map[string]any{
+		"code": "FY-002", "shipmentCode": "CY-001", "costType": "港杂费", "supplierName": "货代A",
+		"amount": 400, "costDate": "2026-02-12", "allocationBasis": "重量",
+	})
+
+	if _, err := uc.Create(ctx, "shipmentCosts", map[string]any{
+		"shipmentCodes": "CY-001", "costType": "货代运费", "supplierName": "货代A",
+		"amount": 100, "costDate": "2026-02-12", "allocationBasis": "件数",
+	}, 1); !errors.Is(err, ErrERPInvalidRecord) {
+		t.Fatalf("unknown allocation basis should be rejected, got %v", err)
+	}
+
+	report, err := uc.ShipmentCostAllocation(ctx, "CY-001")
+	if err != nil {
+		t.Fatalf("shipment cost allocation failed: %v", err)
+	}
+	if len(report.Items) != 2 || len(report.CostCodes) != 2 {
+		t.Fatalf("unexpected allocation report: %+v", report)
+	}
+	// 行1：运费 800×3/8=300 USD → 2100 CNY，港杂 400×1/4=100；行2：运费 100 USD → 700，港杂 300。
+	if report.Items[0].TotalCNY != 2200 || report.Items[1].TotalCNY != 1000 || report.TotalCNY != 3200 {
+		t.Fatalf("unexpected item allocation: %v/%v total %v",
+			report.Items[0].TotalCNY, report.Items[1].TotalCNY, report.TotalCNY)
+	}
+
+	payables, err := uc.Payables(ctx, ERPPayableFilter{SupplierName: "货代A"})
+	if err != nil {
+		t.Fatalf("payables failed: %v", err)
+	}
+	if len(payables) != 2 {
+		t.Fatalf("expected 2 cost payables, got %d", len(payables))
+	}
+	var freight *ERPPayable
+	for _, item := range payables {
+		if item.PurchaseCode == "FY-001" {
+			freight = item
+		}
+	}
+	if freight == nil || freight.SourceModule != ERPModuleShipmentCosts || freight.Currency != "USD" ||
+		freight.Entries[0].DueDate.Format("2006-01-02") != "2026-03-14" {
+		t.Fatalf("unexpected cost payable: %+v", freight)
+	}
+
+	profit, err := uc.OrderProfit(ctx, ERPOrderProfitFilter{})
+	if err != nil {
+		t.Fatalf("order profit failed: %v", err)
+	}
+	if len(profit.Rows) != 1 || profit.Rows[0].LogisticsCost != 3200 {
+		t.Fatalf("order profit should include allocated logistics cost, got %+v", profit.Rows)
+	}
+	// 1000 × 7 - 3200 = 3800
+	if profit.Rows[0].GrossMarginCNY != 3800 {
+		t.Fatalf("unexpected gross margin: %v", profit.Rows[0].GrossMarginCNY)
+	}
+}
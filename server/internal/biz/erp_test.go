@@ -36,6 +36,17 @@ func (r *memERPRepo) ListByModule(ctx context.Context, moduleKey string) ([]*ERP
 	return out, nil
 }
 
+func (r *memERPRepo) GetByID(ctx context.Context, moduleKey string, id int) (*ERPRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, item := range r.records[moduleKey] {
+		if item.ID == id {
+			return cloneERPRecord(item), nil
+		}
+	}
+	return nil, ErrERPRecordNotFound
+}
+
 func (r *memERPRepo) Create(ctx context.Context, moduleKey string, payload map[string]any, createdByAdminID int) (*ERPRecord, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -49,6 +60,7 @@ func (r *memERPRepo) Create(ctx context.Context, moduleKey string, payload map[s
 		Code:      code,
 		Box:       box,
 		Payload:   cloneMap(payload),
+		Version:   1,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
@@ -61,7 +73,7 @@ func (r *memERPRepo) Create(ctx context.Context, moduleKey string, payload map[s
 	return cloneERPRecord(record), nil
 }
 
-func (r *memERPRepo) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, updatedByAdminID int) (*ERPRecord, error) {
+func (r *memERPRepo) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, expectedVersion, updatedByAdminID int) (*ERPRecord, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -70,12 +82,16 @@ func (r *memERPRepo) Update(ctx context.Context, moduleKey string, id int, paylo
 		if item.ID != id {
 			continue
 		}
+		if expectedVersion > 0 && item.Version != expectedVersion {
+			return nil, ErrERPVersionConflict
+		}
 		code, _ := payload["code"].(string)
 		box, _ := payload["box"].(string)
 		item.Code = code
 		item.Box = box
 		item.Payload = cloneMap(payload)
 		item.UpdatedAt = time.Now()
+		item.Version++
 		if updatedByAdminID > 0 {
 			item.UpdatedByAdminID = &updatedByAdminID
 		}
@@ -144,12 +160,28 @@ func TestERPUsecaseCRUD(t *testing.T) {
 		"contactPhone":     "13800001111",
 		"paymentCycleDays": 45,
 		"box":              "草稿箱",
-	}, 2)
+		"version":          1,
+	}, 1, 2)
 	if err != nil {
 		t.Fatalf("update failed: %v", err)
 	}
-	if updated["name"] != "客户A-更新" {
-		t.Fatalf("unexpected updated name: %v", updated["name"])
+	if updated["name"] != "客户A-更新" || updated["version"] != 2 {
+		t.Fatalf("unexpected updated record: name=%v version=%v", updated["name"], updated["version"])
+	}
+	if _, ok := repo.records["partners"][0].Payload["version"]; ok {
+		t.Fatalf("version should not be stored in payload")
+	}
+	// 基于旧版本的修改被拒绝
+	stale := map[string]any{
+		"code": "CS-001", "partnerType": "合作客户", "name": "客户A-旧", "address": "浙江杭州",
+		"contact": "张三", "contactPhone": "13800001111", "paymentCycleDays": 30, "box": "草稿箱", "version": 1,
+	}
+	if _, err := uc.Update(ctx, "partners", id, stale, 1, 3); !errors.Is(err, ErrERPVersionConflict) {
+		t.Fatalf("stale update should conflict, got %v", err)
+	}
+	current, err := uc.Get(ctx, "partners", id)
+	if err != nil || current["name"] != "客户A-更新" {
+		t.Fatalf("Get() = %v, %v", current, err)
 	}
 
 	if err := uc.Delete(ctx, "partners", id); err != nil {
@@ -186,6 +218,7 @@ func TestERPUsecaseInvalidArgs(t *testing.T) {
 
 func TestERPUsecaseDeriveFields(t *testing.T) {
 	repo := newMemERPRepo()
+	seedERPPartners(t, repo, "合作客户", "客户A")
 	logger := log.NewStdLogger(io.Discard)
 	uc := NewERPUsecase(repo, logger, tracesdk.NewTracerProvider())
 
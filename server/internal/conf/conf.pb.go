@@ -149,6 +149,8 @@ type Data struct {
 	Auth                  *Data_Auth             `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
 	AdminAuth             *Data_AdminAuth        `protobuf:"bytes,4,opt,name=admin_auth,json=adminAuth,proto3" json:"admin_auth,omitempty"`
 	UserExpiryWarningDays int32                  `protobuf:"varint,5,opt,name=user_expiry_warning_days,json=userExpiryWarningDays,proto3" json:"user_expiry_warning_days,omitempty"` // 默认几天算过期
+	AdminSecurity         *Data_AdminSecurity    `protobuf:"bytes,6,opt,name=admin_security,json=adminSecurity,proto3" json:"admin_security,omitempty"`                              // 管理员密码与登录锁定策略
+	Erp                   *Data_Erp              `protobuf:"bytes,7,opt,name=erp,proto3" json:"erp,omitempty"`                                                                       // ERP 业务规则
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -218,6 +220,20 @@ func (x *Data) GetUserExpiryWarningDays() int32 {
 	return 0
 }
 
+func (x *Data) GetAdminSecurity() *Data_AdminSecurity {
+	if x != nil {
+		return x.AdminSecurity
+	}
+	return nil
+}
+
+func (x *Data) GetErp() *Data_Erp {
+	if x != nil {
+		return x.Erp
+	}
+	return nil
+}
+
 type Trace struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Jaeger        *Trace_Jaeger          `protobuf:"bytes,1,opt,name=jaeger,proto3" json:"jaeger,omitempty"`
@@ -351,12 +367,13 @@ func (x *Notify) GetTelegram() *Notify_Telegram {
 }
 
 type Server_HTTP struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Network        string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr           string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout        *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	TrustedProxies []string               `protobuf:"bytes,4,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"` // 可信反向代理的 IP 或 CIDR；仅当请求来自这些地址时才采用 X-Forwarded-For / X-Real-IP 作为客户端 IP
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Server_HTTP) Reset() {
@@ -410,6 +427,13 @@ func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *Server_HTTP) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 type Server_GRPC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -686,6 +710,158 @@ func (x *Data_AdminAuth) GetAdmin() *Data_AdminAuth_Admin {
 	return nil
 }
 
+type Data_AdminSecurity struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PasswordMinLength   int32                  `protobuf:"varint,1,opt,name=password_min_length,json=passwordMinLength,proto3" json:"password_min_length,omitempty"`           // 密码最小长度，默认 8
+	PasswordMinClasses  int32                  `protobuf:"varint,2,opt,name=password_min_classes,json=passwordMinClasses,proto3" json:"password_min_classes,omitempty"`        // 至少包含几类字符（大写/小写/数字/符号），默认 3
+	MaxFailedAttempts   int32                  `protobuf:"varint,3,opt,name=max_failed_attempts,json=maxFailedAttempts,proto3" json:"max_failed_attempts,omitempty"`           // 窗口内失败几次锁定账号，默认 5
+	FailedWindowSeconds int32                  `protobuf:"varint,4,opt,name=failed_window_seconds,json=failedWindowSeconds,proto3" json:"failed_window_seconds,omitempty"`     // 失败计数窗口（秒），默认 900
+	LockoutSeconds      int32                  `protobuf:"varint,5,opt,name=lockout_seconds,json=lockoutSeconds,proto3" json:"lockout_seconds,omitempty"`                      // 锁定时长（秒），默认 900
+	TotpRequiredLevels  []int32                `protobuf:"varint,6,rep,packed,name=totp_required_levels,json=totpRequiredLevels,proto3" json:"totp_required_levels,omitempty"` // 强制启用两步验证的管理员等级（0=超级，1=一级，2=二级）
+	TotpIssuer          string                 `protobuf:"bytes,7,opt,name=totp_issuer,json=totpIssuer,proto3" json:"totp_issuer,omitempty"`                                   // 验证器 App 中显示的发行方，默认 ERP
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Data_AdminSecurity) Reset() {
+	*x = Data_AdminSecurity{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_AdminSecurity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_AdminSecurity) ProtoMessage() {}
+
+func (x *Data_AdminSecurity) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_AdminSecurity.ProtoReflect.Descriptor instead.
+func (*Data_AdminSecurity) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Data_AdminSecurity) GetPasswordMinLength() int32 {
+	if x != nil {
+		return x.PasswordMinLength
+	}
+	return 0
+}
+
+func (x *Data_AdminSecurity) GetPasswordMinClasses() int32 {
+	if x != nil {
+		return x.PasswordMinClasses
+	}
+	return 0
+}
+
+func (x *Data_AdminSecurity) GetMaxFailedAttempts() int32 {
+	if x != nil {
+		return x.MaxFailedAttempts
+	}
+	return 0
+}
+
+func (x *Data_AdminSecurity) GetFailedWindowSeconds() int32 {
+	if x != nil {
+		return x.FailedWindowSeconds
+	}
+	return 0
+}
+
+func (x *Data_AdminSecurity) GetLockoutSeconds() int32 {
+	if x != nil {
+		return x.LockoutSeconds
+	}
+	return 0
+}
+
+func (x *Data_AdminSecurity) GetTotpRequiredLevels() []int32 {
+	if x != nil {
+		return x.TotpRequiredLevels
+	}
+	return nil
+}
+
+func (x *Data_AdminSecurity) GetTotpIssuer() string {
+	if x != nil {
+		return x.TotpIssuer
+	}
+	return ""
+}
+
+type Data_Erp struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	FulfilmentTolerancePercent  float64                `protobuf:"fixed64,1,opt,name=fulfilment_tolerance_percent,json=fulfilmentTolerancePercent,proto3" json:"fulfilment_tolerance_percent,omitempty"`     // 下游累计数量（采购、入库、出运、出库）允许超出来源单据数量的百分比，默认 0；来源单据填写 tolerancePercent 时以单据为准
+	MinMarginPercent            float64                `protobuf:"fixed64,2,opt,name=min_margin_percent,json=minMarginPercent,proto3" json:"min_margin_percent,omitempty"`                                   // 报价单价低于最近采购成本加该百分比毛利时提示，默认 0 表示只提示低于成本
+	QuotationStatusCheckMinutes int32                  `protobuf:"varint,3,opt,name=quotation_status_check_minutes,json=quotationStatusCheckMinutes,proto3" json:"quotation_status_check_minutes,omitempty"` // 报价单状态检查间隔（分钟）：过期报价单改为已过期、被外销合同引用的改为已成交；0 表示不启动
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *Data_Erp) Reset() {
+	*x = Data_Erp{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Erp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Erp) ProtoMessage() {}
+
+func (x *Data_Erp) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Erp.ProtoReflect.Descriptor instead.
+func (*Data_Erp) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_Erp) GetFulfilmentTolerancePercent() float64 {
+	if x != nil {
+		return x.FulfilmentTolerancePercent
+	}
+	return 0
+}
+
+func (x *Data_Erp) GetMinMarginPercent() float64 {
+	if x != nil {
+		return x.MinMarginPercent
+	}
+	return 0
+}
+
+func (x *Data_Erp) GetQuotationStatusCheckMinutes() int32 {
+	if x != nil {
+		return x.QuotationStatusCheckMinutes
+	}
+	return 0
+}
+
 type Data_Auth_Admin struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -696,7 +872,7 @@ type Data_Auth_Admin struct {
 
 func (x *Data_Auth_Admin) Reset() {
 	*x = Data_Auth_Admin{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -708,7 +884,7 @@ func (x *Data_Auth_Admin) String() string {
 func (*Data_Auth_Admin) ProtoMessage() {}
 
 func (x *Data_Auth_Admin) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +924,7 @@ type Data_AdminAuth_Admin struct {
 
 func (x *Data_AdminAuth_Admin) Reset() {
 	*x = Data_AdminAuth_Admin{}
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -760,7 +936,7 @@ func (x *Data_AdminAuth_Admin) String() string {
 func (*Data_AdminAuth_Admin) ProtoMessage() {}
 
 func (x *Data_AdminAuth_Admin) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +977,7 @@ type Trace_Jaeger struct {
 
 func (x *Trace_Jaeger) Reset() {
 	*x = Trace_Jaeger{}
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -813,7 +989,7 @@ func (x *Trace_Jaeger) String() string {
 func (*Trace_Jaeger) ProtoMessage() {}
 
 func (x *Trace_Jaeger) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,7 +1036,7 @@ type Notify_Telegram struct {
 
 func (x *Notify_Telegram) Reset() {
 	*x = Notify_Telegram{}
-	mi := &file_conf_conf_proto_msgTypes[15]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -872,7 +1048,7 @@ func (x *Notify_Telegram) String() string {
 func (*Notify_Telegram) ProtoMessage() {}
 
 func (x *Notify_Telegram) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[15]
+	mi := &file_conf_conf_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,25 +1088,29 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12'\n" +
 	"\x05trace\x18\x03 \x01(\v2\x11.kratos.api.TraceR\x05trace\x12!\n" +
-	"\x03log\x18\x04 \x01(\v2\x0f.kratos.api.LogR\x03log\"\xb8\x02\n" +
+	"\x03log\x18\x04 \x01(\v2\x0f.kratos.api.LogR\x03log\"\xe2\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1a\x92\x01\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12'\n" +
+	"\x0ftrusted_proxies\x18\x04 \x03(\tR\x0etrustedProxies\x1ai\n" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xe5\x05\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xe5\n" +
+	"\n" +
 	"\x04Data\x12,\n" +
 	"\x05mysql\x18\x01 \x01(\v2\x16.kratos.api.Data.MysqlR\x05mysql\x12)\n" +
 	"\x04etcd\x18\x02 \x01(\v2\x15.kratos.api.Data.EtcdR\x04etcd\x12)\n" +
 	"\x04auth\x18\x03 \x01(\v2\x15.kratos.api.Data.AuthR\x04auth\x129\n" +
 	"\n" +
 	"admin_auth\x18\x04 \x01(\v2\x1a.kratos.api.Data.AdminAuthR\tadminAuth\x127\n" +
-	"\x18user_expiry_warning_days\x18\x05 \x01(\x05R\x15userExpiryWarningDays\x1a/\n" +
+	"\x18user_expiry_warning_days\x18\x05 \x01(\x05R\x15userExpiryWarningDays\x12E\n" +
+	"\x0eadmin_security\x18\x06 \x01(\v2\x1e.kratos.api.Data.AdminSecurityR\radminSecurity\x12&\n" +
+	"\x03erp\x18\a \x01(\v2\x14.kratos.api.Data.ErpR\x03erp\x1a/\n" +
 	"\x05Mysql\x12\x10\n" +
 	"\x03dsn\x18\x01 \x01(\tR\x03dsn\x12\x14\n" +
 	"\x05debug\x18\x02 \x01(\bR\x05debug\x1a\x1c\n" +
@@ -949,7 +1129,20 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x05admin\x18\x03 \x01(\v2 .kratos.api.Data.AdminAuth.AdminR\x05admin\x1a?\n" +
 	"\x05Admin\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x93\x01\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x1a\xd1\x02\n" +
+	"\rAdminSecurity\x12.\n" +
+	"\x13password_min_length\x18\x01 \x01(\x05R\x11passwordMinLength\x120\n" +
+	"\x14password_min_classes\x18\x02 \x01(\x05R\x12passwordMinClasses\x12.\n" +
+	"\x13max_failed_attempts\x18\x03 \x01(\x05R\x11maxFailedAttempts\x122\n" +
+	"\x15failed_window_seconds\x18\x04 \x01(\x05R\x13failedWindowSeconds\x12'\n" +
+	"\x0flockout_seconds\x18\x05 \x01(\x05R\x0elockoutSeconds\x120\n" +
+	"\x14totp_required_levels\x18\x06 \x03(\x05R\x12totpRequiredLevels\x12\x1f\n" +
+	"\vtotp_issuer\x18\a \x01(\tR\n" +
+	"totpIssuer\x1a\xba\x01\n" +
+	"\x03Erp\x12@\n" +
+	"\x1cfulfilment_tolerance_percent\x18\x01 \x01(\x01R\x1afulfilmentTolerancePercent\x12,\n" +
+	"\x12min_margin_percent\x18\x02 \x01(\x01R\x10minMarginPercent\x12C\n" +
+	"\x1equotation_status_check_minutes\x18\x03 \x01(\x05R\x1bquotationStatusCheckMinutes\"\x93\x01\n" +
 	"\x05Trace\x120\n" +
 	"\x06jaeger\x18\x01 \x01(\v2\x18.kratos.api.Trace.JaegerR\x06jaeger\x1aX\n" +
 	"\x06Jaeger\x12\x1c\n" +
@@ -976,7 +1169,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),            // 0: kratos.api.Bootstrap
 	(*Server)(nil),               // 1: kratos.api.Server
@@ -990,11 +1183,13 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Data_Etcd)(nil),            // 9: kratos.api.Data.Etcd
 	(*Data_Auth)(nil),            // 10: kratos.api.Data.Auth
 	(*Data_AdminAuth)(nil),       // 11: kratos.api.Data.AdminAuth
-	(*Data_Auth_Admin)(nil),      // 12: kratos.api.Data.Auth.Admin
-	(*Data_AdminAuth_Admin)(nil), // 13: kratos.api.Data.AdminAuth.Admin
-	(*Trace_Jaeger)(nil),         // 14: kratos.api.Trace.Jaeger
-	(*Notify_Telegram)(nil),      // 15: kratos.api.Notify.Telegram
-	(*durationpb.Duration)(nil),  // 16: google.protobuf.Duration
+	(*Data_AdminSecurity)(nil),   // 12: kratos.api.Data.AdminSecurity
+	(*Data_Erp)(nil),             // 13: kratos.api.Data.Erp
+	(*Data_Auth_Admin)(nil),      // 14: kratos.api.Data.Auth.Admin
+	(*Data_AdminAuth_Admin)(nil), // 15: kratos.api.Data.AdminAuth.Admin
+	(*Trace_Jaeger)(nil),         // 16: kratos.api.Trace.Jaeger
+	(*Notify_Telegram)(nil),      // 17: kratos.api.Notify.Telegram
+	(*durationpb.Duration)(nil),  // 18: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -1007,17 +1202,19 @@ var file_conf_conf_proto_depIdxs = []int32{
 	9,  // 7: kratos.api.Data.etcd:type_name -> kratos.api.Data.Etcd
 	10, // 8: kratos.api.Data.auth:type_name -> kratos.api.Data.Auth
 	11, // 9: kratos.api.Data.admin_auth:type_name -> kratos.api.Data.AdminAuth
-	14, // 10: kratos.api.Trace.jaeger:type_name -> kratos.api.Trace.Jaeger
-	15, // 11: kratos.api.Notify.telegram:type_name -> kratos.api.Notify.Telegram
-	16, // 12: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	16, // 13: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	12, // 14: kratos.api.Data.Auth.admin:type_name -> kratos.api.Data.Auth.Admin
-	13, // 15: kratos.api.Data.AdminAuth.admin:type_name -> kratos.api.Data.AdminAuth.Admin
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	12, // 10: kratos.api.Data.admin_security:type_name -> kratos.api.Data.AdminSecurity
+	13, // 11: kratos.api.Data.erp:type_name -> kratos.api.Data.Erp
+	16, // 12: kratos.api.Trace.jaeger:type_name -> kratos.api.Trace.Jaeger
+	17, // 13: kratos.api.Notify.telegram:type_name -> kratos.api.Notify.Telegram
+	18, // 14: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	18, // 15: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	14, // 16: kratos.api.Data.Auth.admin:type_name -> kratos.api.Data.Auth.Admin
+	15, // 17: kratos.api.Data.AdminAuth.admin:type_name -> kratos.api.Data.AdminAuth.Admin
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -1031,7 +1228,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
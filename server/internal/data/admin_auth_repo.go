@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/adminloginattempt"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -38,13 +40,15 @@ func (r *adminAuthRepo) GetAdminByUsername(ctx context.Context, username string)
 		uname        string
 		passwordHash string
 		disabled     bool
+		mustChange   bool
+		lockedUntil  sql.NullTime
 	)
 
 	err := r.data.sqldb.QueryRowContext(
 		ctx,
-		"SELECT id, username, password_hash, disabled FROM admin_users WHERE username = ? LIMIT 1",
+		"SELECT id, username, password_hash, disabled, must_change_password, locked_until FROM admin_users WHERE username = ? LIMIT 1",
 		username,
-	).Scan(&id, &uname, &passwordHash, &disabled)
+	).Scan(&id, &uname, &passwordHash, &disabled, &mustChange, &lockedUntil)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			l.Infof("GetAdminByUsername not found username=%s", username)
@@ -54,12 +58,17 @@ func (r *adminAuthRepo) GetAdminByUsername(ctx context.Context, username string)
 		return nil, err
 	}
 
-	return &biz.AdminUser{
-		ID:           id,
-		Username:     uname,
-		PasswordHash: passwordHash,
-		Disabled:     disabled,
-	}, nil
+	admin := &biz.AdminUser{
+		ID:                 id,
+		Username:           uname,
+		PasswordHash:       passwordHash,
+		Disabled:           disabled,
+		MustChangePassword: mustChange,
+	}
+	if lockedUntil.Valid {
+		admin.LockedUntil = &lockedUntil.Time
+	}
+	return admin, nil
 }
 
 func (r *adminAuthRepo) UpdateAdminLastLogin(ctx context.Context, id int, t time.Time) error {
@@ -79,3 +88,68 @@ func (r *adminAuthRepo) UpdateAdminLastLogin(ctx context.Context, id int, t time
 	}
 	return err
 }
+
+func (r *adminAuthRepo) UpdateAdminPassword(ctx context.Context, id int, passwordHash string, mustChange bool) error {
+	if id <= 0 || passwordHash == "" {
+		return biz.ErrBadParam
+	}
+	err := r.data.mysql.AdminUser.UpdateOneID(id).
+		SetPasswordHash(passwordHash).
+		SetMustChangePassword(mustChange).
+		SetPasswordChangedAt(time.Now()).
+		ClearLockedUntil().
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrAdminNotFound
+	}
+	return err
+}
+
+func (r *adminAuthRepo) SetAdminLockedUntil(ctx context.Context, id int, until *time.Time) error {
+	update := r.data.mysql.AdminUser.UpdateOneID(id)
+	if until == nil {
+		update.ClearLockedUntil()
+	} else {
+		update.SetLockedUntil(*until)
+	}
+	return update.Exec(ctx)
+}
+
+func (r *adminAuthRepo) RecordLoginAttempt(ctx context.Context, attempt *biz.AdminLoginAttempt) error {
+	if attempt == nil {
+		return biz.ErrBadParam
+	}
+	return r.data.mysql.AdminLoginAttempt.Create().
+		SetUsername(truncateUTF8(attempt.Username, 64)).
+		SetNillableAdminUserID(attempt.AdminID).
+		SetSuccess(attempt.Success).
+		SetReason(attempt.Reason).
+		SetIP(truncateUTF8(attempt.IP, 64)).
+		SetUserAgent(truncateUTF8(attempt.UserAgent, 255)).
+		SetCreatedAt(attempt.CreatedAt).
+		Exec(ctx)
+}
+
+func (r *adminAuthRepo) CountFailedLoginAttempts(ctx context.Context, adminID int, since time.Time) (int, error) {
+	lastSuccess, err := r.data.mysql.AdminLoginAttempt.Query().
+		Where(
+			adminloginattempt.AdminUserID(adminID),
+			adminloginattempt.Success(true),
+			adminloginattempt.CreatedAtGTE(since),
+		).
+		Order(ent.Desc(adminloginattempt.FieldCreatedAt), ent.Desc(adminloginattempt.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, err
+	}
+	query := r.data.mysql.AdminLoginAttempt.Query().
+		Where(
+			adminloginattempt.AdminUserID(adminID),
+			adminloginattempt.ReasonIn(biz.AdminLoginInvalidPassword, biz.AdminLoginInvalidTOTP),
+			adminloginattempt.CreatedAtGTE(since),
+		)
+	if lastSuccess != nil {
+		query = query.Where(adminloginattempt.IDGT(lastSuccess.ID))
+	}
+	return query.Count(ctx)
+}
@@ -1,6 +1,11 @@
 package data
 
-import "server/internal/conf"
+import (
+	"time"
+
+	"server/internal/biz"
+	"server/internal/conf"
+)
 
 type resolvedAdminConfig struct {
 	jwtSecret        string
@@ -44,3 +49,34 @@ func resolveAdminConfig(c *conf.Data) resolvedAdminConfig {
 
 	return cfg
 }
+
+// adminSecurityPolicy 解析 data.admin_security，未配置或 <=0 的项取默认值。
+func adminSecurityPolicy(c *conf.Data) biz.AdminSecurityPolicy {
+	policy := biz.DefaultAdminSecurityPolicy()
+	if c == nil || c.AdminSecurity == nil {
+		return policy
+	}
+	sec := c.AdminSecurity
+	if sec.PasswordMinLength > 0 {
+		policy.PasswordMinLength = int(sec.PasswordMinLength)
+	}
+	if sec.PasswordMinClasses > 0 {
+		policy.PasswordMinClasses = int(sec.PasswordMinClasses)
+	}
+	if sec.MaxFailedAttempts > 0 {
+		policy.MaxFailedAttempts = int(sec.MaxFailedAttempts)
+	}
+	if sec.FailedWindowSeconds > 0 {
+		policy.FailedWindow = time.Duration(sec.FailedWindowSeconds) * time.Second
+	}
+	if sec.LockoutSeconds > 0 {
+		policy.Lockout = time.Duration(sec.LockoutSeconds) * time.Second
+	}
+	for _, level := range sec.TotpRequiredLevels {
+		policy.TOTPRequiredLevels = append(policy.TOTPRequiredLevels, biz.AdminLevel(level))
+	}
+	if sec.TotpIssuer != "" {
+		policy.TOTPIssuer = sec.TotpIssuer
+	}
+	return policy
+}
@@ -33,17 +33,22 @@ func (r *adminManageRepo) toBizAdmin(a *ent.AdminUser) *biz.AdminAccount {
 		return nil
 	}
 	return &biz.AdminAccount{
-		ID:              a.ID,
-		Username:        a.Username,
-		Level:           biz.AdminLevel(a.Level),
-		MenuPermissions: decodeMenuPermissions(a.MenuPermissions),
-		ParentID:        a.ParentID,
-		Disabled:        a.Disabled,
-		LastLoginAt:     a.LastLoginAt,
-		CreatedAt:       a.CreatedAt,
-		UpdatedAt:       a.UpdatedAt,
-		UserCount:       0,
-		ChildAdminCount: 0,
+		ID:                 a.ID,
+		Username:           a.Username,
+		Level:              biz.AdminLevel(a.Level),
+		MenuPermissions:    decodeMenuPermissions(a.MenuPermissions),
+		RoleBased:          a.RoleBased,
+		ParentID:           a.ParentID,
+		Disabled:           a.Disabled,
+		LastLoginAt:        a.LastLoginAt,
+		MustChangePassword: a.MustChangePassword,
+		PasswordChangedAt:  a.PasswordChangedAt,
+		LockedUntil:        a.LockedUntil,
+		TOTPEnabled:        a.TotpEnabled,
+		CreatedAt:          a.CreatedAt,
+		UpdatedAt:          a.UpdatedAt,
+		UserCount:          0,
+		ChildAdminCount:    0,
 	}
 }
 
@@ -58,7 +63,7 @@ func (r *adminManageRepo) GetAdminByID(ctx context.Context, id int) (*biz.AdminA
 		}
 		return nil, err
 	}
-	return r.toBizAdmin(row), nil
+	return r.withRoles(ctx, r.toBizAdmin(row))
 }
 
 func (r *adminManageRepo) GetAdminByUsername(ctx context.Context, username string) (*biz.AdminAccount, error) {
@@ -72,7 +77,7 @@ func (r *adminManageRepo) GetAdminByUsername(ctx context.Context, username strin
 		}
 		return nil, err
 	}
-	return r.toBizAdmin(row), nil
+	return r.withRoles(ctx, r.toBizAdmin(row))
 }
 
 func (r *adminManageRepo) ListAdmins(ctx context.Context) ([]*biz.AdminAccount, error) {
@@ -84,12 +89,31 @@ func (r *adminManageRepo) ListAdmins(ctx context.Context) ([]*biz.AdminAccount,
 		return nil, err
 	}
 	out := make([]*biz.AdminAccount, 0, len(rows))
+	ids := make([]int, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, r.toBizAdmin(row))
+		ids = append(ids, row.ID)
+	}
+	roles, err := loadAdminRoles(ctx, r.data.mysql, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range out {
+		a.Roles = roles[a.ID]
 	}
 	return out, nil
 }
 
+// withRoles 补齐管理员持有的角色，供有效权限计算使用。
+func (r *adminManageRepo) withRoles(ctx context.Context, a *biz.AdminAccount) (*biz.AdminAccount, error) {
+	roles, err := loadAdminRoles(ctx, r.data.mysql, a.ID)
+	if err != nil {
+		return nil, err
+	}
+	a.Roles = roles[a.ID]
+	return a, nil
+}
+
 func (r *adminManageRepo) CountUsersByAdmin(ctx context.Context) (map[int]int, error) {
 	counts := map[int]int{}
 	rows, err := r.data.sqldb.QueryContext(
@@ -0,0 +1,110 @@
+// server/internal/data/admin_role_init.go
+package data
+
+import (
+	"context"
+	"errors"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/adminrole"
+	"server/internal/data/model/ent/adminuser"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// InitAdminRolesIfNeeded 补齐内置角色及其缺失的默认授权，并把仍按菜单鉴权的管理员转换为等价角色。
+//
+// 转换规则：相同菜单组合共用一个 menu-<hash> 角色，分配后管理员切换为按角色鉴权；
+// 已切换的管理员不再处理，因此可重复执行。
+func InitAdminRolesIfNeeded(ctx context.Context, d *Data, l *log.Helper) error {
+	if d == nil || d.mysql == nil {
+		return errors.New("InitAdminRolesIfNeeded: missing db")
+	}
+	repo := &adminRoleRepo{data: d, log: l}
+
+	for _, role := range biz.BuiltinAdminRoles() {
+		if _, err := ensureAdminRole(ctx, repo, role); err != nil {
+			return err
+		}
+	}
+
+	admins, err := d.mysql.AdminUser.Query().
+		Where(
+			adminuser.RoleBased(false),
+			adminuser.LevelNEQ(int8(biz.AdminLevelSuper)),
+		).
+		Order(ent.Asc(adminuser.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, admin := range admins {
+		menus := biz.EffectiveAdminMenuPermissions(biz.AdminLevel(admin.Level), decodeMenuPermissions(admin.MenuPermissions))
+		roleID, err := ensureAdminRole(ctx, repo, biz.AdminRoleFromMenuPermissions(menus))
+		if err != nil {
+			return err
+		}
+		if err := repo.SetAdminRoles(ctx, admin.ID, []int{roleID}); err != nil {
+			return err
+		}
+		l.Infof("convert admin menu permissions to role admin_id=%d role_id=%d", admin.ID, roleID)
+	}
+	return nil
+}
+
+// ensureAdminRole 按 key 查找角色，不存在时创建；已存在的内置角色补齐缺失的默认授权，
+// 运营后加的授权保留不动。
+func ensureAdminRole(ctx context.Context, repo *adminRoleRepo, role *biz.AdminRole) (int, error) {
+	row, err := repo.data.mysql.AdminRole.Query().Where(adminrole.RoleKey(role.Key)).Only(ctx)
+	if err == nil {
+		if row.Builtin && role.Builtin {
+			return row.ID, mergeBuiltinAdminRoleGrants(ctx, repo, row, role)
+		}
+		return row.ID, nil
+	}
+	if !ent.IsNotFound(err) {
+		return 0, err
+	}
+	created, err := repo.CreateRole(ctx, role)
+	if err != nil {
+		return 0, err
+	}
+	return created.ID, nil
+}
+
+// mergeBuiltinAdminRoleGrants 把内置角色新增的模块/动作写入已存储的角色，用于版本升级后新模块的默认授权。
+func mergeBuiltinAdminRoleGrants(ctx context.Context, repo *adminRoleRepo, row *ent.AdminRole, role *biz.AdminRole) error {
+	stored, err := loadAdminRoleGrants(ctx, repo.data.mysql, []*ent.AdminRole{row})
+	if err != nil {
+		return err
+	}
+	missing := missingAdminRoleGrants(stored[0].Grants, role.Grants)
+	if len(missing) == 0 {
+		return nil
+	}
+	if err := repo.withTx(ctx, func(tx *ent.Tx) error {
+		return createAdminRoleGrants(ctx, tx, row.ID, missing)
+	}); err != nil {
+		return err
+	}
+	repo.log.Infof("merge builtin role grants role_key=%s modules=%d", role.Key, len(missing))
+	return nil
+}
+
+// missingAdminRoleGrants 返回 want 中 stored 尚未包含的模块/动作。
+func missingAdminRoleGrants(stored, want map[string][]string) map[string][]string {
+	missing := map[string][]string{}
+	for moduleKey, actions := range want {
+		have := make(map[string]struct{}, len(stored[moduleKey]))
+		for _, action := range stored[moduleKey] {
+			have[action] = struct{}{}
+		}
+		for _, action := range actions {
+			if _, ok := have[action]; !ok {
+				missing[moduleKey] = append(missing[moduleKey], action)
+			}
+		}
+	}
+	return missing
+}
@@ -0,0 +1,32 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+
+	"server/internal/biz"
+)
+
+func TestMissingAdminRoleGrants(t *testing.T) {
+	stored := map[string][]string{
+		"products":  {biz.ERPActionView, biz.ERPActionCreate},
+		"customKey": {biz.ERPActionView},
+	}
+	want := map[string][]string{
+		"products":         {biz.ERPActionView, biz.ERPActionCreate, biz.ERPActionEdit},
+		"priceLists":       {biz.ERPActionView, biz.ERPActionViewAmounts},
+		"productionOrders": {biz.ERPActionView},
+	}
+	got := missingAdminRoleGrants(stored, want)
+	expected := map[string][]string{
+		"products":         {biz.ERPActionEdit},
+		"priceLists":       {biz.ERPActionView, biz.ERPActionViewAmounts},
+		"productionOrders": {biz.ERPActionView},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("missing grants = %v, want %v", got, expected)
+	}
+	if got := missingAdminRoleGrants(want, want); len(got) != 0 {
+		t.Fatalf("complete role should have no missing grants, got %v", got)
+	}
+}
@@ -0,0 +1,308 @@
+// server/internal/data/admin_role_repo.go
+package data
+
+import (
+	"context"
+	"sort"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/adminrole"
+	"server/internal/data/model/ent/adminrolepermission"
+	"server/internal/data/model/ent/adminuserrole"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type adminRoleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAdminRoleRepo(d *Data, logger log.Logger) *adminRoleRepo {
+	return &adminRoleRepo{
+		data: d,
+		log:  log.NewHelper(log.With(logger, "module", "data.admin_role_repo")),
+	}
+}
+
+var _ biz.AdminRoleRepo = (*adminRoleRepo)(nil)
+
+func (r *adminRoleRepo) ListRoles(ctx context.Context) ([]*biz.AdminRole, error) {
+	rows, err := r.data.mysql.AdminRole.Query().Order(ent.Asc(adminrole.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := loadAdminRoleGrants(ctx, r.data.mysql, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := r.data.mysql.AdminUserRole.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts := map[int]int{}
+	for _, link := range links {
+		counts[link.RoleID]++
+	}
+	for _, role := range roles {
+		role.AdminCount = counts[role.ID]
+	}
+	return roles, nil
+}
+
+func (r *adminRoleRepo) GetRole(ctx context.Context, id int) (*biz.AdminRole, error) {
+	if id <= 0 {
+		return nil, biz.ErrBadParam
+	}
+	row, err := r.data.mysql.AdminRole.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrAdminRoleNotFound
+		}
+		return nil, err
+	}
+	roles, err := loadAdminRoleGrants(ctx, r.data.mysql, []*ent.AdminRole{row})
+	if err != nil {
+		return nil, err
+	}
+	return roles[0], nil
+}
+
+func (r *adminRoleRepo) CreateRole(ctx context.Context, role *biz.AdminRole) (*biz.AdminRole, error) {
+	if role == nil || role.Key == "" || role.Name == "" {
+		return nil, biz.ErrBadParam
+	}
+	var id int
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := tx.AdminRole.Create().
+			SetRoleKey(role.Key).
+			SetName(role.Name).
+			SetDescription(role.Description).
+			SetBuiltin(role.Builtin).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		id = row.ID
+		return createAdminRoleGrants(ctx, tx, row.ID, role.Grants)
+	})
+	if err != nil {
+		if isDuplicateUniqueConstraint(err, "adminrole_role_key", "admin_roles.role_key", "role_key") {
+			return nil, biz.ErrAdminRoleExists
+		}
+		return nil, err
+	}
+	return r.GetRole(ctx, id)
+}
+
+func (r *adminRoleRepo) UpdateRole(ctx context.Context, role *biz.AdminRole) (*biz.AdminRole, error) {
+	if role == nil || role.ID <= 0 || role.Name == "" {
+		return nil, biz.ErrBadParam
+	}
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		if _, err := tx.AdminRole.UpdateOneID(role.ID).
+			SetName(role.Name).
+			SetDescription(role.Description).
+			Save(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return biz.ErrAdminRoleNotFound
+			}
+			return err
+		}
+		// 授权整体覆盖：先删后建。
+		if _, err := tx.AdminRolePermission.Delete().
+			Where(adminrolepermission.RoleIDEQ(role.ID)).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return createAdminRoleGrants(ctx, tx, role.ID, role.Grants)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetRole(ctx, role.ID)
+}
+
+func (r *adminRoleRepo) DeleteRole(ctx context.Context, id int) error {
+	if id <= 0 {
+		return biz.ErrBadParam
+	}
+	return r.withTx(ctx, func(tx *ent.Tx) error {
+		if _, err := tx.AdminUserRole.Delete().Where(adminuserrole.RoleIDEQ(id)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.AdminRolePermission.Delete().Where(adminrolepermission.RoleIDEQ(id)).Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.AdminRole.DeleteOneID(id).Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return biz.ErrAdminRoleNotFound
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *adminRoleRepo) ListRoleAdminIDs(ctx context.Context, roleID int) ([]int, error) {
+	if roleID <= 0 {
+		return nil, biz.ErrBadParam
+	}
+	rows, err := r.data.mysql.AdminUserRole.Query().
+		Where(adminuserrole.RoleIDEQ(roleID)).
+		Order(ent.Asc(adminuserrole.FieldAdminUserID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.AdminUserID)
+	}
+	return ids, nil
+}
+
+func (r *adminRoleRepo) SetAdminRoles(ctx context.Context, adminID int, roleIDs []int) error {
+	if adminID <= 0 {
+		return biz.ErrBadParam
+	}
+	return r.withTx(ctx, func(tx *ent.Tx) error {
+		if _, err := tx.AdminUser.UpdateOneID(adminID).SetRoleBased(true).Save(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return biz.ErrAdminNotFound
+			}
+			return err
+		}
+		if _, err := tx.AdminUserRole.Delete().Where(adminuserrole.AdminUserIDEQ(adminID)).Exec(ctx); err != nil {
+			return err
+		}
+		if len(roleIDs) == 0 {
+			return nil
+		}
+		builders := make([]*ent.AdminUserRoleCreate, 0, len(roleIDs))
+		for _, roleID := range roleIDs {
+			builders = append(builders, tx.AdminUserRole.Create().SetAdminUserID(adminID).SetRoleID(roleID))
+		}
+		return tx.AdminUserRole.CreateBulk(builders...).Exec(ctx)
+	})
+}
+
+// withTx 在事务内执行 fn，任一步失败整体回滚。
+func (r *adminRoleRepo) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := r.data.mysql.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.WithContext(ctx).Errorf("[admin_role] rollback failed err=%v", rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func createAdminRoleGrants(ctx context.Context, tx *ent.Tx, roleID int, grants map[string][]string) error {
+	moduleKeys := make([]string, 0, len(grants))
+	for moduleKey := range grants {
+		moduleKeys = append(moduleKeys, moduleKey)
+	}
+	sort.Strings(moduleKeys)
+
+	builders := make([]*ent.AdminRolePermissionCreate, 0, len(grants))
+	for _, moduleKey := range moduleKeys {
+		for _, action := range grants[moduleKey] {
+			builders = append(builders, tx.AdminRolePermission.Create().
+				SetRoleID(roleID).
+				SetModuleKey(moduleKey).
+				SetAction(action))
+		}
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	return tx.AdminRolePermission.CreateBulk(builders...).Exec(ctx)
+}
+
+// loadAdminRoleGrants 为角色行补齐授权明细，保持入参顺序。
+func loadAdminRoleGrants(ctx context.Context, client *ent.Client, rows []*ent.AdminRole) ([]*biz.AdminRole, error) {
+	out := make([]*biz.AdminRole, 0, len(rows))
+	if len(rows) == 0 {
+		return out, nil
+	}
+	ids := make([]int, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	perms, err := client.AdminRolePermission.Query().
+		Where(adminrolepermission.RoleIDIn(ids...)).
+		Order(ent.Asc(adminrolepermission.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grants := map[int]map[string][]string{}
+	for _, perm := range perms {
+		if grants[perm.RoleID] == nil {
+			grants[perm.RoleID] = map[string][]string{}
+		}
+		grants[perm.RoleID][perm.ModuleKey] = append(grants[perm.RoleID][perm.ModuleKey], perm.Action)
+	}
+
+	for _, row := range rows {
+		roleGrants := grants[row.ID]
+		if roleGrants == nil {
+			roleGrants = map[string][]string{}
+		}
+		out = append(out, &biz.AdminRole{
+			ID:          row.ID,
+			Key:         row.RoleKey,
+			Name:        row.Name,
+			Description: row.Description,
+			Builtin:     row.Builtin,
+			Grants:      roleGrants,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+		})
+	}
+	return out, nil
+}
+
+// loadAdminRoles 按管理员 ID 加载其持有的角色（含授权）。
+func loadAdminRoles(ctx context.Context, client *ent.Client, adminIDs ...int) (map[int][]*biz.AdminRole, error) {
+	out := map[int][]*biz.AdminRole{}
+	if len(adminIDs) == 0 {
+		return out, nil
+	}
+	links, err := client.AdminUserRole.Query().
+		Where(adminuserrole.AdminUserIDIn(adminIDs...)).
+		Order(ent.Asc(adminuserrole.FieldRoleID)).
+		All(ctx)
+	if err != nil || len(links) == 0 {
+		return out, err
+	}
+	roleIDs := make([]int, 0, len(links))
+	for _, link := range links {
+		roleIDs = append(roleIDs, link.RoleID)
+	}
+	rows, err := client.AdminRole.Query().Where(adminrole.IDIn(roleIDs...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := loadAdminRoleGrants(ctx, client, rows)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*biz.AdminRole, len(roles))
+	for _, role := range roles {
+		byID[role.ID] = role
+	}
+	for _, link := range links {
+		if role, ok := byID[link.RoleID]; ok {
+			out[link.AdminUserID] = append(out[link.AdminUserID], role)
+		}
+	}
+	return out, nil
+}
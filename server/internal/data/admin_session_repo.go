@@ -0,0 +1,174 @@
+// server/internal/data/admin_session_repo.go
+package data
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/adminsession"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type adminSessionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAdminSessionRepo(d *Data, logger log.Logger) *adminSessionRepo {
+	return &adminSessionRepo{
+		data: d,
+		log:  log.NewHelper(log.With(logger, "module", "data.admin_session_repo")),
+	}
+}
+
+var _ biz.AdminSessionRepo = (*adminSessionRepo)(nil)
+
+func (r *adminSessionRepo) CreateSession(ctx context.Context, session *biz.AdminSession) (*biz.AdminSession, error) {
+	if session == nil {
+		return nil, biz.ErrBadParam
+	}
+	row, err := r.data.mysql.AdminSession.Create().
+		SetSessionKey(session.SessionKey).
+		SetAdminUserID(session.AdminID).
+		SetAccessTokenID(session.AccessTokenID).
+		SetRefreshTokenHash(session.RefreshTokenHash).
+		SetIP(truncateUTF8(session.IP, 64)).
+		SetUserAgent(truncateUTF8(session.UserAgent, 255)).
+		SetCreatedAt(session.CreatedAt).
+		SetLastSeenAt(session.LastSeenAt).
+		SetExpiresAt(session.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toBizAdminSession(row), nil
+}
+
+func (r *adminSessionRepo) GetSessionByID(ctx context.Context, id int) (*biz.AdminSession, error) {
+	row, err := r.data.mysql.AdminSession.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrAdminSessionNotFound
+		}
+		return nil, err
+	}
+	return toBizAdminSession(row), nil
+}
+
+func (r *adminSessionRepo) GetSessionByKey(ctx context.Context, sessionKey string) (*biz.AdminSession, error) {
+	row, err := r.data.mysql.AdminSession.Query().
+		Where(adminsession.SessionKey(sessionKey)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrAdminSessionNotFound
+		}
+		return nil, err
+	}
+	return toBizAdminSession(row), nil
+}
+
+func (r *adminSessionRepo) ListActiveSessions(ctx context.Context, adminID int, now time.Time) ([]*biz.AdminSession, error) {
+	rows, err := r.data.mysql.AdminSession.Query().
+		Where(
+			adminsession.AdminUserID(adminID),
+			adminsession.RevokedAtIsNil(),
+			adminsession.ExpiresAtGT(now),
+		).
+		Order(ent.Desc(adminsession.FieldLastSeenAt), ent.Desc(adminsession.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*biz.AdminSession, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toBizAdminSession(row))
+	}
+	return out, nil
+}
+
+func (r *adminSessionRepo) RotateSession(
+	ctx context.Context,
+	id int,
+	previousRefreshHash, accessTokenID, refreshTokenHash string,
+	client biz.ClientInfo,
+	t time.Time,
+) error {
+	update := r.data.mysql.AdminSession.Update().
+		Where(
+			adminsession.ID(id),
+			adminsession.RefreshTokenHash(previousRefreshHash),
+			adminsession.RevokedAtIsNil(),
+		).
+		SetAccessTokenID(accessTokenID).
+		SetRefreshTokenHash(refreshTokenHash).
+		SetLastSeenAt(t)
+	if client.IP != "" {
+		update.SetIP(truncateUTF8(client.IP, 64))
+	}
+	if client.UserAgent != "" {
+		update.SetUserAgent(truncateUTF8(client.UserAgent, 255))
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		// 并发刷新时只有一个请求能轮换成功
+		return biz.ErrAdminSessionRevoked
+	}
+	return nil
+}
+
+func (r *adminSessionRepo) TouchSession(ctx context.Context, id int, t time.Time) error {
+	return r.data.mysql.AdminSession.UpdateOneID(id).SetLastSeenAt(t).Exec(ctx)
+}
+
+func (r *adminSessionRepo) RevokeSession(ctx context.Context, id int, reason string, t time.Time) error {
+	_, err := r.data.mysql.AdminSession.Update().
+		Where(adminsession.ID(id), adminsession.RevokedAtIsNil()).
+		SetRevokedAt(t).
+		SetRevokeReason(reason).
+		Save(ctx)
+	return err
+}
+
+func (r *adminSessionRepo) RevokeAdminSessions(ctx context.Context, adminID int, reason string, t time.Time) (int, error) {
+	return r.data.mysql.AdminSession.Update().
+		Where(adminsession.AdminUserID(adminID), adminsession.RevokedAtIsNil()).
+		SetRevokedAt(t).
+		SetRevokeReason(reason).
+		Save(ctx)
+}
+
+func toBizAdminSession(row *ent.AdminSession) *biz.AdminSession {
+	return &biz.AdminSession{
+		ID:               row.ID,
+		SessionKey:       row.SessionKey,
+		AdminID:          row.AdminUserID,
+		AccessTokenID:    row.AccessTokenID,
+		RefreshTokenHash: row.RefreshTokenHash,
+		IP:               row.IP,
+		UserAgent:        row.UserAgent,
+		CreatedAt:        row.CreatedAt,
+		LastSeenAt:       row.LastSeenAt,
+		ExpiresAt:        row.ExpiresAt,
+		RevokedAt:        row.RevokedAt,
+		RevokeReason:     row.RevokeReason,
+	}
+}
+
+// truncateUTF8 按字节截断（ent MaxLen 按字节校验），不切断多字节字符。
+func truncateUTF8(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	for maxBytes > 0 && !utf8.RuneStart(s[maxBytes]) {
+		maxBytes--
+	}
+	return s[:maxBytes]
+}
@@ -39,3 +39,40 @@ func NewAdminTokenGenerator(c *conf.Data, logger log.Logger) biz.AdminTokenGener
 		return jwtutil.NewToken(cfg, userID, username, role)
 	}
 }
+
+// adminAccessTokenTTL 会话模式下 access token 的有效期，过期后用 refresh token 换取。
+const adminAccessTokenTTL = 15 * time.Minute
+
+// adminSessionTTL 管理员会话（refresh token）有效期，沿用 jwtExpireSeconds，默认 7 天。
+func adminSessionTTL(c *conf.Data) time.Duration {
+	if seconds := resolveAdminConfig(c).jwtExpireSeconds; seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return 7 * 24 * time.Hour
+}
+
+// NewAdminSessionTokenGenerator 签发带 sid/jti 的短期 access token；有效期不超过会话有效期。
+func NewAdminSessionTokenGenerator(c *conf.Data, logger log.Logger) biz.AdminSessionTokenGenerator {
+	l := log.NewHelper(log.With(logger, "module", "data.admin_token"))
+	adminCfg := resolveAdminConfig(c)
+
+	if adminCfg.jwtSecret == "" {
+		panic("NewAdminSessionTokenGenerator: missing data.auth.jwtSecret (or fallback data.admin_auth.jwtSecret)")
+	}
+
+	exp := adminAccessTokenTTL
+	if ttl := adminSessionTTL(c); ttl < exp {
+		exp = ttl
+	}
+
+	cfg := jwtutil.Config{
+		Secret:         []byte(adminCfg.jwtSecret),
+		ExpireDuration: exp,
+	}
+
+	l.Infof("admin session token generator init ok, access expire=%s", exp)
+
+	return func(adminID int, username, sessionKey, tokenID string) (string, time.Time, error) {
+		return jwtutil.NewSessionToken(cfg, adminID, username, int8(biz.RoleAdmin), sessionKey, tokenID)
+	}
+}
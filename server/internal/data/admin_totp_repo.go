@@ -0,0 +1,171 @@
+// server/internal/data/admin_totp_repo.go
+package data
+
+import (
+	"context"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/adminrecoverycode"
+	"server/internal/data/model/ent/adminuser"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type adminTOTPRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAdminTOTPRepo(d *Data, logger log.Logger) *adminTOTPRepo {
+	return &adminTOTPRepo{
+		data: d,
+		log:  log.NewHelper(log.With(logger, "module", "data.admin_totp_repo")),
+	}
+}
+
+var _ biz.AdminTOTPRepo = (*adminTOTPRepo)(nil)
+
+func (r *adminTOTPRepo) GetAdminTOTP(ctx context.Context, adminID int) (*biz.AdminTOTP, error) {
+	row, err := r.data.mysql.AdminUser.Query().
+		Where(adminuser.ID(adminID)).
+		Select(adminuser.FieldID, adminuser.FieldTotpSecret, adminuser.FieldTotpEnabled, adminuser.FieldTotpEnabledAt, adminuser.FieldTotpLastStep).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrAdminNotFound
+		}
+		return nil, err
+	}
+	return &biz.AdminTOTP{
+		AdminID:   row.ID,
+		Secret:    row.TotpSecret,
+		Enabled:   row.TotpEnabled,
+		EnabledAt: row.TotpEnabledAt,
+		LastStep:  row.TotpLastStep,
+	}, nil
+}
+
+func (r *adminTOTPRepo) SetAdminTOTPSecret(ctx context.Context, adminID int, secret string) error {
+	n, err := r.data.mysql.AdminUser.Update().
+		Where(adminuser.ID(adminID), adminuser.TotpEnabled(false)).
+		SetTotpSecret(secret).
+		SetTotpLastStep(0).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return biz.ErrTOTPAlreadyEnabled
+	}
+	return nil
+}
+
+func (r *adminTOTPRepo) EnableAdminTOTP(ctx context.Context, adminID int, step int64, recoveryCodeHashes []string, t time.Time) error {
+	return r.withTx(ctx, func(tx *ent.Tx) error {
+		n, err := tx.AdminUser.Update().
+			Where(adminuser.ID(adminID), adminuser.TotpEnabled(false), adminuser.TotpSecretNEQ("")).
+			SetTotpEnabled(true).
+			SetTotpEnabledAt(t).
+			SetTotpLastStep(step).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return biz.ErrTOTPAlreadyEnabled
+		}
+		return replaceAdminRecoveryCodes(ctx, tx, adminID, recoveryCodeHashes, t)
+	})
+}
+
+func (r *adminTOTPRepo) AdvanceAdminTOTPStep(ctx context.Context, adminID int, step int64) (bool, error) {
+	n, err := r.data.mysql.AdminUser.Update().
+		Where(adminuser.ID(adminID), adminuser.TotpEnabled(true), adminuser.TotpLastStepLT(step)).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *adminTOTPRepo) ClearAdminTOTP(ctx context.Context, adminID int) error {
+	return r.withTx(ctx, func(tx *ent.Tx) error {
+		if err := tx.AdminUser.UpdateOneID(adminID).
+			SetTotpSecret("").
+			SetTotpEnabled(false).
+			ClearTotpEnabledAt().
+			SetTotpLastStep(0).
+			Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return biz.ErrAdminNotFound
+			}
+			return err
+		}
+		_, err := tx.AdminRecoveryCode.Delete().
+			Where(adminrecoverycode.AdminUserID(adminID)).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (r *adminTOTPRepo) ReplaceRecoveryCodes(ctx context.Context, adminID int, hashes []string, t time.Time) error {
+	return r.withTx(ctx, func(tx *ent.Tx) error {
+		return replaceAdminRecoveryCodes(ctx, tx, adminID, hashes, t)
+	})
+}
+
+func (r *adminTOTPRepo) UseRecoveryCode(ctx context.Context, adminID int, hash string, t time.Time) (bool, error) {
+	n, err := r.data.mysql.AdminRecoveryCode.Update().
+		Where(
+			adminrecoverycode.AdminUserID(adminID),
+			adminrecoverycode.CodeHash(hash),
+			adminrecoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(t).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *adminTOTPRepo) CountRecoveryCodes(ctx context.Context, adminID int) (int, error) {
+	return r.data.mysql.AdminRecoveryCode.Query().
+		Where(adminrecoverycode.AdminUserID(adminID), adminrecoverycode.UsedAtIsNil()).
+		Count(ctx)
+}
+
+// withTx 在事务内执行 fn，任一步失败整体回滚。
+func (r *adminTOTPRepo) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := r.data.mysql.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.WithContext(ctx).Errorf("[admin_totp] rollback failed err=%v", rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// replaceAdminRecoveryCodes 删除旧恢复码（含已使用）后写入新的一组。
+func replaceAdminRecoveryCodes(ctx context.Context, tx *ent.Tx, adminID int, hashes []string, t time.Time) error {
+	if _, err := tx.AdminRecoveryCode.Delete().
+		Where(adminrecoverycode.AdminUserID(adminID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	builders := make([]*ent.AdminRecoveryCodeCreate, 0, len(hashes))
+	for _, hash := range hashes {
+		builders = append(builders, tx.AdminRecoveryCode.Create().
+			SetAdminUserID(adminID).
+			SetCodeHash(hash).
+			SetCreatedAt(t))
+	}
+	return tx.AdminRecoveryCode.CreateBulk(builders...).Exec(ctx)
+}
@@ -12,6 +12,7 @@ import (
 	"server/internal/conf"
 	"server/internal/data/model/ent"
 	entLogger "server/pkg/logger"
+	"server/pkg/threading"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
@@ -54,6 +55,8 @@ type Data struct {
 	mysql *ent.Client
 	sqldb *sql.DB
 	conf  *conf.Data
+	// jobs 后台定时任务，cleanup 时先取消任务再关闭数据库；为空（单测）时不启动任务。
+	jobs *threading.Threading
 }
 
 const (
@@ -164,6 +167,7 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		sqldb: db,
 		mysql: mysqlClient,
 		conf:  c,
+		jobs:  threading.New(),
 	}
 
 	if err := InitAdminIfNeeded(context.Background(), data, c); err != nil {
@@ -172,8 +176,13 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	if err := InitAdminUsersIfNeeded(context.Background(), data, c, l); err != nil {
 		return nil, nil, err
 	}
+	if err := InitAdminRolesIfNeeded(context.Background(), data, l); err != nil {
+		return nil, nil, err
+	}
 
 	cleanup := func() {
+		// 定时任务常驻运行，直接取消（进行中的查询随 ctx 中断），不等待
+		data.jobs.Stop(false, 0)
 		if mysqlClient != nil {
 			mysqlClient.Close()
 		}
@@ -0,0 +1,42 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// startERPQuotationStatusJob 启动报价单状态检查：启动时执行一次，之后每隔 interval 执行；
+// interval <= 0 或 Data 未带任务管理（单测）时不启动。
+func startERPQuotationStatusJob(data *Data, uc *biz.ERPUsecase, interval time.Duration, l *log.Helper) {
+	if data == nil || data.jobs == nil || uc == nil || interval <= 0 {
+		return
+	}
+	l.Infof("erp quotation status job started, interval=%s", interval)
+	data.jobs.Go(context.Background(), func(ctx context.Context) {
+		for {
+			runERPQuotationStatusCheck(ctx, uc, l)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	})
+}
+
+func runERPQuotationStatusCheck(ctx context.Context, uc *biz.ERPUsecase, l *log.Helper) {
+	changes, err := uc.RefreshQuotationStatuses(ctx, time.Now())
+	if err != nil {
+		if ctx.Err() == nil {
+			l.Errorf("erp quotation status check failed: %v", err)
+		}
+		return
+	}
+	if len(changes.Won) > 0 || len(changes.Expired) > 0 {
+		l.Infof("erp quotation status check done, won=%v expired=%v", changes.Won, changes.Expired)
+	}
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/erpexportsale"
+	"server/internal/data/model/ent/erpexportsaleitem"
+	"server/internal/data/model/ent/erpinboundnoticeitem"
+	"server/internal/data/model/ent/erpoutboundorderitem"
+	"server/internal/data/model/ent/erppartner"
+	"server/internal/data/model/ent/erpproduct"
+	"server/internal/data/model/ent/erppurchasecontract"
+	"server/internal/data/model/ent/erppurchasecontractitem"
+	"server/internal/data/model/ent/erpquotation"
+	"server/internal/data/model/ent/erpquotationitem"
+	"server/internal/data/model/ent/erpshipmentdetail"
+	"server/internal/data/model/ent/erpshipmentdetailitem"
+	"server/internal/data/model/ent/erpstockbalance"
+	"server/internal/data/model/ent/erpstocktransaction"
+)
+
+var _ biz.ERPMergeRepo = (*erpRepo)(nil)
+
+// MergeRecords 在同一事务内保存合并涉及的全部记录并改写专表中的编码；任一记录版本不符即整体回滚。
+// 每条内容有变化的记录各记一条 merge 审计并产生历史版本；保留记录另以 mergedFrom 记下被合并记录，内容不变时也留痕。
+func (r *erpRepo) MergeRecords(ctx context.Context, plan *biz.ERPMergePlan, operatorID int) error {
+	return r.withTx(ctx, func(tx *ent.Tx) error {
+		for _, record := range plan.Records {
+			var extra []biz.ERPAuditChange
+			if record.ModuleKey == plan.ModuleKey && record.ID == plan.SurvivorID {
+				extra = append(extra, biz.ERPAuditChange{Field: "mergedFrom", New: plan.FromCode})
+			}
+			if _, err := updateERPRecord(ctx, tx, record, biz.ERPAuditMerge, operatorID, extra); err != nil {
+				return err
+			}
+		}
+		return rewriteERPMasterTables(ctx, tx, plan)
+	})
+}
+
+// rewriteERPMasterTables 把专表中被合并记录的编码改为保留记录；往来单位、结汇单已随记录同步，这里处理其余专表。
+// 任一方没有编码（如潜在客户）时专表中不会有它的行，跳过。
+func rewriteERPMasterTables(ctx context.Context, tx *ent.Tx, plan *biz.ERPMergePlan) error {
+	if plan.FromCode == "" || plan.ToCode == "" || plan.FromCode == plan.ToCode {
+		return nil
+	}
+	switch plan.ModuleKey {
+	case biz.ERPModulePartners:
+		return rewriteERPPartnerTables(ctx, tx, plan.FromCode, plan.ToCode)
+	case biz.ERPModuleProducts:
+		return rewriteERPProductTables(ctx, tx, plan.FromCode, plan.ToCode)
+	default:
+		return nil
+	}
+}
+
+func rewriteERPPartnerTables(ctx context.Context, tx *ent.Tx, from, to string) error {
+	var partnerID *int
+	if partner, err := tx.ERPPartner.Query().Where(erppartner.CodeEQ(to)).Only(ctx); err == nil {
+		partnerID = &partner.ID
+	} else if !ent.IsNotFound(err) {
+		return err
+	}
+
+	if _, err := tx.ERPQuotation.Update().Where(erpquotation.CustomerCodeEQ(from)).
+		SetCustomerCode(to).ClearCustomerPartnerID().SetNillableCustomerPartnerID(partnerID).Save(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ERPExportSale.Update().Where(erpexportsale.CustomerCodeEQ(from)).
+		SetCustomerCode(to).ClearCustomerPartnerID().SetNillableCustomerPartnerID(partnerID).Save(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ERPShipmentDetail.Update().Where(erpshipmentdetail.CustomerCodeEQ(from)).
+		SetCustomerCode(to).ClearCustomerPartnerID().SetNillableCustomerPartnerID(partnerID).Save(ctx); err != nil {
+		return err
+	}
+	_, err := tx.ERPPurchaseContract.Update().Where(erppurchasecontract.SupplierCodeEQ(from)).
+		SetSupplierCode(to).ClearSupplierPartnerID().SetNillableSupplierPartnerID(partnerID).Save(ctx)
+	return err
+}
+
+// rewriteERPProductTables 改写单据明细与库存流水的产品编码；库存余额在同一仓位、批次已有保留产品的行时数量累加到该行。
+func rewriteERPProductTables(ctx context.Context, tx *ent.Tx, from, to string) error {
+	var productID *int
+	if product, err := tx.ERPProduct.Query().Where(erpproduct.CodeEQ(to)).Only(ctx); err == nil {
+		productID = &product.ID
+	} else if !ent.IsNotFound(err) {
+		return err
+	}
+
+	if _, err := tx.ERPQuotationItem.Update().Where(erpquotationitem.ProductCodeEQ(from)).
+		SetProductCode(to).ClearProductID().SetNillableProductID(productID).Save(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ERPExportSaleItem.Update().Where(erpexportsaleitem.ProductCodeEQ(from)).
+		SetProductCode(to).ClearProductID().SetNillableProductID(productID).Save(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ERPPurchaseContractItem.Update().Where(erppurchasecontractitem.ProductCodeEQ(from)).
+		SetProductCode(to).ClearProductID().SetNillableProductID(productID).Save(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ERPShipmentDetailItem.Update().Where(erpshipmentdetailitem.ProductCodeEQ(from)).
+		SetProductCode(to).ClearProductID().SetNillableProductID(productID).Save(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ERPInboundNoticeItem.Update().Where(erpinboundnoticeitem.ProductCodeEQ(from)).
+		SetProductCode(to).ClearProductID().SetNillableProductID(productID).Save(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ERPOutboundOrderItem.Update().Where(erpoutboundorderitem.ProductCodeEQ(from)).
+		SetProductCode(to).ClearProductID().SetNillableProductID(productID).Save(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ERPStockTransaction.Update().Where(erpstocktransaction.ProductCodeEQ(from)).
+		SetProductCode(to).Save(ctx); err != nil {
+		return err
+	}
+
+	balances, err := tx.ERPStockBalance.Query().Where(erpstockbalance.ProductCodeEQ(from)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, balance := range balances {
+		n, err := tx.ERPStockBalance.Update().
+			Where(
+				erpstockbalance.ProductCodeEQ(to),
+				erpstockbalance.WarehouseIDEQ(balance.WarehouseID),
+				erpstockbalance.LocationIDEQ(balance.LocationID),
+				erpstockbalance.LotNoEQ(balance.LotNo),
+			).
+			AddAvailableQty(balance.AvailableQty).
+			AddLockedQty(balance.LockedQty).
+			AddVersion(1).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			err = tx.ERPStockBalance.DeleteOneID(balance.ID).Exec(ctx)
+		} else {
+			err = tx.ERPStockBalance.UpdateOneID(balance.ID).SetProductCode(to).AddVersion(1).Exec(ctx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ERPProduct.Update().Where(erpproduct.CodeEQ(from)).SetDisabled(true).Save(ctx)
+	return err
+}
@@ -0,0 +1,210 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/erppartner"
+	"server/internal/data/model/ent/erppartneraddress"
+	"server/internal/data/model/ent/erppartnerbankaccount"
+	"server/internal/data/model/ent/erppartnercontact"
+)
+
+// syncERPPartner 将往来单位 payload 同步到 erp_partners 及联系人、地址、银行账户子表；未编码的潜在客户不同步。
+func syncERPPartner(ctx context.Context, tx *ent.Tx, previousCode string, record *biz.ERPRecord) error {
+	if previousCode != "" && previousCode != record.Code {
+		if err := deleteERPPartner(ctx, tx, previousCode); err != nil {
+			return err
+		}
+	}
+	if record.Code == "" {
+		return nil
+	}
+	payload := record.Payload
+	partnerType := "customer"
+	if getPayloadString(payload, "partnerType") == "合作供应商" {
+		partnerType = "supplier"
+	}
+	name := strings.TrimSpace(getPayloadString(payload, "name"))
+	if name == "" {
+		name = record.Code
+	}
+	currency := strings.TrimSpace(getPayloadString(payload, "currency"))
+	if currency == "" {
+		currency = "USD"
+	}
+
+	existing, err := tx.ERPPartner.Query().Where(erppartner.CodeEQ(record.Code)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	var partnerID int
+	if existing == nil {
+		saved, err := tx.ERPPartner.Create().
+			SetCode(record.Code).
+			SetPartnerType(partnerType).
+			SetName(name).
+			SetNillableShortName(optionalPayloadString(payload, "shortName")).
+			SetNillableTaxNo(optionalPayloadString(payload, "taxNo")).
+			SetCurrency(currency).
+			SetPaymentCycleDays(int(payloadFloat(payload, "paymentCycleDays"))).
+			SetNillableAddress(optionalPayloadString(payload, "address")).
+			SetNillableContact(optionalPayloadString(payload, "contact")).
+			SetNillableContactPhone(optionalPayloadString(payload, "contactPhone")).
+			SetNillableEmail(optionalPayloadString(payload, "email")).
+			SetDisabled(payloadBool(payload, "disabled")).
+			SetNillableCreatedByAdminID(record.CreatedByAdminID).
+			SetNillableUpdatedByAdminID(record.UpdatedByAdminID).
+			Save(ctx)
+		if err != nil {
+			return normalizeERPRepoError(err)
+		}
+		partnerID = saved.ID
+	} else {
+		update := tx.ERPPartner.UpdateOneID(existing.ID).
+			SetPartnerType(partnerType).
+			SetName(name).
+			SetCurrency(currency).
+			SetPaymentCycleDays(int(payloadFloat(payload, "paymentCycleDays"))).
+			SetDisabled(payloadBool(payload, "disabled")).
+			SetNillableUpdatedByAdminID(record.UpdatedByAdminID).
+			ClearShortName().SetNillableShortName(optionalPayloadString(payload, "shortName")).
+			ClearTaxNo().SetNillableTaxNo(optionalPayloadString(payload, "taxNo")).
+			ClearAddress().SetNillableAddress(optionalPayloadString(payload, "address")).
+			ClearContact().SetNillableContact(optionalPayloadString(payload, "contact")).
+			ClearContactPhone().SetNillableContactPhone(optionalPayloadString(payload, "contactPhone")).
+			ClearEmail().SetNillableEmail(optionalPayloadString(payload, "email"))
+		if _, err := update.Save(ctx); err != nil {
+			return normalizeERPRepoError(err)
+		}
+		partnerID = existing.ID
+	}
+
+	if err := deleteERPPartnerChildren(ctx, tx, partnerID); err != nil {
+		return err
+	}
+
+	var contacts []*ent.ERPPartnerContactCreate
+	for index, row := range payloadRows(payload, "contacts") {
+		name := strings.TrimSpace(getPayloadString(row, "name"))
+		if name == "" {
+			continue
+		}
+		contacts = append(contacts, tx.ERPPartnerContact.Create().
+			SetPartnerID(partnerID).
+			SetSortOrder(index).
+			SetName(name).
+			SetNillableRole(optionalPayloadString(row, "role")).
+			SetNillablePhone(optionalPayloadString(row, "phone")).
+			SetNillableEmail(optionalPayloadString(row, "email")).
+			SetIsDefault(payloadBool(row, "isDefault")))
+	}
+	if len(contacts) > 0 {
+		if _, err := tx.ERPPartnerContact.CreateBulk(contacts...).Save(ctx); err != nil {
+			return normalizeERPRepoError(err)
+		}
+	}
+
+	var addresses []*ent.ERPPartnerAddressCreate
+	for index, row := range payloadRows(payload, "addresses") {
+		label := strings.TrimSpace(getPayloadString(row, "label"))
+		address := strings.TrimSpace(getPayloadString(row, "address"))
+		addressType := strings.TrimSpace(getPayloadString(row, "addressType"))
+		if label == "" || address == "" || addressType == "" {
+			continue
+		}
+		addresses = append(addresses, tx.ERPPartnerAddress.Create().
+			SetPartnerID(partnerID).
+			SetSortOrder(index).
+			SetLabel(label).
+			SetAddressType(addressType).
+			SetNillablePartyName(optionalPayloadString(row, "partyName")).
+			SetAddress(address).
+			SetNillableCountry(optionalPayloadString(row, "country")).
+			SetNillableContact(optionalPayloadString(row, "contact")).
+			SetNillablePhone(optionalPayloadString(row, "phone")).
+			SetIsDefault(payloadBool(row, "isDefault")))
+	}
+	if len(addresses) > 0 {
+		if _, err := tx.ERPPartnerAddress.CreateBulk(addresses...).Save(ctx); err != nil {
+			return normalizeERPRepoError(err)
+		}
+	}
+
+	var accounts []*ent.ERPPartnerBankAccountCreate
+	for index, row := range payloadRows(payload, "bankAccounts") {
+		bankName := strings.TrimSpace(getPayloadString(row, "bankName"))
+		accountNo := strings.TrimSpace(getPayloadString(row, "accountNo"))
+		if bankName == "" || accountNo == "" {
+			continue
+		}
+		accounts = append(accounts, tx.ERPPartnerBankAccount.Create().
+			SetPartnerID(partnerID).
+			SetSortOrder(index).
+			SetBankName(bankName).
+			SetNillableAccountName(optionalPayloadString(row, "accountName")).
+			SetAccountNo(accountNo).
+			SetNillableSwiftCode(optionalPayloadString(row, "swiftCode")).
+			SetNillableCurrency(optionalPayloadString(row, "currency")).
+			SetIsDefault(payloadBool(row, "isDefault")))
+	}
+	if len(accounts) > 0 {
+		if _, err := tx.ERPPartnerBankAccount.CreateBulk(accounts...).Save(ctx); err != nil {
+			return normalizeERPRepoError(err)
+		}
+	}
+	return nil
+}
+
+func deleteERPPartner(ctx context.Context, tx *ent.Tx, code string) error {
+	existing, err := tx.ERPPartner.Query().Where(erppartner.CodeEQ(code)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if err := deleteERPPartnerChildren(ctx, tx, existing.ID); err != nil {
+		return err
+	}
+	return tx.ERPPartner.DeleteOneID(existing.ID).Exec(ctx)
+}
+
+func deleteERPPartnerChildren(ctx context.Context, tx *ent.Tx, partnerID int) error {
+	if _, err := tx.ERPPartnerContact.Delete().Where(erppartnercontact.PartnerIDEQ(partnerID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ERPPartnerAddress.Delete().Where(erppartneraddress.PartnerIDEQ(partnerID)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.ERPPartnerBankAccount.Delete().Where(erppartnerbankaccount.PartnerIDEQ(partnerID)).Exec(ctx)
+	return err
+}
+
+// payloadRows 读取 payload 中的对象数组，跳过非对象元素。
+func payloadRows(payload map[string]any, key string) []map[string]any {
+	raw, _ := payload[key].([]any)
+	out := make([]map[string]any, 0, len(raw))
+	for _, item := range raw {
+		if row, ok := item.(map[string]any); ok {
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// optionalPayloadString 去掉首尾空白后为空时返回 nil，对应专表的可空列。
+func optionalPayloadString(payload map[string]any, key string) *string {
+	value := strings.TrimSpace(getPayloadString(payload, key))
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func payloadBool(payload map[string]any, key string) bool {
+	value, _ := payload[key].(bool)
+	return value
+}
@@ -0,0 +1,208 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/erpdoclink"
+	"server/internal/data/model/ent/erplocation"
+	"server/internal/data/model/ent/erpstockbalance"
+	"server/internal/data/model/ent/predicate"
+)
+
+var _ biz.ERPProductionRepo = (*erpRepo)(nil)
+
+func (r *erpRepo) ListStockBalances(ctx context.Context, productCodes []string) ([]*biz.ERPStockBalance, error) {
+	if len(productCodes) == 0 {
+		return nil, nil
+	}
+	rows, err := r.data.mysql.ERPStockBalance.
+		Query().
+		Where(erpstockbalance.ProductCodeIn(productCodes...)).
+		Order(ent.Asc(erpstockbalance.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*biz.ERPStockBalance, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &biz.ERPStockBalance{
+			ID:           row.ID,
+			ProductCode:  row.ProductCode,
+			WarehouseID:  row.WarehouseID,
+			LocationID:   row.LocationID,
+			LotNo:        row.LotNo,
+			AvailableQty: row.AvailableQty,
+			LockedQty:    row.LockedQty,
+		})
+	}
+	return out, nil
+}
+
+// SaveProduction 在同一事务内保存生产单（新建记 create 审计，修改记 update 审计并产生历史版本）、
+// 按顺序变动库存余额并写入流水，再补齐单据链路；任一步失败整体回滚。
+func (r *erpRepo) SaveProduction(ctx context.Context, plan *biz.ERPProductionPlan, operatorID int) (*biz.ERPRecord, error) {
+	if plan == nil || plan.Record == nil {
+		return nil, biz.ErrBadParam
+	}
+	var out *biz.ERPRecord
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		var err error
+		if plan.Record.ID == 0 {
+			out, err = createERPRecord(ctx, tx, plan.Record.ModuleKey, plan.Record.Payload, operatorID)
+		} else {
+			out, err = updateERPRecord(ctx, tx, plan.Record, biz.ERPAuditUpdate, operatorID, nil)
+		}
+		if err != nil {
+			return err
+		}
+		for _, move := range plan.Moves {
+			if err := applyERPStockMove(ctx, tx, out.Code, move, operatorID); err != nil {
+				return err
+			}
+		}
+		for _, link := range plan.Links {
+			if err := ensureERPDocLink(ctx, tx, link); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// applyERPStockMove 按条件更新余额：扣减可用或锁定数量时要求余额足够，受影响 0 行即库存不足（并发领用时后提交者失败）；
+// 入库的余额行不存在时新建。流水 delta_qty 记可用数量的变化，领料只扣锁定数量时记锁定数量的变化。
+func applyERPStockMove(ctx context.Context, tx *ent.Tx, bizCode string, move biz.ERPStockMove, operatorID int) error {
+	if move.BizType == biz.ERPStockBizReceive {
+		if err := checkERPStockLocation(ctx, tx, move); err != nil {
+			return err
+		}
+	}
+	key := []predicate.ERPStockBalance{
+		erpstockbalance.ProductCodeEQ(move.ProductCode),
+		erpstockbalance.WarehouseIDEQ(move.WarehouseID),
+		erpstockbalance.LocationIDEQ(move.LocationID),
+		erpstockbalance.LotNoEQ(move.LotNo),
+	}
+	where := append([]predicate.ERPStockBalance(nil), key...)
+	if move.AvailableDelta < 0 {
+		where = append(where, erpstockbalance.AvailableQtyGTE(-move.AvailableDelta))
+	}
+	if move.LockedDelta < 0 {
+		where = append(where, erpstockbalance.LockedQtyGTE(-move.LockedDelta))
+	}
+	n, err := tx.ERPStockBalance.Update().
+		Where(where...).
+		AddAvailableQty(move.AvailableDelta).
+		AddLockedQty(move.LockedDelta).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		if move.AvailableDelta < 0 || move.LockedDelta < 0 {
+			return erpStockMoveError(move, "库存不足，请刷新后重试")
+		}
+		if err := tx.ERPStockBalance.Create().
+			SetProductCode(move.ProductCode).
+			SetWarehouseID(move.WarehouseID).
+			SetLocationID(move.LocationID).
+			SetLotNo(move.LotNo).
+			SetAvailableQty(move.AvailableDelta).
+			SetLockedQty(move.LockedDelta).
+			Exec(ctx); err != nil {
+			return normalizeERPRepoError(err)
+		}
+	}
+	balance, err := tx.ERPStockBalance.Query().Where(key...).Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	delta := move.AvailableDelta
+	if delta == 0 {
+		delta = move.LockedDelta
+	}
+	create := tx.ERPStockTransaction.Create().
+		SetBizType(move.BizType).
+		SetBizCode(bizCode).
+		SetBizLineNo(move.LineNo).
+		SetProductCode(move.ProductCode).
+		SetWarehouseID(move.WarehouseID).
+		SetLocationID(move.LocationID).
+		SetLotNo(move.LotNo).
+		SetDeltaQty(delta).
+		SetBeforeAvailableQty(balance.AvailableQty - move.AvailableDelta).
+		SetAfterAvailableQty(balance.AvailableQty)
+	if operatorID > 0 {
+		create = create.SetOperatorAdminID(operatorID)
+	}
+	return normalizeERPRepoError(create.Exec(ctx))
+}
+
+// checkERPStockLocation 入库货位须存在、属于该仓库且仓库与货位均未停用。
+func checkERPStockLocation(ctx context.Context, tx *ent.Tx, move biz.ERPStockMove) error {
+	location, err := tx.ERPLocation.Query().Where(erplocation.IDEQ(move.LocationID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if location == nil || location.WarehouseID != move.WarehouseID || location.Disabled {
+		return erpStockMoveError(move, "货位不存在、不属于该仓库或已停用")
+	}
+	warehouse, err := tx.ERPWarehouse.Get(ctx, move.WarehouseID)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if warehouse == nil || warehouse.Disabled {
+		return erpStockMoveError(move, "仓库不存在或已停用")
+	}
+	return nil
+}
+
+func erpStockMoveError(move biz.ERPStockMove, reason string) error {
+	lot := ""
+	if move.LotNo != "" {
+		lot = " 批次 " + move.LotNo
+	}
+	return &biz.ERPValidationError{Fields: []biz.ERPFieldError{{
+		Path:    move.Path,
+		Message: fmt.Sprintf("%s %s（仓库 %d 货位 %d%s）%s", move.BizType, move.ProductCode, move.WarehouseID, move.LocationID, lot, reason),
+	}}}
+}
+
+// ensureERPDocLink 写入单据链路，已存在时跳过。
+func ensureERPDocLink(ctx context.Context, tx *ent.Tx, link *biz.ERPDocLink) error {
+	if link == nil {
+		return nil
+	}
+	relation := link.RelationType
+	if relation == "" {
+		relation = "derived"
+	}
+	exists, err := tx.ERPDocLink.Query().
+		Where(
+			erpdoclink.FromModuleEQ(link.FromModule),
+			erpdoclink.FromCodeEQ(link.FromCode),
+			erpdoclink.ToModuleEQ(link.ToModule),
+			erpdoclink.ToCodeEQ(link.ToCode),
+			erpdoclink.RelationTypeEQ(relation),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	return normalizeERPRepoError(tx.ERPDocLink.Create().
+		SetFromModule(link.FromModule).
+		SetFromCode(link.FromCode).
+		SetToModule(link.ToModule).
+		SetToCode(link.ToCode).
+		SetRelationType(relation).
+		Exec(ctx))
+}
@@ -7,7 +7,13 @@ import (
 
 	"server/internal/biz"
 	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/erpdoclink"
 	"server/internal/data/model/ent/erpmodulerecord"
+	"server/internal/data/model/ent/erprecordrevision"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -24,12 +30,15 @@ func NewERPRepo(d *Data, logger log.Logger) *erpRepo {
 	}
 }
 
-var _ biz.ERPRepo = (*erpRepo)(nil)
+var (
+	_ biz.ERPRepo        = (*erpRepo)(nil)
+	_ biz.ERPDocLinkRepo = (*erpRepo)(nil)
+)
 
 func (r *erpRepo) ListByModule(ctx context.Context, moduleKey string) ([]*biz.ERPRecord, error) {
 	rows, err := r.data.mysql.ERPModuleRecord.
 		Query().
-		Where(erpmodulerecord.ModuleKeyEQ(moduleKey)).
+		Where(erpRecordScopePredicates(ctx, moduleKey, erpmodulerecord.ModuleKeyEQ(moduleKey))...).
 		Order(ent.Desc(erpmodulerecord.FieldID)).
 		All(ctx)
 	if err != nil {
@@ -47,13 +56,61 @@ func (r *erpRepo) ListByModule(ctx context.Context, moduleKey string) ([]*biz.ER
 	return out, nil
 }
 
+func (r *erpRepo) GetByID(ctx context.Context, moduleKey string, id int) (*biz.ERPRecord, error) {
+	row, err := r.data.mysql.ERPModuleRecord.
+		Query().
+		Where(erpRecordScopePredicates(ctx, moduleKey,
+			erpmodulerecord.IDEQ(id),
+			erpmodulerecord.ModuleKeyEQ(moduleKey),
+		)...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrERPRecordNotFound
+		}
+		return nil, err
+	}
+	return toBizERPRecord(row)
+}
+
 func (r *erpRepo) Create(ctx context.Context, moduleKey string, payload map[string]any, createdByAdminID int) (*biz.ERPRecord, error) {
+	var out *biz.ERPRecord
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		var err error
+		out, err = createERPRecord(ctx, tx, moduleKey, payload, createdByAdminID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _ biz.ERPQuotationReviseRepo = (*erpRepo)(nil)
+
+// ReviseRecord 在同一事务内新建修订单并按 Version 保存原记录；原记录已被修改时整体回滚，不留下修订单。
+func (r *erpRepo) ReviseRecord(ctx context.Context, revision, previous *biz.ERPRecord, operatorID int) (created, updated *biz.ERPRecord, err error) {
+	err = r.withTx(ctx, func(tx *ent.Tx) error {
+		var err error
+		if updated, err = updateERPRecord(ctx, tx, previous, biz.ERPAuditUpdate, operatorID, nil); err != nil {
+			return err
+		}
+		created, err = createERPRecord(ctx, tx, revision.ModuleKey, revision.Payload, operatorID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, updated, nil
+}
+
+// createERPRecord 在事务内新建记录，记 create 审计并同步专表。
+func createERPRecord(ctx context.Context, tx *ent.Tx, moduleKey string, payload map[string]any, createdByAdminID int) (*biz.ERPRecord, error) {
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return nil, biz.ErrERPInvalidRecord
 	}
-
-	create := r.data.mysql.ERPModuleRecord.
+	create := tx.ERPModuleRecord.
 		Create().
 		SetModuleKey(moduleKey).
 		SetPayload(string(payloadJSON))
@@ -73,16 +130,33 @@ func (r *erpRepo) Create(ctx context.Context, moduleKey string, payload map[stri
 	if err != nil {
 		return nil, normalizeERPRepoError(err)
 	}
-	return toBizERPRecord(row)
+	out, err := toBizERPRecord(row)
+	if err != nil {
+		return nil, err
+	}
+	changes := biz.DiffERPPayload(nil, decodeERPAuditPayload(row.Payload))
+	if err := writeERPAudit(ctx, tx, biz.ERPAuditCreate, moduleKey, row.ID, out.Code, createdByAdminID, changes); err != nil {
+		return nil, err
+	}
+	if err := syncERPStructuredTables(ctx, tx, "", out); err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (r *erpRepo) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, updatedByAdminID int) (*biz.ERPRecord, error) {
-	row, err := r.data.mysql.ERPModuleRecord.
+// updateERPRecord 在事务内按 record.Version（为 0 时不校验）保存 record.Payload，编码、状态箱取内容中的值；
+// 记录范围外的记录视为不存在。内容有变化时产生历史版本，内容变化与 extra 合并记一条 action 审计（均为空时不记）。
+func updateERPRecord(ctx context.Context, tx *ent.Tx, record *biz.ERPRecord, action string, operatorID int, extra []biz.ERPAuditChange) (*biz.ERPRecord, error) {
+	payloadJSON, err := json.Marshal(record.Payload)
+	if err != nil {
+		return nil, biz.ErrERPInvalidRecord
+	}
+	row, err := tx.ERPModuleRecord.
 		Query().
-		Where(
-			erpmodulerecord.IDEQ(id),
-			erpmodulerecord.ModuleKeyEQ(moduleKey),
-		).
+		Where(erpRecordScopePredicates(ctx, record.ModuleKey,
+			erpmodulerecord.IDEQ(record.ID),
+			erpmodulerecord.ModuleKeyEQ(record.ModuleKey),
+		)...).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -90,52 +164,189 @@ func (r *erpRepo) Update(ctx context.Context, moduleKey string, id int, payload
 		}
 		return nil, err
 	}
-
-	payloadJSON, err := json.Marshal(payload)
-	if err != nil {
-		return nil, biz.ErrERPInvalidRecord
+	if record.Version > 0 && row.Version != record.Version {
+		return nil, biz.ErrERPVersionConflict
+	}
+	previousCode := ""
+	if row.Code != nil {
+		previousCode = *row.Code
 	}
 
-	update := r.data.mysql.ERPModuleRecord.UpdateOneID(row.ID).SetPayload(string(payloadJSON))
-	if code := getPayloadString(payload, "code"); code != "" {
+	// 按读取时的版本条件更新：并发修改时后提交者更新 0 行，返回版本冲突而不是覆盖。
+	// 用批量更新取受影响行数；UpdateOne 在 0 行时按事务快照判断存在性，会把冲突当作成功。
+	update := tx.ERPModuleRecord.Update().
+		Where(erpmodulerecord.IDEQ(row.ID), erpmodulerecord.VersionEQ(row.Version)).
+		SetPayload(string(payloadJSON)).
+		AddVersion(1)
+	if code := getPayloadString(record.Payload, "code"); code != "" {
 		update = update.SetCode(code)
 	} else {
 		update = update.ClearCode()
 	}
-	if box := getPayloadString(payload, "box"); box != "" {
+	if box := getPayloadString(record.Payload, "box"); box != "" {
 		update = update.SetBox(box)
 	} else {
 		update = update.ClearBox()
 	}
-	if updatedByAdminID > 0 {
-		update = update.SetUpdatedByAdminID(updatedByAdminID)
+	if operatorID > 0 {
+		update = update.SetUpdatedByAdminID(operatorID)
 	}
-
-	saved, err := update.Save(ctx)
+	n, err := update.Save(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, biz.ErrERPRecordNotFound
-		}
 		return nil, normalizeERPRepoError(err)
 	}
-	return toBizERPRecord(saved)
+	if n == 0 {
+		return nil, biz.ErrERPVersionConflict
+	}
+	saved, err := tx.ERPModuleRecord.Get(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	out, err := toBizERPRecord(saved)
+	if err != nil {
+		return nil, err
+	}
+	// 内容未变化的保存不产生历史版本
+	changes := biz.DiffERPPayload(decodeERPAuditPayload(row.Payload), decodeERPAuditPayload(saved.Payload))
+	if len(changes) > 0 {
+		if err := writeERPRevision(ctx, tx, row); err != nil {
+			return nil, err
+		}
+	}
+	if changes = append(changes, extra...); len(changes) > 0 {
+		if err := writeERPAudit(ctx, tx, action, record.ModuleKey, row.ID, out.Code, operatorID, changes); err != nil {
+			return nil, err
+		}
+	}
+	if err := syncERPStructuredTables(ctx, tx, previousCode, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *erpRepo) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, expectedVersion, updatedByAdminID int) (*biz.ERPRecord, error) {
+	var out *biz.ERPRecord
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		var err error
+		record := &biz.ERPRecord{ID: id, ModuleKey: moduleKey, Payload: payload, Version: expectedVersion}
+		out, err = updateERPRecord(ctx, tx, record, biz.ERPAuditUpdate, updatedByAdminID, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (r *erpRepo) Delete(ctx context.Context, moduleKey string, id int) error {
-	affected, err := r.data.mysql.ERPModuleRecord.
-		Delete().
-		Where(
-			erpmodulerecord.IDEQ(id),
-			erpmodulerecord.ModuleKeyEQ(moduleKey),
-		).
-		Exec(ctx)
+	return r.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := tx.ERPModuleRecord.
+			Query().
+			Where(erpRecordScopePredicates(ctx, moduleKey,
+				erpmodulerecord.IDEQ(id),
+				erpmodulerecord.ModuleKeyEQ(moduleKey),
+			)...).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return biz.ErrERPRecordNotFound
+			}
+			return err
+		}
+		if err := tx.ERPModuleRecord.DeleteOneID(row.ID).Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return biz.ErrERPRecordNotFound
+			}
+			return err
+		}
+		if _, err := tx.ERPRecordRevision.Delete().
+			Where(erprecordrevision.RecordID(row.ID)).
+			Exec(ctx); err != nil {
+			return err
+		}
+		code := ""
+		if row.Code != nil {
+			code = *row.Code
+		}
+		changes := biz.DiffERPPayload(decodeERPAuditPayload(row.Payload), nil)
+		if err := writeERPAudit(ctx, tx, biz.ERPAuditDelete, moduleKey, row.ID, code, 0, changes); err != nil {
+			return err
+		}
+		if row.Code == nil {
+			return nil
+		}
+		// 单据删除后其链路不再有意义
+		if _, err := tx.ERPDocLink.Delete().
+			Where(erpdoclink.Or(
+				erpdoclink.And(erpdoclink.FromModuleEQ(moduleKey), erpdoclink.FromCodeEQ(*row.Code)),
+				erpdoclink.And(erpdoclink.ToModuleEQ(moduleKey), erpdoclink.ToCodeEQ(*row.Code)),
+			)).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return deleteERPStructuredTables(ctx, tx, moduleKey, *row.Code)
+	})
+}
+
+// erpRecordScopePredicates 在 base 条件后追加记录范围（见 biz.ERPRecordScope）；范围外的记录对列表不可见，
+// 更新与删除返回 ErrERPRecordNotFound。
+func erpRecordScopePredicates(ctx context.Context, moduleKey string, base ...predicate.ERPModuleRecord) []predicate.ERPModuleRecord {
+	scope, ok := biz.ERPScopeFromContext(ctx, moduleKey)
+	if !ok {
+		return base
+	}
+	owners := make([]any, 0, len(scope.OwnerNames))
+	for _, name := range scope.OwnerNames {
+		owners = append(owners, name)
+	}
+	owned := []predicate.ERPModuleRecord{erpmodulerecord.CreatedByAdminIDIn(scope.AdminIDs...)}
+	if len(owners) > 0 {
+		owned = append(owned, func(s *sql.Selector) {
+			s.Where(sqljson.ValueIn(s.C(erpmodulerecord.FieldPayload), owners, sqljson.Path("salesOwner")))
+		})
+	}
+	return append(base, erpmodulerecord.Or(owned...))
+}
+
+// withTx 在事务内执行 fn：通用记录与双写专表在同一事务提交，任一失败整体回滚。
+func (r *erpRepo) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := r.data.mysql.Tx(ctx)
 	if err != nil {
 		return err
 	}
-	if affected == 0 {
-		return biz.ErrERPRecordNotFound
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.WithContext(ctx).Errorf("[erp] rollback failed err=%v", rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *erpRepo) ListDocLinksByModule(ctx context.Context, moduleKey string) ([]*biz.ERPDocLink, error) {
+	rows, err := r.data.mysql.ERPDocLink.
+		Query().
+		Where(erpdoclink.Or(
+			erpdoclink.FromModuleEQ(moduleKey),
+			erpdoclink.ToModuleEQ(moduleKey),
+		)).
+		Order(ent.Asc(erpdoclink.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*biz.ERPDocLink, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &biz.ERPDocLink{
+			FromModule:   row.FromModule,
+			FromCode:     row.FromCode,
+			ToModule:     row.ToModule,
+			ToCode:       row.ToCode,
+			RelationType: row.RelationType,
+		})
 	}
-	return nil
+	return out, nil
 }
 
 func toBizERPRecord(row *ent.ERPModuleRecord) (*biz.ERPRecord, error) {
@@ -167,6 +378,7 @@ func toBizERPRecord(row *ent.ERPModuleRecord) (*biz.ERPRecord, error) {
 		Payload:          payload,
 		CreatedByAdminID: row.CreatedByAdminID,
 		UpdatedByAdminID: row.UpdatedByAdminID,
+		Version:          row.Version,
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        row.UpdatedAt,
 	}, nil
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/erprecordrevision"
+)
+
+var _ biz.ERPRevisionRepo = (*erpRepo)(nil)
+
+// writeERPRevision 在修改记录的同一事务内把修改前的内容保存为下一个历史版本。
+func writeERPRevision(ctx context.Context, tx *ent.Tx, row *ent.ERPModuleRecord) error {
+	latest, err := tx.ERPRecordRevision.Query().
+		Where(erprecordrevision.RecordID(row.ID)).
+		Order(ent.Desc(erprecordrevision.FieldRevision)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	next := 1
+	if latest != nil {
+		next = latest.Revision + 1
+	}
+	code := ""
+	if row.Code != nil {
+		code = *row.Code
+	}
+	savedBy := row.UpdatedByAdminID
+	if savedBy == nil {
+		savedBy = row.CreatedByAdminID
+	}
+	return tx.ERPRecordRevision.Create().
+		SetModuleKey(row.ModuleKey).
+		SetRecordID(row.ID).
+		SetRevision(next).
+		SetCode(code).
+		SetPayload(row.Payload).
+		SetNillableSavedByAdminID(savedBy).
+		SetSavedAt(row.UpdatedAt).
+		Exec(ctx)
+}
+
+func (r *erpRepo) ListRevisions(ctx context.Context, moduleKey string, recordID int) ([]*biz.ERPRevision, error) {
+	rows, err := r.data.mysql.ERPRecordRevision.Query().
+		Where(
+			erprecordrevision.ModuleKey(moduleKey),
+			erprecordrevision.RecordID(recordID),
+		).
+		Order(ent.Desc(erprecordrevision.FieldRevision)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*biz.ERPRevision, 0, len(rows))
+	for _, row := range rows {
+		payload := map[string]any{}
+		if err := json.Unmarshal([]byte(row.Payload), &payload); err != nil {
+			return nil, fmt.Errorf("%w: revision payload 反序列化失败: %v", biz.ErrERPInvalidRecord, err)
+		}
+		out = append(out, &biz.ERPRevision{
+			Revision:       row.Revision,
+			Code:           row.Code,
+			Payload:        payload,
+			SavedByAdminID: row.SavedByAdminID,
+			SavedAt:        row.SavedAt,
+		})
+	}
+	return out, nil
+}
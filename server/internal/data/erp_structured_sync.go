@@ -0,0 +1,286 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/erpsettlement"
+	"server/internal/data/model/ent/erpsettlementline"
+	"server/internal/data/model/ent/erpshipmentdetail"
+	"server/internal/data/model/ent/erpshipmentdetailitem"
+)
+
+// 双写期：erp_module_records 仍是读路径的数据源，保存时同步写入已接线的专表。
+// 目前接线：结汇单 → erp_settlements + erp_settlement_lines；
+// 往来单位 → erp_partners + erp_partner_contacts / erp_partner_addresses / erp_partner_bank_accounts。
+
+func syncERPStructuredTables(ctx context.Context, tx *ent.Tx, previousCode string, record *biz.ERPRecord) error {
+	switch record.ModuleKey {
+	case biz.ERPModuleSettlements:
+		return syncERPSettlement(ctx, tx, previousCode, record)
+	case biz.ERPModulePartners:
+		return syncERPPartner(ctx, tx, previousCode, record)
+	default:
+		return nil
+	}
+}
+
+func deleteERPStructuredTables(ctx context.Context, tx *ent.Tx, moduleKey, code string) error {
+	switch moduleKey {
+	case biz.ERPModuleSettlements:
+		return deleteERPSettlement(ctx, tx, code)
+	case biz.ERPModulePartners:
+		return deleteERPPartner(ctx, tx, code)
+	default:
+		return nil
+	}
+}
+
+type erpSettlementRow struct {
+	Code               string
+	InvoiceNo          string
+	CustomerName       string
+	Currency           string
+	ShipDate           time.Time
+	PaymentCycleDays   int
+	ReceivableDate     time.Time
+	Amount             float64
+	SourceShipmentCode string
+	CreatedByAdminID   *int
+	UpdatedByAdminID   *int
+	Lines              []erpSettlementLineRow
+}
+
+type erpSettlementLineRow struct {
+	LineNo         int
+	ShipmentCode   string
+	ShipmentLineNo int
+	ProductModel   string
+	Quantity       float64
+	UnitPrice      float64
+	Amount         float64
+}
+
+// toERPSettlementRow 将结汇单 payload 映射为专表行；专表必填字段缺失时按记录自身信息兜底。
+func toERPSettlementRow(record *biz.ERPRecord) erpSettlementRow {
+	payload := record.Payload
+	row := erpSettlementRow{
+		Code:               record.Code,
+		InvoiceNo:          strings.TrimSpace(getPayloadString(payload, "invoiceNo")),
+		CustomerName:       strings.TrimSpace(getPayloadString(payload, "customerName")),
+		Currency:           strings.TrimSpace(getPayloadString(payload, "currency")),
+		PaymentCycleDays:   int(payloadFloat(payload, "paymentCycleDays")),
+		Amount:             payloadFloat(payload, "amount"),
+		SourceShipmentCode: strings.TrimSpace(getPayloadString(payload, "sourceShipmentCode")),
+		CreatedByAdminID:   record.CreatedByAdminID,
+		UpdatedByAdminID:   record.UpdatedByAdminID,
+	}
+	if row.InvoiceNo == "" {
+		row.InvoiceNo = record.Code
+	}
+	if row.CustomerName == "" {
+		row.CustomerName = "-"
+	}
+	if row.Currency == "" {
+		row.Currency = "USD"
+	}
+	if row.SourceShipmentCode == "" {
+		row.SourceShipmentCode = row.InvoiceNo
+	}
+	row.ShipDate = payloadDate(payload, "shipDate", record.CreatedAt)
+	row.ReceivableDate = payloadDate(payload, "receivableDate", row.ShipDate)
+
+	lines, _ := payload["lines"].([]any)
+	for index, raw := range lines {
+		line, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		lineNo := int(payloadFloat(line, "lineNo"))
+		if lineNo <= 0 {
+			lineNo = index + 1
+		}
+		row.Lines = append(row.Lines, erpSettlementLineRow{
+			LineNo:         lineNo,
+			ShipmentCode:   strings.TrimSpace(getPayloadString(line, "shipmentCode")),
+			ShipmentLineNo: int(payloadFloat(line, "shipmentLineNo")),
+			ProductModel:   strings.TrimSpace(getPayloadString(line, "productModel")),
+			Quantity:       payloadFloat(line, "quantity"),
+			UnitPrice:      payloadFloat(line, "unitPrice"),
+			Amount:         payloadFloat(line, "amount"),
+		})
+	}
+	return row
+}
+
+func syncERPSettlement(ctx context.Context, tx *ent.Tx, previousCode string, record *biz.ERPRecord) error {
+	if previousCode != "" && previousCode != record.Code {
+		if err := deleteERPSettlement(ctx, tx, previousCode); err != nil {
+			return err
+		}
+	}
+	if record.Code == "" {
+		return nil
+	}
+	row := toERPSettlementRow(record)
+
+	existing, err := tx.ERPSettlement.Query().Where(erpsettlement.CodeEQ(row.Code)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	var settlementID int
+	if existing == nil {
+		saved, err := tx.ERPSettlement.Create().
+			SetCode(row.Code).
+			SetInvoiceNo(row.InvoiceNo).
+			SetCustomerName(row.CustomerName).
+			SetCurrency(row.Currency).
+			SetShipDate(row.ShipDate).
+			SetPaymentCycleDays(row.PaymentCycleDays).
+			SetReceivableDate(row.ReceivableDate).
+			SetAmount(row.Amount).
+			SetOutstandingAmount(row.Amount).
+			SetSourceShipmentCode(row.SourceShipmentCode).
+			SetNillableCreatedByAdminID(row.CreatedByAdminID).
+			SetNillableUpdatedByAdminID(row.UpdatedByAdminID).
+			Save(ctx)
+		if err != nil {
+			return normalizeERPRepoError(err)
+		}
+		settlementID = saved.ID
+	} else {
+		_, err := tx.ERPSettlement.UpdateOneID(existing.ID).
+			SetInvoiceNo(row.InvoiceNo).
+			SetCustomerName(row.CustomerName).
+			SetCurrency(row.Currency).
+			SetShipDate(row.ShipDate).
+			SetPaymentCycleDays(row.PaymentCycleDays).
+			SetReceivableDate(row.ReceivableDate).
+			SetAmount(row.Amount).
+			SetOutstandingAmount(row.Amount - existing.ReceivedAmount).
+			SetSourceShipmentCode(row.SourceShipmentCode).
+			SetNillableUpdatedByAdminID(row.UpdatedByAdminID).
+			Save(ctx)
+		if err != nil {
+			return normalizeERPRepoError(err)
+		}
+		settlementID = existing.ID
+	}
+
+	if _, err := tx.ERPSettlementLine.Delete().
+		Where(erpsettlementline.SettlementIDEQ(settlementID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(row.Lines) == 0 {
+		return nil
+	}
+
+	itemIDs := map[string]map[int]int{}
+	builders := make([]*ent.ERPSettlementLineCreate, 0, len(row.Lines))
+	for _, line := range row.Lines {
+		create := tx.ERPSettlementLine.Create().
+			SetSettlementID(settlementID).
+			SetLineNo(line.LineNo).
+			SetQuantity(line.Quantity).
+			SetUnitPrice(line.UnitPrice).
+			SetAmount(line.Amount).
+			SetOutstandingAmount(line.Amount)
+		if line.ProductModel != "" {
+			create = create.SetProductModel(line.ProductModel)
+		}
+		if line.ShipmentCode != "" {
+			create = create.SetShipmentCode(line.ShipmentCode)
+			if line.ShipmentLineNo > 0 {
+				create = create.SetShipmentLineNo(line.ShipmentLineNo)
+				if _, ok := itemIDs[line.ShipmentCode]; !ok {
+					ids, err := loadERPShipmentItemIDs(ctx, tx, line.ShipmentCode)
+					if err != nil {
+						return err
+					}
+					itemIDs[line.ShipmentCode] = ids
+				}
+				if itemID, ok := itemIDs[line.ShipmentCode][line.ShipmentLineNo]; ok {
+					create = create.SetShipmentDetailItemID(itemID)
+				}
+			}
+		}
+		builders = append(builders, create)
+	}
+	if _, err := tx.ERPSettlementLine.CreateBulk(builders...).Save(ctx); err != nil {
+		return normalizeERPRepoError(err)
+	}
+	return nil
+}
+
+func deleteERPSettlement(ctx context.Context, tx *ent.Tx, code string) error {
+	existing, err := tx.ERPSettlement.Query().Where(erpsettlement.CodeEQ(code)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if _, err := tx.ERPSettlementLine.Delete().
+		Where(erpsettlementline.SettlementIDEQ(existing.ID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return tx.ERPSettlement.DeleteOneID(existing.ID).Exec(ctx)
+}
+
+// loadERPShipmentItemIDs 返回出运明细专表中 行号 → erp_shipment_detail_items.id；出运明细尚未双写时返回空表。
+func loadERPShipmentItemIDs(ctx context.Context, tx *ent.Tx, shipmentCode string) (map[int]int, error) {
+	out := map[int]int{}
+	shipment, err := tx.ERPShipmentDetail.Query().Where(erpshipmentdetail.CodeEQ(shipmentCode)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return out, nil
+		}
+		return nil, err
+	}
+	items, err := tx.ERPShipmentDetailItem.Query().
+		Where(erpshipmentdetailitem.ShipmentDetailIDEQ(shipment.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		out[item.LineNo] = item.ID
+	}
+	return out, nil
+}
+
+func payloadFloat(payload map[string]any, key string) float64 {
+	switch value := payload[key].(type) {
+	case float64:
+		return value
+	case int:
+		return float64(value)
+	case int64:
+		return float64(value)
+	case string:
+		f, _ := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		return f
+	default:
+		return 0
+	}
+}
+
+func payloadDate(payload map[string]any, key string, fallback time.Time) time.Time {
+	raw := strings.TrimSpace(getPayloadString(payload, key))
+	if raw == "" {
+		return fallback
+	}
+	for _, layout := range []string{"2006-01-02", time.RFC3339, "2006/01/02"} {
+		if parsed, err := time.Parse(layout, raw); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
@@ -34,6 +34,13 @@ type JsonrpcData struct {
 	adminManageUC *biz.AdminManageUsecase
 	userAdminUC   *biz.UserAdminUsecase
 	erpUC         *biz.ERPUsecase
+	adminRoleUC   *biz.AdminRoleUsecase
+	// adminSessionUC 为空时（单测）管理员 token 不校验服务端会话。
+	adminSessionUC  *biz.AdminSessionUsecase
+	adminPasswordUC *biz.AdminPasswordUsecase
+	adminTOTPUC     *biz.AdminTOTPUsecase
+	erpAuditUC      *biz.ERPAuditUsecase
+	erpRevisionUC   *biz.ERPRevisionUsecase
 
 	adminManageRepo biz.AdminManageRepo
 }
@@ -87,7 +94,34 @@ func NewJsonrpcData(
 	userAdminUC := biz.NewUserAdminUsecase(userAdminRepo, logger, tracerProvider)
 	helper.Info("JsonrpcData created (user admin usecase constructed inside)")
 	erpUC := biz.NewERPUsecase(NewERPRepo(data, logger), logger, tracerProvider)
+	erpUC.SetFulfilmentTolerance(c.GetErp().GetFulfilmentTolerancePercent())
+	erpUC.SetMinMarginPercent(c.GetErp().GetMinMarginPercent())
 	helper.Info("JsonrpcData created (erp usecase constructed inside)")
+	startERPQuotationStatusJob(data, erpUC, time.Duration(c.GetErp().GetQuotationStatusCheckMinutes())*time.Minute, helper)
+	adminRoleUC := biz.NewAdminRoleUsecase(NewAdminRoleRepo(data, logger), adminManageUC, logger, tracerProvider)
+	helper.Info("JsonrpcData created (admin role usecase constructed inside)")
+	adminSessionUC := biz.NewAdminSessionUsecase(
+		NewAdminSessionRepo(data, logger),
+		adminManageUC,
+		NewAdminSessionTokenGenerator(c, logger),
+		adminSessionTTL(c),
+		logger,
+		tracerProvider,
+	)
+	adminManageUC.SetSessionRevoker(adminSessionUC)
+	helper.Info("JsonrpcData created (admin session usecase constructed inside)")
+	securityPolicy := adminSecurityPolicy(c)
+	adminAuthUC.SetSecurityPolicy(securityPolicy)
+	adminManageUC.SetSecurityPolicy(securityPolicy)
+	adminPasswordUC := biz.NewAdminPasswordUsecase(adminAuthRepo, adminManageUC, logger, tracerProvider)
+	helper.Info("JsonrpcData created (admin password usecase constructed inside)")
+	adminTOTPUC := biz.NewAdminTOTPUsecase(NewAdminTOTPRepo(data, logger), adminManageUC, logger, tracerProvider)
+	adminAuthUC.SetSecondFactor(adminTOTPUC)
+	helper.Info("JsonrpcData created (admin totp usecase constructed inside)")
+	erpAuditUC := biz.NewERPAuditUsecase(NewERPRepo(data, logger), adminManageUC, logger, tracerProvider)
+	helper.Info("JsonrpcData created (erp audit usecase constructed inside)")
+	erpRevisionUC := biz.NewERPRevisionUsecase(NewERPRepo(data, logger), erpUC, logger, tracerProvider)
+	helper.Info("JsonrpcData created (erp revision usecase constructed inside)")
 
 	return &JsonrpcData{
 		data:            data,
@@ -98,6 +132,12 @@ func NewJsonrpcData(
 		adminManageUC:   adminManageUC,
 		userAdminUC:     userAdminUC,
 		erpUC:           erpUC,
+		adminRoleUC:     adminRoleUC,
+		adminSessionUC:  adminSessionUC,
+		adminPasswordUC: adminPasswordUC,
+		adminTOTPUC:     adminTOTPUC,
+		erpAuditUC:      erpAuditUC,
+		erpRevisionUC:   erpRevisionUC,
 		adminManageRepo: adminManageRepo,
 	}
 }
@@ -131,6 +171,10 @@ func (d *JsonrpcData) Handle(
 		}
 	}
 
+	if url == "admin" && accountSetupMethods[method] {
+		ctx = context.WithValue(ctx, ctxKeyAccountSetupExempt{}, true)
+	}
+
 	switch url {
 	case "system":
 		return d.handleSystem(ctx, id, method, params)
@@ -140,10 +184,22 @@ func (d *JsonrpcData) Handle(
 		return d.handleUser(ctx, method, id, params)
 	case "admin":
 		return d.handleAdmin(ctx, method, id, params)
+	case "role":
+		return d.handleRole(ctx, method, id, params)
 	case "subscription":
 		return d.handleSubscription(ctx, method, id, params)
 	case "erp":
 		return d.handleERP(ctx, method, id, params)
+	case "finance":
+		return d.handleFinance(ctx, method, id, params)
+	case "report":
+		return d.handleReport(ctx, method, id, params)
+	case "audit":
+		return d.handleAudit(ctx, method, id, params)
+	case "masterdata":
+		return d.handleMasterdata(ctx, method, id, params)
+	case "production":
+		return d.handleProduction(ctx, method, id, params)
 	default:
 		return id, &v1.JsonrpcResult{
 			Code:    40001,
@@ -235,37 +291,88 @@ func (d *JsonrpcData) handleAuth(
 	case "admin_login":
 		username := getString(pm, "username")
 		password := getString(pm, "password")
+		otpCode := getString(pm, "otp_code")
 
 		if username == "" || password == "" {
 			return id, &v1.JsonrpcResult{Code: 40010, Message: "缺少用户名或密码"}, nil
 		}
 
-		token, expireAt, admin, err := d.adminAuthUC.Login(ctx, username, password)
+		var (
+			admin  *biz.AdminUser
+			tokens *biz.AdminSessionTokens
+			err    error
+		)
+		if d.adminSessionUC != nil {
+			admin, err = d.adminAuthUC.Authenticate(ctx, username, password, otpCode)
+			if err == nil {
+				tokens, err = d.adminSessionUC.Start(ctx, admin)
+			}
+		} else {
+			tokens = &biz.AdminSessionTokens{}
+			tokens.AccessToken, tokens.AccessExpiresAt, admin, err = d.adminAuthUC.Login(ctx, username, password, otpCode)
+		}
 		if err != nil {
 			return id, d.mapAuthError(ctx, err), nil
 		}
 
 		adminLevel := int(biz.AdminLevelSecondary)
 		menuPermissions := []string{}
+		totpEnabled, totpSetupRequired := false, false
 		if d.adminManageRepo != nil {
 			if currentAdmin, getErr := d.adminManageRepo.GetAdminByUsername(ctx, admin.Username); getErr == nil && currentAdmin != nil {
 				adminLevel = int(currentAdmin.Level)
-				menuPermissions = biz.EffectiveAdminMenuPermissions(currentAdmin.Level, currentAdmin.MenuPermissions)
+				menuPermissions = biz.AdminMenuPermissionsFor(currentAdmin)
+				totpEnabled = currentAdmin.TOTPEnabled
+				if d.adminManageUC != nil {
+					totpSetupRequired = !currentAdmin.TOTPEnabled && d.adminManageUC.SecurityPolicy().TOTPRequired(currentAdmin.Level)
+				}
 			}
 		}
 
+		data := map[string]any{
+			"user_id":              admin.ID,
+			"username":             admin.Username,
+			"access_token":         tokens.AccessToken,
+			"expires_at":           tokens.AccessExpiresAt.Unix(),
+			"token_type":           "Bearer",
+			"issued_at":            time.Now().Unix(),
+			"admin_level":          adminLevel,
+			"menu_permissions":     toAnySliceString(menuPermissions),
+			"must_change_password": admin.MustChangePassword,
+			"totp_enabled":         totpEnabled,
+			"totp_setup_required":  totpSetupRequired,
+		}
+		if tokens.RefreshToken != "" {
+			data["refresh_token"] = tokens.RefreshToken
+			data["refresh_expires_at"] = tokens.RefreshExpiresAt.Unix()
+		}
+
 		return id, &v1.JsonrpcResult{
 			Code:    0,
 			Message: "登录成功",
+			Data:    newDataStruct(data),
+		}, nil
+
+	// ---------- refresh（管理员会话：refresh token 换新 access token） ----------
+	case "refresh":
+		refreshToken := getString(pm, "refresh_token")
+		if refreshToken == "" || d.adminSessionUC == nil {
+			return id, &v1.JsonrpcResult{Code: 40010, Message: "缺少 refresh_token"}, nil
+		}
+		tokens, err := d.adminSessionUC.Refresh(ctx, refreshToken)
+		if err != nil {
+			return id, d.mapAdminSessionAuthError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
 			Data: newDataStruct(map[string]any{
-				"user_id":          admin.ID,
-				"username":         admin.Username,
-				"access_token":     token,
-				"expires_at":       expireAt.Unix(),
-				"token_type":       "Bearer",
-				"issued_at":        time.Now().Unix(),
-				"admin_level":      adminLevel,
-				"menu_permissions": toAnySliceString(menuPermissions),
+				"access_token":       tokens.AccessToken,
+				"expires_at":         tokens.AccessExpiresAt.Unix(),
+				"token_type":         "Bearer",
+				"issued_at":          time.Now().Unix(),
+				"refresh_token":      tokens.RefreshToken,
+				"refresh_expires_at": tokens.RefreshExpiresAt.Unix(),
 			}),
 		}, nil
 
@@ -312,6 +419,12 @@ func (d *JsonrpcData) handleAuth(
 				"[auth] user logout failed: no claims found id=%s",
 				"id", id)
 		}
+		if claims.IsAdmin() && d.adminSessionUC != nil {
+			if err := d.adminSessionUC.Logout(ctx); err != nil {
+				d.log.WithContext(ctx).Errorf("[auth] admin logout revoke session failed uid=%d err=%v", claims.UserID, err)
+				return id, &v1.JsonrpcResult{Code: 50000, Message: "系统内部错误"}, nil
+			}
+		}
 
 		return id, &v1.JsonrpcResult{
 			Code:    0,
@@ -403,6 +516,26 @@ func (d *JsonrpcData) mapAuthError(ctx context.Context, err error) *v1.JsonrpcRe
 			Message: "用户名已存在",
 		}
 
+	case biz.ErrAdminLocked:
+		logger.Warn("[auth] admin locked")
+		return &v1.JsonrpcResult{
+			Code:    10007,
+			Message: "登录失败次数过多，账号已临时锁定，请稍后再试",
+		}
+
+	case biz.ErrTOTPRequired:
+		return &v1.JsonrpcResult{
+			Code:    10008,
+			Message: "请输入两步验证码",
+		}
+
+	case biz.ErrInvalidTOTP:
+		logger.Warn("[auth] invalid totp code")
+		return &v1.JsonrpcResult{
+			Code:    10009,
+			Message: "两步验证码错误",
+		}
+
 	// ===== 未知错误 =====
 	default:
 		logger.Errorf("[auth] internal error: %v", err)
@@ -413,6 +546,21 @@ func (d *JsonrpcData) mapAuthError(ctx context.Context, err error) *v1.JsonrpcRe
 	}
 }
 
+// mapAdminSessionAuthError 会话失效按登录态错误返回，前端据此跳转登录页。
+func (d *JsonrpcData) mapAdminSessionAuthError(ctx context.Context, err error) *v1.JsonrpcResult {
+	switch {
+	case errors.Is(err, biz.ErrAdminSessionExpired):
+		return &v1.JsonrpcResult{Code: 10005, Message: "登录已过期，请重新登录"}
+	case errors.Is(err, biz.ErrAdminSessionNotFound), errors.Is(err, biz.ErrAdminSessionRevoked):
+		return &v1.JsonrpcResult{Code: 10006, Message: "登录已失效，请重新登录"}
+	case errors.Is(err, biz.ErrAdminDisabled):
+		return &v1.JsonrpcResult{Code: 10003, Message: "用户已被禁用"}
+	default:
+		d.log.WithContext(ctx).Errorf("[auth] admin session internal error: %v", err)
+		return &v1.JsonrpcResult{Code: 50000, Message: "系统内部错误"}
+	}
+}
+
 // =========================
 // helpers
 // =========================
@@ -447,6 +595,26 @@ func getInt(m map[string]any, key string, def int) int {
 	}
 }
 
+func getFloat(m map[string]any, key string, def float64) float64 {
+	v, ok := m[key]
+	if !ok || v == nil {
+		return def
+	}
+	switch x := v.(type) {
+	case float64:
+		return x
+	case int:
+		return float64(x)
+	case string:
+		if f, err := strconv.ParseFloat(strings.TrimSpace(x), 64); err == nil {
+			return f
+		}
+		return def
+	default:
+		return def
+	}
+}
+
 func getInt64(m map[string]any, key string, def int64) int64 {
 	v, ok := m[key]
 	if !ok || v == nil {
@@ -548,8 +716,13 @@ func newDataStruct(m map[string]any) *structpb.Struct {
 }
 
 func (d *JsonrpcData) requireLogin(ctx context.Context) (*biz.AuthClaims, *v1.JsonrpcResult) {
-	// 1) 有 claims → 已登录
+	// 1) 有 claims → 已登录；管理员 token 还需对应有效的服务端会话
 	if c, ok := biz.GetClaimsFromContext(ctx); ok && c != nil {
+		if c.IsAdmin() && d.adminSessionUC != nil {
+			if _, err := d.adminSessionUC.Verify(ctx, c); err != nil {
+				return nil, d.mapAdminSessionAuthError(ctx, err)
+			}
+		}
 		return c, nil
 	}
 
@@ -576,7 +749,8 @@ func (d *JsonrpcData) requireAdmin(ctx context.Context) (*biz.AuthClaims, *v1.Js
 
 	// 不仅校验 token 角色，还要校验管理员账号仍存在且未禁用。
 	if d.adminManageUC != nil {
-		if _, err := d.adminManageUC.GetCurrent(ctx); err != nil {
+		admin, err := d.adminManageUC.GetCurrent(ctx)
+		if err != nil {
 			switch err {
 			case biz.ErrAdminDisabled:
 				return nil, &v1.JsonrpcResult{Code: 40303, Message: "管理员已禁用"}
@@ -589,10 +763,84 @@ func (d *JsonrpcData) requireAdmin(ctx context.Context) (*biz.AuthClaims, *v1.Js
 				return nil, &v1.JsonrpcResult{Code: 50000, Message: "服务器内部错误"}
 			}
 		}
+		// 密码被重置、或所在等级强制两步验证但未启用时，只允许查看本人信息并完成设置
+		if !accountSetupExempt(ctx) {
+			if admin.MustChangePassword {
+				return nil, &v1.JsonrpcResult{Code: 40304, Message: "请先修改密码"}
+			}
+			if !admin.TOTPEnabled && d.adminManageUC.SecurityPolicy().TOTPRequired(admin.Level) {
+				return nil, &v1.JsonrpcResult{Code: 40305, Message: "请先启用两步验证"}
+			}
+		}
 	}
 	return c, nil
 }
 
+// accountSetupMethods 强制改密或强制两步验证期间仍可调用的 admin 方法。
+var accountSetupMethods = map[string]bool{
+	"me":              true,
+	"change_password": true,
+	"totp_status":     true,
+	"totp_setup":      true,
+	"totp_enable":     true,
+}
+
+type ctxKeyAccountSetupExempt struct{}
+
+func accountSetupExempt(ctx context.Context) bool {
+	exempt, _ := ctx.Value(ctxKeyAccountSetupExempt{}).(bool)
+	return exempt
+}
+
+// requireMenuPermission 校验当前管理员的有效菜单权限包含 menuKey；未注入管理员用例时无法鉴权，一律拒绝。
+func (d *JsonrpcData) requireMenuPermission(ctx context.Context, menuKey string) *v1.JsonrpcResult {
+	if d.adminManageUC == nil {
+		return d.mapERPError(ctx, biz.ErrForbidden)
+	}
+	admin, err := d.adminManageUC.GetCurrent(ctx)
+	if err != nil {
+		return d.mapERPError(ctx, err)
+	}
+	if err := biz.AuthorizeAdminMenu(admin, menuKey); err != nil {
+		return d.mapERPError(ctx, err)
+	}
+	return nil
+}
+
+// requireERPPermission 校验当前管理员对 ERP 模块执行 action 的权限；未注入管理员用例时无法鉴权，一律拒绝。
+func (d *JsonrpcData) requireERPPermission(ctx context.Context, moduleKey, action string) *v1.JsonrpcResult {
+	if d.adminManageUC == nil {
+		return d.mapERPError(ctx, biz.ErrForbidden)
+	}
+	admin, err := d.adminManageUC.GetCurrent(ctx)
+	if err != nil {
+		return d.mapERPError(ctx, err)
+	}
+	if err := biz.AuthorizeERPModule(admin, moduleKey, action); err != nil {
+		return d.mapERPError(ctx, err)
+	}
+	return nil
+}
+
+// withERPAccess 按当前管理员权限绑定记录范围（缺少 view_all 时限本人及下级）与金额脱敏（缺少 view_amounts）。
+func (d *JsonrpcData) withERPAccess(ctx context.Context, moduleKey string) (context.Context, error) {
+	admin, err := d.getCurrentAdmin(ctx)
+	if err != nil || admin == nil {
+		return ctx, err
+	}
+	if !biz.EffectiveAdminPermissions(admin).Allows(moduleKey, biz.ERPActionViewAmounts) {
+		ctx = biz.NewContextWithERPAmountMask(ctx)
+	}
+	if biz.ERPRecordScopeFor(admin, moduleKey, nil) == nil {
+		return ctx, nil
+	}
+	admins, err := d.listAdmins(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	return biz.NewContextWithERPScope(ctx, biz.ERPRecordScopeFor(admin, moduleKey, admins)), nil
+}
+
 func (d *JsonrpcData) getCurrentAdmin(ctx context.Context) (*biz.AdminAccount, error) {
 	if d.adminManageUC == nil {
 		return nil, nil
@@ -624,7 +872,7 @@ func (d *JsonrpcData) isPublic(url, method string) bool {
 		return true
 	}
 	// auth 公共（登录/注册/登出一般也允许不登录调用）
-	if url == "auth" && (method == "login" || method == "admin_login" || method == "register" || method == "logout") {
+	if url == "auth" && (method == "login" || method == "admin_login" || method == "register" || method == "logout" || method == "refresh") {
 		return true
 	}
 	return false
@@ -1008,14 +1256,19 @@ func (d *JsonrpcData) handleAdmin(
 			Code:    0,
 			Message: "OK",
 			Data: newDataStruct(map[string]any{
-				"id":               admin.ID,
-				"username":         admin.Username,
-				"level":            int(admin.Level),
-				"parent_id":        parentID,
-				"disabled":         admin.Disabled,
-				"menu_permissions": toAnySliceString(admin.MenuPermissions),
-				"created_at":       admin.CreatedAt.Unix(),
-				"updated_at":       admin.UpdatedAt.Unix(),
+				"id":                   admin.ID,
+				"username":             admin.Username,
+				"level":                int(admin.Level),
+				"parent_id":            parentID,
+				"disabled":             admin.Disabled,
+				"menu_permissions":     toAnySliceString(admin.MenuPermissions),
+				"role_based":           admin.RoleBased,
+				"roles":                toAdminRoleRefs(admin.Roles),
+				"must_change_password": admin.MustChangePassword,
+				"totp_enabled":         admin.TOTPEnabled,
+				"totp_required":        d.adminManageUC.SecurityPolicy().TOTPRequired(admin.Level),
+				"created_at":           admin.CreatedAt.Unix(),
+				"updated_at":           admin.UpdatedAt.Unix(),
 			}),
 		}, nil
 
@@ -1035,6 +1288,10 @@ func (d *JsonrpcData) handleAdmin(
 			if a.LastLoginAt != nil {
 				lastLogin = a.LastLoginAt.Unix()
 			}
+			lockedUntil := int64(0)
+			if a.LockedUntil != nil {
+				lockedUntil = a.LockedUntil.Unix()
+			}
 			arr = append(arr, map[string]any{
 				"id":                    a.ID,
 				"username":              a.Username,
@@ -1042,10 +1299,15 @@ func (d *JsonrpcData) handleAdmin(
 				"parent_id":             parentID,
 				"disabled":              a.Disabled,
 				"menu_permissions":      toAnySliceString(a.MenuPermissions),
+				"role_based":            a.RoleBased,
+				"roles":                 toAdminRoleRefs(a.Roles),
 				"user_count":            a.UserCount,
 				"manageable_user_count": a.ManageableUserCount,
 				"child_admin_count":     a.ChildAdminCount,
 				"last_login_at":         lastLogin,
+				"must_change_password":  a.MustChangePassword,
+				"locked_until":          lockedUntil,
+				"totp_enabled":          a.TOTPEnabled,
 				"created_at":            a.CreatedAt.Unix(),
 				"updated_at":            a.UpdatedAt.Unix(),
 			})
@@ -1188,6 +1450,156 @@ func (d *JsonrpcData) handleAdmin(
 			}),
 		}, nil
 
+	case "change_password":
+		admin, err := d.adminPasswordUC.ChangePassword(ctx, getString(pm, "old_password"), getString(pm, "new_password"))
+		if err != nil {
+			return id, d.mapAdminPasswordError(ctx, err), nil
+		}
+		data := map[string]any{"success": true}
+		// 改密后原会话全部注销，为当前客户端签发新会话
+		if d.adminSessionUC != nil {
+			tokens, err := d.adminSessionUC.Start(ctx, &biz.AdminUser{ID: admin.ID, Username: admin.Username})
+			if err != nil {
+				return id, d.mapAdminManageError(ctx, err), nil
+			}
+			data["access_token"] = tokens.AccessToken
+			data["expires_at"] = tokens.AccessExpiresAt.Unix()
+			data["token_type"] = "Bearer"
+			data["refresh_token"] = tokens.RefreshToken
+			data["refresh_expires_at"] = tokens.RefreshExpiresAt.Unix()
+		}
+		return id, &v1.JsonrpcResult{Code: 0, Message: "OK", Data: newDataStruct(data)}, nil
+
+	case "reset_password":
+		admin, err := d.adminPasswordUC.ResetPassword(ctx, getInt(pm, "id", 0), getString(pm, "new_password"))
+		if err != nil {
+			return id, d.mapAdminPasswordError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"admin": map[string]any{
+					"id":                   admin.ID,
+					"username":             admin.Username,
+					"must_change_password": admin.MustChangePassword,
+				},
+			}),
+		}, nil
+
+	case "totp_status":
+		status, err := d.adminTOTPUC.Status(ctx)
+		if err != nil {
+			return id, d.mapAdminTOTPError(ctx, err), nil
+		}
+		enabledAt := int64(0)
+		if status.EnabledAt != nil {
+			enabledAt = status.EnabledAt.Unix()
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"enabled":                  status.Enabled,
+				"required":                 status.Required,
+				"enabled_at":               enabledAt,
+				"recovery_codes_remaining": status.RecoveryCodesRemaining,
+			}),
+		}, nil
+
+	case "totp_setup":
+		setup, err := d.adminTOTPUC.Setup(ctx)
+		if err != nil {
+			return id, d.mapAdminTOTPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"secret":           setup.Secret,
+				"provisioning_uri": setup.ProvisioningURI,
+			}),
+		}, nil
+
+	case "totp_enable":
+		codes, err := d.adminTOTPUC.Enable(ctx, getString(pm, "code"))
+		if err != nil {
+			return id, d.mapAdminTOTPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"recovery_codes": toAnySliceString(codes)}),
+		}, nil
+
+	case "totp_disable":
+		if err := d.adminTOTPUC.Disable(ctx, getString(pm, "code")); err != nil {
+			return id, d.mapAdminTOTPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{Code: 0, Message: "OK", Data: newDataStruct(map[string]any{"success": true})}, nil
+
+	case "totp_recovery_codes":
+		codes, err := d.adminTOTPUC.RegenerateRecoveryCodes(ctx, getString(pm, "code"))
+		if err != nil {
+			return id, d.mapAdminTOTPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"recovery_codes": toAnySliceString(codes)}),
+		}, nil
+
+	case "totp_reset":
+		if err := d.adminTOTPUC.Reset(ctx, getInt(pm, "id", 0)); err != nil {
+			return id, d.mapAdminTOTPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{Code: 0, Message: "OK", Data: newDataStruct(map[string]any{"success": true})}, nil
+
+	case "sessions":
+		if d.adminSessionUC == nil {
+			return id, &v1.JsonrpcResult{Code: 40020, Message: "未启用会话管理"}, nil
+		}
+		sessions, err := d.adminSessionUC.List(ctx, getInt(pm, "admin_id", 0))
+		if err != nil {
+			return id, d.mapAdminSessionError(ctx, err), nil
+		}
+		arr := make([]any, 0, len(sessions))
+		for _, session := range sessions {
+			arr = append(arr, toAdminSessionView(session))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"sessions": arr}),
+		}, nil
+
+	case "kick":
+		if d.adminSessionUC == nil {
+			return id, &v1.JsonrpcResult{Code: 40020, Message: "未启用会话管理"}, nil
+		}
+		// session_id 踢出单个会话；只传 admin_id 时踢出该管理员全部会话
+		revoked := 0
+		if sessionID := getInt(pm, "session_id", 0); sessionID > 0 {
+			if err := d.adminSessionUC.Kick(ctx, sessionID); err != nil {
+				return id, d.mapAdminSessionError(ctx, err), nil
+			}
+			revoked = 1
+		} else {
+			n, err := d.adminSessionUC.KickAdmin(ctx, getInt(pm, "admin_id", 0))
+			if err != nil {
+				return id, d.mapAdminSessionError(ctx, err), nil
+			}
+			revoked = n
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"success": true,
+				"revoked": revoked,
+			}),
+		}, nil
+
 	default:
 		l.Warnf("[admin] unknown method=%s id=%s", method, id)
 		return id, &v1.JsonrpcResult{
@@ -1217,12 +1629,64 @@ func (d *JsonrpcData) mapAdminManageError(ctx context.Context, err error) *v1.Js
 		return &v1.JsonrpcResult{Code: 40011, Message: "管理员等级不合法"}
 	case biz.ErrAdminInvalidParent:
 		return &v1.JsonrpcResult{Code: 40012, Message: "上级管理员不合法"}
+	case biz.ErrPasswordTooWeak:
+		return &v1.JsonrpcResult{Code: 40013, Message: d.adminManageUC.SecurityPolicy().Describe()}
 	default:
 		l.Errorf("[admin] internal err=%v", err)
 		return &v1.JsonrpcResult{Code: 50000, Message: "服务器内部错误"}
 	}
 }
 
+func (d *JsonrpcData) mapAdminPasswordError(ctx context.Context, err error) *v1.JsonrpcResult {
+	switch err {
+	case biz.ErrInvalidPassword:
+		return &v1.JsonrpcResult{Code: 40014, Message: "原密码错误"}
+	case biz.ErrPasswordUnchanged:
+		return &v1.JsonrpcResult{Code: 40015, Message: "新密码不能与原密码相同"}
+	default:
+		return d.mapAdminManageError(ctx, err)
+	}
+}
+
+func (d *JsonrpcData) mapAdminTOTPError(ctx context.Context, err error) *v1.JsonrpcResult {
+	switch err {
+	case biz.ErrInvalidTOTP:
+		return &v1.JsonrpcResult{Code: 40016, Message: "验证码错误"}
+	case biz.ErrTOTPRequired:
+		return &v1.JsonrpcResult{Code: 40017, Message: "请输入验证码"}
+	case biz.ErrTOTPEnforced:
+		return &v1.JsonrpcResult{Code: 40306, Message: "当前管理员等级要求启用两步验证，不能关闭"}
+	case biz.ErrTOTPAlreadyEnabled:
+		return &v1.JsonrpcResult{Code: 40913, Message: "已启用两步验证"}
+	case biz.ErrTOTPNotEnabled:
+		return &v1.JsonrpcResult{Code: 40914, Message: "未启用两步验证"}
+	case biz.ErrTOTPNotSetup:
+		return &v1.JsonrpcResult{Code: 40915, Message: "请先获取绑定二维码"}
+	default:
+		return d.mapAdminManageError(ctx, err)
+	}
+}
+
+func (d *JsonrpcData) mapAdminSessionError(ctx context.Context, err error) *v1.JsonrpcResult {
+	if errors.Is(err, biz.ErrAdminSessionNotFound) {
+		return &v1.JsonrpcResult{Code: 40412, Message: "会话不存在"}
+	}
+	return d.mapAdminManageError(ctx, err)
+}
+
+func toAdminSessionView(session *biz.AdminSession) map[string]any {
+	return map[string]any{
+		"id":           session.ID,
+		"admin_id":     session.AdminID,
+		"ip":           session.IP,
+		"user_agent":   session.UserAgent,
+		"current":      session.Current,
+		"created_at":   session.CreatedAt.Unix(),
+		"last_seen_at": session.LastSeenAt.Unix(),
+		"expires_at":   session.ExpiresAt.Unix(),
+	}
+}
+
 func (d *JsonrpcData) handleERP(
 	ctx context.Context,
 	method, id string,
@@ -1239,7 +1703,21 @@ func (d *JsonrpcData) handleERP(
 		pm = params.AsMap()
 	}
 
+	// 鉴权、记录范围与业务调用使用同一个规范化后的模块，避免带空白的 module_key 绕过记录范围
 	moduleKey := getString(pm, "module_key")
+	if key, err := biz.NormalizeERPModuleKey(moduleKey); err == nil {
+		moduleKey = key
+	}
+	if action := biz.ERPRecordAction(method, getMap(pm, "record")); action != "" {
+		if res := d.requireERPPermission(ctx, moduleKey, action); res != nil {
+			l.Warnf("[erp] permission denied method=%s module=%s action=%s code=%d", method, moduleKey, action, res.Code)
+			return id, res, nil
+		}
+	}
+	ctx, err := d.withERPAccess(ctx, moduleKey)
+	if err != nil {
+		return id, d.mapERPError(ctx, err), nil
+	}
 
 	switch method {
 	case "list":
@@ -1284,8 +1762,14 @@ func (d *JsonrpcData) handleERP(
 			operatorID = claims.UserID
 		}
 
-		updated, err := d.erpUC.Update(ctx, moduleKey, recordID, record, operatorID)
+		// 期望版本优先取 version 参数，未传时取回传记录中的 version
+		expectedVersion := getInt(pm, "version", getInt(record, "version", 0))
+
+		updated, err := d.erpUC.Update(ctx, moduleKey, recordID, record, expectedVersion, operatorID)
 		if err != nil {
+			if errors.Is(err, biz.ErrERPVersionConflict) {
+				return id, d.erpVersionConflict(ctx, moduleKey, recordID), nil
+			}
 			return id, d.mapERPError(ctx, err), nil
 		}
 		return id, &v1.JsonrpcResult{
@@ -1307,6 +1791,123 @@ func (d *JsonrpcData) handleERP(
 			Data:    newDataStruct(map[string]any{"success": true}),
 		}, nil
 
+	case "revise":
+		recordID := getInt(pm, "id", 0)
+		claims, _ := biz.GetClaimsFromContext(ctx)
+		operatorID := 0
+		if claims != nil {
+			operatorID = claims.UserID
+		}
+
+		revision, previous, err := d.erpUC.Revise(ctx, moduleKey, recordID, getInt(pm, "version", 0), operatorID)
+		if err != nil {
+			if errors.Is(err, biz.ErrERPVersionConflict) {
+				return id, d.erpVersionConflict(ctx, moduleKey, recordID), nil
+			}
+			return id, d.mapERPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "修订成功",
+			Data: newDataStruct(map[string]any{
+				"record":   revision,
+				"previous": previous,
+			}),
+		}, nil
+
+	case "history":
+		revisions, err := d.erpRevisionUC.History(ctx, moduleKey, getInt(pm, "id", 0))
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		arr := make([]any, 0, len(revisions))
+		for _, revision := range revisions {
+			arr = append(arr, toERPRevisionView(revision))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"revisions": arr}),
+		}, nil
+
+	case "diff":
+		diff, err := d.erpRevisionUC.Diff(ctx, moduleKey, getInt(pm, "id", 0), getInt(pm, "from", 0), getInt(pm, "to", 0))
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(toERPRevisionDiffView(diff)),
+		}, nil
+
+	case "restore":
+		recordID := getInt(pm, "id", 0)
+		revision := getInt(pm, "revision", 0)
+		target, err := d.erpRevisionUC.Revision(ctx, moduleKey, recordID, revision)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		// 恢复到待批箱/已批箱的版本与直接保存一样需要提交/审批权限
+		if action := biz.ERPRecordAction("update", target.Payload); action != biz.ERPActionEdit {
+			if res := d.requireERPPermission(ctx, moduleKey, action); res != nil {
+				l.Warnf("[erp] permission denied method=%s module=%s action=%s code=%d", method, moduleKey, action, res.Code)
+				return id, res, nil
+			}
+		}
+		claims, _ := biz.GetClaimsFromContext(ctx)
+		operatorID := 0
+		if claims != nil {
+			operatorID = claims.UserID
+		}
+
+		restored, err := d.erpRevisionUC.Restore(ctx, moduleKey, recordID, revision, getInt(pm, "version", 0), operatorID)
+		if err != nil {
+			if errors.Is(err, biz.ErrERPVersionConflict) {
+				return id, d.erpVersionConflict(ctx, moduleKey, recordID), nil
+			}
+			return id, d.mapERPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "恢复成功",
+			Data: newDataStruct(map[string]any{
+				"record": restored,
+			}),
+		}, nil
+
+	case "schema":
+		schema, err := d.erpUC.Schema(ctx, moduleKey)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"module_key": moduleKey,
+				"schema":     schema,
+			}),
+		}, nil
+
+	case "fulfilment":
+		reports, err := d.erpUC.Fulfilment(ctx, moduleKey, getString(pm, "code"))
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		records := make([]any, 0, len(reports))
+		for _, report := range reports {
+			records = append(records, toERPFulfilmentView(report))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"module_key": moduleKey,
+				"records":    records,
+			}),
+		}, nil
+
 	default:
 		return id, &v1.JsonrpcResult{
 			Code:    40020,
@@ -1315,16 +1916,134 @@ func (d *JsonrpcData) handleERP(
 	}
 }
 
+// erpVersionConflict 返回 40916 及服务端当前记录，供前端合并后重新提交。
+func (d *JsonrpcData) erpVersionConflict(ctx context.Context, moduleKey string, recordID int) *v1.JsonrpcResult {
+	current, err := d.erpUC.Get(ctx, moduleKey, recordID)
+	if err != nil {
+		return d.mapERPError(ctx, err)
+	}
+	return &v1.JsonrpcResult{
+		Code:    40916,
+		Message: "记录已被他人修改，请合并最新内容后重试",
+		Data: newDataStruct(map[string]any{
+			"record": current,
+		}),
+	}
+}
+
+func toERPFulfilmentView(report *biz.ERPFulfilment) map[string]any {
+	lines := make([]any, 0, len(report.Lines))
+	for _, line := range report.Lines {
+		lineNos := make([]any, 0, len(line.LineNos))
+		for _, lineNo := range line.LineNos {
+			lineNos = append(lineNos, lineNo)
+		}
+		flows := make([]any, 0, len(line.Flows))
+		for _, flow := range line.Flows {
+			documents := make([]any, 0, len(flow.Documents))
+			for _, code := range flow.Documents {
+				documents = append(documents, code)
+			}
+			flows = append(flows, map[string]any{
+				"module_key": flow.ModuleKey,
+				"fulfilled":  flow.Fulfilled,
+				"remaining":  flow.Remaining,
+				"documents":  documents,
+			})
+		}
+		lines = append(lines, map[string]any{
+			"line_nos":     lineNos,
+			"product_code": line.ProductCode,
+			"product_name": line.ProductName,
+			"quantity":     line.Quantity,
+			"max_quantity": line.MaxQuantity,
+			"flows":        flows,
+		})
+	}
+	return map[string]any{
+		"id":                report.ID,
+		"code":              report.Code,
+		"tolerance_percent": report.TolerancePercent,
+		"lines":             lines,
+	}
+}
+
+func toERPRevisionView(revision *biz.ERPRevision) map[string]any {
+	savedBy := 0
+	if revision.SavedByAdminID != nil {
+		savedBy = *revision.SavedByAdminID
+	}
+	return map[string]any{
+		"revision":          revision.Revision,
+		"code":              revision.Code,
+		"payload":           revision.Payload,
+		"saved_by_admin_id": savedBy,
+		"saved_at":          revision.SavedAt.Unix(),
+		"current":           revision.Current,
+	}
+}
+
+func toERPRevisionDiffView(diff *biz.ERPRevisionDiff) map[string]any {
+	items := make([]any, 0, len(diff.Items))
+	for _, item := range diff.Items {
+		items = append(items, map[string]any{
+			"list":    item.List,
+			"index":   item.Index,
+			"line_no": item.LineNo,
+			"status":  item.Status,
+			"changes": toERPAuditChangesView(item.Changes),
+		})
+	}
+	return map[string]any{
+		"from":   diff.From,
+		"to":     diff.To,
+		"fields": toERPAuditChangesView(diff.Fields),
+		"items":  items,
+	}
+}
+
 func (d *JsonrpcData) mapERPError(ctx context.Context, err error) *v1.JsonrpcResult {
 	l := d.log.WithContext(ctx)
 
+	var validationErr *biz.ERPValidationError
+	var inUseErr *biz.ERPRecordInUseError
 	switch {
 	case errors.Is(err, biz.ErrERPInvalidModule):
 		return &v1.JsonrpcResult{Code: 40040, Message: "模块标识不合法"}
+	case errors.As(err, &validationErr):
+		fields := make([]any, 0, len(validationErr.Fields))
+		for _, field := range validationErr.Fields {
+			fields = append(fields, map[string]any{
+				"path":    field.Path,
+				"message": field.Message,
+			})
+		}
+		return &v1.JsonrpcResult{
+			Code:    40041,
+			Message: "记录内容不合法",
+			Data:    newDataStruct(map[string]any{"errors": fields}),
+		}
 	case errors.Is(err, biz.ErrERPInvalidRecord):
 		return &v1.JsonrpcResult{Code: 40041, Message: "记录内容不合法"}
+	case errors.As(err, &inUseErr):
+		refs := make([]any, 0, len(inUseErr.References))
+		for _, ref := range inUseErr.References {
+			refs = append(refs, map[string]any{
+				"module_key": ref.ModuleKey,
+				"id":         ref.ID,
+				"code":       ref.Code,
+				"field":      ref.Field,
+			})
+		}
+		return &v1.JsonrpcResult{
+			Code:    40917,
+			Message: "记录已被其他单据引用",
+			Data:    newDataStruct(map[string]any{"references": refs}),
+		}
 	case errors.Is(err, biz.ErrERPRecordNotFound):
 		return &v1.JsonrpcResult{Code: 40440, Message: "记录不存在"}
+	case errors.Is(err, biz.ErrERPRevisionNotFound):
+		return &v1.JsonrpcResult{Code: 40441, Message: "版本不存在"}
 	case errors.Is(err, biz.ErrBadParam):
 		return &v1.JsonrpcResult{Code: 40010, Message: "参数不合法"}
 	case errors.Is(err, biz.ErrForbidden):
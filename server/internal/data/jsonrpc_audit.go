@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// =========================
+// audit domain (admin only)
+// =========================
+
+func (d *JsonrpcData) handleAudit(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+	if _, res := d.requireAdmin(ctx); res != nil {
+		l.Warnf("[audit] requireAdmin denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+
+	switch method {
+	case "query":
+		filter := biz.ERPAuditFilter{
+			ModuleKey: getString(pm, "module"),
+			RecordID:  getInt(pm, "record_id", 0),
+			Code:      getString(pm, "code"),
+			AdminID:   getInt(pm, "admin_id", 0),
+			Limit:     getInt(pm, "limit", 30),
+			Offset:    getInt(pm, "offset", 0),
+		}
+		if from := getInt64(pm, "from", 0); from > 0 {
+			filter.From = time.Unix(from, 0)
+		}
+		if to := getInt64(pm, "to", 0); to > 0 {
+			filter.To = time.Unix(to, 0)
+		}
+		entries, total, err := d.erpAuditUC.Query(ctx, filter)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		arr := make([]any, 0, len(entries))
+		for _, entry := range entries {
+			arr = append(arr, toERPAuditView(entry))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"entries": arr,
+				"total":   total,
+			}),
+		}, nil
+
+	default:
+		return id, &v1.JsonrpcResult{
+			Code:    40020,
+			Message: fmt.Sprintf("未知审计接口 method=%s", method),
+		}, nil
+	}
+}
+
+func toERPAuditView(entry *biz.ERPAuditEntry) map[string]any {
+	adminID := 0
+	if entry.AdminID != nil {
+		adminID = *entry.AdminID
+	}
+	return map[string]any{
+		"id":             entry.ID,
+		"action":         entry.Action,
+		"module":         entry.ModuleKey,
+		"record_id":      entry.RecordID,
+		"code":           entry.Code,
+		"admin_id":       adminID,
+		"admin_username": entry.AdminUsername,
+		"request_id":     entry.RequestID,
+		"ip":             entry.IP,
+		"changes":        toERPAuditChangesView(entry.Changes),
+		"created_at":     entry.CreatedAt.Unix(),
+	}
+}
+
+func toERPAuditChangesView(changes []biz.ERPAuditChange) []any {
+	out := make([]any, 0, len(changes))
+	for _, change := range changes {
+		out = append(out, map[string]any{
+			"field": change.Field,
+			"old":   change.Old,
+			"new":   change.New,
+		})
+	}
+	return out
+}
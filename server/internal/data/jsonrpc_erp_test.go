@@ -2,7 +2,9 @@ package data
 
 import (
 	"context"
+	"errors"
 	"io"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -31,8 +33,12 @@ func (r *memERPRepoForData) ListByModule(ctx context.Context, moduleKey string)
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	items := r.records[moduleKey]
+	scope, _ := biz.ERPScopeFromContext(ctx, moduleKey)
 	out := make([]*biz.ERPRecord, 0, len(items))
 	for _, item := range items {
+		if !scope.Allows(item) {
+			continue
+		}
 		copyItem := *item
 		copyItem.Payload = cloneMapAny(item.Payload)
 		out = append(out, &copyItem)
@@ -40,6 +46,20 @@ func (r *memERPRepoForData) ListByModule(ctx context.Context, moduleKey string)
 	return out, nil
 }
 
+func (r *memERPRepoForData) GetByID(ctx context.Context, moduleKey string, id int) (*biz.ERPRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	scope, _ := biz.ERPScopeFromContext(ctx, moduleKey)
+	for _, item := range r.records[moduleKey] {
+		if item.ID == id && scope.Allows(item) {
+			copyItem := *item
+			copyItem.Payload = cloneMapAny(item.Payload)
+			return &copyItem, nil
+		}
+	}
+	return nil, biz.ErrERPRecordNotFound
+}
+
 func (r *memERPRepoForData) Create(ctx context.Context, moduleKey string, payload map[string]any, createdByAdminID int) (*biz.ERPRecord, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -53,6 +73,7 @@ func (r *memERPRepoForData) Create(ctx context.Context, moduleKey string, payloa
 		Code:      code,
 		Box:       box,
 		Payload:   cloneMapAny(payload),
+		Version:   1,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
@@ -68,20 +89,25 @@ func (r *memERPRepoForData) Create(ctx context.Context, moduleKey string, payloa
 	return &copyItem, nil
 }
 
-func (r *memERPRepoForData) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, updatedByAdminID int) (*biz.ERPRecord, error) {
+func (r *memERPRepoForData) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, expectedVersion, updatedByAdminID int) (*biz.ERPRecord, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	items := r.records[moduleKey]
+	scope, _ := biz.ERPScopeFromContext(ctx, moduleKey)
 	for index, item := range items {
-		if item.ID != id {
+		if item.ID != id || !scope.Allows(item) {
 			continue
 		}
+		if expectedVersion > 0 && item.Version != expectedVersion {
+			return nil, biz.ErrERPVersionConflict
+		}
 		code, _ := payload["code"].(string)
 		box, _ := payload["box"].(string)
 		item.Code = code
 		item.Box = box
 		item.Payload = cloneMapAny(payload)
 		item.UpdatedAt = time.Now()
+		item.Version++
 		if updatedByAdminID > 0 {
 			item.UpdatedByAdminID = &updatedByAdminID
 		}
@@ -97,8 +123,9 @@ func (r *memERPRepoForData) Delete(ctx context.Context, moduleKey string, id int
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	items := r.records[moduleKey]
+	scope, _ := biz.ERPScopeFromContext(ctx, moduleKey)
 	for index, item := range items {
-		if item.ID != id {
+		if item.ID != id || !scope.Allows(item) {
 			continue
 		}
 		r.records[moduleKey] = append(items[:index], items[index+1:]...)
@@ -113,8 +140,9 @@ func TestJsonrpcData_HandleERP_CRUD(t *testing.T) {
 	erpUC := biz.NewERPUsecase(repo, logger, tracesdk.NewTracerProvider())
 
 	j := &JsonrpcData{
-		log:   log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
-		erpUC: erpUC,
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:         erpUC,
+		adminManageUC: newSuperAdminManageUCForData(logger),
 	}
 
 	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{
@@ -160,6 +188,272 @@ func TestJsonrpcData_HandleERP_CRUD(t *testing.T) {
 	}
 }
 
+// newSuperAdminManageUCForData 返回只有超级管理员 admin（ID 1）的管理员用例，供不关心权限的接口测试注入。
+func newSuperAdminManageUCForData(logger log.Logger) *biz.AdminManageUsecase {
+	repo := &memAdminManageRepoForData{admins: map[int]*biz.AdminAccount{
+		1: {ID: 1, Username: "admin", Level: biz.AdminLevelSuper},
+	}}
+	return biz.NewAdminManageUsecase(repo, logger, tracesdk.NewTracerProvider())
+}
+
+type memAdminManageRepoForData struct {
+	admins map[int]*biz.AdminAccount
+}
+
+func (r *memAdminManageRepoForData) GetAdminByID(ctx context.Context, id int) (*biz.AdminAccount, error) {
+	admin, ok := r.admins[id]
+	if !ok {
+		return nil, biz.ErrAdminNotFound
+	}
+	copyAdmin := *admin
+	return &copyAdmin, nil
+}
+
+func (r *memAdminManageRepoForData) GetAdminByUsername(ctx context.Context, username string) (*biz.AdminAccount, error) {
+	for _, admin := range r.admins {
+		if admin.Username == username {
+			copyAdmin := *admin
+			return &copyAdmin, nil
+		}
+	}
+	return nil, biz.ErrAdminNotFound
+}
+
+func (r *memAdminManageRepoForData) ListAdmins(ctx context.Context) ([]*biz.AdminAccount, error) {
+	out := make([]*biz.AdminAccount, 0, len(r.admins))
+	for _, admin := range r.admins {
+		copyAdmin := *admin
+		out = append(out, &copyAdmin)
+	}
+	return out, nil
+}
+
+func (r *memAdminManageRepoForData) CountUsersByAdmin(ctx context.Context) (map[int]int, error) {
+	return map[int]int{}, nil
+}
+
+func (r *memAdminManageRepoForData) CountChildAdmins(ctx context.Context) (map[int]int, error) {
+	return map[int]int{}, nil
+}
+
+func (r *memAdminManageRepoForData) CountChildAdminsByParent(ctx context.Context, parentID int) (int, error) {
+	return 0, nil
+}
+
+func (r *memAdminManageRepoForData) CreateAdmin(ctx context.Context, admin *biz.AdminCreate) (*biz.AdminAccount, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *memAdminManageRepoForData) UpdateAdminHierarchy(ctx context.Context, id int, level biz.AdminLevel, parentID *int) error {
+	return nil
+}
+
+func (r *memAdminManageRepoForData) UpdateAdminMenuPermissions(ctx context.Context, id int, menuPermissions []string) error {
+	if admin, ok := r.admins[id]; ok {
+		admin.MenuPermissions = menuPermissions
+	}
+	return nil
+}
+
+func (r *memAdminManageRepoForData) SetAdminDisabled(ctx context.Context, id int, disabled bool) error {
+	return nil
+}
+
+func (r *memAdminManageRepoForData) TransferUsers(ctx context.Context, fromAdminID int, toAdminID *int) (int, error) {
+	return 0, nil
+}
+
+func (r *memAdminManageRepoForData) TransferChildAdmins(ctx context.Context, fromAdminID int, toAdminID *int) (int, error) {
+	return 0, nil
+}
+
+func TestJsonrpcData_HandleERP_MenuPermission(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	adminRepo := &memAdminManageRepoForData{admins: map[int]*biz.AdminAccount{
+		2: {ID: 2, Username: "sales", Level: biz.AdminLevelSecondary, MenuPermissions: []string{"/sales/export"}},
+		3: {ID: 3, Username: "viewer", Level: biz.AdminLevelSecondary, RoleBased: true, Roles: []*biz.AdminRole{
+			{ID: 1, Key: "viewer", Name: "只读", Grants: map[string][]string{"exportSales": {"view"}}},
+		}},
+	}}
+	j := &JsonrpcData{
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:         biz.NewERPUsecase(newMemERPRepoForData(), logger, tp),
+		adminManageUC: biz.NewAdminManageUsecase(adminRepo, logger, tp),
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{
+		UserID:   2,
+		Username: "sales",
+		Role:     biz.RoleAdmin,
+	})
+
+	allowed, _ := structpb.NewStruct(map[string]any{"module_key": "exportSales"})
+	_, res, err := j.handleERP(ctx, "list", "1", allowed)
+	if err != nil || res == nil || res.Code != 0 {
+		t.Fatalf("exportSales list should be allowed, got %+v err=%v", res, err)
+	}
+
+	denied, _ := structpb.NewStruct(map[string]any{"module_key": "settlements"})
+	_, res, err = j.handleERP(ctx, "list", "2", denied)
+	if err != nil || res == nil || res.Code != 40302 {
+		t.Fatalf("settlements list should be denied with 40302, got %+v err=%v", res, err)
+	}
+
+	_, res, err = j.handleFinance(ctx, "receivables", "3", nil)
+	if err != nil || res == nil || res.Code != 40302 {
+		t.Fatalf("finance receivables should be denied with 40302, got %+v err=%v", res, err)
+	}
+
+	viewerCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{
+		UserID:   3,
+		Username: "viewer",
+		Role:     biz.RoleAdmin,
+	})
+	_, res, err = j.handleERP(viewerCtx, "list", "4", allowed)
+	if err != nil || res == nil || res.Code != 0 {
+		t.Fatalf("role with view should list exportSales, got %+v err=%v", res, err)
+	}
+	create, _ := structpb.NewStruct(map[string]any{
+		"module_key": "exportSales",
+		"record":     map[string]any{"box": "草稿箱", "payload": map[string]any{}},
+	})
+	_, res, err = j.handleERP(viewerCtx, "create", "5", create)
+	if err != nil || res == nil || res.Code != 40302 {
+		t.Fatalf("role without create should be denied with 40302, got %+v err=%v", res, err)
+	}
+
+	// 未注入管理员用例时无法鉴权，一律拒绝
+	unwired := &JsonrpcData{log: j.log, erpUC: j.erpUC}
+	if _, res, _ = unwired.handleERP(ctx, "list", "6", allowed); res.Code != 40301 {
+		t.Fatalf("erp without admin usecase should be denied with 40301, got %+v", res)
+	}
+	if _, res, _ = unwired.handleFinance(ctx, "receivables", "7", nil); res.Code != 40301 {
+		t.Fatalf("finance without admin usecase should be denied with 40301, got %+v", res)
+	}
+}
+
+func TestJsonrpcData_HandleFinance_ActionPermission(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	role := func(key string, grants map[string][]string) []*biz.AdminRole {
+		return []*biz.AdminRole{{ID: 1, Key: key, Name: key, Grants: grants}}
+	}
+	adminRepo := &memAdminManageRepoForData{admins: map[int]*biz.AdminAccount{
+		2: {ID: 2, Username: "viewer", Level: biz.AdminLevelSecondary, RoleBased: true,
+			Roles: role("viewer", map[string][]string{"settlements": {"view"}, "supplierPayments": {"view"}})},
+		3: {ID: 3, Username: "rates", Level: biz.AdminLevelSecondary, RoleBased: true,
+			Roles: role("rates", map[string][]string{"exchangeRates": {"view", "create"}})},
+		4: {ID: 4, Username: "cashier", Level: biz.AdminLevelSecondary, RoleBased: true,
+			Roles: role("cashier", map[string][]string{"settlements": {"view", "view_amounts"}, "supplierPayments": {"view", "view_amounts"}})},
+	}}
+	j := &JsonrpcData{
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:         biz.NewERPUsecase(newMemERPRepoForData(), logger, tp),
+		adminManageUC: biz.NewAdminManageUsecase(adminRepo, logger, tp),
+	}
+	as := func(adminID int, username string) context.Context {
+		return biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: adminID, Username: username, Role: biz.RoleAdmin})
+	}
+	params, _ := structpb.NewStruct(map[string]any{"shipment_code": "CY-001"})
+
+	for _, tc := range []struct {
+		ctx    context.Context
+		method string
+		code   int32
+	}{
+		// 仅有 view 或仅有汇率授权时可进入结汇菜单，但不能生成结汇单、查看金额
+		{as(2, "viewer"), "generate_settlement", 40302},
+		{as(2, "viewer"), "receivables", 40302},
+		{as(2, "viewer"), "payables", 40302},
+		{as(2, "viewer"), "ap_aging", 40302},
+		{as(3, "rates"), "generate_settlement", 40302},
+		{as(4, "cashier"), "generate_settlement", 40302},
+		{as(4, "cashier"), "receivables", 0},
+		{as(4, "cashier"), "payables", 0},
+	} {
+		_, res, err := j.handleFinance(tc.ctx, tc.method, "1", params)
+		if err != nil || res == nil || res.Code != tc.code {
+			t.Fatalf("%s: want code %d, got %+v err=%v", tc.method, tc.code, res, err)
+		}
+	}
+}
+
+func TestJsonrpcData_HandleERP_RecordScope(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	parentID := 2
+	sales := &biz.AdminRole{ID: 1, Key: "sales", Name: "销售", Grants: map[string][]string{
+		"exportSales": {"view", "create", "edit", "delete"},
+	}}
+	adminRepo := &memAdminManageRepoForData{admins: map[int]*biz.AdminAccount{
+		2: {ID: 2, Username: "lead", Level: biz.AdminLevelPrimary, RoleBased: true, Roles: []*biz.AdminRole{sales}},
+		3: {ID: 3, Username: "alice", Level: biz.AdminLevelSecondary, ParentID: &parentID, RoleBased: true, Roles: []*biz.AdminRole{sales}},
+		4: {ID: 4, Username: "bob", Level: biz.AdminLevelSecondary, RoleBased: true, Roles: []*biz.AdminRole{sales}},
+	}}
+	repo := newMemERPRepoForData()
+	j := &JsonrpcData{
+		log:             log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:           biz.NewERPUsecase(repo, logger, tp),
+		adminManageUC:   biz.NewAdminManageUsecase(adminRepo, logger, tp),
+		adminManageRepo: adminRepo,
+	}
+	as := func(adminID int, username string) context.Context {
+		return biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: adminID, Username: username, Role: biz.RoleAdmin})
+	}
+	seed := func(adminID int, code, owner string) {
+		ownerID := adminID
+		repo.records["exportSales"] = append(repo.records["exportSales"], &biz.ERPRecord{
+			ID: repo.nextID, ModuleKey: "exportSales", Code: code, CreatedByAdminID: &ownerID,
+			Payload: map[string]any{"code": code, "salesOwner": owner},
+		})
+		repo.nextID++
+	}
+	seed(3, "XS-A", "")
+	seed(4, "XS-B", "")
+	seed(1, "XS-C", "alice")
+
+	listCodes := func(ctx context.Context, moduleKey ...string) map[string]bool {
+		key := "exportSales"
+		if len(moduleKey) > 0 {
+			key = moduleKey[0]
+		}
+		params, _ := structpb.NewStruct(map[string]any{"module_key": key})
+		_, res, err := j.handleERP(ctx, "list", "1", params)
+		if err != nil || res == nil || res.Code != 0 {
+			t.Fatalf("list failed: %+v err=%v", res, err)
+		}
+		codes := map[string]bool{}
+		for _, item := range res.GetData().AsMap()["records"].([]any) {
+			codes[item.(map[string]any)["code"].(string)] = true
+		}
+		return codes
+	}
+
+	if codes := listCodes(as(3, "alice")); len(codes) != 2 || !codes["XS-A"] || !codes["XS-C"] {
+		t.Fatalf("alice should see own and owned records, got %v", codes)
+	}
+	if codes := listCodes(as(2, "lead")); len(codes) != 2 || codes["XS-B"] {
+		t.Fatalf("lead should see subordinate records only, got %v", codes)
+	}
+	// 带空白的 module_key 同样按规范化后的模块限定范围
+	if codes := listCodes(as(3, "alice"), " exportSales\t"); len(codes) != 2 || codes["XS-B"] {
+		t.Fatalf("padded module_key should keep the record scope, got %v", codes)
+	}
+
+	deleteParams, _ := structpb.NewStruct(map[string]any{"module_key": "exportSales", "id": 2})
+	_, res, err := j.handleERP(as(3, "alice"), "delete", "2", deleteParams)
+	if err != nil || res == nil || res.Code == 0 {
+		t.Fatalf("deleting out-of-scope record should fail, got %+v err=%v", res, err)
+	}
+
+	adminRepo.admins[4].Roles = []*biz.AdminRole{{ID: 2, Key: "finance", Name: "财务", Grants: map[string][]string{
+		"exportSales": {"view", "view_all"},
+	}}}
+	if codes := listCodes(as(4, "bob")); len(codes) != 3 {
+		t.Fatalf("view_all should see every record, got %v", codes)
+	}
+}
+
 func cloneMapAny(input map[string]any) map[string]any {
 	out := make(map[string]any, len(input))
 	for key, value := range input {
@@ -167,3 +461,483 @@ func cloneMapAny(input map[string]any) map[string]any {
 	}
 	return out
 }
+
+// memERPRevisionRepoForData 修改前保存历史版本。
+type memERPRevisionRepoForData struct {
+	*memERPRepoForData
+	revisions map[int][]*biz.ERPRevision
+}
+
+func (r *memERPRevisionRepoForData) Update(ctx context.Context, moduleKey string, id int, payload map[string]any, expectedVersion, updatedByAdminID int) (*biz.ERPRecord, error) {
+	records, _ := r.ListByModule(ctx, moduleKey)
+	for _, record := range records {
+		if record.ID == id {
+			r.revisions[id] = append([]*biz.ERPRevision{{
+				Revision: len(r.revisions[id]) + 1,
+				Code:     record.Code,
+				Payload:  record.Payload,
+				SavedAt:  record.UpdatedAt,
+			}}, r.revisions[id]...)
+		}
+	}
+	return r.memERPRepoForData.Update(ctx, moduleKey, id, payload, expectedVersion, updatedByAdminID)
+}
+
+func (r *memERPRevisionRepoForData) ListRevisions(ctx context.Context, moduleKey string, recordID int) ([]*biz.ERPRevision, error) {
+	return r.revisions[recordID], nil
+}
+
+func TestJsonrpcData_HandleERP_Revisions(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	adminRepo := &memAdminManageRepoForData{admins: map[int]*biz.AdminAccount{
+		1: {ID: 1, Username: "root", Level: biz.AdminLevelSuper},
+		3: {ID: 3, Username: "editor", Level: biz.AdminLevelSecondary, RoleBased: true, Roles: []*biz.AdminRole{
+			{ID: 1, Key: "editor", Name: "编辑", Grants: map[string][]string{"partners": {"view", "create", "edit"}}},
+		}},
+	}}
+	repo := &memERPRevisionRepoForData{memERPRepoForData: newMemERPRepoForData(), revisions: map[int][]*biz.ERPRevision{}}
+	erpUC := biz.NewERPUsecase(repo, logger, tp)
+	j := &JsonrpcData{
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:         erpUC,
+		erpRevisionUC: biz.NewERPRevisionUsecase(repo, erpUC, logger, tp),
+		adminManageUC: biz.NewAdminManageUsecase(adminRepo, logger, tp),
+	}
+	rootCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "root", Role: biz.RoleAdmin})
+	editorCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 3, Username: "editor", Role: biz.RoleAdmin})
+
+	record := map[string]any{
+		"code": "CS-001", "partnerType": "合作客户", "name": "客户A", "address": "浙江杭州",
+		"contact": "张三", "contactPhone": "13800001111", "paymentCycleDays": 30, "box": biz.ERPBoxDraft,
+	}
+	params, _ := structpb.NewStruct(map[string]any{"module_key": "partners", "record": record})
+	if _, res, _ := j.handleERP(editorCtx, "create", "1", params); res.Code != 0 {
+		t.Fatalf("create failed: %+v", res)
+	}
+	for index, box := range []string{biz.ERPBoxPending, biz.ERPBoxDraft} {
+		record["box"] = box
+		params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": 1, "record": record})
+		if _, res, _ := j.handleERP(rootCtx, "update", "2", params); res.Code != 0 {
+			t.Fatalf("update %d failed: %+v", index, res)
+		}
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": 1})
+	_, res, _ := j.handleERP(editorCtx, "history", "3", params)
+	if res.Code != 0 {
+		t.Fatalf("history failed: %+v", res)
+	}
+	revisions, _ := res.GetData().AsMap()["revisions"].([]any)
+	if len(revisions) != 3 || revisions[0].(map[string]any)["current"] != true {
+		t.Fatalf("unexpected revisions %v", revisions)
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": 1, "from": 1, "to": 2})
+	_, res, _ = j.handleERP(editorCtx, "diff", "4", params)
+	fields, _ := res.GetData().AsMap()["fields"].([]any)
+	if res.Code != 0 || len(fields) != 1 || fields[0].(map[string]any)["new"] != biz.ERPBoxPending {
+		t.Fatalf("unexpected diff %+v", res)
+	}
+
+	// 恢复到待批箱版本等同提交，编辑角色没有 submit
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": 1, "revision": 2})
+	if _, res, _ = j.handleERP(editorCtx, "restore", "5", params); res.Code != 40302 {
+		t.Fatalf("restore pending revision should be denied, got %+v", res)
+	}
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": 1, "revision": 9})
+	if _, res, _ = j.handleERP(editorCtx, "restore", "6", params); res.Code != 40441 {
+		t.Fatalf("unknown revision should return 40441, got %+v", res)
+	}
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": 1, "revision": 1})
+	if _, res, _ = j.handleERP(editorCtx, "restore", "7", params); res.Code != 0 {
+		t.Fatalf("restore draft revision failed: %+v", res)
+	}
+	if len(repo.revisions[1]) != 3 {
+		t.Fatalf("restore should add a revision, got %d", len(repo.revisions[1]))
+	}
+}
+
+func TestJsonrpcData_HandleERP_VersionConflict(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	j := &JsonrpcData{
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:         biz.NewERPUsecase(newMemERPRepoForData(), logger, tracesdk.NewTracerProvider()),
+		adminManageUC: newSuperAdminManageUCForData(logger),
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "admin", Role: biz.RoleAdmin})
+
+	record := map[string]any{
+		"code": "CS-001", "partnerType": "合作客户", "name": "客户A", "address": "浙江杭州",
+		"contact": "张三", "contactPhone": "13800001111", "paymentCycleDays": 30, "box": biz.ERPBoxDraft,
+	}
+	params, _ := structpb.NewStruct(map[string]any{"module_key": "partners", "record": record})
+	_, res, _ := j.handleERP(ctx, "create", "1", params)
+	created := res.GetData().AsMap()["record"].(map[string]any)
+	if res.Code != 0 || created["version"] != float64(1) {
+		t.Fatalf("create result invalid: %+v", res)
+	}
+
+	// 两人基于同一版本修改：先提交者成功，后提交者收到 40916 与服务端当前内容
+	first := cloneMapAny(created)
+	first["name"] = "客户A-甲"
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": 1, "record": first})
+	if _, res, _ = j.handleERP(ctx, "update", "2", params); res.Code != 0 {
+		t.Fatalf("first update failed: %+v", res)
+	}
+	second := cloneMapAny(created)
+	second["name"] = "客户A-乙"
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": 1, "record": second})
+	_, res, _ = j.handleERP(ctx, "update", "3", params)
+	if res.Code != 40916 {
+		t.Fatalf("second update should conflict, got %+v", res)
+	}
+	current := res.GetData().AsMap()["record"].(map[string]any)
+	if current["name"] != "客户A-甲" || current["version"] != float64(2) {
+		t.Fatalf("conflict should return server copy, got %v", current)
+	}
+
+	// 顶层 version 优先于记录中的 version
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": 1, "version": 2, "record": second})
+	if _, res, _ = j.handleERP(ctx, "update", "4", params); res.Code != 0 {
+		t.Fatalf("update with current version failed: %+v", res)
+	}
+}
+
+func TestJsonrpcData_HandleERP_SchemaValidation(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	repo := newMemERPRepoForData()
+	j := &JsonrpcData{
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:         biz.NewERPUsecase(repo, logger, tracesdk.NewTracerProvider()),
+		adminManageUC: newSuperAdminManageUCForData(logger),
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "admin", Role: biz.RoleAdmin})
+	_, _ = repo.Create(ctx, "partners", map[string]any{"partnerType": "合作客户", "name": "客户A"}, 1)
+
+	params, _ := structpb.NewStruct(map[string]any{"module_key": "quotations"})
+	_, res, _ := j.handleERP(ctx, "schema", "1", params)
+	if res.Code != 0 {
+		t.Fatalf("schema failed: %+v", res)
+	}
+	schema := res.GetData().AsMap()["schema"].(map[string]any)
+	items := schema["properties"].(map[string]any)["items"].(map[string]any)
+	if items["type"] != "array" || schema["$defs"] == nil {
+		t.Fatalf("schema invalid: %v", schema)
+	}
+
+	// 明细行数量非数字：返回 40041，errors 列出全部不合法字段
+	params, _ = structpb.NewStruct(map[string]any{
+		"module_key": "quotations",
+		"record": map[string]any{
+			"customerName": "客户A", "currency": "USD",
+			"items": []any{map[string]any{"productName": "磁钢A", "quantity": "abc", "unitPrice": 5}},
+		},
+	})
+	_, res, _ = j.handleERP(ctx, "create", "2", params)
+	if res.Code != 40041 {
+		t.Fatalf("invalid record should be rejected, got %+v", res)
+	}
+	paths := []string{}
+	for _, item := range res.GetData().AsMap()["errors"].([]any) {
+		paths = append(paths, item.(map[string]any)["path"].(string))
+	}
+	if strings.Join(paths, ",") != "items[0].quantity,quotedDate" {
+		t.Fatalf("error paths = %v", paths)
+	}
+}
+
+func TestJsonrpcData_HandleERP_References(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	j := &JsonrpcData{
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:         biz.NewERPUsecase(newMemERPRepoForData(), logger, tracesdk.NewTracerProvider()),
+		adminManageUC: newSuperAdminManageUCForData(logger),
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "admin", Role: biz.RoleAdmin})
+
+	quotation := map[string]any{
+		"code": "BJ-001", "customerName": "客户A", "quotedDate": "2026-01-02", "currency": "USD",
+		"items": []any{map[string]any{"productName": "磁钢A", "quantity": 10, "unitPrice": 5}},
+	}
+	params, _ := structpb.NewStruct(map[string]any{"module_key": "quotations", "record": quotation})
+	_, res, _ := j.handleERP(ctx, "create", "1", params)
+	if res.Code != 40041 {
+		t.Fatalf("unknown customer should be rejected, got %+v", res)
+	}
+	field := res.GetData().AsMap()["errors"].([]any)[0].(map[string]any)
+	if field["path"] != "customerName" || field["message"] != "字段 customerName 引用的客户 客户A 不存在" {
+		t.Fatalf("unexpected reference error: %v", field)
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "record": map[string]any{
+		"code": "CS-001", "partnerType": "合作客户", "name": "客户A", "address": "浙江杭州",
+		"contact": "张三", "contactPhone": "13800001111", "paymentCycleDays": 30,
+	}})
+	_, res, _ = j.handleERP(ctx, "create", "2", params)
+	if res.Code != 0 {
+		t.Fatalf("create partner failed: %+v", res)
+	}
+	partnerID := int(res.GetData().AsMap()["record"].(map[string]any)["id"].(float64))
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "quotations", "record": quotation})
+	if _, res, _ = j.handleERP(ctx, "create", "3", params); res.Code != 0 {
+		t.Fatalf("create quotation failed: %+v", res)
+	}
+
+	// 删除仍被引用的客户：返回 40917，references 列出引用单据
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "id": partnerID})
+	_, res, _ = j.handleERP(ctx, "delete", "4", params)
+	if res.Code != 40917 {
+		t.Fatalf("delete referenced partner should fail, got %+v", res)
+	}
+	refs := res.GetData().AsMap()["references"].([]any)
+	ref := refs[0].(map[string]any)
+	if len(refs) != 1 || ref["module_key"] != "quotations" || ref["code"] != "BJ-001" || ref["field"] != "customerName" {
+		t.Fatalf("unexpected references: %v", refs)
+	}
+}
+
+func TestJsonrpcData_HandleERP_Fulfilment(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	repo := newMemERPRepoForData()
+	j := &JsonrpcData{
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:         biz.NewERPUsecase(repo, logger, tracesdk.NewTracerProvider()),
+		adminManageUC: newSuperAdminManageUCForData(logger),
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "admin", Role: biz.RoleAdmin})
+	_, _ = repo.Create(ctx, "exportSales", map[string]any{
+		"code":  "XS-001",
+		"items": []any{map[string]any{"lineNo": 1, "productName": "磁钢A", "quantity": 100}},
+	}, 1)
+	_, _ = repo.Create(ctx, "shipmentDetails", map[string]any{
+		"code": "CY-001", "sourceExportCode": "XS-001",
+		"items": []any{map[string]any{"productModel": "磁钢A", "quantity": 30}},
+	}, 1)
+
+	params, _ := structpb.NewStruct(map[string]any{"module_key": "exportSales", "code": "XS-001"})
+	_, res, _ := j.handleERP(ctx, "fulfilment", "1", params)
+	if res.Code != 0 {
+		t.Fatalf("fulfilment failed: %+v", res)
+	}
+	record := res.GetData().AsMap()["records"].([]any)[0].(map[string]any)
+	line := record["lines"].([]any)[0].(map[string]any)
+	shipped := line["flows"].([]any)[1].(map[string]any)
+	if line["quantity"] != float64(100) || shipped["module_key"] != "shipmentDetails" ||
+		shipped["fulfilled"] != float64(30) || shipped["remaining"] != float64(70) || shipped["documents"].([]any)[0] != "CY-001" {
+		t.Fatalf("unexpected fulfilment: %v", record)
+	}
+
+	// 范围外的下游单据不出现在结果中
+	_, _ = repo.Create(ctx, "shipmentDetails", map[string]any{
+		"code": "CY-002", "sourceExportCode": "XS-001",
+		"items": []any{map[string]any{"productModel": "磁钢A", "quantity": 20}},
+	}, 2)
+	reports, err := j.erpUC.Fulfilment(biz.NewContextWithERPScope(ctx, &biz.ERPRecordScope{AdminIDs: []int{1}}), "exportSales", "XS-001")
+	if err != nil {
+		t.Fatalf("scoped fulfilment failed: %v", err)
+	}
+	if progress := reports[0].Lines[0].Flows[1]; progress.Fulfilled != 30 || len(progress.Documents) != 1 || progress.Documents[0] != "CY-001" {
+		t.Fatalf("out-of-scope shipment should be hidden, got %+v", progress)
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "outbound"})
+	if _, res, _ = j.handleERP(ctx, "fulfilment", "2", params); res.Code != 40010 {
+		t.Fatalf("module without downstream flow should be rejected, got %+v", res)
+	}
+}
+
+func TestJsonrpcData_HandleMasterdata(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	sales := &biz.AdminRole{ID: 1, Key: "sales", Name: "销售", Grants: map[string][]string{
+		"partners": {"view", "create", "edit"},
+	}}
+	adminRepo := &memAdminManageRepoForData{admins: map[int]*biz.AdminAccount{
+		1: {ID: 1, Username: "admin", Level: biz.AdminLevelSuper},
+		2: {ID: 2, Username: "alice", Level: biz.AdminLevelSecondary, RoleBased: true, Roles: []*biz.AdminRole{sales}},
+	}}
+	j := &JsonrpcData{
+		log:             log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:           biz.NewERPUsecase(newMemERPRepoForData(), logger, tp),
+		adminManageUC:   biz.NewAdminManageUsecase(adminRepo, logger, tp),
+		adminManageRepo: adminRepo,
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "admin", Role: biz.RoleAdmin})
+	alice := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 2, Username: "alice", Role: biz.RoleAdmin})
+
+	ids := make([]int, 0, 2)
+	for _, partner := range []map[string]any{
+		{"code": "CS-001", "partnerType": "合作客户", "name": "XX Co., Ltd", "address": "宁波", "contact": "张三", "contactPhone": "111", "paymentCycleDays": 30},
+		{"code": "CS-002", "partnerType": "合作客户", "name": "XX CO LTD", "address": "宁波", "contact": "李四", "contactPhone": "222", "paymentCycleDays": 30},
+	} {
+		params, _ := structpb.NewStruct(map[string]any{"module_key": "partners", "record": partner})
+		_, res, _ := j.handleERP(ctx, "create", "1", params)
+		if res.Code != 0 {
+			t.Fatalf("create partner failed: %+v", res)
+		}
+		ids = append(ids, int(res.GetData().AsMap()["record"].(map[string]any)["id"].(float64)))
+	}
+
+	params, _ := structpb.NewStruct(map[string]any{"module_key": "partners"})
+	_, res, _ := j.handleMasterdata(ctx, "duplicates", "2", params)
+	if res.Code != 0 {
+		t.Fatalf("duplicates failed: %+v", res)
+	}
+	groups := res.GetData().AsMap()["groups"].([]any)
+	group := groups[0].(map[string]any)
+	if len(groups) != 1 || group["reason"] != "name" || len(group["records"].([]any)) != 2 {
+		t.Fatalf("unexpected groups: %v", groups)
+	}
+	// 缺少 view_all 时只比较本人范围内的记录
+	_, res, _ = j.handleMasterdata(alice, "duplicates", "2", params)
+	if res.Code != 0 || len(res.GetData().AsMap()["groups"].([]any)) != 0 {
+		t.Fatalf("out-of-scope partners should be hidden, got %+v", res)
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "partners", "survivor_id": ids[0], "loser_id": ids[1]})
+	if _, res, _ = j.handleMasterdata(alice, "merge", "3", params); res.Code != 40302 {
+		t.Fatalf("merge without view_all should be denied, got %+v", res)
+	}
+	_, res, _ = j.handleMasterdata(ctx, "merge", "3", params)
+	if res.Code != 0 {
+		t.Fatalf("merge failed: %+v", res)
+	}
+	loser := res.GetData().AsMap()["loser"].(map[string]any)
+	if loser["disabled"] != true || loser["mergedInto"] != "CS-001" {
+		t.Fatalf("unexpected loser: %v", loser)
+	}
+
+	_, res, _ = j.handleMasterdata(ctx, "merge", "4", params)
+	if res.Code != 40041 {
+		t.Fatalf("merging a disabled partner should be rejected, got %+v", res)
+	}
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "quotations"})
+	if _, res, _ = j.handleMasterdata(ctx, "duplicates", "5", params); res.Code != 40010 {
+		t.Fatalf("duplicates of documents should be rejected, got %+v", res)
+	}
+}
+
+func TestJsonrpcData_HandleERP_QuotationRevise(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	repo := newMemERPRepoForData()
+	j := &JsonrpcData{
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.erp.test")),
+		erpUC:         biz.NewERPUsecase(repo, logger, tracesdk.NewTracerProvider()),
+		adminManageUC: newSuperAdminManageUCForData(logger),
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "admin", Role: biz.RoleAdmin})
+	_, _ = repo.Create(ctx, "partners", map[string]any{
+		"partnerType": "合作客户", "name": "客户A", "address": "宁波", "contact": "张三", "contactPhone": "111", "paymentCycleDays": 30,
+	}, 1)
+	params, _ := structpb.NewStruct(map[string]any{"module_key": "quotations", "record": map[string]any{
+		"code": "QT-001", "customerName": "客户A", "quotedDate": "2026-03-01", "currency": "USD", "salesOwner": "张三",
+		"items": []any{map[string]any{"productName": "磁钢A", "quantity": 10, "unitPrice": 5}},
+	}})
+	_, res, _ := j.handleERP(ctx, "create", "1", params)
+	if res.Code != 0 {
+		t.Fatalf("create quotation failed: %+v", res)
+	}
+	quoteID := int(res.GetData().AsMap()["record"].(map[string]any)["id"].(float64))
+
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "quotations", "id": quoteID, "version": 1})
+	_, res, _ = j.handleERP(ctx, "revise", "2", params)
+	if res.Code != 0 {
+		t.Fatalf("revise failed: %+v", res)
+	}
+	data := res.GetData().AsMap()
+	if data["record"].(map[string]any)["code"] != "QT-001-R1" || data["previous"].(map[string]any)["quoteStatus"] != "已修订" {
+		t.Fatalf("unexpected revise result: %v", data)
+	}
+	if _, res, _ = j.handleERP(ctx, "revise", "3", params); res.Code != 40916 {
+		t.Fatalf("stale version should conflict, got %+v", res)
+	}
+	params, _ = structpb.NewStruct(map[string]any{"module_key": "exportSales", "id": quoteID})
+	if _, res, _ = j.handleERP(ctx, "revise", "4", params); res.Code != 40010 {
+		t.Fatalf("revising other modules should be rejected, got %+v", res)
+	}
+
+	_, _ = repo.Create(ctx, "exportSales", map[string]any{"code": "XS-001", "sourceQuotationCode": "QT-001-R1", "signDate": "2026-03-11"}, 1)
+	params, _ = structpb.NewStruct(map[string]any{"date_from": "2026-01-01"})
+	_, res, _ = j.handleReport(ctx, "quotation_conversion", "5", params)
+	if res.Code != 0 {
+		t.Fatalf("quotation conversion failed: %+v", res)
+	}
+	total := res.GetData().AsMap()["total"].(map[string]any)
+	owner := res.GetData().AsMap()["by_sales_owner"].([]any)[0].(map[string]any)
+	if total["quote_count"] != float64(1) || total["revision_count"] != float64(1) || total["won_count"] != float64(1) ||
+		total["win_rate"] != float64(100) || total["avg_days_to_win"] != float64(10) || owner["key"] != "张三" {
+		t.Fatalf("unexpected conversion: %v", res.GetData().AsMap())
+	}
+}
+
+// memERPStockRepoForData 在内存仓储上记录库存变动，不校验余额。
+type memERPStockRepoForData struct {
+	*memERPRepoForData
+	balances []*biz.ERPStockBalance
+	moves    []biz.ERPStockMove
+}
+
+func (r *memERPStockRepoForData) ListStockBalances(ctx context.Context, productCodes []string) ([]*biz.ERPStockBalance, error) {
+	return r.balances, nil
+}
+
+func (r *memERPStockRepoForData) SaveProduction(ctx context.Context, plan *biz.ERPProductionPlan, operatorID int) (*biz.ERPRecord, error) {
+	r.moves = append(r.moves, plan.Moves...)
+	if plan.Record.ID == 0 {
+		return r.Create(ctx, plan.Record.ModuleKey, plan.Record.Payload, operatorID)
+	}
+	return r.Update(ctx, plan.Record.ModuleKey, plan.Record.ID, plan.Record.Payload, plan.Record.Version, operatorID)
+}
+
+func TestJsonrpcData_HandleProduction(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	repo := &memERPStockRepoForData{
+		memERPRepoForData: newMemERPRepoForData(),
+		balances:          []*biz.ERPStockBalance{{ID: 1, ProductCode: "RM-1", WarehouseID: 1, LocationID: 1, AvailableQty: 100}},
+	}
+	j := &JsonrpcData{
+		log:           log.NewHelper(log.With(logger, "module", "data.jsonrpc.production.test")),
+		erpUC:         biz.NewERPUsecase(repo, logger, tracesdk.NewTracerProvider()),
+		adminManageUC: newSuperAdminManageUCForData(logger),
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "admin", Role: biz.RoleAdmin})
+	_, _ = repo.Create(ctx, "products", map[string]any{"code": "RM-1", "hsCode": "7202999000", "specCode": "N35", "cnDesc": "毛坯", "enDesc": "blank"}, 1)
+	_, _ = repo.Create(ctx, "products", map[string]any{"code": "FG-1", "hsCode": "8505111000", "specCode": "D10", "cnDesc": "磁钢", "enDesc": "magnet",
+		"bom": []any{map[string]any{"materialCode": "RM-1", "quantity": 2}}}, 1)
+	_, _ = repo.Create(ctx, "exportSales", map[string]any{"code": "XS-001", "orderFlow": "内部生产", "customerContractNo": "HT-001",
+		"items": []any{map[string]any{"productCode": "FG-1", "productName": "磁钢", "quantity": 10}}}, 1)
+
+	params, _ := structpb.NewStruct(map[string]any{"export_code": "XS-001", "line_no": 1, "quantity": "4"})
+	_, res, _ := j.handleProduction(ctx, "generate", "1", params)
+	if res.Code != 0 {
+		t.Fatalf("generate failed: %+v", res)
+	}
+	record := res.GetData().AsMap()["record"].(map[string]any)
+	if record["code"] != "SC-XS-001-1" || record["quantity"] != float64(4) || len(repo.moves) != 1 || repo.moves[0].LockedDelta != 8 {
+		t.Fatalf("unexpected order %v moves %+v", record, repo.moves)
+	}
+	orderID := int(record["id"].(float64))
+
+	params, _ = structpb.NewStruct(map[string]any{"id": orderID, "version": 1, "lines": []any{map[string]any{"line_no": 1, "quantity": 5}}})
+	if _, res, _ = j.handleProduction(ctx, "issue", "2", params); res.Code != 0 || repo.moves[1].LockedDelta != -5 {
+		t.Fatalf("issue failed: %+v", res)
+	}
+	if _, res, _ = j.handleProduction(ctx, "issue", "3", params); res.Code != 40916 {
+		t.Fatalf("stale version should conflict, got %+v", res)
+	}
+	params, _ = structpb.NewStruct(map[string]any{"id": orderID, "quantity": 5, "warehouse_id": 2, "location_id": 3})
+	_, res, _ = j.handleProduction(ctx, "complete", "4", params)
+	if res.Code != 40041 || res.GetData().AsMap()["errors"].([]any)[0].(map[string]any)["path"] != "quantity" {
+		t.Fatalf("over-completion should fail, got %+v", res)
+	}
+	params, _ = structpb.NewStruct(map[string]any{"id": orderID})
+	_, res, _ = j.handleProduction(ctx, "print_data", "5", params)
+	if res.Code != 0 || res.GetData().AsMap()["template_key"] != "production" ||
+		res.GetData().AsMap()["fields"].(map[string]any)["customerContractNo"] != "HT-001" {
+		t.Fatalf("unexpected print data: %+v", res)
+	}
+	if _, res, _ = j.handleProduction(ctx, "unknown", "6", params); res.Code != 40020 {
+		t.Fatalf("unknown method should be rejected, got %+v", res)
+	}
+}
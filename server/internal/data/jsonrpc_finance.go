@@ -0,0 +1,362 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// =========================
+// finance domain (admin only)
+// =========================
+
+func (d *JsonrpcData) handleFinance(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+	if _, res := d.requireAdmin(ctx); res != nil {
+		l.Warnf("[finance] requireAdmin denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+
+	if menuKey, ok := financeMethodMenuKeys[method]; ok {
+		if res := d.requireMenuPermission(ctx, menuKey); res != nil {
+			l.Warnf("[finance] permission denied method=%s menu=%s code=%d", method, menuKey, res.Code)
+			return id, res, nil
+		}
+	}
+	for _, perm := range financeMethodERPPermissions[method] {
+		if res := d.requireERPPermission(ctx, perm.ModuleKey, perm.Action); res != nil {
+			l.Warnf("[finance] permission denied method=%s module=%s action=%s code=%d", method, perm.ModuleKey, perm.Action, res.Code)
+			return id, res, nil
+		}
+	}
+
+	switch method {
+	case "payables":
+		payables, err := d.erpUC.Payables(ctx, biz.ERPPayableFilter{
+			SupplierName: getString(pm, "supplier_name"),
+			PurchaseCode: getString(pm, "purchase_code"),
+		})
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		arr := make([]any, 0, len(payables))
+		for _, item := range payables {
+			arr = append(arr, toPayableView(item))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"payables": arr}),
+		}, nil
+
+	case "ap_aging":
+		asOf, err := parseFinanceDate(getString(pm, "as_of"))
+		if err != nil {
+			return id, d.mapERPError(ctx, biz.ErrBadParam), nil
+		}
+		report, err := d.erpUC.APAging(ctx, asOf, getString(pm, "supplier_name"))
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		rows := make([]any, 0, len(report.Rows))
+		for _, row := range report.Rows {
+			rows = append(rows, toAPAgingRowView(row))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"as_of":              report.AsOf.Format("2006-01-02"),
+				"rows":               rows,
+				"totals":             toAPAgingRowView(report.Totals),
+				"missing_currencies": toAnySliceString(report.MissingCurrencies),
+			}),
+		}, nil
+
+	case "generate_settlement":
+		claims, _ := biz.GetClaimsFromContext(ctx)
+		operatorID := 0
+		if claims != nil {
+			operatorID = claims.UserID
+		}
+		created, err := d.erpUC.GenerateSettlement(ctx, getString(pm, "shipment_code"), operatorID)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "创建成功",
+			Data:    newDataStruct(map[string]any{"record": created}),
+		}, nil
+
+	case "receivables":
+		receivables, err := d.erpUC.Receivables(ctx, biz.ERPReceivableFilter{
+			SettlementCode: getString(pm, "settlement_code"),
+			CustomerName:   getString(pm, "customer_name"),
+		})
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		arr := make([]any, 0, len(receivables))
+		for _, item := range receivables {
+			arr = append(arr, toReceivableView(item))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"receivables": arr}),
+		}, nil
+
+	case "rebate_estimates":
+		estimates, err := d.erpUC.RebateEstimates(ctx, getString(pm, "shipment_code"))
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		arr := make([]any, 0, len(estimates))
+		for _, item := range estimates {
+			arr = append(arr, toRebateEstimateView(item))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"estimates": arr}),
+		}, nil
+
+	case "rebate_report":
+		rows, err := d.erpUC.RebateReport(ctx, getString(pm, "month_from"), getString(pm, "month_to"))
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		arr := make([]any, 0, len(rows))
+		for _, row := range rows {
+			arr = append(arr, map[string]any{
+				"month":           row.Month,
+				"shipment_count":  row.ShipmentCount,
+				"expected_rebate": row.ExpectedRebate,
+				"declared_rebate": row.DeclaredRebate,
+				"received_rebate": row.ReceivedRebate,
+			})
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"rows": arr}),
+		}, nil
+
+	case "shipment_costs":
+		report, err := d.erpUC.ShipmentCostAllocation(ctx, getString(pm, "shipment_code"))
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(toShipmentCostReportView(report)),
+		}, nil
+
+	default:
+		return id, &v1.JsonrpcResult{
+			Code:    40020,
+			Message: fmt.Sprintf("未知财务接口 method=%s", method),
+		}, nil
+	}
+}
+
+// financeMethodMenuKeys 财务接口 → 所需菜单权限。
+var financeMethodMenuKeys = map[string]string{
+	"payables":            "/finance/payables",
+	"ap_aging":            "/finance/payables",
+	"shipment_costs":      "/finance/payables",
+	"generate_settlement": "/finance/settlements",
+	"receivables":         "/finance/settlements",
+	"rebate_estimates":    "/finance/rebates",
+	"rebate_report":       "/finance/rebates",
+}
+
+// financeERPPermission 财务接口所需的模块动作。
+type financeERPPermission struct {
+	ModuleKey string
+	Action    string
+}
+
+// financeMethodERPPermissions 财务接口在菜单权限之外另需的模块动作：生成结汇单需结汇单的 create，
+// 返回应收、应付金额的接口需对应模块的 view_amounts（菜单可由仅有 view 的授权取得）。
+var financeMethodERPPermissions = map[string][]financeERPPermission{
+	"payables":            {{biz.ERPModuleSupplierPayments, biz.ERPActionViewAmounts}},
+	"ap_aging":            {{biz.ERPModuleSupplierPayments, biz.ERPActionViewAmounts}},
+	"generate_settlement": {{biz.ERPModuleSettlements, biz.ERPActionCreate}},
+	"receivables":         {{biz.ERPModuleSettlements, biz.ERPActionViewAmounts}},
+}
+
+// parseFinanceDate 解析 YYYY-MM-DD 参数，空值返回零值（由 usecase 取当天）。
+func parseFinanceDate(raw string) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", raw)
+}
+
+func formatFinanceDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+func toPayableView(item *biz.ERPPayable) map[string]any {
+	entries := make([]any, 0, len(item.Entries))
+	for _, entry := range item.Entries {
+		entries = append(entries, map[string]any{
+			"currency":     entry.Currency,
+			"cost_type":    entry.CostType,
+			"inbound_code": entry.InboundCode,
+			"entry_no":     entry.EntryNo,
+			"product_name": entry.ProductName,
+			"quantity":     entry.Quantity,
+			"unit_price":   entry.UnitPrice,
+			"amount":       entry.Amount,
+			"paid_amount":  entry.PaidAmount,
+			"inbound_date": formatFinanceDate(entry.InboundDate),
+			"due_date":     formatFinanceDate(entry.DueDate),
+		})
+	}
+	return map[string]any{
+		"purchase_code":      item.PurchaseCode,
+		"source_module":      item.SourceModule,
+		"supplier_name":      item.SupplierName,
+		"currency":           item.Currency,
+		"invoice_required":   item.InvoiceRequired,
+		"payment_cycle_days": item.PaymentCycleDays,
+		"contract_amount":    item.ContractAmount,
+		"payable_amount":     item.PayableAmount,
+		"invoiced_amount":    item.InvoicedAmount,
+		"uninvoiced_amount":  item.UninvoicedAmount,
+		"paid_amount":        item.PaidAmount,
+		"outstanding_amount": item.OutstandingAmount,
+		"invoice_nos":        toAnySliceString(item.InvoiceNos),
+		"entries":            entries,
+	}
+}
+
+func toAPAgingRowView(row *biz.ERPAPAgingRow) map[string]any {
+	return map[string]any{
+		"supplier_name":     row.SupplierName,
+		"not_due":           row.NotDue,
+		"overdue_1_30":      row.Overdue1To30,
+		"overdue_31_60":     row.Overdue31To60,
+		"overdue_61_90":     row.Overdue61To90,
+		"overdue_over_90":   row.OverdueOver90,
+		"outstanding_total": row.OutstandingTotal,
+		"unapplied_payment": row.UnappliedPayment,
+	}
+}
+
+func toRebateEstimateView(item *biz.ERPRebateEstimate) map[string]any {
+	lines := make([]any, 0, len(item.Lines))
+	for _, line := range item.Lines {
+		lines = append(lines, map[string]any{
+			"purchase_code":   line.PurchaseCode,
+			"product_name":    line.ProductName,
+			"hs_code":         line.HSCode,
+			"quantity":        line.Quantity,
+			"unit_price":      line.UnitPrice,
+			"purchase_cost":   line.PurchaseCost,
+			"rebate_rate":     line.RebateRate,
+			"rate_found":      line.RateFound,
+			"expected_rebate": line.ExpectedRebate,
+		})
+	}
+	return map[string]any{
+		"shipment_code":      item.ShipmentCode,
+		"export_code":        item.ExportCode,
+		"customer_name":      item.CustomerName,
+		"ship_date":          formatFinanceDate(item.ShipDate),
+		"purchase_cost":      item.PurchaseCost,
+		"expected_rebate":    item.ExpectedRebate,
+		"missing_hs_codes":   toAnySliceString(item.MissingHSCodes),
+		"declaration_status": item.DeclarationStatus,
+		"customs_entry_nos":  toAnySliceString(item.CustomsEntryNos),
+		"received_amount":    item.ReceivedAmount,
+		"lines":              lines,
+	}
+}
+
+func toReceivableView(item *biz.ERPSettlementReceivable) map[string]any {
+	lines := make([]any, 0, len(item.Lines))
+	for _, line := range item.Lines {
+		lines = append(lines, map[string]any{
+			"line_no":            line.LineNo,
+			"shipment_code":      line.ShipmentCode,
+			"shipment_line_no":   line.ShipmentLineNo,
+			"product_model":      line.ProductModel,
+			"quantity":           line.Quantity,
+			"unit_price":         line.UnitPrice,
+			"amount":             line.Amount,
+			"received_amount":    line.ReceivedAmount,
+			"outstanding_amount": line.OutstandingAmount,
+			"status":             line.Status,
+		})
+	}
+	return map[string]any{
+		"settlement_code":    item.SettlementCode,
+		"invoice_no":         item.InvoiceNo,
+		"customer_name":      item.CustomerName,
+		"receivable_date":    formatFinanceDate(item.ReceivableDate),
+		"amount":             item.Amount,
+		"received_amount":    item.ReceivedAmount,
+		"outstanding_amount": item.OutstandingAmount,
+		"status":             item.Status,
+		"lines":              lines,
+	}
+}
+
+func toShipmentCostReportView(report *biz.ERPShipmentCostReport) map[string]any {
+	items := make([]any, 0, len(report.Items))
+	for _, item := range report.Items {
+		costs := make([]any, 0, len(item.Costs))
+		for _, share := range item.Costs {
+			costs = append(costs, map[string]any{
+				"cost_code":     share.CostCode,
+				"cost_type":     share.CostType,
+				"supplier_name": share.SupplierName,
+				"currency":      share.Currency,
+				"amount":        share.Amount,
+				"amount_cny":    share.AmountCNY,
+				"rate_found":    share.RateFound,
+			})
+		}
+		items = append(items, map[string]any{
+			"line_no":       item.LineNo,
+			"product_model": item.ProductModel,
+			"quantity":      item.Quantity,
+			"volume":        item.Volume,
+			"weight":        item.Weight,
+			"value":         item.Value,
+			"total_cny":     item.TotalCNY,
+			"costs":         costs,
+		})
+	}
+	return map[string]any{
+		"shipment_code":      report.ShipmentCode,
+		"cost_codes":         toAnySliceString(report.CostCodes),
+		"total_cny":          report.TotalCNY,
+		"missing_currencies": toAnySliceString(report.MissingCurrencies),
+		"items":              items,
+	}
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// =========================
+// masterdata domain (admin only)
+// =========================
+
+func (d *JsonrpcData) handleMasterdata(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+	claims, res := d.requireAdmin(ctx)
+	if res != nil {
+		l.Warnf("[masterdata] requireAdmin denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+	moduleKey := getString(pm, "module_key")
+	if key, err := biz.NormalizeERPModuleKey(moduleKey); err == nil {
+		moduleKey = key
+	}
+
+	switch method {
+	case "duplicates":
+		if res := d.requireERPPermission(ctx, moduleKey, biz.ERPActionView); res != nil {
+			l.Warnf("[masterdata] permission denied method=%s module=%s code=%d", method, moduleKey, res.Code)
+			return id, res, nil
+		}
+		ctx, err := d.withERPAccess(ctx, moduleKey)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		groups, err := d.erpUC.Duplicates(ctx, moduleKey)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		arr := make([]any, 0, len(groups))
+		for _, group := range groups {
+			arr = append(arr, toERPDuplicateGroupView(group))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"module_key": moduleKey,
+				"groups":     arr,
+			}),
+		}, nil
+
+	case "merge":
+		// 合并改写全部单据中的引用，须能看到并修改该主数据的全部记录（超级管理员拥有全部权限）
+		for _, action := range []string{biz.ERPActionEdit, biz.ERPActionViewAll} {
+			if res := d.requireERPPermission(ctx, moduleKey, action); res != nil {
+				l.Warnf("[masterdata] permission denied method=%s module=%s action=%s code=%d", method, moduleKey, action, res.Code)
+				return id, res, nil
+			}
+		}
+		ctx, err := d.withERPAccess(ctx, moduleKey)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		result, err := d.erpUC.Merge(ctx, moduleKey, getInt(pm, "survivor_id", 0), getInt(pm, "loser_id", 0), claims.UserID)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "合并成功",
+			Data: newDataStruct(map[string]any{
+				"module_key":          result.ModuleKey,
+				"survivor":            result.Survivor,
+				"loser":               result.Loser,
+				"references":          toERPReferenceViews(result.References),
+				"approved_references": toERPReferenceViews(result.Approved),
+			}),
+		}, nil
+
+	default:
+		return id, &v1.JsonrpcResult{
+			Code:    40020,
+			Message: fmt.Sprintf("未知主数据接口 method=%s", method),
+		}, nil
+	}
+}
+
+func toERPReferenceViews(refs []biz.ERPRecordReference) []any {
+	out := make([]any, 0, len(refs))
+	for _, ref := range refs {
+		view := map[string]any{
+			"module_key": ref.ModuleKey,
+			"id":         ref.ID,
+			"code":       ref.Code,
+		}
+		if ref.Field != "" {
+			view["field"] = ref.Field
+		}
+		out = append(out, view)
+	}
+	return out
+}
+
+func toERPDuplicateGroupView(group *biz.ERPDuplicateGroup) map[string]any {
+	records := make([]any, 0, len(group.Records))
+	for _, record := range group.Records {
+		records = append(records, map[string]any{
+			"id":   record.ID,
+			"code": record.Code,
+			"name": record.Name,
+		})
+	}
+	return map[string]any{
+		"reason":  group.Reason,
+		"key":     group.Key,
+		"records": records,
+	}
+}
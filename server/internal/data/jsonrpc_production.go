@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// =========================
+// production domain (admin only)
+// =========================
+
+func (d *JsonrpcData) handleProduction(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+	claims, res := d.requireAdmin(ctx)
+	if res != nil {
+		l.Warnf("[production] requireAdmin denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+	recordID := getInt(pm, "id", 0)
+	version := getInt(pm, "version", 0)
+
+	action := biz.ERPActionEdit
+	switch method {
+	case "generate":
+		action = biz.ERPActionCreate
+	case "print_data":
+		action = biz.ERPActionPrint
+	}
+	if res := d.requireERPPermission(ctx, biz.ERPModuleProductionOrders, action); res != nil {
+		l.Warnf("[production] permission denied method=%s action=%s code=%d", method, action, res.Code)
+		return id, res, nil
+	}
+
+	var (
+		record map[string]any
+		err    error
+	)
+	switch method {
+	case "generate":
+		// 只能由本人可见的外销合同生成（按记录范围过滤）
+		if res := d.requireERPPermission(ctx, biz.ERPModuleExportSales, biz.ERPActionView); res != nil {
+			l.Warnf("[production] permission denied method=%s module=%s code=%d", method, biz.ERPModuleExportSales, res.Code)
+			return id, res, nil
+		}
+		ctx, err = d.withERPAccess(ctx, biz.ERPModuleExportSales)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		record, err = d.erpUC.GenerateProductionOrder(ctx, getString(pm, "export_code"), getInt(pm, "line_no", 0),
+			getFloat(pm, "quantity", 0), getString(pm, "planned_date"), claims.UserID)
+
+	case "reserve":
+		record, err = d.erpUC.ReserveProductionMaterials(ctx, recordID, version, claims.UserID)
+
+	case "issue":
+		var lines []biz.ERPProductionIssue
+		rawLines, _ := pm["lines"].([]any)
+		for _, raw := range rawLines {
+			line, ok := raw.(map[string]any)
+			if !ok {
+				return id, d.mapERPError(ctx, biz.ErrBadParam), nil
+			}
+			lines = append(lines, biz.ERPProductionIssue{LineNo: getInt(line, "line_no", 0), Quantity: getFloat(line, "quantity", 0)})
+		}
+		record, err = d.erpUC.IssueProductionMaterials(ctx, recordID, version, lines, getString(pm, "issued_date"), claims.UserID)
+
+	case "complete":
+		record, err = d.erpUC.CompleteProduction(ctx, recordID, version, biz.ERPProductionCompletion{
+			CompletedDate: getString(pm, "completed_date"),
+			Quantity:      getFloat(pm, "quantity", 0),
+			WarehouseID:   getInt(pm, "warehouse_id", 0),
+			LocationID:    getInt(pm, "location_id", 0),
+			LotNo:         getString(pm, "lot_no"),
+		}, claims.UserID)
+
+	case "cancel":
+		record, err = d.erpUC.CancelProduction(ctx, recordID, version, claims.UserID)
+
+	case "print_data":
+		doc, err := d.erpUC.ProductionPrintData(ctx, recordID)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		materials := make([]any, 0, len(doc.Materials))
+		for _, material := range doc.Materials {
+			materials = append(materials, material)
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"template_key": doc.TemplateKey,
+				"title":        doc.Title,
+				"fields":       doc.Fields,
+				"materials":    materials,
+			}),
+		}, nil
+
+	default:
+		return id, &v1.JsonrpcResult{
+			Code:    40020,
+			Message: fmt.Sprintf("未知生产接口 method=%s", method),
+		}, nil
+	}
+
+	if err != nil {
+		if errors.Is(err, biz.ErrERPVersionConflict) {
+			return id, d.erpVersionConflict(ctx, biz.ERPModuleProductionOrders, recordID), nil
+		}
+		return id, d.mapERPError(ctx, err), nil
+	}
+	return id, &v1.JsonrpcResult{
+		Code:    0,
+		Message: "OK",
+		Data:    newDataStruct(map[string]any{"record": record}),
+	}, nil
+}
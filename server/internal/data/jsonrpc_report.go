@@ -0,0 +1,173 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// =========================
+// report domain (admin only)
+// =========================
+
+func (d *JsonrpcData) handleReport(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+	if _, res := d.requireAdmin(ctx); res != nil {
+		l.Warnf("[report] requireAdmin denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+
+	switch method {
+	case "order_profit":
+		if res := d.requireMenuPermission(ctx, "/reports/profit"); res != nil {
+			l.Warnf("[report] permission denied method=%s code=%d", method, res.Code)
+			return id, res, nil
+		}
+		dateFrom, err := parseFinanceDate(getString(pm, "date_from"))
+		if err != nil {
+			return id, d.mapERPError(ctx, biz.ErrBadParam), nil
+		}
+		dateTo, err := parseFinanceDate(getString(pm, "date_to"))
+		if err != nil {
+			return id, d.mapERPError(ctx, biz.ErrBadParam), nil
+		}
+		// 只返回本人可见的外销合同（按记录范围过滤）
+		ctx, err = d.withERPAccess(ctx, biz.ERPModuleExportSales)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		report, err := d.erpUC.OrderProfit(ctx, biz.ERPOrderProfitFilter{
+			CustomerName: getString(pm, "customer_name"),
+			SalesOwner:   getString(pm, "sales_owner"),
+			DateFrom:     dateFrom,
+			DateTo:       dateTo,
+		})
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		rows := make([]any, 0, len(report.Rows))
+		for _, row := range report.Rows {
+			rows = append(rows, toOrderProfitView(row))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"rows":                   rows,
+				"total_revenue_cny":      report.TotalRevenueCNY,
+				"total_gross_margin_cny": report.TotalGrossMarginCNY,
+				"missing_currencies":     toAnySliceString(report.MissingCurrencies),
+			}),
+		}, nil
+
+	case "quotation_conversion":
+		if res := d.requireERPPermission(ctx, biz.ERPModuleQuotations, biz.ERPActionView); res != nil {
+			l.Warnf("[report] permission denied method=%s code=%d", method, res.Code)
+			return id, res, nil
+		}
+		dateFrom, err := parseFinanceDate(getString(pm, "date_from"))
+		if err != nil {
+			return id, d.mapERPError(ctx, biz.ErrBadParam), nil
+		}
+		dateTo, err := parseFinanceDate(getString(pm, "date_to"))
+		if err != nil {
+			return id, d.mapERPError(ctx, biz.ErrBadParam), nil
+		}
+		// 只统计本人可见的报价单（按记录范围过滤）
+		ctx, err = d.withERPAccess(ctx, biz.ERPModuleQuotations)
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		report, err := d.erpUC.QuotationConversion(ctx, biz.ERPQuotationConversionFilter{
+			CustomerName: getString(pm, "customer_name"),
+			SalesOwner:   getString(pm, "sales_owner"),
+			DateFrom:     dateFrom,
+			DateTo:       dateTo,
+		})
+		if err != nil {
+			return id, d.mapERPError(ctx, err), nil
+		}
+		lostReasons := make([]any, 0, len(report.LostReasons))
+		for _, item := range report.LostReasons {
+			lostReasons = append(lostReasons, map[string]any{"reason": item.Reason, "count": item.Count})
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"by_customer":    toQuotationConversionViews(report.ByCustomer),
+				"by_sales_owner": toQuotationConversionViews(report.BySalesOwner),
+				"total":          toQuotationConversionView(report.Total),
+				"lost_reasons":   lostReasons,
+			}),
+		}, nil
+
+	default:
+		return id, &v1.JsonrpcResult{
+			Code:    40020,
+			Message: fmt.Sprintf("未知报表接口 method=%s", method),
+		}, nil
+	}
+}
+
+func toOrderProfitView(row *biz.ERPOrderProfit) map[string]any {
+	return map[string]any{
+		"export_code":        row.ExportCode,
+		"customer_name":      row.CustomerName,
+		"sales_owner":        row.SalesOwner,
+		"sign_date":          formatFinanceDate(row.SignDate),
+		"currency":           row.Currency,
+		"exchange_rate":      row.ExchangeRate,
+		"rate_found":         row.RateFound,
+		"revenue":            row.Revenue,
+		"revenue_cny":        row.RevenueCNY,
+		"purchase_cost":      row.PurchaseCost,
+		"freight_cost":       row.FreightCost,
+		"other_cost":         row.OtherCost,
+		"bank_fee":           row.BankFee,
+		"logistics_cost":     row.LogisticsCost,
+		"expected_rebate":    row.ExpectedRebate,
+		"gross_margin":       row.GrossMargin,
+		"gross_margin_cny":   row.GrossMarginCNY,
+		"margin_rate":        row.MarginRate,
+		"purchase_codes":     toAnySliceString(row.PurchaseCodes),
+		"shipment_codes":     toAnySliceString(row.ShipmentCodes),
+		"cost_codes":         toAnySliceString(row.CostCodes),
+		"missing_currencies": toAnySliceString(row.MissingCurrencies),
+	}
+}
+
+func toQuotationConversionViews(rows []*biz.ERPQuotationConversion) []any {
+	out := make([]any, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toQuotationConversionView(row))
+	}
+	return out
+}
+
+func toQuotationConversionView(row *biz.ERPQuotationConversion) map[string]any {
+	return map[string]any{
+		"key":             row.Key,
+		"quote_count":     row.QuoteCount,
+		"revision_count":  row.RevisionCount,
+		"won_count":       row.WonCount,
+		"lost_count":      row.LostCount,
+		"expired_count":   row.ExpiredCount,
+		"open_count":      row.OpenCount,
+		"win_rate":        row.WinRate,
+		"avg_days_to_win": row.AvgDaysToWin,
+	}
+}
@@ -0,0 +1,183 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// =========================
+// role domain (super admin only)
+// =========================
+
+func (d *JsonrpcData) handleRole(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+	if _, res := d.requireAdmin(ctx); res != nil {
+		l.Warnf("[role] requireAdmin denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+
+	switch method {
+	case "list":
+		roles, err := d.adminRoleUC.List(ctx)
+		if err != nil {
+			return id, d.mapAdminRoleError(ctx, err), nil
+		}
+		arr := make([]any, 0, len(roles))
+		for _, role := range roles {
+			arr = append(arr, toAdminRoleView(role))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"roles":   arr,
+				"actions": toAnySliceString(biz.ERPActions()),
+			}),
+		}, nil
+
+	case "create":
+		role, err := d.adminRoleUC.Create(ctx, toBizAdminRole(getMap(pm, "role")))
+		if err != nil {
+			return id, d.mapAdminRoleError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"role": toAdminRoleView(role)}),
+		}, nil
+
+	case "update":
+		in := toBizAdminRole(getMap(pm, "role"))
+		in.ID = getInt(pm, "id", 0)
+		role, err := d.adminRoleUC.Update(ctx, in)
+		if err != nil {
+			return id, d.mapAdminRoleError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"role": toAdminRoleView(role)}),
+		}, nil
+
+	case "delete":
+		if err := d.adminRoleUC.Delete(ctx, getInt(pm, "id", 0)); err != nil {
+			return id, d.mapAdminRoleError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data:    newDataStruct(map[string]any{"success": true}),
+		}, nil
+
+	case "assign":
+		raw, _ := pm["role_ids"].([]any)
+		roleIDs := make([]int, 0, len(raw))
+		for _, item := range raw {
+			v, ok := item.(float64)
+			if !ok {
+				return id, d.mapAdminRoleError(ctx, biz.ErrBadParam), nil
+			}
+			roleIDs = append(roleIDs, int(v))
+		}
+		admin, err := d.adminRoleUC.Assign(ctx, getInt(pm, "admin_id", 0), roleIDs)
+		if err != nil {
+			return id, d.mapAdminRoleError(ctx, err), nil
+		}
+		roles := make([]any, 0, len(admin.Roles))
+		for _, role := range admin.Roles {
+			roles = append(roles, toAdminRoleView(role))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    0,
+			Message: "OK",
+			Data: newDataStruct(map[string]any{
+				"admin": map[string]any{
+					"id":               admin.ID,
+					"username":         admin.Username,
+					"role_based":       admin.RoleBased,
+					"roles":            roles,
+					"menu_permissions": toAnySliceString(admin.MenuPermissions),
+				},
+			}),
+		}, nil
+
+	default:
+		return id, &v1.JsonrpcResult{
+			Code:    40020,
+			Message: fmt.Sprintf("未知角色接口 method=%s", method),
+		}, nil
+	}
+}
+
+func (d *JsonrpcData) mapAdminRoleError(ctx context.Context, err error) *v1.JsonrpcResult {
+	switch {
+	case errors.Is(err, biz.ErrAdminRoleNotFound):
+		return &v1.JsonrpcResult{Code: 40411, Message: "角色不存在"}
+	case errors.Is(err, biz.ErrAdminRoleExists):
+		return &v1.JsonrpcResult{Code: 40911, Message: "角色 key 已存在"}
+	case errors.Is(err, biz.ErrAdminRoleBuiltin):
+		return &v1.JsonrpcResult{Code: 40912, Message: "内置角色不可删除"}
+	default:
+		return d.mapAdminManageError(ctx, err)
+	}
+}
+
+func toBizAdminRole(m map[string]any) *biz.AdminRole {
+	role := &biz.AdminRole{
+		Key:         getString(m, "key"),
+		Name:        getString(m, "name"),
+		Description: getString(m, "description"),
+		Grants:      map[string][]string{},
+	}
+	grants := getMap(m, "grants")
+	for moduleKey := range grants {
+		role.Grants[moduleKey] = getStringSlice(grants, moduleKey)
+	}
+	return role
+}
+
+func toAdminRoleView(role *biz.AdminRole) map[string]any {
+	grants := make(map[string]any, len(role.Grants))
+	for moduleKey, actions := range role.Grants {
+		grants[moduleKey] = toAnySliceString(actions)
+	}
+	return map[string]any{
+		"id":          role.ID,
+		"key":         role.Key,
+		"name":        role.Name,
+		"description": role.Description,
+		"builtin":     role.Builtin,
+		"grants":      grants,
+		"admin_count": role.AdminCount,
+		"created_at":  role.CreatedAt.Unix(),
+		"updated_at":  role.UpdatedAt.Unix(),
+	}
+}
+
+// toAdminRoleRefs 管理员视图中的角色摘要（不含授权明细）。
+func toAdminRoleRefs(roles []*biz.AdminRole) []any {
+	out := make([]any, 0, len(roles))
+	for _, role := range roles {
+		out = append(out, map[string]any{
+			"id":   role.ID,
+			"key":  role.Key,
+			"name": role.Name,
+		})
+	}
+	return out
+}
@@ -0,0 +1,404 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"server/internal/biz"
+	"server/pkg/totp"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type memAdminSessionRepoForData struct {
+	sessions []*biz.AdminSession
+}
+
+func (r *memAdminSessionRepoForData) find(match func(*biz.AdminSession) bool) (*biz.AdminSession, error) {
+	for _, session := range r.sessions {
+		if match(session) {
+			copied := *session
+			return &copied, nil
+		}
+	}
+	return nil, biz.ErrAdminSessionNotFound
+}
+
+func (r *memAdminSessionRepoForData) CreateSession(ctx context.Context, session *biz.AdminSession) (*biz.AdminSession, error) {
+	copied := *session
+	copied.ID = len(r.sessions) + 1
+	r.sessions = append(r.sessions, &copied)
+	out := copied
+	return &out, nil
+}
+
+func (r *memAdminSessionRepoForData) GetSessionByID(ctx context.Context, id int) (*biz.AdminSession, error) {
+	return r.find(func(s *biz.AdminSession) bool { return s.ID == id })
+}
+
+func (r *memAdminSessionRepoForData) GetSessionByKey(ctx context.Context, sessionKey string) (*biz.AdminSession, error) {
+	return r.find(func(s *biz.AdminSession) bool { return s.SessionKey == sessionKey })
+}
+
+func (r *memAdminSessionRepoForData) ListActiveSessions(ctx context.Context, adminID int, now time.Time) ([]*biz.AdminSession, error) {
+	out := []*biz.AdminSession{}
+	for _, session := range r.sessions {
+		if session.AdminID == adminID && session.RevokedAt == nil {
+			copied := *session
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (r *memAdminSessionRepoForData) RotateSession(ctx context.Context, id int, previousRefreshHash, accessTokenID, refreshTokenHash string, client biz.ClientInfo, t time.Time) error {
+	session := r.sessions[id-1]
+	if session.RevokedAt != nil || session.RefreshTokenHash != previousRefreshHash {
+		return biz.ErrAdminSessionRevoked
+	}
+	session.AccessTokenID = accessTokenID
+	session.RefreshTokenHash = refreshTokenHash
+	return nil
+}
+
+func (r *memAdminSessionRepoForData) TouchSession(ctx context.Context, id int, t time.Time) error {
+	return nil
+}
+
+func (r *memAdminSessionRepoForData) RevokeSession(ctx context.Context, id int, reason string, t time.Time) error {
+	r.sessions[id-1].RevokedAt = &t
+	r.sessions[id-1].RevokeReason = reason
+	return nil
+}
+
+func (r *memAdminSessionRepoForData) RevokeAdminSessions(ctx context.Context, adminID int, reason string, t time.Time) (int, error) {
+	n := 0
+	for _, session := range r.sessions {
+		if session.AdminID == adminID && session.RevokedAt == nil {
+			session.RevokedAt = &t
+			session.RevokeReason = reason
+			n++
+		}
+	}
+	return n, nil
+}
+
+type memAdminAuthRepoForData struct {
+	admins   map[string]*biz.AdminUser
+	accounts *memAdminManageRepoForData
+	failed   map[int]int
+}
+
+func (r *memAdminAuthRepoForData) byID(id int) *biz.AdminUser {
+	for _, admin := range r.admins {
+		if admin.ID == id {
+			return admin
+		}
+	}
+	return nil
+}
+
+func (r *memAdminAuthRepoForData) GetAdminByUsername(ctx context.Context, username string) (*biz.AdminUser, error) {
+	admin, ok := r.admins[username]
+	if !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	return admin, nil
+}
+
+func (r *memAdminAuthRepoForData) UpdateAdminLastLogin(ctx context.Context, id int, t time.Time) error {
+	return nil
+}
+
+func (r *memAdminAuthRepoForData) UpdateAdminPassword(ctx context.Context, id int, passwordHash string, mustChange bool) error {
+	admin := r.byID(id)
+	admin.PasswordHash = passwordHash
+	admin.MustChangePassword = mustChange
+	admin.LockedUntil = nil
+	if r.accounts != nil {
+		r.accounts.admins[id].MustChangePassword = mustChange
+	}
+	return nil
+}
+
+func (r *memAdminAuthRepoForData) SetAdminLockedUntil(ctx context.Context, id int, until *time.Time) error {
+	r.byID(id).LockedUntil = until
+	return nil
+}
+
+func (r *memAdminAuthRepoForData) RecordLoginAttempt(ctx context.Context, attempt *biz.AdminLoginAttempt) error {
+	if r.failed == nil {
+		r.failed = map[int]int{}
+	}
+	if attempt.AdminID != nil {
+		if attempt.Success {
+			r.failed[*attempt.AdminID] = 0
+		} else if attempt.Reason == biz.AdminLoginInvalidPassword || attempt.Reason == biz.AdminLoginInvalidTOTP {
+			r.failed[*attempt.AdminID]++
+		}
+	}
+	return nil
+}
+
+func (r *memAdminAuthRepoForData) CountFailedLoginAttempts(ctx context.Context, adminID int, since time.Time) (int, error) {
+	return r.failed[adminID], nil
+}
+
+func TestJsonrpcData_AdminSessionLifecycle(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("p@ss"), bcrypt.MinCost)
+	adminRepo := &memAdminManageRepoForData{admins: map[int]*biz.AdminAccount{
+		2: {ID: 2, Username: "sales", Level: biz.AdminLevelSecondary, MenuPermissions: []string{"/sales/export"}},
+	}}
+	sessionRepo := &memAdminSessionRepoForData{}
+	adminManageUC := biz.NewAdminManageUsecase(adminRepo, logger, tp)
+	genTok := func(adminID int, username, sessionKey, tokenID string) (string, time.Time, error) {
+		return "tok-" + tokenID, time.Now().Add(15 * time.Minute), nil
+	}
+	j := &JsonrpcData{
+		log: log.NewHelper(log.With(logger, "module", "data.jsonrpc.session.test")),
+		adminAuthUC: biz.NewAdminAuthUsecase(&memAdminAuthRepoForData{admins: map[string]*biz.AdminUser{
+			"sales": {ID: 2, Username: "sales", PasswordHash: string(hash)},
+		}}, nil, logger, tp),
+		adminManageUC:   adminManageUC,
+		adminSessionUC:  biz.NewAdminSessionUsecase(sessionRepo, adminManageUC, genTok, time.Hour, logger, tp),
+		adminManageRepo: adminRepo,
+	}
+	claimsOf := func(session *biz.AdminSession) context.Context {
+		return biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{
+			UserID:    session.AdminID,
+			Username:  "sales",
+			Role:      biz.RoleAdmin,
+			SessionID: session.SessionKey,
+			TokenID:   session.AccessTokenID,
+		})
+	}
+
+	login, _ := structpb.NewStruct(map[string]any{"username": "sales", "password": "p@ss"})
+	_, res, err := j.Handle(context.Background(), "auth", "2.0", "admin_login", "1", login)
+	if err != nil || res == nil || res.Code != 0 {
+		t.Fatalf("admin_login should succeed, got %+v err=%v", res, err)
+	}
+	refreshToken, _ := res.GetData().AsMap()["refresh_token"].(string)
+	if refreshToken == "" || len(sessionRepo.sessions) != 1 {
+		t.Fatalf("admin_login should start a session, got %+v", res.GetData().AsMap())
+	}
+	oldCtx := claimsOf(sessionRepo.sessions[0])
+	if _, res, _ = j.Handle(oldCtx, "admin", "2.0", "me", "2", nil); res.Code != 0 {
+		t.Fatalf("admin.me should pass with session token, got %+v", res)
+	}
+
+	// 不带 sid 的旧版 token 不再被接受
+	legacyCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 2, Username: "sales", Role: biz.RoleAdmin})
+	if _, res, _ = j.Handle(legacyCtx, "admin", "2.0", "me", "3", nil); res.Code != 10006 {
+		t.Fatalf("token without session should get 10006, got %+v", res)
+	}
+
+	refresh, _ := structpb.NewStruct(map[string]any{"refresh_token": refreshToken})
+	if _, res, _ = j.Handle(context.Background(), "auth", "2.0", "refresh", "4", refresh); res.Code != 0 {
+		t.Fatalf("auth.refresh should succeed without login, got %+v", res)
+	}
+	if _, res, _ = j.Handle(oldCtx, "admin", "2.0", "me", "5", nil); res.Code != 10006 {
+		t.Fatalf("access token replaced by refresh should get 10006, got %+v", res)
+	}
+	currentCtx := claimsOf(sessionRepo.sessions[0])
+
+	_, res, _ = j.Handle(currentCtx, "admin", "2.0", "sessions", "6", nil)
+	sessions, _ := res.GetData().AsMap()["sessions"].([]any)
+	if res.Code != 0 || len(sessions) != 1 || sessions[0].(map[string]any)["current"] != true {
+		t.Fatalf("admin.sessions should list current session, got %+v", res)
+	}
+
+	kick, _ := structpb.NewStruct(map[string]any{"session_id": 1})
+	if _, res, _ = j.Handle(currentCtx, "admin", "2.0", "kick", "7", kick); res.Code != 0 {
+		t.Fatalf("admin.kick own session should succeed, got %+v", res)
+	}
+	if _, res, _ = j.Handle(currentCtx, "admin", "2.0", "me", "8", nil); res.Code != 10006 {
+		t.Fatalf("kicked session should get 10006, got %+v", res)
+	}
+}
+
+func TestJsonrpcData_AdminPasswordLifecycle(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("Erp#2026pass"), bcrypt.MinCost)
+	adminRepo := &memAdminManageRepoForData{admins: map[int]*biz.AdminAccount{
+		1: {ID: 1, Username: "root", Level: biz.AdminLevelSuper},
+		2: {ID: 2, Username: "sales", Level: biz.AdminLevelSecondary, MenuPermissions: []string{"/sales/export"}},
+	}}
+	authRepo := &memAdminAuthRepoForData{
+		admins: map[string]*biz.AdminUser{
+			"root":  {ID: 1, Username: "root", PasswordHash: string(hash)},
+			"sales": {ID: 2, Username: "sales", PasswordHash: string(hash)},
+		},
+		accounts: adminRepo,
+	}
+	adminAuthUC := biz.NewAdminAuthUsecase(authRepo, nil, logger, tp)
+	adminAuthUC.SetSecurityPolicy(biz.AdminSecurityPolicy{PasswordMinLength: 8, PasswordMinClasses: 3, MaxFailedAttempts: 2, FailedWindow: time.Minute, Lockout: time.Minute})
+	adminManageUC := biz.NewAdminManageUsecase(adminRepo, logger, tp)
+	j := &JsonrpcData{
+		log:             log.NewHelper(log.With(logger, "module", "data.jsonrpc.password.test")),
+		adminAuthUC:     adminAuthUC,
+		adminManageUC:   adminManageUC,
+		adminPasswordUC: biz.NewAdminPasswordUsecase(authRepo, adminManageUC, logger, tp),
+		adminManageRepo: adminRepo,
+	}
+	rootCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "root", Role: biz.RoleAdmin})
+	salesCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 2, Username: "sales", Role: biz.RoleAdmin})
+
+	weak, _ := structpb.NewStruct(map[string]any{"id": 2, "new_password": "12345678"})
+	if _, res, _ := j.Handle(rootCtx, "admin", "2.0", "reset_password", "1", weak); res.Code != 40013 {
+		t.Fatalf("weak reset password should get 40013, got %+v", res)
+	}
+	reset, _ := structpb.NewStruct(map[string]any{"id": 2, "new_password": "Reset#2026pw"})
+	if _, res, _ := j.Handle(rootCtx, "admin", "2.0", "reset_password", "2", reset); res.Code != 0 {
+		t.Fatalf("admin.reset_password should succeed, got %+v", res)
+	}
+
+	// 重置后只能查看本人信息和修改密码
+	if _, res, _ := j.Handle(salesCtx, "admin", "2.0", "list", "3", nil); res.Code != 40304 {
+		t.Fatalf("must change password admin should get 40304, got %+v", res)
+	}
+	_, res, _ := j.Handle(salesCtx, "admin", "2.0", "me", "4", nil)
+	if res.Code != 0 || res.GetData().AsMap()["must_change_password"] != true {
+		t.Fatalf("admin.me should report must_change_password, got %+v", res)
+	}
+	wrongOld, _ := structpb.NewStruct(map[string]any{"old_password": "Erp#2026pass", "new_password": "Sales#New2026"})
+	if _, res, _ := j.Handle(salesCtx, "admin", "2.0", "change_password", "5", wrongOld); res.Code != 40014 {
+		t.Fatalf("wrong old password should get 40014, got %+v", res)
+	}
+	change, _ := structpb.NewStruct(map[string]any{"old_password": "Reset#2026pw", "new_password": "Erp#New2026"})
+	if _, res, _ := j.Handle(salesCtx, "admin", "2.0", "change_password", "6", change); res.Code != 0 {
+		t.Fatalf("admin.change_password should succeed, got %+v", res)
+	}
+	if _, res, _ := j.Handle(salesCtx, "admin", "2.0", "list", "7", nil); res.Code == 40304 {
+		t.Fatalf("changed password should lift the gate, got %+v", res)
+	}
+
+	bad, _ := structpb.NewStruct(map[string]any{"username": "sales", "password": "wrong"})
+	if _, res, _ := j.Handle(context.Background(), "auth", "2.0", "admin_login", "8", bad); res.Code != 10002 {
+		t.Fatalf("first failure should get 10002, got %+v", res)
+	}
+	if _, res, _ := j.Handle(context.Background(), "auth", "2.0", "admin_login", "9", bad); res.Code != 10007 {
+		t.Fatalf("reaching max failures should lock with 10007, got %+v", res)
+	}
+}
+
+type memAdminTOTPRepoForData struct {
+	states map[int]*biz.AdminTOTP
+}
+
+func (r *memAdminTOTPRepoForData) state(adminID int) *biz.AdminTOTP {
+	if r.states[adminID] == nil {
+		r.states[adminID] = &biz.AdminTOTP{AdminID: adminID}
+	}
+	return r.states[adminID]
+}
+
+func (r *memAdminTOTPRepoForData) GetAdminTOTP(ctx context.Context, adminID int) (*biz.AdminTOTP, error) {
+	out := *r.state(adminID)
+	return &out, nil
+}
+
+func (r *memAdminTOTPRepoForData) SetAdminTOTPSecret(ctx context.Context, adminID int, secret string) error {
+	r.state(adminID).Secret = secret
+	return nil
+}
+
+func (r *memAdminTOTPRepoForData) EnableAdminTOTP(ctx context.Context, adminID int, step int64, hashes []string, t time.Time) error {
+	s := r.state(adminID)
+	s.Enabled, s.EnabledAt, s.LastStep = true, &t, step
+	return nil
+}
+
+func (r *memAdminTOTPRepoForData) AdvanceAdminTOTPStep(ctx context.Context, adminID int, step int64) (bool, error) {
+	s := r.state(adminID)
+	if step <= s.LastStep {
+		return false, nil
+	}
+	s.LastStep = step
+	return true, nil
+}
+
+func (r *memAdminTOTPRepoForData) ClearAdminTOTP(ctx context.Context, adminID int) error {
+	r.states[adminID] = &biz.AdminTOTP{AdminID: adminID}
+	return nil
+}
+
+func (r *memAdminTOTPRepoForData) ReplaceRecoveryCodes(ctx context.Context, adminID int, hashes []string, t time.Time) error {
+	return nil
+}
+
+func (r *memAdminTOTPRepoForData) UseRecoveryCode(ctx context.Context, adminID int, hash string, t time.Time) (bool, error) {
+	return false, nil
+}
+
+func (r *memAdminTOTPRepoForData) CountRecoveryCodes(ctx context.Context, adminID int) (int, error) {
+	return 0, nil
+}
+
+func TestJsonrpcData_AdminTOTPEnforced(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("Erp#2026pass"), bcrypt.MinCost)
+	adminRepo := &memAdminManageRepoForData{admins: map[int]*biz.AdminAccount{
+		1: {ID: 1, Username: "root", Level: biz.AdminLevelSuper},
+	}}
+	totpRepo := &memAdminTOTPRepoForData{states: map[int]*biz.AdminTOTP{}}
+	policy := biz.DefaultAdminSecurityPolicy()
+	policy.TOTPRequiredLevels = []biz.AdminLevel{biz.AdminLevelSuper}
+	adminManageUC := biz.NewAdminManageUsecase(adminRepo, logger, tp)
+	adminManageUC.SetSecurityPolicy(policy)
+	adminTOTPUC := biz.NewAdminTOTPUsecase(totpRepo, adminManageUC, logger, tp)
+	adminAuthUC := biz.NewAdminAuthUsecase(&memAdminAuthRepoForData{admins: map[string]*biz.AdminUser{
+		"root": {ID: 1, Username: "root", PasswordHash: string(hash)},
+	}}, func(userID int, username string, role int8) (string, time.Time, error) {
+		return "tok", time.Now().Add(time.Hour), nil
+	}, logger, tp)
+	adminAuthUC.SetSecondFactor(adminTOTPUC)
+	j := &JsonrpcData{
+		log:             log.NewHelper(log.With(logger, "module", "data.jsonrpc.totp.test")),
+		adminAuthUC:     adminAuthUC,
+		adminManageUC:   adminManageUC,
+		adminTOTPUC:     adminTOTPUC,
+		adminManageRepo: adminRepo,
+	}
+	rootCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "root", Role: biz.RoleAdmin})
+
+	login, _ := structpb.NewStruct(map[string]any{"username": "root", "password": "Erp#2026pass"})
+	_, res, _ := j.Handle(context.Background(), "auth", "2.0", "admin_login", "1", login)
+	if res.Code != 0 || res.GetData().AsMap()["totp_setup_required"] != true {
+		t.Fatalf("enforced admin without totp should log in and be asked to set up, got %+v", res)
+	}
+	if _, res, _ = j.Handle(rootCtx, "admin", "2.0", "list", "2", nil); res.Code != 40305 {
+		t.Fatalf("enforced admin without totp should get 40305, got %+v", res)
+	}
+	_, res, _ = j.Handle(rootCtx, "admin", "2.0", "totp_setup", "3", nil)
+	secret, _ := res.GetData().AsMap()["secret"].(string)
+	if res.Code != 0 || secret == "" {
+		t.Fatalf("admin.totp_setup should return secret, got %+v", res)
+	}
+	code, _ := totp.Code(secret, totp.Step(time.Now()))
+	enable, _ := structpb.NewStruct(map[string]any{"code": code})
+	if _, res, _ = j.Handle(rootCtx, "admin", "2.0", "totp_enable", "4", enable); res.Code != 0 {
+		t.Fatalf("admin.totp_enable should succeed, got %+v", res)
+	}
+	adminRepo.admins[1].TOTPEnabled = true
+	if _, res, _ = j.Handle(rootCtx, "admin", "2.0", "list", "5", nil); res.Code == 40305 {
+		t.Fatalf("enabled totp should lift the gate, got %+v", res)
+	}
+
+	if _, res, _ = j.Handle(context.Background(), "auth", "2.0", "admin_login", "6", login); res.Code != 10008 {
+		t.Fatalf("login without otp_code should get 10008, got %+v", res)
+	}
+	wrong, _ := structpb.NewStruct(map[string]any{"username": "root", "password": "Erp#2026pass", "otp_code": "000000"})
+	if _, res, _ = j.Handle(context.Background(), "auth", "2.0", "admin_login", "7", wrong); res.Code != 10009 {
+		t.Fatalf("wrong otp_code should get 10009, got %+v", res)
+	}
+}
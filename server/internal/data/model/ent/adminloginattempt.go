@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/adminloginattempt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AdminLoginAttempt is the model entity for the AdminLoginAttempt schema.
+type AdminLoginAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// 账号不存在时为空
+	AdminUserID *int `json:"admin_user_id,omitempty"`
+	// Success holds the value of the "success" field.
+	Success bool `json:"success,omitempty"`
+	// ok/not_found/disabled/locked/invalid_password/totp_required/invalid_totp
+	Reason string `json:"reason,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdminLoginAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adminloginattempt.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case adminloginattempt.FieldID, adminloginattempt.FieldAdminUserID:
+			values[i] = new(sql.NullInt64)
+		case adminloginattempt.FieldUsername, adminloginattempt.FieldReason, adminloginattempt.FieldIP, adminloginattempt.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case adminloginattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdminLoginAttempt fields.
+func (_m *AdminLoginAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adminloginattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case adminloginattempt.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				_m.Username = value.String
+			}
+		case adminloginattempt.FieldAdminUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_user_id", values[i])
+			} else if value.Valid {
+				_m.AdminUserID = new(int)
+				*_m.AdminUserID = int(value.Int64)
+			}
+		case adminloginattempt.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				_m.Success = value.Bool
+			}
+		case adminloginattempt.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case adminloginattempt.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case adminloginattempt.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case adminloginattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdminLoginAttempt.
+// This includes values selected through modifiers, order, etc.
+func (_m *AdminLoginAttempt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AdminLoginAttempt.
+// Note that you need to call AdminLoginAttempt.Unwrap() before calling this method if this AdminLoginAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AdminLoginAttempt) Update() *AdminLoginAttemptUpdateOne {
+	return NewAdminLoginAttemptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AdminLoginAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AdminLoginAttempt) Unwrap() *AdminLoginAttempt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdminLoginAttempt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AdminLoginAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdminLoginAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("username=")
+	builder.WriteString(_m.Username)
+	builder.WriteString(", ")
+	if v := _m.AdminUserID; v != nil {
+		builder.WriteString("admin_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Success))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdminLoginAttempts is a parsable slice of AdminLoginAttempt.
+type AdminLoginAttempts []*AdminLoginAttempt
@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminloginattempt
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldUsername, v))
+}
+
+// AdminUserID applies equality check predicate on the "admin_user_id" field. It's identical to AdminUserIDEQ.
+func AdminUserID(v int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldAdminUserID, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldSuccess, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldReason, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldIP, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// AdminUserIDEQ applies the EQ predicate on the "admin_user_id" field.
+func AdminUserIDEQ(v int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldAdminUserID, v))
+}
+
+// AdminUserIDNEQ applies the NEQ predicate on the "admin_user_id" field.
+func AdminUserIDNEQ(v int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNEQ(FieldAdminUserID, v))
+}
+
+// AdminUserIDIn applies the In predicate on the "admin_user_id" field.
+func AdminUserIDIn(vs ...int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldIn(FieldAdminUserID, vs...))
+}
+
+// AdminUserIDNotIn applies the NotIn predicate on the "admin_user_id" field.
+func AdminUserIDNotIn(vs ...int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNotIn(FieldAdminUserID, vs...))
+}
+
+// AdminUserIDGT applies the GT predicate on the "admin_user_id" field.
+func AdminUserIDGT(v int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGT(FieldAdminUserID, v))
+}
+
+// AdminUserIDGTE applies the GTE predicate on the "admin_user_id" field.
+func AdminUserIDGTE(v int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGTE(FieldAdminUserID, v))
+}
+
+// AdminUserIDLT applies the LT predicate on the "admin_user_id" field.
+func AdminUserIDLT(v int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLT(FieldAdminUserID, v))
+}
+
+// AdminUserIDLTE applies the LTE predicate on the "admin_user_id" field.
+func AdminUserIDLTE(v int) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLTE(FieldAdminUserID, v))
+}
+
+// AdminUserIDIsNil applies the IsNil predicate on the "admin_user_id" field.
+func AdminUserIDIsNil() predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldIsNull(FieldAdminUserID))
+}
+
+// AdminUserIDNotNil applies the NotNil predicate on the "admin_user_id" field.
+func AdminUserIDNotNil() predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNotNull(FieldAdminUserID))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldContainsFold(FieldReason, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldContainsFold(FieldIP, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdminLoginAttempt) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdminLoginAttempt) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdminLoginAttempt) predicate.AdminLoginAttempt {
+	return predicate.AdminLoginAttempt(sql.NotPredicates(p))
+}
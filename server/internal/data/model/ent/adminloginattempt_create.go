@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminloginattempt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminLoginAttemptCreate is the builder for creating a AdminLoginAttempt entity.
+type AdminLoginAttemptCreate struct {
+	config
+	mutation *AdminLoginAttemptMutation
+	hooks    []Hook
+}
+
+// SetUsername sets the "username" field.
+func (_c *AdminLoginAttemptCreate) SetUsername(v string) *AdminLoginAttemptCreate {
+	_c.mutation.SetUsername(v)
+	return _c
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_c *AdminLoginAttemptCreate) SetAdminUserID(v int) *AdminLoginAttemptCreate {
+	_c.mutation.SetAdminUserID(v)
+	return _c
+}
+
+// SetNillableAdminUserID sets the "admin_user_id" field if the given value is not nil.
+func (_c *AdminLoginAttemptCreate) SetNillableAdminUserID(v *int) *AdminLoginAttemptCreate {
+	if v != nil {
+		_c.SetAdminUserID(*v)
+	}
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *AdminLoginAttemptCreate) SetSuccess(v bool) *AdminLoginAttemptCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_c *AdminLoginAttemptCreate) SetNillableSuccess(v *bool) *AdminLoginAttemptCreate {
+	if v != nil {
+		_c.SetSuccess(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AdminLoginAttemptCreate) SetReason(v string) *AdminLoginAttemptCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *AdminLoginAttemptCreate) SetNillableReason(v *string) *AdminLoginAttemptCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *AdminLoginAttemptCreate) SetIP(v string) *AdminLoginAttemptCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *AdminLoginAttemptCreate) SetNillableIP(v *string) *AdminLoginAttemptCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *AdminLoginAttemptCreate) SetUserAgent(v string) *AdminLoginAttemptCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *AdminLoginAttemptCreate) SetNillableUserAgent(v *string) *AdminLoginAttemptCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminLoginAttemptCreate) SetCreatedAt(v time.Time) *AdminLoginAttemptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminLoginAttemptCreate) SetNillableCreatedAt(v *time.Time) *AdminLoginAttemptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AdminLoginAttemptMutation object of the builder.
+func (_c *AdminLoginAttemptCreate) Mutation() *AdminLoginAttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminLoginAttempt in the database.
+func (_c *AdminLoginAttemptCreate) Save(ctx context.Context) (*AdminLoginAttempt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminLoginAttemptCreate) SaveX(ctx context.Context) *AdminLoginAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminLoginAttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminLoginAttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminLoginAttemptCreate) defaults() {
+	if _, ok := _c.mutation.Success(); !ok {
+		v := adminloginattempt.DefaultSuccess
+		_c.mutation.SetSuccess(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := adminloginattempt.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		v := adminloginattempt.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := adminloginattempt.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminloginattempt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminLoginAttemptCreate) check() error {
+	if _, ok := _c.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "AdminLoginAttempt.username"`)}
+	}
+	if v, ok := _c.mutation.Username(); ok {
+		if err := adminloginattempt.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.username": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "AdminLoginAttempt.success"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "AdminLoginAttempt.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := adminloginattempt.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "AdminLoginAttempt.ip"`)}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := adminloginattempt.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "AdminLoginAttempt.user_agent"`)}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := adminloginattempt.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminLoginAttempt.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AdminLoginAttemptCreate) sqlSave(ctx context.Context) (*AdminLoginAttempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminLoginAttemptCreate) createSpec() (*AdminLoginAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminLoginAttempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminloginattempt.Table, sqlgraph.NewFieldSpec(adminloginattempt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Username(); ok {
+		_spec.SetField(adminloginattempt.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := _c.mutation.AdminUserID(); ok {
+		_spec.SetField(adminloginattempt.FieldAdminUserID, field.TypeInt, value)
+		_node.AdminUserID = &value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(adminloginattempt.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(adminloginattempt.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(adminloginattempt.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(adminloginattempt.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminloginattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AdminLoginAttemptCreateBulk is the builder for creating many AdminLoginAttempt entities in bulk.
+type AdminLoginAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*AdminLoginAttemptCreate
+}
+
+// Save creates the AdminLoginAttempt entities in the database.
+func (_c *AdminLoginAttemptCreateBulk) Save(ctx context.Context) ([]*AdminLoginAttempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminLoginAttempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminLoginAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminLoginAttemptCreateBulk) SaveX(ctx context.Context) []*AdminLoginAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminLoginAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminLoginAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
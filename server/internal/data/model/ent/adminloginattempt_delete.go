@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"server/internal/data/model/ent/adminloginattempt"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminLoginAttemptDelete is the builder for deleting a AdminLoginAttempt entity.
+type AdminLoginAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *AdminLoginAttemptMutation
+}
+
+// Where appends a list predicates to the AdminLoginAttemptDelete builder.
+func (_d *AdminLoginAttemptDelete) Where(ps ...predicate.AdminLoginAttempt) *AdminLoginAttemptDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AdminLoginAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AdminLoginAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AdminLoginAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(adminloginattempt.Table, sqlgraph.NewFieldSpec(adminloginattempt.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AdminLoginAttemptDeleteOne is the builder for deleting a single AdminLoginAttempt entity.
+type AdminLoginAttemptDeleteOne struct {
+	_d *AdminLoginAttemptDelete
+}
+
+// Where appends a list predicates to the AdminLoginAttemptDelete builder.
+func (_d *AdminLoginAttemptDeleteOne) Where(ps ...predicate.AdminLoginAttempt) *AdminLoginAttemptDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AdminLoginAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{adminloginattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AdminLoginAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminloginattempt"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminLoginAttemptUpdate is the builder for updating AdminLoginAttempt entities.
+type AdminLoginAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminLoginAttemptMutation
+}
+
+// Where appends a list predicates to the AdminLoginAttemptUpdate builder.
+func (_u *AdminLoginAttemptUpdate) Where(ps ...predicate.AdminLoginAttempt) *AdminLoginAttemptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *AdminLoginAttemptUpdate) SetUsername(v string) *AdminLoginAttemptUpdate {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdate) SetNillableUsername(v *string) *AdminLoginAttemptUpdate {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_u *AdminLoginAttemptUpdate) SetAdminUserID(v int) *AdminLoginAttemptUpdate {
+	_u.mutation.ResetAdminUserID()
+	_u.mutation.SetAdminUserID(v)
+	return _u
+}
+
+// SetNillableAdminUserID sets the "admin_user_id" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdate) SetNillableAdminUserID(v *int) *AdminLoginAttemptUpdate {
+	if v != nil {
+		_u.SetAdminUserID(*v)
+	}
+	return _u
+}
+
+// AddAdminUserID adds value to the "admin_user_id" field.
+func (_u *AdminLoginAttemptUpdate) AddAdminUserID(v int) *AdminLoginAttemptUpdate {
+	_u.mutation.AddAdminUserID(v)
+	return _u
+}
+
+// ClearAdminUserID clears the value of the "admin_user_id" field.
+func (_u *AdminLoginAttemptUpdate) ClearAdminUserID() *AdminLoginAttemptUpdate {
+	_u.mutation.ClearAdminUserID()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *AdminLoginAttemptUpdate) SetSuccess(v bool) *AdminLoginAttemptUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdate) SetNillableSuccess(v *bool) *AdminLoginAttemptUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AdminLoginAttemptUpdate) SetReason(v string) *AdminLoginAttemptUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdate) SetNillableReason(v *string) *AdminLoginAttemptUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AdminLoginAttemptUpdate) SetIP(v string) *AdminLoginAttemptUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdate) SetNillableIP(v *string) *AdminLoginAttemptUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AdminLoginAttemptUpdate) SetUserAgent(v string) *AdminLoginAttemptUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdate) SetNillableUserAgent(v *string) *AdminLoginAttemptUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AdminLoginAttemptMutation object of the builder.
+func (_u *AdminLoginAttemptUpdate) Mutation() *AdminLoginAttemptMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminLoginAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminLoginAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminLoginAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminLoginAttemptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminLoginAttemptUpdate) check() error {
+	if v, ok := _u.mutation.Username(); ok {
+		if err := adminloginattempt.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := adminloginattempt.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := adminloginattempt.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := adminloginattempt.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminLoginAttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminloginattempt.Table, adminloginattempt.Columns, sqlgraph.NewFieldSpec(adminloginattempt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(adminloginattempt.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdminUserID(); ok {
+		_spec.SetField(adminloginattempt.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminUserID(); ok {
+		_spec.AddField(adminloginattempt.FieldAdminUserID, field.TypeInt, value)
+	}
+	if _u.mutation.AdminUserIDCleared() {
+		_spec.ClearField(adminloginattempt.FieldAdminUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(adminloginattempt.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(adminloginattempt.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(adminloginattempt.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(adminloginattempt.FieldUserAgent, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminloginattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminLoginAttemptUpdateOne is the builder for updating a single AdminLoginAttempt entity.
+type AdminLoginAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminLoginAttemptMutation
+}
+
+// SetUsername sets the "username" field.
+func (_u *AdminLoginAttemptUpdateOne) SetUsername(v string) *AdminLoginAttemptUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdateOne) SetNillableUsername(v *string) *AdminLoginAttemptUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_u *AdminLoginAttemptUpdateOne) SetAdminUserID(v int) *AdminLoginAttemptUpdateOne {
+	_u.mutation.ResetAdminUserID()
+	_u.mutation.SetAdminUserID(v)
+	return _u
+}
+
+// SetNillableAdminUserID sets the "admin_user_id" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdateOne) SetNillableAdminUserID(v *int) *AdminLoginAttemptUpdateOne {
+	if v != nil {
+		_u.SetAdminUserID(*v)
+	}
+	return _u
+}
+
+// AddAdminUserID adds value to the "admin_user_id" field.
+func (_u *AdminLoginAttemptUpdateOne) AddAdminUserID(v int) *AdminLoginAttemptUpdateOne {
+	_u.mutation.AddAdminUserID(v)
+	return _u
+}
+
+// ClearAdminUserID clears the value of the "admin_user_id" field.
+func (_u *AdminLoginAttemptUpdateOne) ClearAdminUserID() *AdminLoginAttemptUpdateOne {
+	_u.mutation.ClearAdminUserID()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *AdminLoginAttemptUpdateOne) SetSuccess(v bool) *AdminLoginAttemptUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdateOne) SetNillableSuccess(v *bool) *AdminLoginAttemptUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AdminLoginAttemptUpdateOne) SetReason(v string) *AdminLoginAttemptUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdateOne) SetNillableReason(v *string) *AdminLoginAttemptUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AdminLoginAttemptUpdateOne) SetIP(v string) *AdminLoginAttemptUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdateOne) SetNillableIP(v *string) *AdminLoginAttemptUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AdminLoginAttemptUpdateOne) SetUserAgent(v string) *AdminLoginAttemptUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AdminLoginAttemptUpdateOne) SetNillableUserAgent(v *string) *AdminLoginAttemptUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AdminLoginAttemptMutation object of the builder.
+func (_u *AdminLoginAttemptUpdateOne) Mutation() *AdminLoginAttemptMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AdminLoginAttemptUpdate builder.
+func (_u *AdminLoginAttemptUpdateOne) Where(ps ...predicate.AdminLoginAttempt) *AdminLoginAttemptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminLoginAttemptUpdateOne) Select(field string, fields ...string) *AdminLoginAttemptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminLoginAttempt entity.
+func (_u *AdminLoginAttemptUpdateOne) Save(ctx context.Context) (*AdminLoginAttempt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminLoginAttemptUpdateOne) SaveX(ctx context.Context) *AdminLoginAttempt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminLoginAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminLoginAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminLoginAttemptUpdateOne) check() error {
+	if v, ok := _u.mutation.Username(); ok {
+		if err := adminloginattempt.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := adminloginattempt.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := adminloginattempt.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := adminloginattempt.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminLoginAttempt.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminLoginAttemptUpdateOne) sqlSave(ctx context.Context) (_node *AdminLoginAttempt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminloginattempt.Table, adminloginattempt.Columns, sqlgraph.NewFieldSpec(adminloginattempt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdminLoginAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminloginattempt.FieldID)
+		for _, f := range fields {
+			if !adminloginattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adminloginattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(adminloginattempt.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdminUserID(); ok {
+		_spec.SetField(adminloginattempt.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminUserID(); ok {
+		_spec.AddField(adminloginattempt.FieldAdminUserID, field.TypeInt, value)
+	}
+	if _u.mutation.AdminUserIDCleared() {
+		_spec.ClearField(adminloginattempt.FieldAdminUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(adminloginattempt.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(adminloginattempt.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(adminloginattempt.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(adminloginattempt.FieldUserAgent, field.TypeString, value)
+	}
+	_node = &AdminLoginAttempt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminloginattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/adminrecoverycode"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AdminRecoveryCode is the model entity for the AdminRecoveryCode schema.
+type AdminRecoveryCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AdminUserID holds the value of the "admin_user_id" field.
+	AdminUserID int `json:"admin_user_id,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"-"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdminRecoveryCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adminrecoverycode.FieldID, adminrecoverycode.FieldAdminUserID:
+			values[i] = new(sql.NullInt64)
+		case adminrecoverycode.FieldCodeHash:
+			values[i] = new(sql.NullString)
+		case adminrecoverycode.FieldUsedAt, adminrecoverycode.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdminRecoveryCode fields.
+func (_m *AdminRecoveryCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adminrecoverycode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case adminrecoverycode.FieldAdminUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_user_id", values[i])
+			} else if value.Valid {
+				_m.AdminUserID = int(value.Int64)
+			}
+		case adminrecoverycode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				_m.CodeHash = value.String
+			}
+		case adminrecoverycode.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		case adminrecoverycode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdminRecoveryCode.
+// This includes values selected through modifiers, order, etc.
+func (_m *AdminRecoveryCode) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AdminRecoveryCode.
+// Note that you need to call AdminRecoveryCode.Unwrap() before calling this method if this AdminRecoveryCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AdminRecoveryCode) Update() *AdminRecoveryCodeUpdateOne {
+	return NewAdminRecoveryCodeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AdminRecoveryCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AdminRecoveryCode) Unwrap() *AdminRecoveryCode {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdminRecoveryCode is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AdminRecoveryCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdminRecoveryCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("admin_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdminUserID))
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdminRecoveryCodes is a parsable slice of AdminRecoveryCode.
+type AdminRecoveryCodes []*AdminRecoveryCode
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminrecoverycode
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLTE(FieldID, id))
+}
+
+// AdminUserID applies equality check predicate on the "admin_user_id" field. It's identical to AdminUserIDEQ.
+func AdminUserID(v int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldAdminUserID, v))
+}
+
+// CodeHash applies equality check predicate on the "code_hash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AdminUserIDEQ applies the EQ predicate on the "admin_user_id" field.
+func AdminUserIDEQ(v int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldAdminUserID, v))
+}
+
+// AdminUserIDNEQ applies the NEQ predicate on the "admin_user_id" field.
+func AdminUserIDNEQ(v int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNEQ(FieldAdminUserID, v))
+}
+
+// AdminUserIDIn applies the In predicate on the "admin_user_id" field.
+func AdminUserIDIn(vs ...int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldIn(FieldAdminUserID, vs...))
+}
+
+// AdminUserIDNotIn applies the NotIn predicate on the "admin_user_id" field.
+func AdminUserIDNotIn(vs ...int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNotIn(FieldAdminUserID, vs...))
+}
+
+// AdminUserIDGT applies the GT predicate on the "admin_user_id" field.
+func AdminUserIDGT(v int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGT(FieldAdminUserID, v))
+}
+
+// AdminUserIDGTE applies the GTE predicate on the "admin_user_id" field.
+func AdminUserIDGTE(v int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGTE(FieldAdminUserID, v))
+}
+
+// AdminUserIDLT applies the LT predicate on the "admin_user_id" field.
+func AdminUserIDLT(v int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLT(FieldAdminUserID, v))
+}
+
+// AdminUserIDLTE applies the LTE predicate on the "admin_user_id" field.
+func AdminUserIDLTE(v int) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLTE(FieldAdminUserID, v))
+}
+
+// CodeHashEQ applies the EQ predicate on the "code_hash" field.
+func CodeHashEQ(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "code_hash" field.
+func CodeHashNEQ(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNEQ(FieldCodeHash, v))
+}
+
+// CodeHashIn applies the In predicate on the "code_hash" field.
+func CodeHashIn(vs ...string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldIn(FieldCodeHash, vs...))
+}
+
+// CodeHashNotIn applies the NotIn predicate on the "code_hash" field.
+func CodeHashNotIn(vs ...string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNotIn(FieldCodeHash, vs...))
+}
+
+// CodeHashGT applies the GT predicate on the "code_hash" field.
+func CodeHashGT(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGT(FieldCodeHash, v))
+}
+
+// CodeHashGTE applies the GTE predicate on the "code_hash" field.
+func CodeHashGTE(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGTE(FieldCodeHash, v))
+}
+
+// CodeHashLT applies the LT predicate on the "code_hash" field.
+func CodeHashLT(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLT(FieldCodeHash, v))
+}
+
+// CodeHashLTE applies the LTE predicate on the "code_hash" field.
+func CodeHashLTE(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLTE(FieldCodeHash, v))
+}
+
+// CodeHashContains applies the Contains predicate on the "code_hash" field.
+func CodeHashContains(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldContains(FieldCodeHash, v))
+}
+
+// CodeHashHasPrefix applies the HasPrefix predicate on the "code_hash" field.
+func CodeHashHasPrefix(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldHasPrefix(FieldCodeHash, v))
+}
+
+// CodeHashHasSuffix applies the HasSuffix predicate on the "code_hash" field.
+func CodeHashHasSuffix(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldHasSuffix(FieldCodeHash, v))
+}
+
+// CodeHashEqualFold applies the EqualFold predicate on the "code_hash" field.
+func CodeHashEqualFold(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEqualFold(FieldCodeHash, v))
+}
+
+// CodeHashContainsFold applies the ContainsFold predicate on the "code_hash" field.
+func CodeHashContainsFold(v string) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldContainsFold(FieldCodeHash, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNotNull(FieldUsedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdminRecoveryCode) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdminRecoveryCode) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdminRecoveryCode) predicate.AdminRecoveryCode {
+	return predicate.AdminRecoveryCode(sql.NotPredicates(p))
+}
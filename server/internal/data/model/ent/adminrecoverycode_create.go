@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminrecoverycode"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminRecoveryCodeCreate is the builder for creating a AdminRecoveryCode entity.
+type AdminRecoveryCodeCreate struct {
+	config
+	mutation *AdminRecoveryCodeMutation
+	hooks    []Hook
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_c *AdminRecoveryCodeCreate) SetAdminUserID(v int) *AdminRecoveryCodeCreate {
+	_c.mutation.SetAdminUserID(v)
+	return _c
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_c *AdminRecoveryCodeCreate) SetCodeHash(v string) *AdminRecoveryCodeCreate {
+	_c.mutation.SetCodeHash(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *AdminRecoveryCodeCreate) SetUsedAt(v time.Time) *AdminRecoveryCodeCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *AdminRecoveryCodeCreate) SetNillableUsedAt(v *time.Time) *AdminRecoveryCodeCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminRecoveryCodeCreate) SetCreatedAt(v time.Time) *AdminRecoveryCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminRecoveryCodeCreate) SetNillableCreatedAt(v *time.Time) *AdminRecoveryCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AdminRecoveryCodeMutation object of the builder.
+func (_c *AdminRecoveryCodeCreate) Mutation() *AdminRecoveryCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminRecoveryCode in the database.
+func (_c *AdminRecoveryCodeCreate) Save(ctx context.Context) (*AdminRecoveryCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminRecoveryCodeCreate) SaveX(ctx context.Context) *AdminRecoveryCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminRecoveryCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminRecoveryCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminRecoveryCodeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminrecoverycode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminRecoveryCodeCreate) check() error {
+	if _, ok := _c.mutation.AdminUserID(); !ok {
+		return &ValidationError{Name: "admin_user_id", err: errors.New(`ent: missing required field "AdminRecoveryCode.admin_user_id"`)}
+	}
+	if _, ok := _c.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "AdminRecoveryCode.code_hash"`)}
+	}
+	if v, ok := _c.mutation.CodeHash(); ok {
+		if err := adminrecoverycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "AdminRecoveryCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminRecoveryCode.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AdminRecoveryCodeCreate) sqlSave(ctx context.Context) (*AdminRecoveryCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminRecoveryCodeCreate) createSpec() (*AdminRecoveryCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminRecoveryCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminrecoverycode.Table, sqlgraph.NewFieldSpec(adminrecoverycode.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.AdminUserID(); ok {
+		_spec.SetField(adminrecoverycode.FieldAdminUserID, field.TypeInt, value)
+		_node.AdminUserID = value
+	}
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(adminrecoverycode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(adminrecoverycode.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminrecoverycode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AdminRecoveryCodeCreateBulk is the builder for creating many AdminRecoveryCode entities in bulk.
+type AdminRecoveryCodeCreateBulk struct {
+	config
+	err      error
+	builders []*AdminRecoveryCodeCreate
+}
+
+// Save creates the AdminRecoveryCode entities in the database.
+func (_c *AdminRecoveryCodeCreateBulk) Save(ctx context.Context) ([]*AdminRecoveryCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminRecoveryCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminRecoveryCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminRecoveryCodeCreateBulk) SaveX(ctx context.Context) []*AdminRecoveryCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminRecoveryCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminRecoveryCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"server/internal/data/model/ent/adminrecoverycode"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminRecoveryCodeDelete is the builder for deleting a AdminRecoveryCode entity.
+type AdminRecoveryCodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *AdminRecoveryCodeMutation
+}
+
+// Where appends a list predicates to the AdminRecoveryCodeDelete builder.
+func (_d *AdminRecoveryCodeDelete) Where(ps ...predicate.AdminRecoveryCode) *AdminRecoveryCodeDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AdminRecoveryCodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AdminRecoveryCodeDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AdminRecoveryCodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(adminrecoverycode.Table, sqlgraph.NewFieldSpec(adminrecoverycode.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AdminRecoveryCodeDeleteOne is the builder for deleting a single AdminRecoveryCode entity.
+type AdminRecoveryCodeDeleteOne struct {
+	_d *AdminRecoveryCodeDelete
+}
+
+// Where appends a list predicates to the AdminRecoveryCodeDelete builder.
+func (_d *AdminRecoveryCodeDeleteOne) Where(ps ...predicate.AdminRecoveryCode) *AdminRecoveryCodeDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AdminRecoveryCodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{adminrecoverycode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AdminRecoveryCodeDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
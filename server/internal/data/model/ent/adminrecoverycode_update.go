@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminrecoverycode"
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminRecoveryCodeUpdate is the builder for updating AdminRecoveryCode entities.
+type AdminRecoveryCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminRecoveryCodeMutation
+}
+
+// Where appends a list predicates to the AdminRecoveryCodeUpdate builder.
+func (_u *AdminRecoveryCodeUpdate) Where(ps ...predicate.AdminRecoveryCode) *AdminRecoveryCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_u *AdminRecoveryCodeUpdate) SetAdminUserID(v int) *AdminRecoveryCodeUpdate {
+	_u.mutation.ResetAdminUserID()
+	_u.mutation.SetAdminUserID(v)
+	return _u
+}
+
+// SetNillableAdminUserID sets the "admin_user_id" field if the given value is not nil.
+func (_u *AdminRecoveryCodeUpdate) SetNillableAdminUserID(v *int) *AdminRecoveryCodeUpdate {
+	if v != nil {
+		_u.SetAdminUserID(*v)
+	}
+	return _u
+}
+
+// AddAdminUserID adds value to the "admin_user_id" field.
+func (_u *AdminRecoveryCodeUpdate) AddAdminUserID(v int) *AdminRecoveryCodeUpdate {
+	_u.mutation.AddAdminUserID(v)
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *AdminRecoveryCodeUpdate) SetCodeHash(v string) *AdminRecoveryCodeUpdate {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *AdminRecoveryCodeUpdate) SetNillableCodeHash(v *string) *AdminRecoveryCodeUpdate {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *AdminRecoveryCodeUpdate) SetUsedAt(v time.Time) *AdminRecoveryCodeUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *AdminRecoveryCodeUpdate) SetNillableUsedAt(v *time.Time) *AdminRecoveryCodeUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *AdminRecoveryCodeUpdate) ClearUsedAt() *AdminRecoveryCodeUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// Mutation returns the AdminRecoveryCodeMutation object of the builder.
+func (_u *AdminRecoveryCodeUpdate) Mutation() *AdminRecoveryCodeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminRecoveryCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminRecoveryCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminRecoveryCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminRecoveryCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminRecoveryCodeUpdate) check() error {
+	if v, ok := _u.mutation.CodeHash(); ok {
+		if err := adminrecoverycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "AdminRecoveryCode.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminRecoveryCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminrecoverycode.Table, adminrecoverycode.Columns, sqlgraph.NewFieldSpec(adminrecoverycode.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AdminUserID(); ok {
+		_spec.SetField(adminrecoverycode.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminUserID(); ok {
+		_spec.AddField(adminrecoverycode.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(adminrecoverycode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(adminrecoverycode.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(adminrecoverycode.FieldUsedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminrecoverycode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminRecoveryCodeUpdateOne is the builder for updating a single AdminRecoveryCode entity.
+type AdminRecoveryCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminRecoveryCodeMutation
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_u *AdminRecoveryCodeUpdateOne) SetAdminUserID(v int) *AdminRecoveryCodeUpdateOne {
+	_u.mutation.ResetAdminUserID()
+	_u.mutation.SetAdminUserID(v)
+	return _u
+}
+
+// SetNillableAdminUserID sets the "admin_user_id" field if the given value is not nil.
+func (_u *AdminRecoveryCodeUpdateOne) SetNillableAdminUserID(v *int) *AdminRecoveryCodeUpdateOne {
+	if v != nil {
+		_u.SetAdminUserID(*v)
+	}
+	return _u
+}
+
+// AddAdminUserID adds value to the "admin_user_id" field.
+func (_u *AdminRecoveryCodeUpdateOne) AddAdminUserID(v int) *AdminRecoveryCodeUpdateOne {
+	_u.mutation.AddAdminUserID(v)
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *AdminRecoveryCodeUpdateOne) SetCodeHash(v string) *AdminRecoveryCodeUpdateOne {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *AdminRecoveryCodeUpdateOne) SetNillableCodeHash(v *string) *AdminRecoveryCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *AdminRecoveryCodeUpdateOne) SetUsedAt(v time.Time) *AdminRecoveryCodeUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *AdminRecoveryCodeUpdateOne) SetNillableUsedAt(v *time.Time) *AdminRecoveryCodeUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *AdminRecoveryCodeUpdateOne) ClearUsedAt() *AdminRecoveryCodeUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// Mutation returns the AdminRecoveryCodeMutation object of the builder.
+func (_u *AdminRecoveryCodeUpdateOne) Mutation() *AdminRecoveryCodeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AdminRecoveryCodeUpdate builder.
+func (_u *AdminRecoveryCodeUpdateOne) Where(ps ...predicate.AdminRecoveryCode) *AdminRecoveryCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminRecoveryCodeUpdateOne) Select(field string, fields ...string) *AdminRecoveryCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminRecoveryCode entity.
+func (_u *AdminRecoveryCodeUpdateOne) Save(ctx context.Context) (*AdminRecoveryCode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminRecoveryCodeUpdateOne) SaveX(ctx context.Context) *AdminRecoveryCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminRecoveryCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminRecoveryCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminRecoveryCodeUpdateOne) check() error {
+	if v, ok := _u.mutation.CodeHash(); ok {
+		if err := adminrecoverycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "AdminRecoveryCode.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminRecoveryCodeUpdateOne) sqlSave(ctx context.Context) (_node *AdminRecoveryCode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminrecoverycode.Table, adminrecoverycode.Columns, sqlgraph.NewFieldSpec(adminrecoverycode.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdminRecoveryCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminrecoverycode.FieldID)
+		for _, f := range fields {
+			if !adminrecoverycode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adminrecoverycode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AdminUserID(); ok {
+		_spec.SetField(adminrecoverycode.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminUserID(); ok {
+		_spec.AddField(adminrecoverycode.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(adminrecoverycode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(adminrecoverycode.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(adminrecoverycode.FieldUsedAt, field.TypeTime)
+	}
+	_node = &AdminRecoveryCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminrecoverycode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminrole
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLTE(FieldID, id))
+}
+
+// RoleKey applies equality check predicate on the "role_key" field. It's identical to RoleKeyEQ.
+func RoleKey(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldRoleKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldDescription, v))
+}
+
+// Builtin applies equality check predicate on the "builtin" field. It's identical to BuiltinEQ.
+func Builtin(v bool) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldBuiltin, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RoleKeyEQ applies the EQ predicate on the "role_key" field.
+func RoleKeyEQ(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldRoleKey, v))
+}
+
+// RoleKeyNEQ applies the NEQ predicate on the "role_key" field.
+func RoleKeyNEQ(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNEQ(FieldRoleKey, v))
+}
+
+// RoleKeyIn applies the In predicate on the "role_key" field.
+func RoleKeyIn(vs ...string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldIn(FieldRoleKey, vs...))
+}
+
+// RoleKeyNotIn applies the NotIn predicate on the "role_key" field.
+func RoleKeyNotIn(vs ...string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNotIn(FieldRoleKey, vs...))
+}
+
+// RoleKeyGT applies the GT predicate on the "role_key" field.
+func RoleKeyGT(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGT(FieldRoleKey, v))
+}
+
+// RoleKeyGTE applies the GTE predicate on the "role_key" field.
+func RoleKeyGTE(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGTE(FieldRoleKey, v))
+}
+
+// RoleKeyLT applies the LT predicate on the "role_key" field.
+func RoleKeyLT(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLT(FieldRoleKey, v))
+}
+
+// RoleKeyLTE applies the LTE predicate on the "role_key" field.
+func RoleKeyLTE(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLTE(FieldRoleKey, v))
+}
+
+// RoleKeyContains applies the Contains predicate on the "role_key" field.
+func RoleKeyContains(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldContains(FieldRoleKey, v))
+}
+
+// RoleKeyHasPrefix applies the HasPrefix predicate on the "role_key" field.
+func RoleKeyHasPrefix(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldHasPrefix(FieldRoleKey, v))
+}
+
+// RoleKeyHasSuffix applies the HasSuffix predicate on the "role_key" field.
+func RoleKeyHasSuffix(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldHasSuffix(FieldRoleKey, v))
+}
+
+// RoleKeyEqualFold applies the EqualFold predicate on the "role_key" field.
+func RoleKeyEqualFold(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEqualFold(FieldRoleKey, v))
+}
+
+// RoleKeyContainsFold applies the ContainsFold predicate on the "role_key" field.
+func RoleKeyContainsFold(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldContainsFold(FieldRoleKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// BuiltinEQ applies the EQ predicate on the "builtin" field.
+func BuiltinEQ(v bool) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldBuiltin, v))
+}
+
+// BuiltinNEQ applies the NEQ predicate on the "builtin" field.
+func BuiltinNEQ(v bool) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNEQ(FieldBuiltin, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AdminRole {
+	return predicate.AdminRole(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdminRole) predicate.AdminRole {
+	return predicate.AdminRole(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdminRole) predicate.AdminRole {
+	return predicate.AdminRole(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdminRole) predicate.AdminRole {
+	return predicate.AdminRole(sql.NotPredicates(p))
+}
@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminrole"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminRoleCreate is the builder for creating a AdminRole entity.
+type AdminRoleCreate struct {
+	config
+	mutation *AdminRoleMutation
+	hooks    []Hook
+}
+
+// SetRoleKey sets the "role_key" field.
+func (_c *AdminRoleCreate) SetRoleKey(v string) *AdminRoleCreate {
+	_c.mutation.SetRoleKey(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AdminRoleCreate) SetName(v string) *AdminRoleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *AdminRoleCreate) SetDescription(v string) *AdminRoleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *AdminRoleCreate) SetNillableDescription(v *string) *AdminRoleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetBuiltin sets the "builtin" field.
+func (_c *AdminRoleCreate) SetBuiltin(v bool) *AdminRoleCreate {
+	_c.mutation.SetBuiltin(v)
+	return _c
+}
+
+// SetNillableBuiltin sets the "builtin" field if the given value is not nil.
+func (_c *AdminRoleCreate) SetNillableBuiltin(v *bool) *AdminRoleCreate {
+	if v != nil {
+		_c.SetBuiltin(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminRoleCreate) SetCreatedAt(v time.Time) *AdminRoleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminRoleCreate) SetNillableCreatedAt(v *time.Time) *AdminRoleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AdminRoleCreate) SetUpdatedAt(v time.Time) *AdminRoleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AdminRoleCreate) SetNillableUpdatedAt(v *time.Time) *AdminRoleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AdminRoleMutation object of the builder.
+func (_c *AdminRoleCreate) Mutation() *AdminRoleMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminRole in the database.
+func (_c *AdminRoleCreate) Save(ctx context.Context) (*AdminRole, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminRoleCreate) SaveX(ctx context.Context) *AdminRole {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminRoleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminRoleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminRoleCreate) defaults() {
+	if _, ok := _c.mutation.Description(); !ok {
+		v := adminrole.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.Builtin(); !ok {
+		v := adminrole.DefaultBuiltin
+		_c.mutation.SetBuiltin(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminrole.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := adminrole.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminRoleCreate) check() error {
+	if _, ok := _c.mutation.RoleKey(); !ok {
+		return &ValidationError{Name: "role_key", err: errors.New(`ent: missing required field "AdminRole.role_key"`)}
+	}
+	if v, ok := _c.mutation.RoleKey(); ok {
+		if err := adminrole.RoleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "role_key", err: fmt.Errorf(`ent: validator failed for field "AdminRole.role_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AdminRole.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := adminrole.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AdminRole.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "AdminRole.description"`)}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := adminrole.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "AdminRole.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Builtin(); !ok {
+		return &ValidationError{Name: "builtin", err: errors.New(`ent: missing required field "AdminRole.builtin"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminRole.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AdminRole.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *AdminRoleCreate) sqlSave(ctx context.Context) (*AdminRole, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminRoleCreate) createSpec() (*AdminRole, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminRole{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminrole.Table, sqlgraph.NewFieldSpec(adminrole.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.RoleKey(); ok {
+		_spec.SetField(adminrole.FieldRoleKey, field.TypeString, value)
+		_node.RoleKey = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(adminrole.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(adminrole.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Builtin(); ok {
+		_spec.SetField(adminrole.FieldBuiltin, field.TypeBool, value)
+		_node.Builtin = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminrole.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminrole.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// AdminRoleCreateBulk is the builder for creating many AdminRole entities in bulk.
+type AdminRoleCreateBulk struct {
+	config
+	err      error
+	builders []*AdminRoleCreate
+}
+
+// Save creates the AdminRole entities in the database.
+func (_c *AdminRoleCreateBulk) Save(ctx context.Context) ([]*AdminRole, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminRole, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminRoleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminRoleCreateBulk) SaveX(ctx context.Context) []*AdminRole {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminRoleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminRoleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/adminrolepermission"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AdminRolePermission is the model entity for the AdminRolePermission schema.
+type AdminRolePermission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID int `json:"role_id,omitempty"`
+	// ModuleKey holds the value of the "module_key" field.
+	ModuleKey string `json:"module_key,omitempty"`
+	// view/create/edit/delete/submit/approve/print/export/view_amounts
+	Action string `json:"action,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdminRolePermission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adminrolepermission.FieldID, adminrolepermission.FieldRoleID:
+			values[i] = new(sql.NullInt64)
+		case adminrolepermission.FieldModuleKey, adminrolepermission.FieldAction:
+			values[i] = new(sql.NullString)
+		case adminrolepermission.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdminRolePermission fields.
+func (_m *AdminRolePermission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adminrolepermission.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case adminrolepermission.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				_m.RoleID = int(value.Int64)
+			}
+		case adminrolepermission.FieldModuleKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field module_key", values[i])
+			} else if value.Valid {
+				_m.ModuleKey = value.String
+			}
+		case adminrolepermission.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case adminrolepermission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdminRolePermission.
+// This includes values selected through modifiers, order, etc.
+func (_m *AdminRolePermission) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AdminRolePermission.
+// Note that you need to call AdminRolePermission.Unwrap() before calling this method if this AdminRolePermission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AdminRolePermission) Update() *AdminRolePermissionUpdateOne {
+	return NewAdminRolePermissionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AdminRolePermission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AdminRolePermission) Unwrap() *AdminRolePermission {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdminRolePermission is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AdminRolePermission) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdminRolePermission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("module_key=")
+	builder.WriteString(_m.ModuleKey)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdminRolePermissions is a parsable slice of AdminRolePermission.
+type AdminRolePermissions []*AdminRolePermission
@@ -0,0 +1,82 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminrolepermission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the adminrolepermission type in the database.
+	Label = "admin_role_permission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRoleID holds the string denoting the role_id field in the database.
+	FieldRoleID = "role_id"
+	// FieldModuleKey holds the string denoting the module_key field in the database.
+	FieldModuleKey = "module_key"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the adminrolepermission in the database.
+	Table = "admin_role_permissions"
+)
+
+// Columns holds all SQL columns for adminrolepermission fields.
+var Columns = []string{
+	FieldID,
+	FieldRoleID,
+	FieldModuleKey,
+	FieldAction,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ModuleKeyValidator is a validator for the "module_key" field. It is called by the builders before save.
+	ModuleKeyValidator func(string) error
+	// ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	ActionValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AdminRolePermission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRoleID orders the results by the role_id field.
+func ByRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleID, opts...).ToFunc()
+}
+
+// ByModuleKey orders the results by the module_key field.
+func ByModuleKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModuleKey, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminrolepermission
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLTE(FieldID, id))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldRoleID, v))
+}
+
+// ModuleKey applies equality check predicate on the "module_key" field. It's identical to ModuleKeyEQ.
+func ModuleKey(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldModuleKey, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldAction, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// RoleIDGT applies the GT predicate on the "role_id" field.
+func RoleIDGT(v int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGT(FieldRoleID, v))
+}
+
+// RoleIDGTE applies the GTE predicate on the "role_id" field.
+func RoleIDGTE(v int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGTE(FieldRoleID, v))
+}
+
+// RoleIDLT applies the LT predicate on the "role_id" field.
+func RoleIDLT(v int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLT(FieldRoleID, v))
+}
+
+// RoleIDLTE applies the LTE predicate on the "role_id" field.
+func RoleIDLTE(v int) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLTE(FieldRoleID, v))
+}
+
+// ModuleKeyEQ applies the EQ predicate on the "module_key" field.
+func ModuleKeyEQ(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldModuleKey, v))
+}
+
+// ModuleKeyNEQ applies the NEQ predicate on the "module_key" field.
+func ModuleKeyNEQ(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNEQ(FieldModuleKey, v))
+}
+
+// ModuleKeyIn applies the In predicate on the "module_key" field.
+func ModuleKeyIn(vs ...string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldIn(FieldModuleKey, vs...))
+}
+
+// ModuleKeyNotIn applies the NotIn predicate on the "module_key" field.
+func ModuleKeyNotIn(vs ...string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNotIn(FieldModuleKey, vs...))
+}
+
+// ModuleKeyGT applies the GT predicate on the "module_key" field.
+func ModuleKeyGT(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGT(FieldModuleKey, v))
+}
+
+// ModuleKeyGTE applies the GTE predicate on the "module_key" field.
+func ModuleKeyGTE(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGTE(FieldModuleKey, v))
+}
+
+// ModuleKeyLT applies the LT predicate on the "module_key" field.
+func ModuleKeyLT(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLT(FieldModuleKey, v))
+}
+
+// ModuleKeyLTE applies the LTE predicate on the "module_key" field.
+func ModuleKeyLTE(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLTE(FieldModuleKey, v))
+}
+
+// ModuleKeyContains applies the Contains predicate on the "module_key" field.
+func ModuleKeyContains(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldContains(FieldModuleKey, v))
+}
+
+// ModuleKeyHasPrefix applies the HasPrefix predicate on the "module_key" field.
+func ModuleKeyHasPrefix(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldHasPrefix(FieldModuleKey, v))
+}
+
+// ModuleKeyHasSuffix applies the HasSuffix predicate on the "module_key" field.
+func ModuleKeyHasSuffix(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldHasSuffix(FieldModuleKey, v))
+}
+
+// ModuleKeyEqualFold applies the EqualFold predicate on the "module_key" field.
+func ModuleKeyEqualFold(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEqualFold(FieldModuleKey, v))
+}
+
+// ModuleKeyContainsFold applies the ContainsFold predicate on the "module_key" field.
+func ModuleKeyContainsFold(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldContainsFold(FieldModuleKey, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldContainsFold(FieldAction, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdminRolePermission) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdminRolePermission) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdminRolePermission) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.NotPredicates(p))
+}
@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminrolepermission"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminRolePermissionCreate is the builder for creating a AdminRolePermission entity.
+type AdminRolePermissionCreate struct {
+	config
+	mutation *AdminRolePermissionMutation
+	hooks    []Hook
+}
+
+// SetRoleID sets the "role_id" field.
+func (_c *AdminRolePermissionCreate) SetRoleID(v int) *AdminRolePermissionCreate {
+	_c.mutation.SetRoleID(v)
+	return _c
+}
+
+// SetModuleKey sets the "module_key" field.
+func (_c *AdminRolePermissionCreate) SetModuleKey(v string) *AdminRolePermissionCreate {
+	_c.mutation.SetModuleKey(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *AdminRolePermissionCreate) SetAction(v string) *AdminRolePermissionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminRolePermissionCreate) SetCreatedAt(v time.Time) *AdminRolePermissionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminRolePermissionCreate) SetNillableCreatedAt(v *time.Time) *AdminRolePermissionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AdminRolePermissionMutation object of the builder.
+func (_c *AdminRolePermissionCreate) Mutation() *AdminRolePermissionMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminRolePermission in the database.
+func (_c *AdminRolePermissionCreate) Save(ctx context.Context) (*AdminRolePermission, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminRolePermissionCreate) SaveX(ctx context.Context) *AdminRolePermission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminRolePermissionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminRolePermissionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminRolePermissionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminrolepermission.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminRolePermissionCreate) check() error {
+	if _, ok := _c.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "AdminRolePermission.role_id"`)}
+	}
+	if _, ok := _c.mutation.ModuleKey(); !ok {
+		return &ValidationError{Name: "module_key", err: errors.New(`ent: missing required field "AdminRolePermission.module_key"`)}
+	}
+	if v, ok := _c.mutation.ModuleKey(); ok {
+		if err := adminrolepermission.ModuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "module_key", err: fmt.Errorf(`ent: validator failed for field "AdminRolePermission.module_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AdminRolePermission.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := adminrolepermission.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AdminRolePermission.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminRolePermission.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AdminRolePermissionCreate) sqlSave(ctx context.Context) (*AdminRolePermission, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminRolePermissionCreate) createSpec() (*AdminRolePermission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminRolePermission{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminrolepermission.Table, sqlgraph.NewFieldSpec(adminrolepermission.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.RoleID(); ok {
+		_spec.SetField(adminrolepermission.FieldRoleID, field.TypeInt, value)
+		_node.RoleID = value
+	}
+	if value, ok := _c.mutation.ModuleKey(); ok {
+		_spec.SetField(adminrolepermission.FieldModuleKey, field.TypeString, value)
+		_node.ModuleKey = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(adminrolepermission.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminrolepermission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AdminRolePermissionCreateBulk is the builder for creating many AdminRolePermission entities in bulk.
+type AdminRolePermissionCreateBulk struct {
+	config
+	err      error
+	builders []*AdminRolePermissionCreate
+}
+
+// Save creates the AdminRolePermission entities in the database.
+func (_c *AdminRolePermissionCreateBulk) Save(ctx context.Context) ([]*AdminRolePermission, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminRolePermission, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminRolePermissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminRolePermissionCreateBulk) SaveX(ctx context.Context) []*AdminRolePermission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminRolePermissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminRolePermissionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
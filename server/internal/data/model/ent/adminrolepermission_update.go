@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminrolepermission"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminRolePermissionUpdate is the builder for updating AdminRolePermission entities.
+type AdminRolePermissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminRolePermissionMutation
+}
+
+// Where appends a list predicates to the AdminRolePermissionUpdate builder.
+func (_u *AdminRolePermissionUpdate) Where(ps ...predicate.AdminRolePermission) *AdminRolePermissionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *AdminRolePermissionUpdate) SetRoleID(v int) *AdminRolePermissionUpdate {
+	_u.mutation.ResetRoleID()
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *AdminRolePermissionUpdate) SetNillableRoleID(v *int) *AdminRolePermissionUpdate {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// AddRoleID adds value to the "role_id" field.
+func (_u *AdminRolePermissionUpdate) AddRoleID(v int) *AdminRolePermissionUpdate {
+	_u.mutation.AddRoleID(v)
+	return _u
+}
+
+// SetModuleKey sets the "module_key" field.
+func (_u *AdminRolePermissionUpdate) SetModuleKey(v string) *AdminRolePermissionUpdate {
+	_u.mutation.SetModuleKey(v)
+	return _u
+}
+
+// SetNillableModuleKey sets the "module_key" field if the given value is not nil.
+func (_u *AdminRolePermissionUpdate) SetNillableModuleKey(v *string) *AdminRolePermissionUpdate {
+	if v != nil {
+		_u.SetModuleKey(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AdminRolePermissionUpdate) SetAction(v string) *AdminRolePermissionUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AdminRolePermissionUpdate) SetNillableAction(v *string) *AdminRolePermissionUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AdminRolePermissionMutation object of the builder.
+func (_u *AdminRolePermissionUpdate) Mutation() *AdminRolePermissionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminRolePermissionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminRolePermissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminRolePermissionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminRolePermissionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminRolePermissionUpdate) check() error {
+	if v, ok := _u.mutation.ModuleKey(); ok {
+		if err := adminrolepermission.ModuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "module_key", err: fmt.Errorf(`ent: validator failed for field "AdminRolePermission.module_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := adminrolepermission.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AdminRolePermission.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminRolePermissionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminrolepermission.Table, adminrolepermission.Columns, sqlgraph.NewFieldSpec(adminrolepermission.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RoleID(); ok {
+		_spec.SetField(adminrolepermission.FieldRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRoleID(); ok {
+		_spec.AddField(adminrolepermission.FieldRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ModuleKey(); ok {
+		_spec.SetField(adminrolepermission.FieldModuleKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(adminrolepermission.FieldAction, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminrolepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminRolePermissionUpdateOne is the builder for updating a single AdminRolePermission entity.
+type AdminRolePermissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminRolePermissionMutation
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *AdminRolePermissionUpdateOne) SetRoleID(v int) *AdminRolePermissionUpdateOne {
+	_u.mutation.ResetRoleID()
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *AdminRolePermissionUpdateOne) SetNillableRoleID(v *int) *AdminRolePermissionUpdateOne {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// AddRoleID adds value to the "role_id" field.
+func (_u *AdminRolePermissionUpdateOne) AddRoleID(v int) *AdminRolePermissionUpdateOne {
+	_u.mutation.AddRoleID(v)
+	return _u
+}
+
+// SetModuleKey sets the "module_key" field.
+func (_u *AdminRolePermissionUpdateOne) SetModuleKey(v string) *AdminRolePermissionUpdateOne {
+	_u.mutation.SetModuleKey(v)
+	return _u
+}
+
+// SetNillableModuleKey sets the "module_key" field if the given value is not nil.
+func (_u *AdminRolePermissionUpdateOne) SetNillableModuleKey(v *string) *AdminRolePermissionUpdateOne {
+	if v != nil {
+		_u.SetModuleKey(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AdminRolePermissionUpdateOne) SetAction(v string) *AdminRolePermissionUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AdminRolePermissionUpdateOne) SetNillableAction(v *string) *AdminRolePermissionUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AdminRolePermissionMutation object of the builder.
+func (_u *AdminRolePermissionUpdateOne) Mutation() *AdminRolePermissionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AdminRolePermissionUpdate builder.
+func (_u *AdminRolePermissionUpdateOne) Where(ps ...predicate.AdminRolePermission) *AdminRolePermissionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminRolePermissionUpdateOne) Select(field string, fields ...string) *AdminRolePermissionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminRolePermission entity.
+func (_u *AdminRolePermissionUpdateOne) Save(ctx context.Context) (*AdminRolePermission, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminRolePermissionUpdateOne) SaveX(ctx context.Context) *AdminRolePermission {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminRolePermissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminRolePermissionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminRolePermissionUpdateOne) check() error {
+	if v, ok := _u.mutation.ModuleKey(); ok {
+		if err := adminrolepermission.ModuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "module_key", err: fmt.Errorf(`ent: validator failed for field "AdminRolePermission.module_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := adminrolepermission.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AdminRolePermission.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminRolePermissionUpdateOne) sqlSave(ctx context.Context) (_node *AdminRolePermission, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminrolepermission.Table, adminrolepermission.Columns, sqlgraph.NewFieldSpec(adminrolepermission.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdminRolePermission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminrolepermission.FieldID)
+		for _, f := range fields {
+			if !adminrolepermission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adminrolepermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RoleID(); ok {
+		_spec.SetField(adminrolepermission.FieldRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRoleID(); ok {
+		_spec.AddField(adminrolepermission.FieldRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ModuleKey(); ok {
+		_spec.SetField(adminrolepermission.FieldModuleKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(adminrolepermission.FieldAction, field.TypeString, value)
+	}
+	_node = &AdminRolePermission{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminrolepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
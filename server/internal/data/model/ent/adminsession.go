@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/adminsession"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AdminSession is the model entity for the AdminSession schema.
+type AdminSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 写入 token 的 sid
+	SessionKey string `json:"session_key,omitempty"`
+	// AdminUserID holds the value of the "admin_user_id" field.
+	AdminUserID int `json:"admin_user_id,omitempty"`
+	// 当前有效 access token 的 jti，刷新后旧 token 失效
+	AccessTokenID string `json:"access_token_id,omitempty"`
+	// refresh token 的 sha256
+	RefreshTokenHash string `json:"-"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// 会话（refresh token）到期时间，刷新不延长
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// RevokeReason holds the value of the "revoke_reason" field.
+	RevokeReason string `json:"revoke_reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdminSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adminsession.FieldID, adminsession.FieldAdminUserID:
+			values[i] = new(sql.NullInt64)
+		case adminsession.FieldSessionKey, adminsession.FieldAccessTokenID, adminsession.FieldRefreshTokenHash, adminsession.FieldIP, adminsession.FieldUserAgent, adminsession.FieldRevokeReason:
+			values[i] = new(sql.NullString)
+		case adminsession.FieldCreatedAt, adminsession.FieldLastSeenAt, adminsession.FieldExpiresAt, adminsession.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdminSession fields.
+func (_m *AdminSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adminsession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case adminsession.FieldSessionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_key", values[i])
+			} else if value.Valid {
+				_m.SessionKey = value.String
+			}
+		case adminsession.FieldAdminUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_user_id", values[i])
+			} else if value.Valid {
+				_m.AdminUserID = int(value.Int64)
+			}
+		case adminsession.FieldAccessTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_token_id", values[i])
+			} else if value.Valid {
+				_m.AccessTokenID = value.String
+			}
+		case adminsession.FieldRefreshTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_token_hash", values[i])
+			} else if value.Valid {
+				_m.RefreshTokenHash = value.String
+			}
+		case adminsession.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case adminsession.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case adminsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case adminsession.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		case adminsession.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case adminsession.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
+		case adminsession.FieldRevokeReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field revoke_reason", values[i])
+			} else if value.Valid {
+				_m.RevokeReason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdminSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *AdminSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AdminSession.
+// Note that you need to call AdminSession.Unwrap() before calling this method if this AdminSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AdminSession) Update() *AdminSessionUpdateOne {
+	return NewAdminSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AdminSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AdminSession) Unwrap() *AdminSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdminSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AdminSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdminSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("session_key=")
+	builder.WriteString(_m.SessionKey)
+	builder.WriteString(", ")
+	builder.WriteString("admin_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdminUserID))
+	builder.WriteString(", ")
+	builder.WriteString("access_token_id=")
+	builder.WriteString(_m.AccessTokenID)
+	builder.WriteString(", ")
+	builder.WriteString("refresh_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("revoke_reason=")
+	builder.WriteString(_m.RevokeReason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdminSessions is a parsable slice of AdminSession.
+type AdminSessions []*AdminSession
@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the adminsession type in the database.
+	Label = "admin_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSessionKey holds the string denoting the session_key field in the database.
+	FieldSessionKey = "session_key"
+	// FieldAdminUserID holds the string denoting the admin_user_id field in the database.
+	FieldAdminUserID = "admin_user_id"
+	// FieldAccessTokenID holds the string denoting the access_token_id field in the database.
+	FieldAccessTokenID = "access_token_id"
+	// FieldRefreshTokenHash holds the string denoting the refresh_token_hash field in the database.
+	FieldRefreshTokenHash = "refresh_token_hash"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
+	// FieldRevokeReason holds the string denoting the revoke_reason field in the database.
+	FieldRevokeReason = "revoke_reason"
+	// Table holds the table name of the adminsession in the database.
+	Table = "admin_sessions"
+)
+
+// Columns holds all SQL columns for adminsession fields.
+var Columns = []string{
+	FieldID,
+	FieldSessionKey,
+	FieldAdminUserID,
+	FieldAccessTokenID,
+	FieldRefreshTokenHash,
+	FieldIP,
+	FieldUserAgent,
+	FieldCreatedAt,
+	FieldLastSeenAt,
+	FieldExpiresAt,
+	FieldRevokedAt,
+	FieldRevokeReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SessionKeyValidator is a validator for the "session_key" field. It is called by the builders before save.
+	SessionKeyValidator func(string) error
+	// AccessTokenIDValidator is a validator for the "access_token_id" field. It is called by the builders before save.
+	AccessTokenIDValidator func(string) error
+	// RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	RefreshTokenHashValidator func(string) error
+	// DefaultIP holds the default value on creation for the "ip" field.
+	DefaultIP string
+	// IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	IPValidator func(string) error
+	// DefaultUserAgent holds the default value on creation for the "user_agent" field.
+	DefaultUserAgent string
+	// UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	UserAgentValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultLastSeenAt holds the default value on creation for the "last_seen_at" field.
+	DefaultLastSeenAt func() time.Time
+	// DefaultRevokeReason holds the default value on creation for the "revoke_reason" field.
+	DefaultRevokeReason string
+	// RevokeReasonValidator is a validator for the "revoke_reason" field. It is called by the builders before save.
+	RevokeReasonValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the AdminSession queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySessionKey orders the results by the session_key field.
+func BySessionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionKey, opts...).ToFunc()
+}
+
+// ByAdminUserID orders the results by the admin_user_id field.
+func ByAdminUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdminUserID, opts...).ToFunc()
+}
+
+// ByAccessTokenID orders the results by the access_token_id field.
+func ByAccessTokenID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessTokenID, opts...).ToFunc()
+}
+
+// ByRefreshTokenHash orders the results by the refresh_token_hash field.
+func ByRefreshTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefreshTokenHash, opts...).ToFunc()
+}
+
+// ByIP orders the results by the ip field.
+func ByIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIP, opts...).ToFunc()
+}
+
+// ByUserAgent orders the results by the user_agent field.
+func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgent, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByRevokedAt orders the results by the revoked_at field.
+func ByRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
+
+// ByRevokeReason orders the results by the revoke_reason field.
+func ByRevokeReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokeReason, opts...).ToFunc()
+}
@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminsession
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldID, id))
+}
+
+// SessionKey applies equality check predicate on the "session_key" field. It's identical to SessionKeyEQ.
+func SessionKey(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldSessionKey, v))
+}
+
+// AdminUserID applies equality check predicate on the "admin_user_id" field. It's identical to AdminUserIDEQ.
+func AdminUserID(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldAdminUserID, v))
+}
+
+// AccessTokenID applies equality check predicate on the "access_token_id" field. It's identical to AccessTokenIDEQ.
+func AccessTokenID(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldAccessTokenID, v))
+}
+
+// RefreshTokenHash applies equality check predicate on the "refresh_token_hash" field. It's identical to RefreshTokenHashEQ.
+func RefreshTokenHash(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRefreshTokenHash, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldIP, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokeReason applies equality check predicate on the "revoke_reason" field. It's identical to RevokeReasonEQ.
+func RevokeReason(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRevokeReason, v))
+}
+
+// SessionKeyEQ applies the EQ predicate on the "session_key" field.
+func SessionKeyEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldSessionKey, v))
+}
+
+// SessionKeyNEQ applies the NEQ predicate on the "session_key" field.
+func SessionKeyNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldSessionKey, v))
+}
+
+// SessionKeyIn applies the In predicate on the "session_key" field.
+func SessionKeyIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldSessionKey, vs...))
+}
+
+// SessionKeyNotIn applies the NotIn predicate on the "session_key" field.
+func SessionKeyNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldSessionKey, vs...))
+}
+
+// SessionKeyGT applies the GT predicate on the "session_key" field.
+func SessionKeyGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldSessionKey, v))
+}
+
+// SessionKeyGTE applies the GTE predicate on the "session_key" field.
+func SessionKeyGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldSessionKey, v))
+}
+
+// SessionKeyLT applies the LT predicate on the "session_key" field.
+func SessionKeyLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldSessionKey, v))
+}
+
+// SessionKeyLTE applies the LTE predicate on the "session_key" field.
+func SessionKeyLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldSessionKey, v))
+}
+
+// SessionKeyContains applies the Contains predicate on the "session_key" field.
+func SessionKeyContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldSessionKey, v))
+}
+
+// SessionKeyHasPrefix applies the HasPrefix predicate on the "session_key" field.
+func SessionKeyHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldSessionKey, v))
+}
+
+// SessionKeyHasSuffix applies the HasSuffix predicate on the "session_key" field.
+func SessionKeyHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldSessionKey, v))
+}
+
+// SessionKeyEqualFold applies the EqualFold predicate on the "session_key" field.
+func SessionKeyEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldSessionKey, v))
+}
+
+// SessionKeyContainsFold applies the ContainsFold predicate on the "session_key" field.
+func SessionKeyContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldSessionKey, v))
+}
+
+// AdminUserIDEQ applies the EQ predicate on the "admin_user_id" field.
+func AdminUserIDEQ(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldAdminUserID, v))
+}
+
+// AdminUserIDNEQ applies the NEQ predicate on the "admin_user_id" field.
+func AdminUserIDNEQ(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldAdminUserID, v))
+}
+
+// AdminUserIDIn applies the In predicate on the "admin_user_id" field.
+func AdminUserIDIn(vs ...int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldAdminUserID, vs...))
+}
+
+// AdminUserIDNotIn applies the NotIn predicate on the "admin_user_id" field.
+func AdminUserIDNotIn(vs ...int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldAdminUserID, vs...))
+}
+
+// AdminUserIDGT applies the GT predicate on the "admin_user_id" field.
+func AdminUserIDGT(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldAdminUserID, v))
+}
+
+// AdminUserIDGTE applies the GTE predicate on the "admin_user_id" field.
+func AdminUserIDGTE(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldAdminUserID, v))
+}
+
+// AdminUserIDLT applies the LT predicate on the "admin_user_id" field.
+func AdminUserIDLT(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldAdminUserID, v))
+}
+
+// AdminUserIDLTE applies the LTE predicate on the "admin_user_id" field.
+func AdminUserIDLTE(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldAdminUserID, v))
+}
+
+// AccessTokenIDEQ applies the EQ predicate on the "access_token_id" field.
+func AccessTokenIDEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDNEQ applies the NEQ predicate on the "access_token_id" field.
+func AccessTokenIDNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDIn applies the In predicate on the "access_token_id" field.
+func AccessTokenIDIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldAccessTokenID, vs...))
+}
+
+// AccessTokenIDNotIn applies the NotIn predicate on the "access_token_id" field.
+func AccessTokenIDNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldAccessTokenID, vs...))
+}
+
+// AccessTokenIDGT applies the GT predicate on the "access_token_id" field.
+func AccessTokenIDGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDGTE applies the GTE predicate on the "access_token_id" field.
+func AccessTokenIDGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDLT applies the LT predicate on the "access_token_id" field.
+func AccessTokenIDLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDLTE applies the LTE predicate on the "access_token_id" field.
+func AccessTokenIDLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDContains applies the Contains predicate on the "access_token_id" field.
+func AccessTokenIDContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDHasPrefix applies the HasPrefix predicate on the "access_token_id" field.
+func AccessTokenIDHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDHasSuffix applies the HasSuffix predicate on the "access_token_id" field.
+func AccessTokenIDHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDEqualFold applies the EqualFold predicate on the "access_token_id" field.
+func AccessTokenIDEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldAccessTokenID, v))
+}
+
+// AccessTokenIDContainsFold applies the ContainsFold predicate on the "access_token_id" field.
+func AccessTokenIDContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldAccessTokenID, v))
+}
+
+// RefreshTokenHashEQ applies the EQ predicate on the "refresh_token_hash" field.
+func RefreshTokenHashEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashNEQ applies the NEQ predicate on the "refresh_token_hash" field.
+func RefreshTokenHashNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashIn applies the In predicate on the "refresh_token_hash" field.
+func RefreshTokenHashIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldRefreshTokenHash, vs...))
+}
+
+// RefreshTokenHashNotIn applies the NotIn predicate on the "refresh_token_hash" field.
+func RefreshTokenHashNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldRefreshTokenHash, vs...))
+}
+
+// RefreshTokenHashGT applies the GT predicate on the "refresh_token_hash" field.
+func RefreshTokenHashGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashGTE applies the GTE predicate on the "refresh_token_hash" field.
+func RefreshTokenHashGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashLT applies the LT predicate on the "refresh_token_hash" field.
+func RefreshTokenHashLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashLTE applies the LTE predicate on the "refresh_token_hash" field.
+func RefreshTokenHashLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashContains applies the Contains predicate on the "refresh_token_hash" field.
+func RefreshTokenHashContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashHasPrefix applies the HasPrefix predicate on the "refresh_token_hash" field.
+func RefreshTokenHashHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashHasSuffix applies the HasSuffix predicate on the "refresh_token_hash" field.
+func RefreshTokenHashHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashEqualFold applies the EqualFold predicate on the "refresh_token_hash" field.
+func RefreshTokenHashEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashContainsFold applies the ContainsFold predicate on the "refresh_token_hash" field.
+func RefreshTokenHashContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldRefreshTokenHash, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldIP, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// RevokeReasonEQ applies the EQ predicate on the "revoke_reason" field.
+func RevokeReasonEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRevokeReason, v))
+}
+
+// RevokeReasonNEQ applies the NEQ predicate on the "revoke_reason" field.
+func RevokeReasonNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldRevokeReason, v))
+}
+
+// RevokeReasonIn applies the In predicate on the "revoke_reason" field.
+func RevokeReasonIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldRevokeReason, vs...))
+}
+
+// RevokeReasonNotIn applies the NotIn predicate on the "revoke_reason" field.
+func RevokeReasonNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldRevokeReason, vs...))
+}
+
+// RevokeReasonGT applies the GT predicate on the "revoke_reason" field.
+func RevokeReasonGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldRevokeReason, v))
+}
+
+// RevokeReasonGTE applies the GTE predicate on the "revoke_reason" field.
+func RevokeReasonGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldRevokeReason, v))
+}
+
+// RevokeReasonLT applies the LT predicate on the "revoke_reason" field.
+func RevokeReasonLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldRevokeReason, v))
+}
+
+// RevokeReasonLTE applies the LTE predicate on the "revoke_reason" field.
+func RevokeReasonLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldRevokeReason, v))
+}
+
+// RevokeReasonContains applies the Contains predicate on the "revoke_reason" field.
+func RevokeReasonContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldRevokeReason, v))
+}
+
+// RevokeReasonHasPrefix applies the HasPrefix predicate on the "revoke_reason" field.
+func RevokeReasonHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldRevokeReason, v))
+}
+
+// RevokeReasonHasSuffix applies the HasSuffix predicate on the "revoke_reason" field.
+func RevokeReasonHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldRevokeReason, v))
+}
+
+// RevokeReasonEqualFold applies the EqualFold predicate on the "revoke_reason" field.
+func RevokeReasonEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldRevokeReason, v))
+}
+
+// RevokeReasonContainsFold applies the ContainsFold predicate on the "revoke_reason" field.
+func RevokeReasonContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldRevokeReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdminSession) predicate.AdminSession {
+	return predicate.AdminSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdminSession) predicate.AdminSession {
+	return predicate.AdminSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdminSession) predicate.AdminSession {
+	return predicate.AdminSession(sql.NotPredicates(p))
+}
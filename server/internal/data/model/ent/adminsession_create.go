@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminsession"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminSessionCreate is the builder for creating a AdminSession entity.
+type AdminSessionCreate struct {
+	config
+	mutation *AdminSessionMutation
+	hooks    []Hook
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_c *AdminSessionCreate) SetSessionKey(v string) *AdminSessionCreate {
+	_c.mutation.SetSessionKey(v)
+	return _c
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_c *AdminSessionCreate) SetAdminUserID(v int) *AdminSessionCreate {
+	_c.mutation.SetAdminUserID(v)
+	return _c
+}
+
+// SetAccessTokenID sets the "access_token_id" field.
+func (_c *AdminSessionCreate) SetAccessTokenID(v string) *AdminSessionCreate {
+	_c.mutation.SetAccessTokenID(v)
+	return _c
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (_c *AdminSessionCreate) SetRefreshTokenHash(v string) *AdminSessionCreate {
+	_c.mutation.SetRefreshTokenHash(v)
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *AdminSessionCreate) SetIP(v string) *AdminSessionCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableIP(v *string) *AdminSessionCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *AdminSessionCreate) SetUserAgent(v string) *AdminSessionCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableUserAgent(v *string) *AdminSessionCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminSessionCreate) SetCreatedAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableCreatedAt(v *time.Time) *AdminSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *AdminSessionCreate) SetLastSeenAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableLastSeenAt(v *time.Time) *AdminSessionCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AdminSessionCreate) SetExpiresAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *AdminSessionCreate) SetRevokedAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableRevokedAt(v *time.Time) *AdminSessionCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokeReason sets the "revoke_reason" field.
+func (_c *AdminSessionCreate) SetRevokeReason(v string) *AdminSessionCreate {
+	_c.mutation.SetRevokeReason(v)
+	return _c
+}
+
+// SetNillableRevokeReason sets the "revoke_reason" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableRevokeReason(v *string) *AdminSessionCreate {
+	if v != nil {
+		_c.SetRevokeReason(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AdminSessionMutation object of the builder.
+func (_c *AdminSessionCreate) Mutation() *AdminSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminSession in the database.
+func (_c *AdminSessionCreate) Save(ctx context.Context) (*AdminSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminSessionCreate) SaveX(ctx context.Context) *AdminSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminSessionCreate) defaults() {
+	if _, ok := _c.mutation.IP(); !ok {
+		v := adminsession.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := adminsession.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		v := adminsession.DefaultLastSeenAt()
+		_c.mutation.SetLastSeenAt(v)
+	}
+	if _, ok := _c.mutation.RevokeReason(); !ok {
+		v := adminsession.DefaultRevokeReason
+		_c.mutation.SetRevokeReason(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminSessionCreate) check() error {
+	if _, ok := _c.mutation.SessionKey(); !ok {
+		return &ValidationError{Name: "session_key", err: errors.New(`ent: missing required field "AdminSession.session_key"`)}
+	}
+	if v, ok := _c.mutation.SessionKey(); ok {
+		if err := adminsession.SessionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "session_key", err: fmt.Errorf(`ent: validator failed for field "AdminSession.session_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AdminUserID(); !ok {
+		return &ValidationError{Name: "admin_user_id", err: errors.New(`ent: missing required field "AdminSession.admin_user_id"`)}
+	}
+	if _, ok := _c.mutation.AccessTokenID(); !ok {
+		return &ValidationError{Name: "access_token_id", err: errors.New(`ent: missing required field "AdminSession.access_token_id"`)}
+	}
+	if v, ok := _c.mutation.AccessTokenID(); ok {
+		if err := adminsession.AccessTokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "access_token_id", err: fmt.Errorf(`ent: validator failed for field "AdminSession.access_token_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefreshTokenHash(); !ok {
+		return &ValidationError{Name: "refresh_token_hash", err: errors.New(`ent: missing required field "AdminSession.refresh_token_hash"`)}
+	}
+	if v, ok := _c.mutation.RefreshTokenHash(); ok {
+		if err := adminsession.RefreshTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token_hash", err: fmt.Errorf(`ent: validator failed for field "AdminSession.refresh_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "AdminSession.ip"`)}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := adminsession.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AdminSession.ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "AdminSession.user_agent"`)}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := adminsession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminSession.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "AdminSession.last_seen_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AdminSession.expires_at"`)}
+	}
+	if _, ok := _c.mutation.RevokeReason(); !ok {
+		return &ValidationError{Name: "revoke_reason", err: errors.New(`ent: missing required field "AdminSession.revoke_reason"`)}
+	}
+	if v, ok := _c.mutation.RevokeReason(); ok {
+		if err := adminsession.RevokeReasonValidator(v); err != nil {
+			return &ValidationError{Name: "revoke_reason", err: fmt.Errorf(`ent: validator failed for field "AdminSession.revoke_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AdminSessionCreate) sqlSave(ctx context.Context) (*AdminSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminSessionCreate) createSpec() (*AdminSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminsession.Table, sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.SessionKey(); ok {
+		_spec.SetField(adminsession.FieldSessionKey, field.TypeString, value)
+		_node.SessionKey = value
+	}
+	if value, ok := _c.mutation.AdminUserID(); ok {
+		_spec.SetField(adminsession.FieldAdminUserID, field.TypeInt, value)
+		_node.AdminUserID = value
+	}
+	if value, ok := _c.mutation.AccessTokenID(); ok {
+		_spec.SetField(adminsession.FieldAccessTokenID, field.TypeString, value)
+		_node.AccessTokenID = value
+	}
+	if value, ok := _c.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(adminsession.FieldRefreshTokenHash, field.TypeString, value)
+		_node.RefreshTokenHash = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(adminsession.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(adminsession.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(adminsession.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminsession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(adminsession.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.RevokeReason(); ok {
+		_spec.SetField(adminsession.FieldRevokeReason, field.TypeString, value)
+		_node.RevokeReason = value
+	}
+	return _node, _spec
+}
+
+// AdminSessionCreateBulk is the builder for creating many AdminSession entities in bulk.
+type AdminSessionCreateBulk struct {
+	config
+	err      error
+	builders []*AdminSessionCreate
+}
+
+// Save creates the AdminSession entities in the database.
+func (_c *AdminSessionCreateBulk) Save(ctx context.Context) ([]*AdminSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminSessionCreateBulk) SaveX(ctx context.Context) []*AdminSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminsession"
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminSessionUpdate is the builder for updating AdminSession entities.
+type AdminSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminSessionMutation
+}
+
+// Where appends a list predicates to the AdminSessionUpdate builder.
+func (_u *AdminSessionUpdate) Where(ps ...predicate.AdminSession) *AdminSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_u *AdminSessionUpdate) SetSessionKey(v string) *AdminSessionUpdate {
+	_u.mutation.SetSessionKey(v)
+	return _u
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableSessionKey(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetSessionKey(*v)
+	}
+	return _u
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_u *AdminSessionUpdate) SetAdminUserID(v int) *AdminSessionUpdate {
+	_u.mutation.ResetAdminUserID()
+	_u.mutation.SetAdminUserID(v)
+	return _u
+}
+
+// SetNillableAdminUserID sets the "admin_user_id" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableAdminUserID(v *int) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetAdminUserID(*v)
+	}
+	return _u
+}
+
+// AddAdminUserID adds value to the "admin_user_id" field.
+func (_u *AdminSessionUpdate) AddAdminUserID(v int) *AdminSessionUpdate {
+	_u.mutation.AddAdminUserID(v)
+	return _u
+}
+
+// SetAccessTokenID sets the "access_token_id" field.
+func (_u *AdminSessionUpdate) SetAccessTokenID(v string) *AdminSessionUpdate {
+	_u.mutation.SetAccessTokenID(v)
+	return _u
+}
+
+// SetNillableAccessTokenID sets the "access_token_id" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableAccessTokenID(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetAccessTokenID(*v)
+	}
+	return _u
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (_u *AdminSessionUpdate) SetRefreshTokenHash(v string) *AdminSessionUpdate {
+	_u.mutation.SetRefreshTokenHash(v)
+	return _u
+}
+
+// SetNillableRefreshTokenHash sets the "refresh_token_hash" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableRefreshTokenHash(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetRefreshTokenHash(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AdminSessionUpdate) SetIP(v string) *AdminSessionUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableIP(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AdminSessionUpdate) SetUserAgent(v string) *AdminSessionUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableUserAgent(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *AdminSessionUpdate) SetLastSeenAt(v time.Time) *AdminSessionUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableLastSeenAt(v *time.Time) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AdminSessionUpdate) SetExpiresAt(v time.Time) *AdminSessionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableExpiresAt(v *time.Time) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *AdminSessionUpdate) SetRevokedAt(v time.Time) *AdminSessionUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableRevokedAt(v *time.Time) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *AdminSessionUpdate) ClearRevokedAt() *AdminSessionUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokeReason sets the "revoke_reason" field.
+func (_u *AdminSessionUpdate) SetRevokeReason(v string) *AdminSessionUpdate {
+	_u.mutation.SetRevokeReason(v)
+	return _u
+}
+
+// SetNillableRevokeReason sets the "revoke_reason" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableRevokeReason(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetRevokeReason(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AdminSessionMutation object of the builder.
+func (_u *AdminSessionUpdate) Mutation() *AdminSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminSessionUpdate) check() error {
+	if v, ok := _u.mutation.SessionKey(); ok {
+		if err := adminsession.SessionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "session_key", err: fmt.Errorf(`ent: validator failed for field "AdminSession.session_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccessTokenID(); ok {
+		if err := adminsession.AccessTokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "access_token_id", err: fmt.Errorf(`ent: validator failed for field "AdminSession.access_token_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefreshTokenHash(); ok {
+		if err := adminsession.RefreshTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token_hash", err: fmt.Errorf(`ent: validator failed for field "AdminSession.refresh_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := adminsession.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AdminSession.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := adminsession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminSession.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RevokeReason(); ok {
+		if err := adminsession.RevokeReasonValidator(v); err != nil {
+			return &ValidationError{Name: "revoke_reason", err: fmt.Errorf(`ent: validator failed for field "AdminSession.revoke_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminsession.Table, adminsession.Columns, sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SessionKey(); ok {
+		_spec.SetField(adminsession.FieldSessionKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdminUserID(); ok {
+		_spec.SetField(adminsession.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminUserID(); ok {
+		_spec.AddField(adminsession.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AccessTokenID(); ok {
+		_spec.SetField(adminsession.FieldAccessTokenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(adminsession.FieldRefreshTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(adminsession.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(adminsession.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(adminsession.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(adminsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(adminsession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokeReason(); ok {
+		_spec.SetField(adminsession.FieldRevokeReason, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminSessionUpdateOne is the builder for updating a single AdminSession entity.
+type AdminSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminSessionMutation
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_u *AdminSessionUpdateOne) SetSessionKey(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetSessionKey(v)
+	return _u
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableSessionKey(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetSessionKey(*v)
+	}
+	return _u
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_u *AdminSessionUpdateOne) SetAdminUserID(v int) *AdminSessionUpdateOne {
+	_u.mutation.ResetAdminUserID()
+	_u.mutation.SetAdminUserID(v)
+	return _u
+}
+
+// SetNillableAdminUserID sets the "admin_user_id" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableAdminUserID(v *int) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetAdminUserID(*v)
+	}
+	return _u
+}
+
+// AddAdminUserID adds value to the "admin_user_id" field.
+func (_u *AdminSessionUpdateOne) AddAdminUserID(v int) *AdminSessionUpdateOne {
+	_u.mutation.AddAdminUserID(v)
+	return _u
+}
+
+// SetAccessTokenID sets the "access_token_id" field.
+func (_u *AdminSessionUpdateOne) SetAccessTokenID(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetAccessTokenID(v)
+	return _u
+}
+
+// SetNillableAccessTokenID sets the "access_token_id" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableAccessTokenID(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetAccessTokenID(*v)
+	}
+	return _u
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (_u *AdminSessionUpdateOne) SetRefreshTokenHash(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetRefreshTokenHash(v)
+	return _u
+}
+
+// SetNillableRefreshTokenHash sets the "refresh_token_hash" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableRefreshTokenHash(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetRefreshTokenHash(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AdminSessionUpdateOne) SetIP(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableIP(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AdminSessionUpdateOne) SetUserAgent(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableUserAgent(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *AdminSessionUpdateOne) SetLastSeenAt(v time.Time) *AdminSessionUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableLastSeenAt(v *time.Time) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AdminSessionUpdateOne) SetExpiresAt(v time.Time) *AdminSessionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableExpiresAt(v *time.Time) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *AdminSessionUpdateOne) SetRevokedAt(v time.Time) *AdminSessionUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableRevokedAt(v *time.Time) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *AdminSessionUpdateOne) ClearRevokedAt() *AdminSessionUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokeReason sets the "revoke_reason" field.
+func (_u *AdminSessionUpdateOne) SetRevokeReason(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetRevokeReason(v)
+	return _u
+}
+
+// SetNillableRevokeReason sets the "revoke_reason" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableRevokeReason(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokeReason(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AdminSessionMutation object of the builder.
+func (_u *AdminSessionUpdateOne) Mutation() *AdminSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AdminSessionUpdate builder.
+func (_u *AdminSessionUpdateOne) Where(ps ...predicate.AdminSession) *AdminSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminSessionUpdateOne) Select(field string, fields ...string) *AdminSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminSession entity.
+func (_u *AdminSessionUpdateOne) Save(ctx context.Context) (*AdminSession, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminSessionUpdateOne) SaveX(ctx context.Context) *AdminSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.SessionKey(); ok {
+		if err := adminsession.SessionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "session_key", err: fmt.Errorf(`ent: validator failed for field "AdminSession.session_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccessTokenID(); ok {
+		if err := adminsession.AccessTokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "access_token_id", err: fmt.Errorf(`ent: validator failed for field "AdminSession.access_token_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefreshTokenHash(); ok {
+		if err := adminsession.RefreshTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token_hash", err: fmt.Errorf(`ent: validator failed for field "AdminSession.refresh_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := adminsession.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AdminSession.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := adminsession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminSession.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RevokeReason(); ok {
+		if err := adminsession.RevokeReasonValidator(v); err != nil {
+			return &ValidationError{Name: "revoke_reason", err: fmt.Errorf(`ent: validator failed for field "AdminSession.revoke_reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminSessionUpdateOne) sqlSave(ctx context.Context) (_node *AdminSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminsession.Table, adminsession.Columns, sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdminSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminsession.FieldID)
+		for _, f := range fields {
+			if !adminsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adminsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SessionKey(); ok {
+		_spec.SetField(adminsession.FieldSessionKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdminUserID(); ok {
+		_spec.SetField(adminsession.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminUserID(); ok {
+		_spec.AddField(adminsession.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AccessTokenID(); ok {
+		_spec.SetField(adminsession.FieldAccessTokenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(adminsession.FieldRefreshTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(adminsession.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(adminsession.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(adminsession.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(adminsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(adminsession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokeReason(); ok {
+		_spec.SetField(adminsession.FieldRevokeReason, field.TypeString, value)
+	}
+	_node = &AdminSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
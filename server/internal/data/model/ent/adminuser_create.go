@@ -60,6 +60,20 @@ func (_c *AdminUserCreate) SetNillableMenuPermissions(v *string) *AdminUserCreat
 	return _c
 }
 
+// SetRoleBased sets the "role_based" field.
+func (_c *AdminUserCreate) SetRoleBased(v bool) *AdminUserCreate {
+	_c.mutation.SetRoleBased(v)
+	return _c
+}
+
+// SetNillableRoleBased sets the "role_based" field if the given value is not nil.
+func (_c *AdminUserCreate) SetNillableRoleBased(v *bool) *AdminUserCreate {
+	if v != nil {
+		_c.SetRoleBased(*v)
+	}
+	return _c
+}
+
 // SetParentID sets the "parent_id" field.
 func (_c *AdminUserCreate) SetParentID(v int) *AdminUserCreate {
 	_c.mutation.SetParentID(v)
@@ -102,6 +116,104 @@ func (_c *AdminUserCreate) SetNillableLastLoginAt(v *time.Time) *AdminUserCreate
 	return _c
 }
 
+// SetMustChangePassword sets the "must_change_password" field.
+func (_c *AdminUserCreate) SetMustChangePassword(v bool) *AdminUserCreate {
+	_c.mutation.SetMustChangePassword(v)
+	return _c
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (_c *AdminUserCreate) SetNillableMustChangePassword(v *bool) *AdminUserCreate {
+	if v != nil {
+		_c.SetMustChangePassword(*v)
+	}
+	return _c
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (_c *AdminUserCreate) SetPasswordChangedAt(v time.Time) *AdminUserCreate {
+	_c.mutation.SetPasswordChangedAt(v)
+	return _c
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (_c *AdminUserCreate) SetNillablePasswordChangedAt(v *time.Time) *AdminUserCreate {
+	if v != nil {
+		_c.SetPasswordChangedAt(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *AdminUserCreate) SetLockedUntil(v time.Time) *AdminUserCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *AdminUserCreate) SetNillableLockedUntil(v *time.Time) *AdminUserCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (_c *AdminUserCreate) SetTotpSecret(v string) *AdminUserCreate {
+	_c.mutation.SetTotpSecret(v)
+	return _c
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_c *AdminUserCreate) SetNillableTotpSecret(v *string) *AdminUserCreate {
+	if v != nil {
+		_c.SetTotpSecret(*v)
+	}
+	return _c
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_c *AdminUserCreate) SetTotpEnabled(v bool) *AdminUserCreate {
+	_c.mutation.SetTotpEnabled(v)
+	return _c
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_c *AdminUserCreate) SetNillableTotpEnabled(v *bool) *AdminUserCreate {
+	if v != nil {
+		_c.SetTotpEnabled(*v)
+	}
+	return _c
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (_c *AdminUserCreate) SetTotpEnabledAt(v time.Time) *AdminUserCreate {
+	_c.mutation.SetTotpEnabledAt(v)
+	return _c
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (_c *AdminUserCreate) SetNillableTotpEnabledAt(v *time.Time) *AdminUserCreate {
+	if v != nil {
+		_c.SetTotpEnabledAt(*v)
+	}
+	return _c
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (_c *AdminUserCreate) SetTotpLastStep(v int64) *AdminUserCreate {
+	_c.mutation.SetTotpLastStep(v)
+	return _c
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (_c *AdminUserCreate) SetNillableTotpLastStep(v *int64) *AdminUserCreate {
+	if v != nil {
+		_c.SetTotpLastStep(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AdminUserCreate) SetCreatedAt(v time.Time) *AdminUserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -173,10 +285,30 @@ func (_c *AdminUserCreate) defaults() {
 		v := adminuser.DefaultMenuPermissions
 		_c.mutation.SetMenuPermissions(v)
 	}
+	if _, ok := _c.mutation.RoleBased(); !ok {
+		v := adminuser.DefaultRoleBased
+		_c.mutation.SetRoleBased(v)
+	}
 	if _, ok := _c.mutation.Disabled(); !ok {
 		v := adminuser.DefaultDisabled
 		_c.mutation.SetDisabled(v)
 	}
+	if _, ok := _c.mutation.MustChangePassword(); !ok {
+		v := adminuser.DefaultMustChangePassword
+		_c.mutation.SetMustChangePassword(v)
+	}
+	if _, ok := _c.mutation.TotpSecret(); !ok {
+		v := adminuser.DefaultTotpSecret
+		_c.mutation.SetTotpSecret(v)
+	}
+	if _, ok := _c.mutation.TotpEnabled(); !ok {
+		v := adminuser.DefaultTotpEnabled
+		_c.mutation.SetTotpEnabled(v)
+	}
+	if _, ok := _c.mutation.TotpLastStep(); !ok {
+		v := adminuser.DefaultTotpLastStep
+		_c.mutation.SetTotpLastStep(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := adminuser.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -216,9 +348,29 @@ func (_c *AdminUserCreate) check() error {
 			return &ValidationError{Name: "menu_permissions", err: fmt.Errorf(`ent: validator failed for field "AdminUser.menu_permissions": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.RoleBased(); !ok {
+		return &ValidationError{Name: "role_based", err: errors.New(`ent: missing required field "AdminUser.role_based"`)}
+	}
 	if _, ok := _c.mutation.Disabled(); !ok {
 		return &ValidationError{Name: "disabled", err: errors.New(`ent: missing required field "AdminUser.disabled"`)}
 	}
+	if _, ok := _c.mutation.MustChangePassword(); !ok {
+		return &ValidationError{Name: "must_change_password", err: errors.New(`ent: missing required field "AdminUser.must_change_password"`)}
+	}
+	if _, ok := _c.mutation.TotpSecret(); !ok {
+		return &ValidationError{Name: "totp_secret", err: errors.New(`ent: missing required field "AdminUser.totp_secret"`)}
+	}
+	if v, ok := _c.mutation.TotpSecret(); ok {
+		if err := adminuser.TotpSecretValidator(v); err != nil {
+			return &ValidationError{Name: "totp_secret", err: fmt.Errorf(`ent: validator failed for field "AdminUser.totp_secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotpEnabled(); !ok {
+		return &ValidationError{Name: "totp_enabled", err: errors.New(`ent: missing required field "AdminUser.totp_enabled"`)}
+	}
+	if _, ok := _c.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "AdminUser.totp_last_step"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminUser.created_at"`)}
 	}
@@ -267,6 +419,10 @@ func (_c *AdminUserCreate) createSpec() (*AdminUser, *sqlgraph.CreateSpec) {
 		_spec.SetField(adminuser.FieldMenuPermissions, field.TypeString, value)
 		_node.MenuPermissions = value
 	}
+	if value, ok := _c.mutation.RoleBased(); ok {
+		_spec.SetField(adminuser.FieldRoleBased, field.TypeBool, value)
+		_node.RoleBased = value
+	}
 	if value, ok := _c.mutation.ParentID(); ok {
 		_spec.SetField(adminuser.FieldParentID, field.TypeInt, value)
 		_node.ParentID = &value
@@ -279,6 +435,34 @@ func (_c *AdminUserCreate) createSpec() (*AdminUser, *sqlgraph.CreateSpec) {
 		_spec.SetField(adminuser.FieldLastLoginAt, field.TypeTime, value)
 		_node.LastLoginAt = &value
 	}
+	if value, ok := _c.mutation.MustChangePassword(); ok {
+		_spec.SetField(adminuser.FieldMustChangePassword, field.TypeBool, value)
+		_node.MustChangePassword = value
+	}
+	if value, ok := _c.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(adminuser.FieldPasswordChangedAt, field.TypeTime, value)
+		_node.PasswordChangedAt = &value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(adminuser.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.TotpSecret(); ok {
+		_spec.SetField(adminuser.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = value
+	}
+	if value, ok := _c.mutation.TotpEnabled(); ok {
+		_spec.SetField(adminuser.FieldTotpEnabled, field.TypeBool, value)
+		_node.TotpEnabled = value
+	}
+	if value, ok := _c.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(adminuser.FieldTotpEnabledAt, field.TypeTime, value)
+		_node.TotpEnabledAt = &value
+	}
+	if value, ok := _c.mutation.TotpLastStep(); ok {
+		_spec.SetField(adminuser.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(adminuser.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
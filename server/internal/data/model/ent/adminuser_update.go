@@ -91,6 +91,20 @@ func (_u *AdminUserUpdate) SetNillableMenuPermissions(v *string) *AdminUserUpdat
 	return _u
 }
 
+// SetRoleBased sets the "role_based" field.
+func (_u *AdminUserUpdate) SetRoleBased(v bool) *AdminUserUpdate {
+	_u.mutation.SetRoleBased(v)
+	return _u
+}
+
+// SetNillableRoleBased sets the "role_based" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableRoleBased(v *bool) *AdminUserUpdate {
+	if v != nil {
+		_u.SetRoleBased(*v)
+	}
+	return _u
+}
+
 // SetParentID sets the "parent_id" field.
 func (_u *AdminUserUpdate) SetParentID(v int) *AdminUserUpdate {
 	_u.mutation.ResetParentID()
@@ -152,6 +166,129 @@ func (_u *AdminUserUpdate) ClearLastLoginAt() *AdminUserUpdate {
 	return _u
 }
 
+// SetMustChangePassword sets the "must_change_password" field.
+func (_u *AdminUserUpdate) SetMustChangePassword(v bool) *AdminUserUpdate {
+	_u.mutation.SetMustChangePassword(v)
+	return _u
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableMustChangePassword(v *bool) *AdminUserUpdate {
+	if v != nil {
+		_u.SetMustChangePassword(*v)
+	}
+	return _u
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (_u *AdminUserUpdate) SetPasswordChangedAt(v time.Time) *AdminUserUpdate {
+	_u.mutation.SetPasswordChangedAt(v)
+	return _u
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillablePasswordChangedAt(v *time.Time) *AdminUserUpdate {
+	if v != nil {
+		_u.SetPasswordChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (_u *AdminUserUpdate) ClearPasswordChangedAt() *AdminUserUpdate {
+	_u.mutation.ClearPasswordChangedAt()
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *AdminUserUpdate) SetLockedUntil(v time.Time) *AdminUserUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableLockedUntil(v *time.Time) *AdminUserUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *AdminUserUpdate) ClearLockedUntil() *AdminUserUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (_u *AdminUserUpdate) SetTotpSecret(v string) *AdminUserUpdate {
+	_u.mutation.SetTotpSecret(v)
+	return _u
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableTotpSecret(v *string) *AdminUserUpdate {
+	if v != nil {
+		_u.SetTotpSecret(*v)
+	}
+	return _u
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_u *AdminUserUpdate) SetTotpEnabled(v bool) *AdminUserUpdate {
+	_u.mutation.SetTotpEnabled(v)
+	return _u
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableTotpEnabled(v *bool) *AdminUserUpdate {
+	if v != nil {
+		_u.SetTotpEnabled(*v)
+	}
+	return _u
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (_u *AdminUserUpdate) SetTotpEnabledAt(v time.Time) *AdminUserUpdate {
+	_u.mutation.SetTotpEnabledAt(v)
+	return _u
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableTotpEnabledAt(v *time.Time) *AdminUserUpdate {
+	if v != nil {
+		_u.SetTotpEnabledAt(*v)
+	}
+	return _u
+}
+
+// ClearTotpEnabledAt clears the value of the "totp_enabled_at" field.
+func (_u *AdminUserUpdate) ClearTotpEnabledAt() *AdminUserUpdate {
+	_u.mutation.ClearTotpEnabledAt()
+	return _u
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (_u *AdminUserUpdate) SetTotpLastStep(v int64) *AdminUserUpdate {
+	_u.mutation.ResetTotpLastStep()
+	_u.mutation.SetTotpLastStep(v)
+	return _u
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableTotpLastStep(v *int64) *AdminUserUpdate {
+	if v != nil {
+		_u.SetTotpLastStep(*v)
+	}
+	return _u
+}
+
+// AddTotpLastStep adds value to the "totp_last_step" field.
+func (_u *AdminUserUpdate) AddTotpLastStep(v int64) *AdminUserUpdate {
+	_u.mutation.AddTotpLastStep(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *AdminUserUpdate) SetUpdatedAt(v time.Time) *AdminUserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -216,6 +353,11 @@ func (_u *AdminUserUpdate) check() error {
 			return &ValidationError{Name: "menu_permissions", err: fmt.Errorf(`ent: validator failed for field "AdminUser.menu_permissions": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TotpSecret(); ok {
+		if err := adminuser.TotpSecretValidator(v); err != nil {
+			return &ValidationError{Name: "totp_secret", err: fmt.Errorf(`ent: validator failed for field "AdminUser.totp_secret": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -246,6 +388,9 @@ func (_u *AdminUserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.MenuPermissions(); ok {
 		_spec.SetField(adminuser.FieldMenuPermissions, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.RoleBased(); ok {
+		_spec.SetField(adminuser.FieldRoleBased, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.ParentID(); ok {
 		_spec.SetField(adminuser.FieldParentID, field.TypeInt, value)
 	}
@@ -264,6 +409,39 @@ func (_u *AdminUserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LastLoginAtCleared() {
 		_spec.ClearField(adminuser.FieldLastLoginAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.MustChangePassword(); ok {
+		_spec.SetField(adminuser.FieldMustChangePassword, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(adminuser.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(adminuser.FieldPasswordChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(adminuser.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(adminuser.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TotpSecret(); ok {
+		_spec.SetField(adminuser.FieldTotpSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TotpEnabled(); ok {
+		_spec.SetField(adminuser.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(adminuser.FieldTotpEnabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.TotpEnabledAtCleared() {
+		_spec.ClearField(adminuser.FieldTotpEnabledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TotpLastStep(); ok {
+		_spec.SetField(adminuser.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(adminuser.FieldTotpLastStep, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(adminuser.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -350,6 +528,20 @@ func (_u *AdminUserUpdateOne) SetNillableMenuPermissions(v *string) *AdminUserUp
 	return _u
 }
 
+// SetRoleBased sets the "role_based" field.
+func (_u *AdminUserUpdateOne) SetRoleBased(v bool) *AdminUserUpdateOne {
+	_u.mutation.SetRoleBased(v)
+	return _u
+}
+
+// SetNillableRoleBased sets the "role_based" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableRoleBased(v *bool) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetRoleBased(*v)
+	}
+	return _u
+}
+
 // SetParentID sets the "parent_id" field.
 func (_u *AdminUserUpdateOne) SetParentID(v int) *AdminUserUpdateOne {
 	_u.mutation.ResetParentID()
@@ -411,6 +603,129 @@ func (_u *AdminUserUpdateOne) ClearLastLoginAt() *AdminUserUpdateOne {
 	return _u
 }
 
+// SetMustChangePassword sets the "must_change_password" field.
+func (_u *AdminUserUpdateOne) SetMustChangePassword(v bool) *AdminUserUpdateOne {
+	_u.mutation.SetMustChangePassword(v)
+	return _u
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableMustChangePassword(v *bool) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetMustChangePassword(*v)
+	}
+	return _u
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (_u *AdminUserUpdateOne) SetPasswordChangedAt(v time.Time) *AdminUserUpdateOne {
+	_u.mutation.SetPasswordChangedAt(v)
+	return _u
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillablePasswordChangedAt(v *time.Time) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetPasswordChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (_u *AdminUserUpdateOne) ClearPasswordChangedAt() *AdminUserUpdateOne {
+	_u.mutation.ClearPasswordChangedAt()
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *AdminUserUpdateOne) SetLockedUntil(v time.Time) *AdminUserUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableLockedUntil(v *time.Time) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *AdminUserUpdateOne) ClearLockedUntil() *AdminUserUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (_u *AdminUserUpdateOne) SetTotpSecret(v string) *AdminUserUpdateOne {
+	_u.mutation.SetTotpSecret(v)
+	return _u
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableTotpSecret(v *string) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetTotpSecret(*v)
+	}
+	return _u
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_u *AdminUserUpdateOne) SetTotpEnabled(v bool) *AdminUserUpdateOne {
+	_u.mutation.SetTotpEnabled(v)
+	return _u
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableTotpEnabled(v *bool) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetTotpEnabled(*v)
+	}
+	return _u
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (_u *AdminUserUpdateOne) SetTotpEnabledAt(v time.Time) *AdminUserUpdateOne {
+	_u.mutation.SetTotpEnabledAt(v)
+	return _u
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableTotpEnabledAt(v *time.Time) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetTotpEnabledAt(*v)
+	}
+	return _u
+}
+
+// ClearTotpEnabledAt clears the value of the "totp_enabled_at" field.
+func (_u *AdminUserUpdateOne) ClearTotpEnabledAt() *AdminUserUpdateOne {
+	_u.mutation.ClearTotpEnabledAt()
+	return _u
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (_u *AdminUserUpdateOne) SetTotpLastStep(v int64) *AdminUserUpdateOne {
+	_u.mutation.ResetTotpLastStep()
+	_u.mutation.SetTotpLastStep(v)
+	return _u
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableTotpLastStep(v *int64) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetTotpLastStep(*v)
+	}
+	return _u
+}
+
+// AddTotpLastStep adds value to the "totp_last_step" field.
+func (_u *AdminUserUpdateOne) AddTotpLastStep(v int64) *AdminUserUpdateOne {
+	_u.mutation.AddTotpLastStep(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *AdminUserUpdateOne) SetUpdatedAt(v time.Time) *AdminUserUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -488,6 +803,11 @@ func (_u *AdminUserUpdateOne) check() error {
 			return &ValidationError{Name: "menu_permissions", err: fmt.Errorf(`ent: validator failed for field "AdminUser.menu_permissions": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TotpSecret(); ok {
+		if err := adminuser.TotpSecretValidator(v); err != nil {
+			return &ValidationError{Name: "totp_secret", err: fmt.Errorf(`ent: validator failed for field "AdminUser.totp_secret": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -535,6 +855,9 @@ func (_u *AdminUserUpdateOne) sqlSave(ctx context.Context) (_node *AdminUser, er
 	if value, ok := _u.mutation.MenuPermissions(); ok {
 		_spec.SetField(adminuser.FieldMenuPermissions, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.RoleBased(); ok {
+		_spec.SetField(adminuser.FieldRoleBased, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.ParentID(); ok {
 		_spec.SetField(adminuser.FieldParentID, field.TypeInt, value)
 	}
@@ -553,6 +876,39 @@ func (_u *AdminUserUpdateOne) sqlSave(ctx context.Context) (_node *AdminUser, er
 	if _u.mutation.LastLoginAtCleared() {
 		_spec.ClearField(adminuser.FieldLastLoginAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.MustChangePassword(); ok {
+		_spec.SetField(adminuser.FieldMustChangePassword, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(adminuser.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(adminuser.FieldPasswordChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(adminuser.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(adminuser.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TotpSecret(); ok {
+		_spec.SetField(adminuser.FieldTotpSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TotpEnabled(); ok {
+		_spec.SetField(adminuser.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(adminuser.FieldTotpEnabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.TotpEnabledAtCleared() {
+		_spec.ClearField(adminuser.FieldTotpEnabledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TotpLastStep(); ok {
+		_spec.SetField(adminuser.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(adminuser.FieldTotpLastStep, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(adminuser.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -11,8 +11,15 @@ import (
 
 	"server/internal/data/model/ent/migrate"
 
+	"server/internal/data/model/ent/adminloginattempt"
+	"server/internal/data/model/ent/adminrecoverycode"
+	"server/internal/data/model/ent/adminrole"
+	"server/internal/data/model/ent/adminrolepermission"
+	"server/internal/data/model/ent/adminsession"
 	"server/internal/data/model/ent/adminuser"
+	"server/internal/data/model/ent/adminuserrole"
 	"server/internal/data/model/ent/erpattachment"
+	"server/internal/data/model/ent/erpauditlog"
 	"server/internal/data/model/ent/erpbankreceipt"
 	"server/internal/data/model/ent/erpbankreceiptclaim"
 	"server/internal/data/model/ent/erpdoclink"
@@ -25,13 +32,18 @@ import (
 	"server/internal/data/model/ent/erpoutboundorder"
 	"server/internal/data/model/ent/erpoutboundorderitem"
 	"server/internal/data/model/ent/erppartner"
+	"server/internal/data/model/ent/erppartneraddress"
+	"server/internal/data/model/ent/erppartnerbankaccount"
+	"server/internal/data/model/ent/erppartnercontact"
 	"server/internal/data/model/ent/erpproduct"
 	"server/internal/data/model/ent/erppurchasecontract"
 	"server/internal/data/model/ent/erppurchasecontractitem"
 	"server/internal/data/model/ent/erpquotation"
 	"server/internal/data/model/ent/erpquotationitem"
+	"server/internal/data/model/ent/erprecordrevision"
 	"server/internal/data/model/ent/erpsequence"
 	"server/internal/data/model/ent/erpsettlement"
+	"server/internal/data/model/ent/erpsettlementline"
 	"server/internal/data/model/ent/erpshipmentdetail"
 	"server/internal/data/model/ent/erpshipmentdetailitem"
 	"server/internal/data/model/ent/erpstockbalance"
@@ -52,10 +64,24 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AdminLoginAttempt is the client for interacting with the AdminLoginAttempt builders.
+	AdminLoginAttempt *AdminLoginAttemptClient
+	// AdminRecoveryCode is the client for interacting with the AdminRecoveryCode builders.
+	AdminRecoveryCode *AdminRecoveryCodeClient
+	// AdminRole is the client for interacting with the AdminRole builders.
+	AdminRole *AdminRoleClient
+	// AdminRolePermission is the client for interacting with the AdminRolePermission builders.
+	AdminRolePermission *AdminRolePermissionClient
+	// AdminSession is the client for interacting with the AdminSession builders.
+	AdminSession *AdminSessionClient
 	// AdminUser is the client for interacting with the AdminUser builders.
 	AdminUser *AdminUserClient
+	// AdminUserRole is the client for interacting with the AdminUserRole builders.
+	AdminUserRole *AdminUserRoleClient
 	// ERPAttachment is the client for interacting with the ERPAttachment builders.
 	ERPAttachment *ERPAttachmentClient
+	// ERPAuditLog is the client for interacting with the ERPAuditLog builders.
+	ERPAuditLog *ERPAuditLogClient
 	// ERPBankReceipt is the client for interacting with the ERPBankReceipt builders.
 	ERPBankReceipt *ERPBankReceiptClient
 	// ERPBankReceiptClaim is the client for interacting with the ERPBankReceiptClaim builders.
@@ -80,6 +106,12 @@ type Client struct {
 	ERPOutboundOrderItem *ERPOutboundOrderItemClient
 	// ERPPartner is the client for interacting with the ERPPartner builders.
 	ERPPartner *ERPPartnerClient
+	// ERPPartnerAddress is the client for interacting with the ERPPartnerAddress builders.
+	ERPPartnerAddress *ERPPartnerAddressClient
+	// ERPPartnerBankAccount is the client for interacting with the ERPPartnerBankAccount builders.
+	ERPPartnerBankAccount *ERPPartnerBankAccountClient
+	// ERPPartnerContact is the client for interacting with the ERPPartnerContact builders.
+	ERPPartnerContact *ERPPartnerContactClient
 	// ERPProduct is the client for interacting with the ERPProduct builders.
 	ERPProduct *ERPProductClient
 	// ERPPurchaseContract is the client for interacting with the ERPPurchaseContract builders.
@@ -90,10 +122,14 @@ type Client struct {
 	ERPQuotation *ERPQuotationClient
 	// ERPQuotationItem is the client for interacting with the ERPQuotationItem builders.
 	ERPQuotationItem *ERPQuotationItemClient
+	// ERPRecordRevision is the client for interacting with the ERPRecordRevision builders.
+	ERPRecordRevision *ERPRecordRevisionClient
 	// ERPSequence is the client for interacting with the ERPSequence builders.
 	ERPSequence *ERPSequenceClient
 	// ERPSettlement is the client for interacting with the ERPSettlement builders.
 	ERPSettlement *ERPSettlementClient
+	// ERPSettlementLine is the client for interacting with the ERPSettlementLine builders.
+	ERPSettlementLine *ERPSettlementLineClient
 	// ERPShipmentDetail is the client for interacting with the ERPShipmentDetail builders.
 	ERPShipmentDetail *ERPShipmentDetailClient
 	// ERPShipmentDetailItem is the client for interacting with the ERPShipmentDetailItem builders.
@@ -123,8 +159,15 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AdminLoginAttempt = NewAdminLoginAttemptClient(c.config)
+	c.AdminRecoveryCode = NewAdminRecoveryCodeClient(c.config)
+	c.AdminRole = NewAdminRoleClient(c.config)
+	c.AdminRolePermission = NewAdminRolePermissionClient(c.config)
+	c.AdminSession = NewAdminSessionClient(c.config)
 	c.AdminUser = NewAdminUserClient(c.config)
+	c.AdminUserRole = NewAdminUserRoleClient(c.config)
 	c.ERPAttachment = NewERPAttachmentClient(c.config)
+	c.ERPAuditLog = NewERPAuditLogClient(c.config)
 	c.ERPBankReceipt = NewERPBankReceiptClient(c.config)
 	c.ERPBankReceiptClaim = NewERPBankReceiptClaimClient(c.config)
 	c.ERPDocLink = NewERPDocLinkClient(c.config)
@@ -137,13 +180,18 @@ func (c *Client) init() {
 	c.ERPOutboundOrder = NewERPOutboundOrderClient(c.config)
 	c.ERPOutboundOrderItem = NewERPOutboundOrderItemClient(c.config)
 	c.ERPPartner = NewERPPartnerClient(c.config)
+	c.ERPPartnerAddress = NewERPPartnerAddressClient(c.config)
+	c.ERPPartnerBankAccount = NewERPPartnerBankAccountClient(c.config)
+	c.ERPPartnerContact = NewERPPartnerContactClient(c.config)
 	c.ERPProduct = NewERPProductClient(c.config)
 	c.ERPPurchaseContract = NewERPPurchaseContractClient(c.config)
 	c.ERPPurchaseContractItem = NewERPPurchaseContractItemClient(c.config)
 	c.ERPQuotation = NewERPQuotationClient(c.config)
 	c.ERPQuotationItem = NewERPQuotationItemClient(c.config)
+	c.ERPRecordRevision = NewERPRecordRevisionClient(c.config)
 	c.ERPSequence = NewERPSequenceClient(c.config)
 	c.ERPSettlement = NewERPSettlementClient(c.config)
+	c.ERPSettlementLine = NewERPSettlementLineClient(c.config)
 	c.ERPShipmentDetail = NewERPShipmentDetailClient(c.config)
 	c.ERPShipmentDetailItem = NewERPShipmentDetailItemClient(c.config)
 	c.ERPStockBalance = NewERPStockBalanceClient(c.config)
@@ -245,8 +293,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		AdminLoginAttempt:       NewAdminLoginAttemptClient(cfg),
+		AdminRecoveryCode:       NewAdminRecoveryCodeClient(cfg),
+		AdminRole:               NewAdminRoleClient(cfg),
+		AdminRolePermission:     NewAdminRolePermissionClient(cfg),
+		AdminSession:            NewAdminSessionClient(cfg),
 		AdminUser:               NewAdminUserClient(cfg),
+		AdminUserRole:           NewAdminUserRoleClient(cfg),
 		ERPAttachment:           NewERPAttachmentClient(cfg),
+		ERPAuditLog:             NewERPAuditLogClient(cfg),
 		ERPBankReceipt:          NewERPBankReceiptClient(cfg),
 		ERPBankReceiptClaim:     NewERPBankReceiptClaimClient(cfg),
 		ERPDocLink:              NewERPDocLinkClient(cfg),
@@ -259,13 +314,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ERPOutboundOrder:        NewERPOutboundOrderClient(cfg),
 		ERPOutboundOrderItem:    NewERPOutboundOrderItemClient(cfg),
 		ERPPartner:              NewERPPartnerClient(cfg),
+		ERPPartnerAddress:       NewERPPartnerAddressClient(cfg),
+		ERPPartnerBankAccount:   NewERPPartnerBankAccountClient(cfg),
+		ERPPartnerContact:       NewERPPartnerContactClient(cfg),
 		ERPProduct:              NewERPProductClient(cfg),
 		ERPPurchaseContract:     NewERPPurchaseContractClient(cfg),
 		ERPPurchaseContractItem: NewERPPurchaseContractItemClient(cfg),
 		ERPQuotation:            NewERPQuotationClient(cfg),
 		ERPQuotationItem:        NewERPQuotationItemClient(cfg),
+		ERPRecordRevision:       NewERPRecordRevisionClient(cfg),
 		ERPSequence:             NewERPSequenceClient(cfg),
 		ERPSettlement:           NewERPSettlementClient(cfg),
+		ERPSettlementLine:       NewERPSettlementLineClient(cfg),
 		ERPShipmentDetail:       NewERPShipmentDetailClient(cfg),
 		ERPShipmentDetailItem:   NewERPShipmentDetailItemClient(cfg),
 		ERPStockBalance:         NewERPStockBalanceClient(cfg),
@@ -294,8 +354,15 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		AdminLoginAttempt:       NewAdminLoginAttemptClient(cfg),
+		AdminRecoveryCode:       NewAdminRecoveryCodeClient(cfg),
+		AdminRole:               NewAdminRoleClient(cfg),
+		AdminRolePermission:     NewAdminRolePermissionClient(cfg),
+		AdminSession:            NewAdminSessionClient(cfg),
 		AdminUser:               NewAdminUserClient(cfg),
+		AdminUserRole:           NewAdminUserRoleClient(cfg),
 		ERPAttachment:           NewERPAttachmentClient(cfg),
+		ERPAuditLog:             NewERPAuditLogClient(cfg),
 		ERPBankReceipt:          NewERPBankReceiptClient(cfg),
 		ERPBankReceiptClaim:     NewERPBankReceiptClaimClient(cfg),
 		ERPDocLink:              NewERPDocLinkClient(cfg),
@@ -308,13 +375,18 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ERPOutboundOrder:        NewERPOutboundOrderClient(cfg),
 		ERPOutboundOrderItem:    NewERPOutboundOrderItemClient(cfg),
 		ERPPartner:              NewERPPartnerClient(cfg),
+		ERPPartnerAddress:       NewERPPartnerAddressClient(cfg),
+		ERPPartnerBankAccount:   NewERPPartnerBankAccountClient(cfg),
+		ERPPartnerContact:       NewERPPartnerContactClient(cfg),
 		ERPProduct:              NewERPProductClient(cfg),
 		ERPPurchaseContract:     NewERPPurchaseContractClient(cfg),
 		ERPPurchaseContractItem: NewERPPurchaseContractItemClient(cfg),
 		ERPQuotation:            NewERPQuotationClient(cfg),
 		ERPQuotationItem:        NewERPQuotationItemClient(cfg),
+		ERPRecordRevision:       NewERPRecordRevisionClient(cfg),
 		ERPSequence:             NewERPSequenceClient(cfg),
 		ERPSettlement:           NewERPSettlementClient(cfg),
+		ERPSettlementLine:       NewERPSettlementLineClient(cfg),
 		ERPShipmentDetail:       NewERPShipmentDetailClient(cfg),
 		ERPShipmentDetailItem:   NewERPShipmentDetailItemClient(cfg),
 		ERPStockBalance:         NewERPStockBalanceClient(cfg),
@@ -330,7 +402,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AdminUser.
+//		AdminLoginAttempt.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -353,12 +425,15 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AdminUser, c.ERPAttachment, c.ERPBankReceipt, c.ERPBankReceiptClaim,
-		c.ERPDocLink, c.ERPExportSale, c.ERPExportSaleItem, c.ERPInboundNotice,
-		c.ERPInboundNoticeItem, c.ERPLocation, c.ERPModuleRecord, c.ERPOutboundOrder,
-		c.ERPOutboundOrderItem, c.ERPPartner, c.ERPProduct, c.ERPPurchaseContract,
-		c.ERPPurchaseContractItem, c.ERPQuotation, c.ERPQuotationItem, c.ERPSequence,
-		c.ERPSettlement, c.ERPShipmentDetail, c.ERPShipmentDetailItem,
+		c.AdminLoginAttempt, c.AdminRecoveryCode, c.AdminRole, c.AdminRolePermission,
+		c.AdminSession, c.AdminUser, c.AdminUserRole, c.ERPAttachment, c.ERPAuditLog,
+		c.ERPBankReceipt, c.ERPBankReceiptClaim, c.ERPDocLink, c.ERPExportSale,
+		c.ERPExportSaleItem, c.ERPInboundNotice, c.ERPInboundNoticeItem, c.ERPLocation,
+		c.ERPModuleRecord, c.ERPOutboundOrder, c.ERPOutboundOrderItem, c.ERPPartner,
+		c.ERPPartnerAddress, c.ERPPartnerBankAccount, c.ERPPartnerContact,
+		c.ERPProduct, c.ERPPurchaseContract, c.ERPPurchaseContractItem, c.ERPQuotation,
+		c.ERPQuotationItem, c.ERPRecordRevision, c.ERPSequence, c.ERPSettlement,
+		c.ERPSettlementLine, c.ERPShipmentDetail, c.ERPShipmentDetailItem,
 		c.ERPStockBalance, c.ERPStockTransaction, c.ERPWarehouse,
 		c.ERPWorkflowActionLog, c.ERPWorkflowInstance, c.ERPWorkflowTask, c.User,
 	} {
@@ -370,12 +445,15 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AdminUser, c.ERPAttachment, c.ERPBankReceipt, c.ERPBankReceiptClaim,
-		c.ERPDocLink, c.ERPExportSale, c.ERPExportSaleItem, c.ERPInboundNotice,
-		c.ERPInboundNoticeItem, c.ERPLocation, c.ERPModuleRecord, c.ERPOutboundOrder,
-		c.ERPOutboundOrderItem, c.ERPPartner, c.ERPProduct, c.ERPPurchaseContract,
-		c.ERPPurchaseContractItem, c.ERPQuotation, c.ERPQuotationItem, c.ERPSequence,
-		c.ERPSettlement, c.ERPShipmentDetail, c.ERPShipmentDetailItem,
+		c.AdminLoginAttempt, c.AdminRecoveryCode, c.AdminRole, c.AdminRolePermission,
+		c.AdminSession, c.AdminUser, c.AdminUserRole, c.ERPAttachment, c.ERPAuditLog,
+		c.ERPBankReceipt, c.ERPBankReceiptClaim, c.ERPDocLink, c.ERPExportSale,
+		c.ERPExportSaleItem, c.ERPInboundNotice, c.ERPInboundNoticeItem, c.ERPLocation,
+		c.ERPModuleRecord, c.ERPOutboundOrder, c.ERPOutboundOrderItem, c.ERPPartner,
+		c.ERPPartnerAddress, c.ERPPartnerBankAccount, c.ERPPartnerContact,
+		c.ERPProduct, c.ERPPurchaseContract, c.ERPPurchaseContractItem, c.ERPQuotation,
+		c.ERPQuotationItem, c.ERPRecordRevision, c.ERPSequence, c.ERPSettlement,
+		c.ERPSettlementLine, c.ERPShipmentDetail, c.ERPShipmentDetailItem,
 		c.ERPStockBalance, c.ERPStockTransaction, c.ERPWarehouse,
 		c.ERPWorkflowActionLog, c.ERPWorkflowInstance, c.ERPWorkflowTask, c.User,
 	} {
@@ -386,10 +464,24 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AdminLoginAttemptMutation:
+		return c.AdminLoginAttempt.mutate(ctx, m)
+	case *AdminRecoveryCodeMutation:
+		return c.AdminRecoveryCode.mutate(ctx, m)
+	case *AdminRoleMutation:
+		return c.AdminRole.mutate(ctx, m)
+	case *AdminRolePermissionMutation:
+		return c.AdminRolePermission.mutate(ctx, m)
+	case *AdminSessionMutation:
+		return c.AdminSession.mutate(ctx, m)
 	case *AdminUserMutation:
 		return c.AdminUser.mutate(ctx, m)
+	case *AdminUserRoleMutation:
+		return c.AdminUserRole.mutate(ctx, m)
 	case *ERPAttachmentMutation:
 		return c.ERPAttachment.mutate(ctx, m)
+	case *ERPAuditLogMutation:
+		return c.ERPAuditLog.mutate(ctx, m)
 	case *ERPBankReceiptMutation:
 		return c.ERPBankReceipt.mutate(ctx, m)
 	case *ERPBankReceiptClaimMutation:
@@ -414,6 +506,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ERPOutboundOrderItem.mutate(ctx, m)
 	case *ERPPartnerMutation:
 		return c.ERPPartner.mutate(ctx, m)
+	case *ERPPartnerAddressMutation:
+		return c.ERPPartnerAddress.mutate(ctx, m)
+	case *ERPPartnerBankAccountMutation:
+		return c.ERPPartnerBankAccount.mutate(ctx, m)
+	case *ERPPartnerContactMutation:
+		return c.ERPPartnerContact.mutate(ctx, m)
 	case *ERPProductMutation:
 		return c.ERPProduct.mutate(ctx, m)
 	case *ERPPurchaseContractMutation:
@@ -424,10 +522,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ERPQuotation.mutate(ctx, m)
 	case *ERPQuotationItemMutation:
 		return c.ERPQuotationItem.mutate(ctx, m)
+	case *ERPRecordRevisionMutation:
+		return c.ERPRecordRevision.mutate(ctx, m)
 	case *ERPSequenceMutation:
 		return c.ERPSequence.mutate(ctx, m)
 	case *ERPSettlementMutation:
 		return c.ERPSettlement.mutate(ctx, m)
+	case *ERPSettlementLineMutation:
+		return c.ERPSettlementLine.mutate(ctx, m)
 	case *ERPShipmentDetailMutation:
 		return c.ERPShipmentDetail.mutate(ctx, m)
 	case *ERPShipmentDetailItemMutation:
@@ -451,107 +553,107 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
-// AdminUserClient is a client for the AdminUser schema.
-type AdminUserClient struct {
+// AdminLoginAttemptClient is a client for the AdminLoginAttempt schema.
+type AdminLoginAttemptClient struct {
 	config
 }
 
-// NewAdminUserClient returns a client for the AdminUser from the given config.
-func NewAdminUserClient(c config) *AdminUserClient {
-	return &AdminUserClient{config: c}
+// NewAdminLoginAttemptClient returns a client for the AdminLoginAttempt from the given config.
+func NewAdminLoginAttemptClient(c config) *AdminLoginAttemptClient {
+	return &AdminLoginAttemptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `adminuser.Hooks(f(g(h())))`.
-func (c *AdminUserClient) Use(hooks ...Hook) {
-	c.hooks.AdminUser = append(c.hooks.AdminUser, hooks...)
+// A call to `Use(f, g, h)` equals to `adminloginattempt.Hooks(f(g(h())))`.
+func (c *AdminLoginAttemptClient) Use(hooks ...Hook) {
+	c.hooks.AdminLoginAttempt = append(c.hooks.AdminLoginAttempt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `adminuser.Intercept(f(g(h())))`.
-func (c *AdminUserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.AdminUser = append(c.inters.AdminUser, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminloginattempt.Intercept(f(g(h())))`.
+func (c *AdminLoginAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminLoginAttempt = append(c.inters.AdminLoginAttempt, interceptors...)
 }
 
-// Create returns a builder for creating a AdminUser entity.
-func (c *AdminUserClient) Create() *AdminUserCreate {
-	mutation := newAdminUserMutation(c.config, OpCreate)
-	return &AdminUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminLoginAttempt entity.
+func (c *AdminLoginAttemptClient) Create() *AdminLoginAttemptCreate {
+	mutation := newAdminLoginAttemptMutation(c.config, OpCreate)
+	return &AdminLoginAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of AdminUser entities.
-func (c *AdminUserClient) CreateBulk(builders ...*AdminUserCreate) *AdminUserCreateBulk {
-	return &AdminUserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminLoginAttempt entities.
+func (c *AdminLoginAttemptClient) CreateBulk(builders ...*AdminLoginAttemptCreate) *AdminLoginAttemptCreateBulk {
+	return &AdminLoginAttemptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *AdminUserClient) MapCreateBulk(slice any, setFunc func(*AdminUserCreate, int)) *AdminUserCreateBulk {
+func (c *AdminLoginAttemptClient) MapCreateBulk(slice any, setFunc func(*AdminLoginAttemptCreate, int)) *AdminLoginAttemptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &AdminUserCreateBulk{err: fmt.Errorf("calling to AdminUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminLoginAttemptCreateBulk{err: fmt.Errorf("calling to AdminLoginAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*AdminUserCreate, rv.Len())
+	builders := make([]*AdminLoginAttemptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &AdminUserCreateBulk{config: c.config, builders: builders}
+	return &AdminLoginAttemptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for AdminUser.
-func (c *AdminUserClient) Update() *AdminUserUpdate {
-	mutation := newAdminUserMutation(c.config, OpUpdate)
-	return &AdminUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminLoginAttempt.
+func (c *AdminLoginAttemptClient) Update() *AdminLoginAttemptUpdate {
+	mutation := newAdminLoginAttemptMutation(c.config, OpUpdate)
+	return &AdminLoginAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *AdminUserClient) UpdateOne(_m *AdminUser) *AdminUserUpdateOne {
-	mutation := newAdminUserMutation(c.config, OpUpdateOne, withAdminUser(_m))
-	return &AdminUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminLoginAttemptClient) UpdateOne(_m *AdminLoginAttempt) *AdminLoginAttemptUpdateOne {
+	mutation := newAdminLoginAttemptMutation(c.config, OpUpdateOne, withAdminLoginAttempt(_m))
+	return &AdminLoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *AdminUserClient) UpdateOneID(id int) *AdminUserUpdateOne {
-	mutation := newAdminUserMutation(c.config, OpUpdateOne, withAdminUserID(id))
-	return &AdminUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminLoginAttemptClient) UpdateOneID(id int) *AdminLoginAttemptUpdateOne {
+	mutation := newAdminLoginAttemptMutation(c.config, OpUpdateOne, withAdminLoginAttemptID(id))
+	return &AdminLoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for AdminUser.
-func (c *AdminUserClient) Delete() *AdminUserDelete {
-	mutation := newAdminUserMutation(c.config, OpDelete)
-	return &AdminUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminLoginAttempt.
+func (c *AdminLoginAttemptClient) Delete() *AdminLoginAttemptDelete {
+	mutation := newAdminLoginAttemptMutation(c.config, OpDelete)
+	return &AdminLoginAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *AdminUserClient) DeleteOne(_m *AdminUser) *AdminUserDeleteOne {
+func (c *AdminLoginAttemptClient) DeleteOne(_m *AdminLoginAttempt) *AdminLoginAttemptDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *AdminUserClient) DeleteOneID(id int) *AdminUserDeleteOne {
-	builder := c.Delete().Where(adminuser.ID(id))
+func (c *AdminLoginAttemptClient) DeleteOneID(id int) *AdminLoginAttemptDeleteOne {
+	builder := c.Delete().Where(adminloginattempt.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &AdminUserDeleteOne{builder}
+	return &AdminLoginAttemptDeleteOne{builder}
 }
 
-// Query returns a query builder for AdminUser.
-func (c *AdminUserClient) Query() *AdminUserQuery {
-	return &AdminUserQuery{
+// Query returns a query builder for AdminLoginAttempt.
+func (c *AdminLoginAttemptClient) Query() *AdminLoginAttemptQuery {
+	return &AdminLoginAttemptQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeAdminUser},
+		ctx:    &QueryContext{Type: TypeAdminLoginAttempt},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a AdminUser entity by its id.
-func (c *AdminUserClient) Get(ctx context.Context, id int) (*AdminUser, error) {
-	return c.Query().Where(adminuser.ID(id)).Only(ctx)
+// Get returns a AdminLoginAttempt entity by its id.
+func (c *AdminLoginAttemptClient) Get(ctx context.Context, id int) (*AdminLoginAttempt, error) {
+	return c.Query().Where(adminloginattempt.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *AdminUserClient) GetX(ctx context.Context, id int) *AdminUser {
+func (c *AdminLoginAttemptClient) GetX(ctx context.Context, id int) *AdminLoginAttempt {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -560,131 +662,131 @@ func (c *AdminUserClient) GetX(ctx context.Context, id int) *AdminUser {
 }
 
 // Hooks returns the client hooks.
-func (c *AdminUserClient) Hooks() []Hook {
-	return c.hooks.AdminUser
+func (c *AdminLoginAttemptClient) Hooks() []Hook {
+	return c.hooks.AdminLoginAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *AdminUserClient) Interceptors() []Interceptor {
-	return c.inters.AdminUser
+func (c *AdminLoginAttemptClient) Interceptors() []Interceptor {
+	return c.inters.AdminLoginAttempt
 }
 
-func (c *AdminUserClient) mutate(ctx context.Context, m *AdminUserMutation) (Value, error) {
+func (c *AdminLoginAttemptClient) mutate(ctx context.Context, m *AdminLoginAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&AdminUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminLoginAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&AdminUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminLoginAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&AdminUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminLoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&AdminUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminLoginAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown AdminUser mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminLoginAttempt mutation op: %q", m.Op())
 	}
 }
 
-// ERPAttachmentClient is a client for the ERPAttachment schema.
-type ERPAttachmentClient struct {
+// AdminRecoveryCodeClient is a client for the AdminRecoveryCode schema.
+type AdminRecoveryCodeClient struct {
 	config
 }
 
-// NewERPAttachmentClient returns a client for the ERPAttachment from the given config.
-func NewERPAttachmentClient(c config) *ERPAttachmentClient {
-	return &ERPAttachmentClient{config: c}
+// NewAdminRecoveryCodeClient returns a client for the AdminRecoveryCode from the given config.
+func NewAdminRecoveryCodeClient(c config) *AdminRecoveryCodeClient {
+	return &AdminRecoveryCodeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpattachment.Hooks(f(g(h())))`.
-func (c *ERPAttachmentClient) Use(hooks ...Hook) {
-	c.hooks.ERPAttachment = append(c.hooks.ERPAttachment, hooks...)
+// A call to `Use(f, g, h)` equals to `adminrecoverycode.Hooks(f(g(h())))`.
+func (c *AdminRecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.AdminRecoveryCode = append(c.hooks.AdminRecoveryCode, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpattachment.Intercept(f(g(h())))`.
-func (c *ERPAttachmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPAttachment = append(c.inters.ERPAttachment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminrecoverycode.Intercept(f(g(h())))`.
+func (c *AdminRecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminRecoveryCode = append(c.inters.AdminRecoveryCode, interceptors...)
 }
 
-// Create returns a builder for creating a ERPAttachment entity.
-func (c *ERPAttachmentClient) Create() *ERPAttachmentCreate {
-	mutation := newERPAttachmentMutation(c.config, OpCreate)
-	return &ERPAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminRecoveryCode entity.
+func (c *AdminRecoveryCodeClient) Create() *AdminRecoveryCodeCreate {
+	mutation := newAdminRecoveryCodeMutation(c.config, OpCreate)
+	return &AdminRecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPAttachment entities.
-func (c *ERPAttachmentClient) CreateBulk(builders ...*ERPAttachmentCreate) *ERPAttachmentCreateBulk {
-	return &ERPAttachmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminRecoveryCode entities.
+func (c *AdminRecoveryCodeClient) CreateBulk(builders ...*AdminRecoveryCodeCreate) *AdminRecoveryCodeCreateBulk {
+	return &AdminRecoveryCodeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPAttachmentClient) MapCreateBulk(slice any, setFunc func(*ERPAttachmentCreate, int)) *ERPAttachmentCreateBulk {
+func (c *AdminRecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*AdminRecoveryCodeCreate, int)) *AdminRecoveryCodeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPAttachmentCreateBulk{err: fmt.Errorf("calling to ERPAttachmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminRecoveryCodeCreateBulk{err: fmt.Errorf("calling to AdminRecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPAttachmentCreate, rv.Len())
+	builders := make([]*AdminRecoveryCodeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPAttachmentCreateBulk{config: c.config, builders: builders}
+	return &AdminRecoveryCodeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPAttachment.
-func (c *ERPAttachmentClient) Update() *ERPAttachmentUpdate {
-	mutation := newERPAttachmentMutation(c.config, OpUpdate)
-	return &ERPAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminRecoveryCode.
+func (c *AdminRecoveryCodeClient) Update() *AdminRecoveryCodeUpdate {
+	mutation := newAdminRecoveryCodeMutation(c.config, OpUpdate)
+	return &AdminRecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPAttachmentClient) UpdateOne(_m *ERPAttachment) *ERPAttachmentUpdateOne {
-	mutation := newERPAttachmentMutation(c.config, OpUpdateOne, withERPAttachment(_m))
-	return &ERPAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminRecoveryCodeClient) UpdateOne(_m *AdminRecoveryCode) *AdminRecoveryCodeUpdateOne {
+	mutation := newAdminRecoveryCodeMutation(c.config, OpUpdateOne, withAdminRecoveryCode(_m))
+	return &AdminRecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPAttachmentClient) UpdateOneID(id int) *ERPAttachmentUpdateOne {
-	mutation := newERPAttachmentMutation(c.config, OpUpdateOne, withERPAttachmentID(id))
-	return &ERPAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminRecoveryCodeClient) UpdateOneID(id int) *AdminRecoveryCodeUpdateOne {
+	mutation := newAdminRecoveryCodeMutation(c.config, OpUpdateOne, withAdminRecoveryCodeID(id))
+	return &AdminRecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPAttachment.
-func (c *ERPAttachmentClient) Delete() *ERPAttachmentDelete {
-	mutation := newERPAttachmentMutation(c.config, OpDelete)
-	return &ERPAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminRecoveryCode.
+func (c *AdminRecoveryCodeClient) Delete() *AdminRecoveryCodeDelete {
+	mutation := newAdminRecoveryCodeMutation(c.config, OpDelete)
+	return &AdminRecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPAttachmentClient) DeleteOne(_m *ERPAttachment) *ERPAttachmentDeleteOne {
+func (c *AdminRecoveryCodeClient) DeleteOne(_m *AdminRecoveryCode) *AdminRecoveryCodeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPAttachmentClient) DeleteOneID(id int) *ERPAttachmentDeleteOne {
-	builder := c.Delete().Where(erpattachment.ID(id))
+func (c *AdminRecoveryCodeClient) DeleteOneID(id int) *AdminRecoveryCodeDeleteOne {
+	builder := c.Delete().Where(adminrecoverycode.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPAttachmentDeleteOne{builder}
+	return &AdminRecoveryCodeDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPAttachment.
-func (c *ERPAttachmentClient) Query() *ERPAttachmentQuery {
-	return &ERPAttachmentQuery{
+// Query returns a query builder for AdminRecoveryCode.
+func (c *AdminRecoveryCodeClient) Query() *AdminRecoveryCodeQuery {
+	return &AdminRecoveryCodeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPAttachment},
+		ctx:    &QueryContext{Type: TypeAdminRecoveryCode},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPAttachment entity by its id.
-func (c *ERPAttachmentClient) Get(ctx context.Context, id int) (*ERPAttachment, error) {
-	return c.Query().Where(erpattachment.ID(id)).Only(ctx)
+// Get returns a AdminRecoveryCode entity by its id.
+func (c *AdminRecoveryCodeClient) Get(ctx context.Context, id int) (*AdminRecoveryCode, error) {
+	return c.Query().Where(adminrecoverycode.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPAttachmentClient) GetX(ctx context.Context, id int) *ERPAttachment {
+func (c *AdminRecoveryCodeClient) GetX(ctx context.Context, id int) *AdminRecoveryCode {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -693,131 +795,131 @@ func (c *ERPAttachmentClient) GetX(ctx context.Context, id int) *ERPAttachment {
 }
 
 // Hooks returns the client hooks.
-func (c *ERPAttachmentClient) Hooks() []Hook {
-	return c.hooks.ERPAttachment
+func (c *AdminRecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.AdminRecoveryCode
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPAttachmentClient) Interceptors() []Interceptor {
-	return c.inters.ERPAttachment
+func (c *AdminRecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.AdminRecoveryCode
 }
 
-func (c *ERPAttachmentClient) mutate(ctx context.Context, m *ERPAttachmentMutation) (Value, error) {
+func (c *AdminRecoveryCodeClient) mutate(ctx context.Context, m *AdminRecoveryCodeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminRecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPAttachment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminRecoveryCode mutation op: %q", m.Op())
 	}
 }
 
-// ERPBankReceiptClient is a client for the ERPBankReceipt schema.
-type ERPBankReceiptClient struct {
+// AdminRoleClient is a client for the AdminRole schema.
+type AdminRoleClient struct {
 	config
 }
 
-// NewERPBankReceiptClient returns a client for the ERPBankReceipt from the given config.
-func NewERPBankReceiptClient(c config) *ERPBankReceiptClient {
-	return &ERPBankReceiptClient{config: c}
+// NewAdminRoleClient returns a client for the AdminRole from the given config.
+func NewAdminRoleClient(c config) *AdminRoleClient {
+	return &AdminRoleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpbankreceipt.Hooks(f(g(h())))`.
-func (c *ERPBankReceiptClient) Use(hooks ...Hook) {
-	c.hooks.ERPBankReceipt = append(c.hooks.ERPBankReceipt, hooks...)
+// A call to `Use(f, g, h)` equals to `adminrole.Hooks(f(g(h())))`.
+func (c *AdminRoleClient) Use(hooks ...Hook) {
+	c.hooks.AdminRole = append(c.hooks.AdminRole, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpbankreceipt.Intercept(f(g(h())))`.
-func (c *ERPBankReceiptClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPBankReceipt = append(c.inters.ERPBankReceipt, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminrole.Intercept(f(g(h())))`.
+func (c *AdminRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminRole = append(c.inters.AdminRole, interceptors...)
 }
 
-// Create returns a builder for creating a ERPBankReceipt entity.
-func (c *ERPBankReceiptClient) Create() *ERPBankReceiptCreate {
-	mutation := newERPBankReceiptMutation(c.config, OpCreate)
-	return &ERPBankReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminRole entity.
+func (c *AdminRoleClient) Create() *AdminRoleCreate {
+	mutation := newAdminRoleMutation(c.config, OpCreate)
+	return &AdminRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPBankReceipt entities.
-func (c *ERPBankReceiptClient) CreateBulk(builders ...*ERPBankReceiptCreate) *ERPBankReceiptCreateBulk {
-	return &ERPBankReceiptCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminRole entities.
+func (c *AdminRoleClient) CreateBulk(builders ...*AdminRoleCreate) *AdminRoleCreateBulk {
+	return &AdminRoleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPBankReceiptClient) MapCreateBulk(slice any, setFunc func(*ERPBankReceiptCreate, int)) *ERPBankReceiptCreateBulk {
+func (c *AdminRoleClient) MapCreateBulk(slice any, setFunc func(*AdminRoleCreate, int)) *AdminRoleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPBankReceiptCreateBulk{err: fmt.Errorf("calling to ERPBankReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminRoleCreateBulk{err: fmt.Errorf("calling to AdminRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPBankReceiptCreate, rv.Len())
+	builders := make([]*AdminRoleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPBankReceiptCreateBulk{config: c.config, builders: builders}
+	return &AdminRoleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPBankReceipt.
-func (c *ERPBankReceiptClient) Update() *ERPBankReceiptUpdate {
-	mutation := newERPBankReceiptMutation(c.config, OpUpdate)
-	return &ERPBankReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminRole.
+func (c *AdminRoleClient) Update() *AdminRoleUpdate {
+	mutation := newAdminRoleMutation(c.config, OpUpdate)
+	return &AdminRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPBankReceiptClient) UpdateOne(_m *ERPBankReceipt) *ERPBankReceiptUpdateOne {
-	mutation := newERPBankReceiptMutation(c.config, OpUpdateOne, withERPBankReceipt(_m))
-	return &ERPBankReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminRoleClient) UpdateOne(_m *AdminRole) *AdminRoleUpdateOne {
+	mutation := newAdminRoleMutation(c.config, OpUpdateOne, withAdminRole(_m))
+	return &AdminRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPBankReceiptClient) UpdateOneID(id int) *ERPBankReceiptUpdateOne {
-	mutation := newERPBankReceiptMutation(c.config, OpUpdateOne, withERPBankReceiptID(id))
-	return &ERPBankReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminRoleClient) UpdateOneID(id int) *AdminRoleUpdateOne {
+	mutation := newAdminRoleMutation(c.config, OpUpdateOne, withAdminRoleID(id))
+	return &AdminRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPBankReceipt.
-func (c *ERPBankReceiptClient) Delete() *ERPBankReceiptDelete {
-	mutation := newERPBankReceiptMutation(c.config, OpDelete)
-	return &ERPBankReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminRole.
+func (c *AdminRoleClient) Delete() *AdminRoleDelete {
+	mutation := newAdminRoleMutation(c.config, OpDelete)
+	return &AdminRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPBankReceiptClient) DeleteOne(_m *ERPBankReceipt) *ERPBankReceiptDeleteOne {
+func (c *AdminRoleClient) DeleteOne(_m *AdminRole) *AdminRoleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPBankReceiptClient) DeleteOneID(id int) *ERPBankReceiptDeleteOne {
-	builder := c.Delete().Where(erpbankreceipt.ID(id))
+func (c *AdminRoleClient) DeleteOneID(id int) *AdminRoleDeleteOne {
+	builder := c.Delete().Where(adminrole.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPBankReceiptDeleteOne{builder}
+	return &AdminRoleDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPBankReceipt.
-func (c *ERPBankReceiptClient) Query() *ERPBankReceiptQuery {
-	return &ERPBankReceiptQuery{
+// Query returns a query builder for AdminRole.
+func (c *AdminRoleClient) Query() *AdminRoleQuery {
+	return &AdminRoleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPBankReceipt},
+		ctx:    &QueryContext{Type: TypeAdminRole},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPBankReceipt entity by its id.
-func (c *ERPBankReceiptClient) Get(ctx context.Context, id int) (*ERPBankReceipt, error) {
-	return c.Query().Where(erpbankreceipt.ID(id)).Only(ctx)
+// Get returns a AdminRole entity by its id.
+func (c *AdminRoleClient) Get(ctx context.Context, id int) (*AdminRole, error) {
+	return c.Query().Where(adminrole.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPBankReceiptClient) GetX(ctx context.Context, id int) *ERPBankReceipt {
+func (c *AdminRoleClient) GetX(ctx context.Context, id int) *AdminRole {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -826,131 +928,131 @@ func (c *ERPBankReceiptClient) GetX(ctx context.Context, id int) *ERPBankReceipt
 }
 
 // Hooks returns the client hooks.
-func (c *ERPBankReceiptClient) Hooks() []Hook {
-	return c.hooks.ERPBankReceipt
+func (c *AdminRoleClient) Hooks() []Hook {
+	return c.hooks.AdminRole
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPBankReceiptClient) Interceptors() []Interceptor {
-	return c.inters.ERPBankReceipt
+func (c *AdminRoleClient) Interceptors() []Interceptor {
+	return c.inters.AdminRole
 }
 
-func (c *ERPBankReceiptClient) mutate(ctx context.Context, m *ERPBankReceiptMutation) (Value, error) {
+func (c *AdminRoleClient) mutate(ctx context.Context, m *AdminRoleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPBankReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPBankReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPBankReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPBankReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPBankReceipt mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminRole mutation op: %q", m.Op())
 	}
 }
 
-// ERPBankReceiptClaimClient is a client for the ERPBankReceiptClaim schema.
-type ERPBankReceiptClaimClient struct {
+// AdminRolePermissionClient is a client for the AdminRolePermission schema.
+type AdminRolePermissionClient struct {
 	config
 }
 
-// NewERPBankReceiptClaimClient returns a client for the ERPBankReceiptClaim from the given config.
-func NewERPBankReceiptClaimClient(c config) *ERPBankReceiptClaimClient {
-	return &ERPBankReceiptClaimClient{config: c}
+// NewAdminRolePermissionClient returns a client for the AdminRolePermission from the given config.
+func NewAdminRolePermissionClient(c config) *AdminRolePermissionClient {
+	return &AdminRolePermissionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpbankreceiptclaim.Hooks(f(g(h())))`.
-func (c *ERPBankReceiptClaimClient) Use(hooks ...Hook) {
-	c.hooks.ERPBankReceiptClaim = append(c.hooks.ERPBankReceiptClaim, hooks...)
+// A call to `Use(f, g, h)` equals to `adminrolepermission.Hooks(f(g(h())))`.
+func (c *AdminRolePermissionClient) Use(hooks ...Hook) {
+	c.hooks.AdminRolePermission = append(c.hooks.AdminRolePermission, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpbankreceiptclaim.Intercept(f(g(h())))`.
-func (c *ERPBankReceiptClaimClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPBankReceiptClaim = append(c.inters.ERPBankReceiptClaim, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminrolepermission.Intercept(f(g(h())))`.
+func (c *AdminRolePermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminRolePermission = append(c.inters.AdminRolePermission, interceptors...)
 }
 
-// Create returns a builder for creating a ERPBankReceiptClaim entity.
-func (c *ERPBankReceiptClaimClient) Create() *ERPBankReceiptClaimCreate {
-	mutation := newERPBankReceiptClaimMutation(c.config, OpCreate)
-	return &ERPBankReceiptClaimCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminRolePermission entity.
+func (c *AdminRolePermissionClient) Create() *AdminRolePermissionCreate {
+	mutation := newAdminRolePermissionMutation(c.config, OpCreate)
+	return &AdminRolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPBankReceiptClaim entities.
-func (c *ERPBankReceiptClaimClient) CreateBulk(builders ...*ERPBankReceiptClaimCreate) *ERPBankReceiptClaimCreateBulk {
-	return &ERPBankReceiptClaimCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminRolePermission entities.
+func (c *AdminRolePermissionClient) CreateBulk(builders ...*AdminRolePermissionCreate) *AdminRolePermissionCreateBulk {
+	return &AdminRolePermissionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPBankReceiptClaimClient) MapCreateBulk(slice any, setFunc func(*ERPBankReceiptClaimCreate, int)) *ERPBankReceiptClaimCreateBulk {
+func (c *AdminRolePermissionClient) MapCreateBulk(slice any, setFunc func(*AdminRolePermissionCreate, int)) *AdminRolePermissionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPBankReceiptClaimCreateBulk{err: fmt.Errorf("calling to ERPBankReceiptClaimClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminRolePermissionCreateBulk{err: fmt.Errorf("calling to AdminRolePermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPBankReceiptClaimCreate, rv.Len())
+	builders := make([]*AdminRolePermissionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPBankReceiptClaimCreateBulk{config: c.config, builders: builders}
+	return &AdminRolePermissionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPBankReceiptClaim.
-func (c *ERPBankReceiptClaimClient) Update() *ERPBankReceiptClaimUpdate {
-	mutation := newERPBankReceiptClaimMutation(c.config, OpUpdate)
-	return &ERPBankReceiptClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminRolePermission.
+func (c *AdminRolePermissionClient) Update() *AdminRolePermissionUpdate {
+	mutation := newAdminRolePermissionMutation(c.config, OpUpdate)
+	return &AdminRolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPBankReceiptClaimClient) UpdateOne(_m *ERPBankReceiptClaim) *ERPBankReceiptClaimUpdateOne {
-	mutation := newERPBankReceiptClaimMutation(c.config, OpUpdateOne, withERPBankReceiptClaim(_m))
-	return &ERPBankReceiptClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminRolePermissionClient) UpdateOne(_m *AdminRolePermission) *AdminRolePermissionUpdateOne {
+	mutation := newAdminRolePermissionMutation(c.config, OpUpdateOne, withAdminRolePermission(_m))
+	return &AdminRolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPBankReceiptClaimClient) UpdateOneID(id int) *ERPBankReceiptClaimUpdateOne {
-	mutation := newERPBankReceiptClaimMutation(c.config, OpUpdateOne, withERPBankReceiptClaimID(id))
-	return &ERPBankReceiptClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminRolePermissionClient) UpdateOneID(id int) *AdminRolePermissionUpdateOne {
+	mutation := newAdminRolePermissionMutation(c.config, OpUpdateOne, withAdminRolePermissionID(id))
+	return &AdminRolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPBankReceiptClaim.
-func (c *ERPBankReceiptClaimClient) Delete() *ERPBankReceiptClaimDelete {
-	mutation := newERPBankReceiptClaimMutation(c.config, OpDelete)
-	return &ERPBankReceiptClaimDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminRolePermission.
+func (c *AdminRolePermissionClient) Delete() *AdminRolePermissionDelete {
+	mutation := newAdminRolePermissionMutation(c.config, OpDelete)
+	return &AdminRolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPBankReceiptClaimClient) DeleteOne(_m *ERPBankReceiptClaim) *ERPBankReceiptClaimDeleteOne {
+func (c *AdminRolePermissionClient) DeleteOne(_m *AdminRolePermission) *AdminRolePermissionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPBankReceiptClaimClient) DeleteOneID(id int) *ERPBankReceiptClaimDeleteOne {
-	builder := c.Delete().Where(erpbankreceiptclaim.ID(id))
+func (c *AdminRolePermissionClient) DeleteOneID(id int) *AdminRolePermissionDeleteOne {
+	builder := c.Delete().Where(adminrolepermission.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPBankReceiptClaimDeleteOne{builder}
+	return &AdminRolePermissionDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPBankReceiptClaim.
-func (c *ERPBankReceiptClaimClient) Query() *ERPBankReceiptClaimQuery {
-	return &ERPBankReceiptClaimQuery{
+// Query returns a query builder for AdminRolePermission.
+func (c *AdminRolePermissionClient) Query() *AdminRolePermissionQuery {
+	return &AdminRolePermissionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPBankReceiptClaim},
+		ctx:    &QueryContext{Type: TypeAdminRolePermission},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPBankReceiptClaim entity by its id.
-func (c *ERPBankReceiptClaimClient) Get(ctx context.Context, id int) (*ERPBankReceiptClaim, error) {
-	return c.Query().Where(erpbankreceiptclaim.ID(id)).Only(ctx)
+// Get returns a AdminRolePermission entity by its id.
+func (c *AdminRolePermissionClient) Get(ctx context.Context, id int) (*AdminRolePermission, error) {
+	return c.Query().Where(adminrolepermission.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPBankReceiptClaimClient) GetX(ctx context.Context, id int) *ERPBankReceiptClaim {
+func (c *AdminRolePermissionClient) GetX(ctx context.Context, id int) *AdminRolePermission {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -959,131 +1061,131 @@ func (c *ERPBankReceiptClaimClient) GetX(ctx context.Context, id int) *ERPBankRe
 }
 
 // Hooks returns the client hooks.
-func (c *ERPBankReceiptClaimClient) Hooks() []Hook {
-	return c.hooks.ERPBankReceiptClaim
+func (c *AdminRolePermissionClient) Hooks() []Hook {
+	return c.hooks.AdminRolePermission
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPBankReceiptClaimClient) Interceptors() []Interceptor {
-	return c.inters.ERPBankReceiptClaim
+func (c *AdminRolePermissionClient) Interceptors() []Interceptor {
+	return c.inters.AdminRolePermission
 }
 
-func (c *ERPBankReceiptClaimClient) mutate(ctx context.Context, m *ERPBankReceiptClaimMutation) (Value, error) {
+func (c *AdminRolePermissionClient) mutate(ctx context.Context, m *AdminRolePermissionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPBankReceiptClaimCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPBankReceiptClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPBankReceiptClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPBankReceiptClaimDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminRolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPBankReceiptClaim mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminRolePermission mutation op: %q", m.Op())
 	}
 }
 
-// ERPDocLinkClient is a client for the ERPDocLink schema.
-type ERPDocLinkClient struct {
+// AdminSessionClient is a client for the AdminSession schema.
+type AdminSessionClient struct {
 	config
 }
 
-// NewERPDocLinkClient returns a client for the ERPDocLink from the given config.
-func NewERPDocLinkClient(c config) *ERPDocLinkClient {
-	return &ERPDocLinkClient{config: c}
+// NewAdminSessionClient returns a client for the AdminSession from the given config.
+func NewAdminSessionClient(c config) *AdminSessionClient {
+	return &AdminSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpdoclink.Hooks(f(g(h())))`.
-func (c *ERPDocLinkClient) Use(hooks ...Hook) {
-	c.hooks.ERPDocLink = append(c.hooks.ERPDocLink, hooks...)
+// A call to `Use(f, g, h)` equals to `adminsession.Hooks(f(g(h())))`.
+func (c *AdminSessionClient) Use(hooks ...Hook) {
+	c.hooks.AdminSession = append(c.hooks.AdminSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpdoclink.Intercept(f(g(h())))`.
-func (c *ERPDocLinkClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPDocLink = append(c.inters.ERPDocLink, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminsession.Intercept(f(g(h())))`.
+func (c *AdminSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminSession = append(c.inters.AdminSession, interceptors...)
 }
 
-// Create returns a builder for creating a ERPDocLink entity.
-func (c *ERPDocLinkClient) Create() *ERPDocLinkCreate {
-	mutation := newERPDocLinkMutation(c.config, OpCreate)
-	return &ERPDocLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminSession entity.
+func (c *AdminSessionClient) Create() *AdminSessionCreate {
+	mutation := newAdminSessionMutation(c.config, OpCreate)
+	return &AdminSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPDocLink entities.
-func (c *ERPDocLinkClient) CreateBulk(builders ...*ERPDocLinkCreate) *ERPDocLinkCreateBulk {
-	return &ERPDocLinkCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminSession entities.
+func (c *AdminSessionClient) CreateBulk(builders ...*AdminSessionCreate) *AdminSessionCreateBulk {
+	return &AdminSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPDocLinkClient) MapCreateBulk(slice any, setFunc func(*ERPDocLinkCreate, int)) *ERPDocLinkCreateBulk {
+func (c *AdminSessionClient) MapCreateBulk(slice any, setFunc func(*AdminSessionCreate, int)) *AdminSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPDocLinkCreateBulk{err: fmt.Errorf("calling to ERPDocLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminSessionCreateBulk{err: fmt.Errorf("calling to AdminSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPDocLinkCreate, rv.Len())
+	builders := make([]*AdminSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPDocLinkCreateBulk{config: c.config, builders: builders}
+	return &AdminSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPDocLink.
-func (c *ERPDocLinkClient) Update() *ERPDocLinkUpdate {
-	mutation := newERPDocLinkMutation(c.config, OpUpdate)
-	return &ERPDocLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminSession.
+func (c *AdminSessionClient) Update() *AdminSessionUpdate {
+	mutation := newAdminSessionMutation(c.config, OpUpdate)
+	return &AdminSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPDocLinkClient) UpdateOne(_m *ERPDocLink) *ERPDocLinkUpdateOne {
-	mutation := newERPDocLinkMutation(c.config, OpUpdateOne, withERPDocLink(_m))
-	return &ERPDocLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminSessionClient) UpdateOne(_m *AdminSession) *AdminSessionUpdateOne {
+	mutation := newAdminSessionMutation(c.config, OpUpdateOne, withAdminSession(_m))
+	return &AdminSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPDocLinkClient) UpdateOneID(id int) *ERPDocLinkUpdateOne {
-	mutation := newERPDocLinkMutation(c.config, OpUpdateOne, withERPDocLinkID(id))
-	return &ERPDocLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminSessionClient) UpdateOneID(id int) *AdminSessionUpdateOne {
+	mutation := newAdminSessionMutation(c.config, OpUpdateOne, withAdminSessionID(id))
+	return &AdminSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPDocLink.
-func (c *ERPDocLinkClient) Delete() *ERPDocLinkDelete {
-	mutation := newERPDocLinkMutation(c.config, OpDelete)
-	return &ERPDocLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminSession.
+func (c *AdminSessionClient) Delete() *AdminSessionDelete {
+	mutation := newAdminSessionMutation(c.config, OpDelete)
+	return &AdminSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPDocLinkClient) DeleteOne(_m *ERPDocLink) *ERPDocLinkDeleteOne {
+func (c *AdminSessionClient) DeleteOne(_m *AdminSession) *AdminSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPDocLinkClient) DeleteOneID(id int) *ERPDocLinkDeleteOne {
-	builder := c.Delete().Where(erpdoclink.ID(id))
+func (c *AdminSessionClient) DeleteOneID(id int) *AdminSessionDeleteOne {
+	builder := c.Delete().Where(adminsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPDocLinkDeleteOne{builder}
+	return &AdminSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPDocLink.
-func (c *ERPDocLinkClient) Query() *ERPDocLinkQuery {
-	return &ERPDocLinkQuery{
+// Query returns a query builder for AdminSession.
+func (c *AdminSessionClient) Query() *AdminSessionQuery {
+	return &AdminSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPDocLink},
+		ctx:    &QueryContext{Type: TypeAdminSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPDocLink entity by its id.
-func (c *ERPDocLinkClient) Get(ctx context.Context, id int) (*ERPDocLink, error) {
-	return c.Query().Where(erpdoclink.ID(id)).Only(ctx)
+// Get returns a AdminSession entity by its id.
+func (c *AdminSessionClient) Get(ctx context.Context, id int) (*AdminSession, error) {
+	return c.Query().Where(adminsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPDocLinkClient) GetX(ctx context.Context, id int) *ERPDocLink {
+func (c *AdminSessionClient) GetX(ctx context.Context, id int) *AdminSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1092,131 +1194,131 @@ func (c *ERPDocLinkClient) GetX(ctx context.Context, id int) *ERPDocLink {
 }
 
 // Hooks returns the client hooks.
-func (c *ERPDocLinkClient) Hooks() []Hook {
-	return c.hooks.ERPDocLink
+func (c *AdminSessionClient) Hooks() []Hook {
+	return c.hooks.AdminSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPDocLinkClient) Interceptors() []Interceptor {
-	return c.inters.ERPDocLink
+func (c *AdminSessionClient) Interceptors() []Interceptor {
+	return c.inters.AdminSession
 }
 
-func (c *ERPDocLinkClient) mutate(ctx context.Context, m *ERPDocLinkMutation) (Value, error) {
+func (c *AdminSessionClient) mutate(ctx context.Context, m *AdminSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPDocLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPDocLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPDocLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPDocLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPDocLink mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminSession mutation op: %q", m.Op())
 	}
 }
 
-// ERPExportSaleClient is a client for the ERPExportSale schema.
-type ERPExportSaleClient struct {
+// AdminUserClient is a client for the AdminUser schema.
+type AdminUserClient struct {
 	config
 }
 
-// NewERPExportSaleClient returns a client for the ERPExportSale from the given config.
-func NewERPExportSaleClient(c config) *ERPExportSaleClient {
-	return &ERPExportSaleClient{config: c}
+// NewAdminUserClient returns a client for the AdminUser from the given config.
+func NewAdminUserClient(c config) *AdminUserClient {
+	return &AdminUserClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpexportsale.Hooks(f(g(h())))`.
-func (c *ERPExportSaleClient) Use(hooks ...Hook) {
-	c.hooks.ERPExportSale = append(c.hooks.ERPExportSale, hooks...)
+// A call to `Use(f, g, h)` equals to `adminuser.Hooks(f(g(h())))`.
+func (c *AdminUserClient) Use(hooks ...Hook) {
+	c.hooks.AdminUser = append(c.hooks.AdminUser, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpexportsale.Intercept(f(g(h())))`.
-func (c *ERPExportSaleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPExportSale = append(c.inters.ERPExportSale, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminuser.Intercept(f(g(h())))`.
+func (c *AdminUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminUser = append(c.inters.AdminUser, interceptors...)
 }
 
-// Create returns a builder for creating a ERPExportSale entity.
-func (c *ERPExportSaleClient) Create() *ERPExportSaleCreate {
-	mutation := newERPExportSaleMutation(c.config, OpCreate)
-	return &ERPExportSaleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminUser entity.
+func (c *AdminUserClient) Create() *AdminUserCreate {
+	mutation := newAdminUserMutation(c.config, OpCreate)
+	return &AdminUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPExportSale entities.
-func (c *ERPExportSaleClient) CreateBulk(builders ...*ERPExportSaleCreate) *ERPExportSaleCreateBulk {
-	return &ERPExportSaleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminUser entities.
+func (c *AdminUserClient) CreateBulk(builders ...*AdminUserCreate) *AdminUserCreateBulk {
+	return &AdminUserCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPExportSaleClient) MapCreateBulk(slice any, setFunc func(*ERPExportSaleCreate, int)) *ERPExportSaleCreateBulk {
+func (c *AdminUserClient) MapCreateBulk(slice any, setFunc func(*AdminUserCreate, int)) *AdminUserCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPExportSaleCreateBulk{err: fmt.Errorf("calling to ERPExportSaleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminUserCreateBulk{err: fmt.Errorf("calling to AdminUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPExportSaleCreate, rv.Len())
+	builders := make([]*AdminUserCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPExportSaleCreateBulk{config: c.config, builders: builders}
+	return &AdminUserCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPExportSale.
-func (c *ERPExportSaleClient) Update() *ERPExportSaleUpdate {
-	mutation := newERPExportSaleMutation(c.config, OpUpdate)
-	return &ERPExportSaleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminUser.
+func (c *AdminUserClient) Update() *AdminUserUpdate {
+	mutation := newAdminUserMutation(c.config, OpUpdate)
+	return &AdminUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPExportSaleClient) UpdateOne(_m *ERPExportSale) *ERPExportSaleUpdateOne {
-	mutation := newERPExportSaleMutation(c.config, OpUpdateOne, withERPExportSale(_m))
-	return &ERPExportSaleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminUserClient) UpdateOne(_m *AdminUser) *AdminUserUpdateOne {
+	mutation := newAdminUserMutation(c.config, OpUpdateOne, withAdminUser(_m))
+	return &AdminUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPExportSaleClient) UpdateOneID(id int) *ERPExportSaleUpdateOne {
-	mutation := newERPExportSaleMutation(c.config, OpUpdateOne, withERPExportSaleID(id))
-	return &ERPExportSaleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminUserClient) UpdateOneID(id int) *AdminUserUpdateOne {
+	mutation := newAdminUserMutation(c.config, OpUpdateOne, withAdminUserID(id))
+	return &AdminUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPExportSale.
-func (c *ERPExportSaleClient) Delete() *ERPExportSaleDelete {
-	mutation := newERPExportSaleMutation(c.config, OpDelete)
-	return &ERPExportSaleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminUser.
+func (c *AdminUserClient) Delete() *AdminUserDelete {
+	mutation := newAdminUserMutation(c.config, OpDelete)
+	return &AdminUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPExportSaleClient) DeleteOne(_m *ERPExportSale) *ERPExportSaleDeleteOne {
+func (c *AdminUserClient) DeleteOne(_m *AdminUser) *AdminUserDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPExportSaleClient) DeleteOneID(id int) *ERPExportSaleDeleteOne {
-	builder := c.Delete().Where(erpexportsale.ID(id))
+func (c *AdminUserClient) DeleteOneID(id int) *AdminUserDeleteOne {
+	builder := c.Delete().Where(adminuser.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPExportSaleDeleteOne{builder}
+	return &AdminUserDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPExportSale.
-func (c *ERPExportSaleClient) Query() *ERPExportSaleQuery {
-	return &ERPExportSaleQuery{
+// Query returns a query builder for AdminUser.
+func (c *AdminUserClient) Query() *AdminUserQuery {
+	return &AdminUserQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPExportSale},
+		ctx:    &QueryContext{Type: TypeAdminUser},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPExportSale entity by its id.
-func (c *ERPExportSaleClient) Get(ctx context.Context, id int) (*ERPExportSale, error) {
-	return c.Query().Where(erpexportsale.ID(id)).Only(ctx)
+// Get returns a AdminUser entity by its id.
+func (c *AdminUserClient) Get(ctx context.Context, id int) (*AdminUser, error) {
+	return c.Query().Where(adminuser.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPExportSaleClient) GetX(ctx context.Context, id int) *ERPExportSale {
+func (c *AdminUserClient) GetX(ctx context.Context, id int) *AdminUser {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1225,131 +1327,131 @@ func (c *ERPExportSaleClient) GetX(ctx context.Context, id int) *ERPExportSale {
 }
 
 // Hooks returns the client hooks.
-func (c *ERPExportSaleClient) Hooks() []Hook {
-	return c.hooks.ERPExportSale
+func (c *AdminUserClient) Hooks() []Hook {
+	return c.hooks.AdminUser
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPExportSaleClient) Interceptors() []Interceptor {
-	return c.inters.ERPExportSale
+func (c *AdminUserClient) Interceptors() []Interceptor {
+	return c.inters.AdminUser
 }
 
-func (c *ERPExportSaleClient) mutate(ctx context.Context, m *ERPExportSaleMutation) (Value, error) {
+func (c *AdminUserClient) mutate(ctx context.Context, m *AdminUserMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPExportSaleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPExportSaleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPExportSaleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPExportSaleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPExportSale mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminUser mutation op: %q", m.Op())
 	}
 }
 
-// ERPExportSaleItemClient is a client for the ERPExportSaleItem schema.
-type ERPExportSaleItemClient struct {
+// AdminUserRoleClient is a client for the AdminUserRole schema.
+type AdminUserRoleClient struct {
 	config
 }
 
-// NewERPExportSaleItemClient returns a client for the ERPExportSaleItem from the given config.
-func NewERPExportSaleItemClient(c config) *ERPExportSaleItemClient {
-	return &ERPExportSaleItemClient{config: c}
+// NewAdminUserRoleClient returns a client for the AdminUserRole from the given config.
+func NewAdminUserRoleClient(c config) *AdminUserRoleClient {
+	return &AdminUserRoleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpexportsaleitem.Hooks(f(g(h())))`.
-func (c *ERPExportSaleItemClient) Use(hooks ...Hook) {
-	c.hooks.ERPExportSaleItem = append(c.hooks.ERPExportSaleItem, hooks...)
+// A call to `Use(f, g, h)` equals to `adminuserrole.Hooks(f(g(h())))`.
+func (c *AdminUserRoleClient) Use(hooks ...Hook) {
+	c.hooks.AdminUserRole = append(c.hooks.AdminUserRole, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpexportsaleitem.Intercept(f(g(h())))`.
-func (c *ERPExportSaleItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPExportSaleItem = append(c.inters.ERPExportSaleItem, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminuserrole.Intercept(f(g(h())))`.
+func (c *AdminUserRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminUserRole = append(c.inters.AdminUserRole, interceptors...)
 }
 
-// Create returns a builder for creating a ERPExportSaleItem entity.
-func (c *ERPExportSaleItemClient) Create() *ERPExportSaleItemCreate {
-	mutation := newERPExportSaleItemMutation(c.config, OpCreate)
-	return &ERPExportSaleItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminUserRole entity.
+func (c *AdminUserRoleClient) Create() *AdminUserRoleCreate {
+	mutation := newAdminUserRoleMutation(c.config, OpCreate)
+	return &AdminUserRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPExportSaleItem entities.
-func (c *ERPExportSaleItemClient) CreateBulk(builders ...*ERPExportSaleItemCreate) *ERPExportSaleItemCreateBulk {
-	return &ERPExportSaleItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminUserRole entities.
+func (c *AdminUserRoleClient) CreateBulk(builders ...*AdminUserRoleCreate) *AdminUserRoleCreateBulk {
+	return &AdminUserRoleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPExportSaleItemClient) MapCreateBulk(slice any, setFunc func(*ERPExportSaleItemCreate, int)) *ERPExportSaleItemCreateBulk {
+func (c *AdminUserRoleClient) MapCreateBulk(slice any, setFunc func(*AdminUserRoleCreate, int)) *AdminUserRoleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPExportSaleItemCreateBulk{err: fmt.Errorf("calling to ERPExportSaleItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminUserRoleCreateBulk{err: fmt.Errorf("calling to AdminUserRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPExportSaleItemCreate, rv.Len())
+	builders := make([]*AdminUserRoleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPExportSaleItemCreateBulk{config: c.config, builders: builders}
+	return &AdminUserRoleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPExportSaleItem.
-func (c *ERPExportSaleItemClient) Update() *ERPExportSaleItemUpdate {
-	mutation := newERPExportSaleItemMutation(c.config, OpUpdate)
-	return &ERPExportSaleItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminUserRole.
+func (c *AdminUserRoleClient) Update() *AdminUserRoleUpdate {
+	mutation := newAdminUserRoleMutation(c.config, OpUpdate)
+	return &AdminUserRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPExportSaleItemClient) UpdateOne(_m *ERPExportSaleItem) *ERPExportSaleItemUpdateOne {
-	mutation := newERPExportSaleItemMutation(c.config, OpUpdateOne, withERPExportSaleItem(_m))
-	return &ERPExportSaleItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminUserRoleClient) UpdateOne(_m *AdminUserRole) *AdminUserRoleUpdateOne {
+	mutation := newAdminUserRoleMutation(c.config, OpUpdateOne, withAdminUserRole(_m))
+	return &AdminUserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPExportSaleItemClient) UpdateOneID(id int) *ERPExportSaleItemUpdateOne {
-	mutation := newERPExportSaleItemMutation(c.config, OpUpdateOne, withERPExportSaleItemID(id))
-	return &ERPExportSaleItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminUserRoleClient) UpdateOneID(id int) *AdminUserRoleUpdateOne {
+	mutation := newAdminUserRoleMutation(c.config, OpUpdateOne, withAdminUserRoleID(id))
+	return &AdminUserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPExportSaleItem.
-func (c *ERPExportSaleItemClient) Delete() *ERPExportSaleItemDelete {
-	mutation := newERPExportSaleItemMutation(c.config, OpDelete)
-	return &ERPExportSaleItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminUserRole.
+func (c *AdminUserRoleClient) Delete() *AdminUserRoleDelete {
+	mutation := newAdminUserRoleMutation(c.config, OpDelete)
+	return &AdminUserRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPExportSaleItemClient) DeleteOne(_m *ERPExportSaleItem) *ERPExportSaleItemDeleteOne {
+func (c *AdminUserRoleClient) DeleteOne(_m *AdminUserRole) *AdminUserRoleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPExportSaleItemClient) DeleteOneID(id int) *ERPExportSaleItemDeleteOne {
-	builder := c.Delete().Where(erpexportsaleitem.ID(id))
+func (c *AdminUserRoleClient) DeleteOneID(id int) *AdminUserRoleDeleteOne {
+	builder := c.Delete().Where(adminuserrole.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPExportSaleItemDeleteOne{builder}
+	return &AdminUserRoleDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPExportSaleItem.
-func (c *ERPExportSaleItemClient) Query() *ERPExportSaleItemQuery {
-	return &ERPExportSaleItemQuery{
+// Query returns a query builder for AdminUserRole.
+func (c *AdminUserRoleClient) Query() *AdminUserRoleQuery {
+	return &AdminUserRoleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPExportSaleItem},
+		ctx:    &QueryContext{Type: TypeAdminUserRole},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPExportSaleItem entity by its id.
-func (c *ERPExportSaleItemClient) Get(ctx context.Context, id int) (*ERPExportSaleItem, error) {
-	return c.Query().Where(erpexportsaleitem.ID(id)).Only(ctx)
+// Get returns a AdminUserRole entity by its id.
+func (c *AdminUserRoleClient) Get(ctx context.Context, id int) (*AdminUserRole, error) {
+	return c.Query().Where(adminuserrole.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPExportSaleItemClient) GetX(ctx context.Context, id int) *ERPExportSaleItem {
+func (c *AdminUserRoleClient) GetX(ctx context.Context, id int) *AdminUserRole {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1358,131 +1460,131 @@ func (c *ERPExportSaleItemClient) GetX(ctx context.Context, id int) *ERPExportSa
 }
 
 // Hooks returns the client hooks.
-func (c *ERPExportSaleItemClient) Hooks() []Hook {
-	return c.hooks.ERPExportSaleItem
+func (c *AdminUserRoleClient) Hooks() []Hook {
+	return c.hooks.AdminUserRole
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPExportSaleItemClient) Interceptors() []Interceptor {
-	return c.inters.ERPExportSaleItem
+func (c *AdminUserRoleClient) Interceptors() []Interceptor {
+	return c.inters.AdminUserRole
 }
 
-func (c *ERPExportSaleItemClient) mutate(ctx context.Context, m *ERPExportSaleItemMutation) (Value, error) {
+func (c *AdminUserRoleClient) mutate(ctx context.Context, m *AdminUserRoleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPExportSaleItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminUserRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPExportSaleItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminUserRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPExportSaleItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminUserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPExportSaleItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminUserRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPExportSaleItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminUserRole mutation op: %q", m.Op())
 	}
 }
 
-// ERPInboundNoticeClient is a client for the ERPInboundNotice schema.
-type ERPInboundNoticeClient struct {
+// ERPAttachmentClient is a client for the ERPAttachment schema.
+type ERPAttachmentClient struct {
 	config
 }
 
-// NewERPInboundNoticeClient returns a client for the ERPInboundNotice from the given config.
-func NewERPInboundNoticeClient(c config) *ERPInboundNoticeClient {
-	return &ERPInboundNoticeClient{config: c}
+// NewERPAttachmentClient returns a client for the ERPAttachment from the given config.
+func NewERPAttachmentClient(c config) *ERPAttachmentClient {
+	return &ERPAttachmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpinboundnotice.Hooks(f(g(h())))`.
-func (c *ERPInboundNoticeClient) Use(hooks ...Hook) {
-	c.hooks.ERPInboundNotice = append(c.hooks.ERPInboundNotice, hooks...)
+// A call to `Use(f, g, h)` equals to `erpattachment.Hooks(f(g(h())))`.
+func (c *ERPAttachmentClient) Use(hooks ...Hook) {
+	c.hooks.ERPAttachment = append(c.hooks.ERPAttachment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpinboundnotice.Intercept(f(g(h())))`.
-func (c *ERPInboundNoticeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPInboundNotice = append(c.inters.ERPInboundNotice, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `erpattachment.Intercept(f(g(h())))`.
+func (c *ERPAttachmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPAttachment = append(c.inters.ERPAttachment, interceptors...)
 }
 
-// Create returns a builder for creating a ERPInboundNotice entity.
-func (c *ERPInboundNoticeClient) Create() *ERPInboundNoticeCreate {
-	mutation := newERPInboundNoticeMutation(c.config, OpCreate)
-	return &ERPInboundNoticeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ERPAttachment entity.
+func (c *ERPAttachmentClient) Create() *ERPAttachmentCreate {
+	mutation := newERPAttachmentMutation(c.config, OpCreate)
+	return &ERPAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPInboundNotice entities.
-func (c *ERPInboundNoticeClient) CreateBulk(builders ...*ERPInboundNoticeCreate) *ERPInboundNoticeCreateBulk {
-	return &ERPInboundNoticeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ERPAttachment entities.
+func (c *ERPAttachmentClient) CreateBulk(builders ...*ERPAttachmentCreate) *ERPAttachmentCreateBulk {
+	return &ERPAttachmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPInboundNoticeClient) MapCreateBulk(slice any, setFunc func(*ERPInboundNoticeCreate, int)) *ERPInboundNoticeCreateBulk {
+func (c *ERPAttachmentClient) MapCreateBulk(slice any, setFunc func(*ERPAttachmentCreate, int)) *ERPAttachmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPInboundNoticeCreateBulk{err: fmt.Errorf("calling to ERPInboundNoticeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ERPAttachmentCreateBulk{err: fmt.Errorf("calling to ERPAttachmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPInboundNoticeCreate, rv.Len())
+	builders := make([]*ERPAttachmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPInboundNoticeCreateBulk{config: c.config, builders: builders}
+	return &ERPAttachmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPInboundNotice.
-func (c *ERPInboundNoticeClient) Update() *ERPInboundNoticeUpdate {
-	mutation := newERPInboundNoticeMutation(c.config, OpUpdate)
-	return &ERPInboundNoticeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ERPAttachment.
+func (c *ERPAttachmentClient) Update() *ERPAttachmentUpdate {
+	mutation := newERPAttachmentMutation(c.config, OpUpdate)
+	return &ERPAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPInboundNoticeClient) UpdateOne(_m *ERPInboundNotice) *ERPInboundNoticeUpdateOne {
-	mutation := newERPInboundNoticeMutation(c.config, OpUpdateOne, withERPInboundNotice(_m))
-	return &ERPInboundNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPAttachmentClient) UpdateOne(_m *ERPAttachment) *ERPAttachmentUpdateOne {
+	mutation := newERPAttachmentMutation(c.config, OpUpdateOne, withERPAttachment(_m))
+	return &ERPAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPInboundNoticeClient) UpdateOneID(id int) *ERPInboundNoticeUpdateOne {
-	mutation := newERPInboundNoticeMutation(c.config, OpUpdateOne, withERPInboundNoticeID(id))
-	return &ERPInboundNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPAttachmentClient) UpdateOneID(id int) *ERPAttachmentUpdateOne {
+	mutation := newERPAttachmentMutation(c.config, OpUpdateOne, withERPAttachmentID(id))
+	return &ERPAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPInboundNotice.
-func (c *ERPInboundNoticeClient) Delete() *ERPInboundNoticeDelete {
-	mutation := newERPInboundNoticeMutation(c.config, OpDelete)
-	return &ERPInboundNoticeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ERPAttachment.
+func (c *ERPAttachmentClient) Delete() *ERPAttachmentDelete {
+	mutation := newERPAttachmentMutation(c.config, OpDelete)
+	return &ERPAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPInboundNoticeClient) DeleteOne(_m *ERPInboundNotice) *ERPInboundNoticeDeleteOne {
+func (c *ERPAttachmentClient) DeleteOne(_m *ERPAttachment) *ERPAttachmentDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPInboundNoticeClient) DeleteOneID(id int) *ERPInboundNoticeDeleteOne {
-	builder := c.Delete().Where(erpinboundnotice.ID(id))
+func (c *ERPAttachmentClient) DeleteOneID(id int) *ERPAttachmentDeleteOne {
+	builder := c.Delete().Where(erpattachment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPInboundNoticeDeleteOne{builder}
+	return &ERPAttachmentDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPInboundNotice.
-func (c *ERPInboundNoticeClient) Query() *ERPInboundNoticeQuery {
-	return &ERPInboundNoticeQuery{
+// Query returns a query builder for ERPAttachment.
+func (c *ERPAttachmentClient) Query() *ERPAttachmentQuery {
+	return &ERPAttachmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPInboundNotice},
+		ctx:    &QueryContext{Type: TypeERPAttachment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPInboundNotice entity by its id.
-func (c *ERPInboundNoticeClient) Get(ctx context.Context, id int) (*ERPInboundNotice, error) {
-	return c.Query().Where(erpinboundnotice.ID(id)).Only(ctx)
+// Get returns a ERPAttachment entity by its id.
+func (c *ERPAttachmentClient) Get(ctx context.Context, id int) (*ERPAttachment, error) {
+	return c.Query().Where(erpattachment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPInboundNoticeClient) GetX(ctx context.Context, id int) *ERPInboundNotice {
+func (c *ERPAttachmentClient) GetX(ctx context.Context, id int) *ERPAttachment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1491,131 +1593,131 @@ func (c *ERPInboundNoticeClient) GetX(ctx context.Context, id int) *ERPInboundNo
 }
 
 // Hooks returns the client hooks.
-func (c *ERPInboundNoticeClient) Hooks() []Hook {
-	return c.hooks.ERPInboundNotice
+func (c *ERPAttachmentClient) Hooks() []Hook {
+	return c.hooks.ERPAttachment
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPInboundNoticeClient) Interceptors() []Interceptor {
-	return c.inters.ERPInboundNotice
+func (c *ERPAttachmentClient) Interceptors() []Interceptor {
+	return c.inters.ERPAttachment
 }
 
-func (c *ERPInboundNoticeClient) mutate(ctx context.Context, m *ERPInboundNoticeMutation) (Value, error) {
+func (c *ERPAttachmentClient) mutate(ctx context.Context, m *ERPAttachmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPInboundNoticeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPInboundNoticeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPInboundNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPInboundNoticeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ERPAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPInboundNotice mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ERPAttachment mutation op: %q", m.Op())
 	}
 }
 
-// ERPInboundNoticeItemClient is a client for the ERPInboundNoticeItem schema.
-type ERPInboundNoticeItemClient struct {
+// ERPAuditLogClient is a client for the ERPAuditLog schema.
+type ERPAuditLogClient struct {
 	config
 }
 
-// NewERPInboundNoticeItemClient returns a client for the ERPInboundNoticeItem from the given config.
-func NewERPInboundNoticeItemClient(c config) *ERPInboundNoticeItemClient {
-	return &ERPInboundNoticeItemClient{config: c}
+// NewERPAuditLogClient returns a client for the ERPAuditLog from the given config.
+func NewERPAuditLogClient(c config) *ERPAuditLogClient {
+	return &ERPAuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpinboundnoticeitem.Hooks(f(g(h())))`.
-func (c *ERPInboundNoticeItemClient) Use(hooks ...Hook) {
-	c.hooks.ERPInboundNoticeItem = append(c.hooks.ERPInboundNoticeItem, hooks...)
+// A call to `Use(f, g, h)` equals to `erpauditlog.Hooks(f(g(h())))`.
+func (c *ERPAuditLogClient) Use(hooks ...Hook) {
+	c.hooks.ERPAuditLog = append(c.hooks.ERPAuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpinboundnoticeitem.Intercept(f(g(h())))`.
-func (c *ERPInboundNoticeItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPInboundNoticeItem = append(c.inters.ERPInboundNoticeItem, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `erpauditlog.Intercept(f(g(h())))`.
+func (c *ERPAuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPAuditLog = append(c.inters.ERPAuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a ERPInboundNoticeItem entity.
-func (c *ERPInboundNoticeItemClient) Create() *ERPInboundNoticeItemCreate {
-	mutation := newERPInboundNoticeItemMutation(c.config, OpCreate)
-	return &ERPInboundNoticeItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ERPAuditLog entity.
+func (c *ERPAuditLogClient) Create() *ERPAuditLogCreate {
+	mutation := newERPAuditLogMutation(c.config, OpCreate)
+	return &ERPAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPInboundNoticeItem entities.
-func (c *ERPInboundNoticeItemClient) CreateBulk(builders ...*ERPInboundNoticeItemCreate) *ERPInboundNoticeItemCreateBulk {
-	return &ERPInboundNoticeItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ERPAuditLog entities.
+func (c *ERPAuditLogClient) CreateBulk(builders ...*ERPAuditLogCreate) *ERPAuditLogCreateBulk {
+	return &ERPAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPInboundNoticeItemClient) MapCreateBulk(slice any, setFunc func(*ERPInboundNoticeItemCreate, int)) *ERPInboundNoticeItemCreateBulk {
+func (c *ERPAuditLogClient) MapCreateBulk(slice any, setFunc func(*ERPAuditLogCreate, int)) *ERPAuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPInboundNoticeItemCreateBulk{err: fmt.Errorf("calling to ERPInboundNoticeItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ERPAuditLogCreateBulk{err: fmt.Errorf("calling to ERPAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPInboundNoticeItemCreate, rv.Len())
+	builders := make([]*ERPAuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPInboundNoticeItemCreateBulk{config: c.config, builders: builders}
+	return &ERPAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPInboundNoticeItem.
-func (c *ERPInboundNoticeItemClient) Update() *ERPInboundNoticeItemUpdate {
-	mutation := newERPInboundNoticeItemMutation(c.config, OpUpdate)
-	return &ERPInboundNoticeItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ERPAuditLog.
+func (c *ERPAuditLogClient) Update() *ERPAuditLogUpdate {
+	mutation := newERPAuditLogMutation(c.config, OpUpdate)
+	return &ERPAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPInboundNoticeItemClient) UpdateOne(_m *ERPInboundNoticeItem) *ERPInboundNoticeItemUpdateOne {
-	mutation := newERPInboundNoticeItemMutation(c.config, OpUpdateOne, withERPInboundNoticeItem(_m))
-	return &ERPInboundNoticeItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPAuditLogClient) UpdateOne(_m *ERPAuditLog) *ERPAuditLogUpdateOne {
+	mutation := newERPAuditLogMutation(c.config, OpUpdateOne, withERPAuditLog(_m))
+	return &ERPAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPInboundNoticeItemClient) UpdateOneID(id int) *ERPInboundNoticeItemUpdateOne {
-	mutation := newERPInboundNoticeItemMutation(c.config, OpUpdateOne, withERPInboundNoticeItemID(id))
-	return &ERPInboundNoticeItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPAuditLogClient) UpdateOneID(id int) *ERPAuditLogUpdateOne {
+	mutation := newERPAuditLogMutation(c.config, OpUpdateOne, withERPAuditLogID(id))
+	return &ERPAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPInboundNoticeItem.
-func (c *ERPInboundNoticeItemClient) Delete() *ERPInboundNoticeItemDelete {
-	mutation := newERPInboundNoticeItemMutation(c.config, OpDelete)
-	return &ERPInboundNoticeItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ERPAuditLog.
+func (c *ERPAuditLogClient) Delete() *ERPAuditLogDelete {
+	mutation := newERPAuditLogMutation(c.config, OpDelete)
+	return &ERPAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPInboundNoticeItemClient) DeleteOne(_m *ERPInboundNoticeItem) *ERPInboundNoticeItemDeleteOne {
+func (c *ERPAuditLogClient) DeleteOne(_m *ERPAuditLog) *ERPAuditLogDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPInboundNoticeItemClient) DeleteOneID(id int) *ERPInboundNoticeItemDeleteOne {
-	builder := c.Delete().Where(erpinboundnoticeitem.ID(id))
+func (c *ERPAuditLogClient) DeleteOneID(id int) *ERPAuditLogDeleteOne {
+	builder := c.Delete().Where(erpauditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPInboundNoticeItemDeleteOne{builder}
+	return &ERPAuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPInboundNoticeItem.
-func (c *ERPInboundNoticeItemClient) Query() *ERPInboundNoticeItemQuery {
-	return &ERPInboundNoticeItemQuery{
+// Query returns a query builder for ERPAuditLog.
+func (c *ERPAuditLogClient) Query() *ERPAuditLogQuery {
+	return &ERPAuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPInboundNoticeItem},
+		ctx:    &QueryContext{Type: TypeERPAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPInboundNoticeItem entity by its id.
-func (c *ERPInboundNoticeItemClient) Get(ctx context.Context, id int) (*ERPInboundNoticeItem, error) {
-	return c.Query().Where(erpinboundnoticeitem.ID(id)).Only(ctx)
+// Get returns a ERPAuditLog entity by its id.
+func (c *ERPAuditLogClient) Get(ctx context.Context, id int) (*ERPAuditLog, error) {
+	return c.Query().Where(erpauditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPInboundNoticeItemClient) GetX(ctx context.Context, id int) *ERPInboundNoticeItem {
+func (c *ERPAuditLogClient) GetX(ctx context.Context, id int) *ERPAuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1624,131 +1726,1461 @@ func (c *ERPInboundNoticeItemClient) GetX(ctx context.Context, id int) *ERPInbou
 }
 
 // Hooks returns the client hooks.
-func (c *ERPInboundNoticeItemClient) Hooks() []Hook {
-	return c.hooks.ERPInboundNoticeItem
+func (c *ERPAuditLogClient) Hooks() []Hook {
+	return c.hooks.ERPAuditLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPInboundNoticeItemClient) Interceptors() []Interceptor {
-	return c.inters.ERPInboundNoticeItem
+func (c *ERPAuditLogClient) Interceptors() []Interceptor {
+	return c.inters.ERPAuditLog
 }
 
-func (c *ERPInboundNoticeItemClient) mutate(ctx context.Context, m *ERPInboundNoticeItemMutation) (Value, error) {
+func (c *ERPAuditLogClient) mutate(ctx context.Context, m *ERPAuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPInboundNoticeItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPInboundNoticeItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPInboundNoticeItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPInboundNoticeItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ERPAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPInboundNoticeItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ERPAuditLog mutation op: %q", m.Op())
 	}
 }
 
-// ERPLocationClient is a client for the ERPLocation schema.
-type ERPLocationClient struct {
+// ERPBankReceiptClient is a client for the ERPBankReceipt schema.
+type ERPBankReceiptClient struct {
 	config
 }
 
-// NewERPLocationClient returns a client for the ERPLocation from the given config.
-func NewERPLocationClient(c config) *ERPLocationClient {
-	return &ERPLocationClient{config: c}
+// NewERPBankReceiptClient returns a client for the ERPBankReceipt from the given config.
+func NewERPBankReceiptClient(c config) *ERPBankReceiptClient {
+	return &ERPBankReceiptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erplocation.Hooks(f(g(h())))`.
-func (c *ERPLocationClient) Use(hooks ...Hook) {
-	c.hooks.ERPLocation = append(c.hooks.ERPLocation, hooks...)
+// A call to `Use(f, g, h)` equals to `erpbankreceipt.Hooks(f(g(h())))`.
+func (c *ERPBankReceiptClient) Use(hooks ...Hook) {
+	c.hooks.ERPBankReceipt = append(c.hooks.ERPBankReceipt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erplocation.Intercept(f(g(h())))`.
-func (c *ERPLocationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPLocation = append(c.inters.ERPLocation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `erpbankreceipt.Intercept(f(g(h())))`.
+func (c *ERPBankReceiptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPBankReceipt = append(c.inters.ERPBankReceipt, interceptors...)
 }
 
-// Create returns a builder for creating a ERPLocation entity.
-func (c *ERPLocationClient) Create() *ERPLocationCreate {
-	mutation := newERPLocationMutation(c.config, OpCreate)
-	return &ERPLocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ERPBankReceipt entity.
+func (c *ERPBankReceiptClient) Create() *ERPBankReceiptCreate {
+	mutation := newERPBankReceiptMutation(c.config, OpCreate)
+	return &ERPBankReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPLocation entities.
-func (c *ERPLocationClient) CreateBulk(builders ...*ERPLocationCreate) *ERPLocationCreateBulk {
-	return &ERPLocationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ERPBankReceipt entities.
+func (c *ERPBankReceiptClient) CreateBulk(builders ...*ERPBankReceiptCreate) *ERPBankReceiptCreateBulk {
+	return &ERPBankReceiptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPLocationClient) MapCreateBulk(slice any, setFunc func(*ERPLocationCreate, int)) *ERPLocationCreateBulk {
+func (c *ERPBankReceiptClient) MapCreateBulk(slice any, setFunc func(*ERPBankReceiptCreate, int)) *ERPBankReceiptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPLocationCreateBulk{err: fmt.Errorf("calling to ERPLocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ERPBankReceiptCreateBulk{err: fmt.Errorf("calling to ERPBankReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPLocationCreate, rv.Len())
+	builders := make([]*ERPBankReceiptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPLocationCreateBulk{config: c.config, builders: builders}
+	return &ERPBankReceiptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPLocation.
-func (c *ERPLocationClient) Update() *ERPLocationUpdate {
-	mutation := newERPLocationMutation(c.config, OpUpdate)
-	return &ERPLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ERPBankReceipt.
+func (c *ERPBankReceiptClient) Update() *ERPBankReceiptUpdate {
+	mutation := newERPBankReceiptMutation(c.config, OpUpdate)
+	return &ERPBankReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPBankReceiptClient) UpdateOne(_m *ERPBankReceipt) *ERPBankReceiptUpdateOne {
+	mutation := newERPBankReceiptMutation(c.config, OpUpdateOne, withERPBankReceipt(_m))
+	return &ERPBankReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPBankReceiptClient) UpdateOneID(id int) *ERPBankReceiptUpdateOne {
+	mutation := newERPBankReceiptMutation(c.config, OpUpdateOne, withERPBankReceiptID(id))
+	return &ERPBankReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPBankReceipt.
+func (c *ERPBankReceiptClient) Delete() *ERPBankReceiptDelete {
+	mutation := newERPBankReceiptMutation(c.config, OpDelete)
+	return &ERPBankReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPBankReceiptClient) DeleteOne(_m *ERPBankReceipt) *ERPBankReceiptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPBankReceiptClient) DeleteOneID(id int) *ERPBankReceiptDeleteOne {
+	builder := c.Delete().Where(erpbankreceipt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPBankReceiptDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPBankReceipt.
+func (c *ERPBankReceiptClient) Query() *ERPBankReceiptQuery {
+	return &ERPBankReceiptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPBankReceipt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPBankReceipt entity by its id.
+func (c *ERPBankReceiptClient) Get(ctx context.Context, id int) (*ERPBankReceipt, error) {
+	return c.Query().Where(erpbankreceipt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPBankReceiptClient) GetX(ctx context.Context, id int) *ERPBankReceipt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPBankReceiptClient) Hooks() []Hook {
+	return c.hooks.ERPBankReceipt
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPBankReceiptClient) Interceptors() []Interceptor {
+	return c.inters.ERPBankReceipt
+}
+
+func (c *ERPBankReceiptClient) mutate(ctx context.Context, m *ERPBankReceiptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPBankReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPBankReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPBankReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPBankReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPBankReceipt mutation op: %q", m.Op())
+	}
+}
+
+// ERPBankReceiptClaimClient is a client for the ERPBankReceiptClaim schema.
+type ERPBankReceiptClaimClient struct {
+	config
+}
+
+// NewERPBankReceiptClaimClient returns a client for the ERPBankReceiptClaim from the given config.
+func NewERPBankReceiptClaimClient(c config) *ERPBankReceiptClaimClient {
+	return &ERPBankReceiptClaimClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpbankreceiptclaim.Hooks(f(g(h())))`.
+func (c *ERPBankReceiptClaimClient) Use(hooks ...Hook) {
+	c.hooks.ERPBankReceiptClaim = append(c.hooks.ERPBankReceiptClaim, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpbankreceiptclaim.Intercept(f(g(h())))`.
+func (c *ERPBankReceiptClaimClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPBankReceiptClaim = append(c.inters.ERPBankReceiptClaim, interceptors...)
+}
+
+// Create returns a builder for creating a ERPBankReceiptClaim entity.
+func (c *ERPBankReceiptClaimClient) Create() *ERPBankReceiptClaimCreate {
+	mutation := newERPBankReceiptClaimMutation(c.config, OpCreate)
+	return &ERPBankReceiptClaimCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPBankReceiptClaim entities.
+func (c *ERPBankReceiptClaimClient) CreateBulk(builders ...*ERPBankReceiptClaimCreate) *ERPBankReceiptClaimCreateBulk {
+	return &ERPBankReceiptClaimCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPBankReceiptClaimClient) MapCreateBulk(slice any, setFunc func(*ERPBankReceiptClaimCreate, int)) *ERPBankReceiptClaimCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPBankReceiptClaimCreateBulk{err: fmt.Errorf("calling to ERPBankReceiptClaimClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPBankReceiptClaimCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPBankReceiptClaimCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPBankReceiptClaim.
+func (c *ERPBankReceiptClaimClient) Update() *ERPBankReceiptClaimUpdate {
+	mutation := newERPBankReceiptClaimMutation(c.config, OpUpdate)
+	return &ERPBankReceiptClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPBankReceiptClaimClient) UpdateOne(_m *ERPBankReceiptClaim) *ERPBankReceiptClaimUpdateOne {
+	mutation := newERPBankReceiptClaimMutation(c.config, OpUpdateOne, withERPBankReceiptClaim(_m))
+	return &ERPBankReceiptClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPBankReceiptClaimClient) UpdateOneID(id int) *ERPBankReceiptClaimUpdateOne {
+	mutation := newERPBankReceiptClaimMutation(c.config, OpUpdateOne, withERPBankReceiptClaimID(id))
+	return &ERPBankReceiptClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPBankReceiptClaim.
+func (c *ERPBankReceiptClaimClient) Delete() *ERPBankReceiptClaimDelete {
+	mutation := newERPBankReceiptClaimMutation(c.config, OpDelete)
+	return &ERPBankReceiptClaimDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPBankReceiptClaimClient) DeleteOne(_m *ERPBankReceiptClaim) *ERPBankReceiptClaimDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPBankReceiptClaimClient) DeleteOneID(id int) *ERPBankReceiptClaimDeleteOne {
+	builder := c.Delete().Where(erpbankreceiptclaim.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPBankReceiptClaimDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPBankReceiptClaim.
+func (c *ERPBankReceiptClaimClient) Query() *ERPBankReceiptClaimQuery {
+	return &ERPBankReceiptClaimQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPBankReceiptClaim},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPBankReceiptClaim entity by its id.
+func (c *ERPBankReceiptClaimClient) Get(ctx context.Context, id int) (*ERPBankReceiptClaim, error) {
+	return c.Query().Where(erpbankreceiptclaim.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPBankReceiptClaimClient) GetX(ctx context.Context, id int) *ERPBankReceiptClaim {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPBankReceiptClaimClient) Hooks() []Hook {
+	return c.hooks.ERPBankReceiptClaim
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPBankReceiptClaimClient) Interceptors() []Interceptor {
+	return c.inters.ERPBankReceiptClaim
+}
+
+func (c *ERPBankReceiptClaimClient) mutate(ctx context.Context, m *ERPBankReceiptClaimMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPBankReceiptClaimCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPBankReceiptClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPBankReceiptClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPBankReceiptClaimDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPBankReceiptClaim mutation op: %q", m.Op())
+	}
+}
+
+// ERPDocLinkClient is a client for the ERPDocLink schema.
+type ERPDocLinkClient struct {
+	config
+}
+
+// NewERPDocLinkClient returns a client for the ERPDocLink from the given config.
+func NewERPDocLinkClient(c config) *ERPDocLinkClient {
+	return &ERPDocLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpdoclink.Hooks(f(g(h())))`.
+func (c *ERPDocLinkClient) Use(hooks ...Hook) {
+	c.hooks.ERPDocLink = append(c.hooks.ERPDocLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpdoclink.Intercept(f(g(h())))`.
+func (c *ERPDocLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPDocLink = append(c.inters.ERPDocLink, interceptors...)
+}
+
+// Create returns a builder for creating a ERPDocLink entity.
+func (c *ERPDocLinkClient) Create() *ERPDocLinkCreate {
+	mutation := newERPDocLinkMutation(c.config, OpCreate)
+	return &ERPDocLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPDocLink entities.
+func (c *ERPDocLinkClient) CreateBulk(builders ...*ERPDocLinkCreate) *ERPDocLinkCreateBulk {
+	return &ERPDocLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPDocLinkClient) MapCreateBulk(slice any, setFunc func(*ERPDocLinkCreate, int)) *ERPDocLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPDocLinkCreateBulk{err: fmt.Errorf("calling to ERPDocLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPDocLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPDocLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPDocLink.
+func (c *ERPDocLinkClient) Update() *ERPDocLinkUpdate {
+	mutation := newERPDocLinkMutation(c.config, OpUpdate)
+	return &ERPDocLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPDocLinkClient) UpdateOne(_m *ERPDocLink) *ERPDocLinkUpdateOne {
+	mutation := newERPDocLinkMutation(c.config, OpUpdateOne, withERPDocLink(_m))
+	return &ERPDocLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPDocLinkClient) UpdateOneID(id int) *ERPDocLinkUpdateOne {
+	mutation := newERPDocLinkMutation(c.config, OpUpdateOne, withERPDocLinkID(id))
+	return &ERPDocLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPDocLink.
+func (c *ERPDocLinkClient) Delete() *ERPDocLinkDelete {
+	mutation := newERPDocLinkMutation(c.config, OpDelete)
+	return &ERPDocLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPDocLinkClient) DeleteOne(_m *ERPDocLink) *ERPDocLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPDocLinkClient) DeleteOneID(id int) *ERPDocLinkDeleteOne {
+	builder := c.Delete().Where(erpdoclink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPDocLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPDocLink.
+func (c *ERPDocLinkClient) Query() *ERPDocLinkQuery {
+	return &ERPDocLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPDocLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPDocLink entity by its id.
+func (c *ERPDocLinkClient) Get(ctx context.Context, id int) (*ERPDocLink, error) {
+	return c.Query().Where(erpdoclink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPDocLinkClient) GetX(ctx context.Context, id int) *ERPDocLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPDocLinkClient) Hooks() []Hook {
+	return c.hooks.ERPDocLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPDocLinkClient) Interceptors() []Interceptor {
+	return c.inters.ERPDocLink
+}
+
+func (c *ERPDocLinkClient) mutate(ctx context.Context, m *ERPDocLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPDocLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPDocLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPDocLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPDocLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPDocLink mutation op: %q", m.Op())
+	}
+}
+
+// ERPExportSaleClient is a client for the ERPExportSale schema.
+type ERPExportSaleClient struct {
+	config
+}
+
+// NewERPExportSaleClient returns a client for the ERPExportSale from the given config.
+func NewERPExportSaleClient(c config) *ERPExportSaleClient {
+	return &ERPExportSaleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpexportsale.Hooks(f(g(h())))`.
+func (c *ERPExportSaleClient) Use(hooks ...Hook) {
+	c.hooks.ERPExportSale = append(c.hooks.ERPExportSale, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpexportsale.Intercept(f(g(h())))`.
+func (c *ERPExportSaleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPExportSale = append(c.inters.ERPExportSale, interceptors...)
+}
+
+// Create returns a builder for creating a ERPExportSale entity.
+func (c *ERPExportSaleClient) Create() *ERPExportSaleCreate {
+	mutation := newERPExportSaleMutation(c.config, OpCreate)
+	return &ERPExportSaleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPExportSale entities.
+func (c *ERPExportSaleClient) CreateBulk(builders ...*ERPExportSaleCreate) *ERPExportSaleCreateBulk {
+	return &ERPExportSaleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPExportSaleClient) MapCreateBulk(slice any, setFunc func(*ERPExportSaleCreate, int)) *ERPExportSaleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPExportSaleCreateBulk{err: fmt.Errorf("calling to ERPExportSaleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPExportSaleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPExportSaleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPExportSale.
+func (c *ERPExportSaleClient) Update() *ERPExportSaleUpdate {
+	mutation := newERPExportSaleMutation(c.config, OpUpdate)
+	return &ERPExportSaleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPExportSaleClient) UpdateOne(_m *ERPExportSale) *ERPExportSaleUpdateOne {
+	mutation := newERPExportSaleMutation(c.config, OpUpdateOne, withERPExportSale(_m))
+	return &ERPExportSaleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPExportSaleClient) UpdateOneID(id int) *ERPExportSaleUpdateOne {
+	mutation := newERPExportSaleMutation(c.config, OpUpdateOne, withERPExportSaleID(id))
+	return &ERPExportSaleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPExportSale.
+func (c *ERPExportSaleClient) Delete() *ERPExportSaleDelete {
+	mutation := newERPExportSaleMutation(c.config, OpDelete)
+	return &ERPExportSaleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPExportSaleClient) DeleteOne(_m *ERPExportSale) *ERPExportSaleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPExportSaleClient) DeleteOneID(id int) *ERPExportSaleDeleteOne {
+	builder := c.Delete().Where(erpexportsale.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPExportSaleDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPExportSale.
+func (c *ERPExportSaleClient) Query() *ERPExportSaleQuery {
+	return &ERPExportSaleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPExportSale},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPExportSale entity by its id.
+func (c *ERPExportSaleClient) Get(ctx context.Context, id int) (*ERPExportSale, error) {
+	return c.Query().Where(erpexportsale.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPExportSaleClient) GetX(ctx context.Context, id int) *ERPExportSale {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPExportSaleClient) Hooks() []Hook {
+	return c.hooks.ERPExportSale
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPExportSaleClient) Interceptors() []Interceptor {
+	return c.inters.ERPExportSale
+}
+
+func (c *ERPExportSaleClient) mutate(ctx context.Context, m *ERPExportSaleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPExportSaleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPExportSaleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPExportSaleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPExportSaleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPExportSale mutation op: %q", m.Op())
+	}
+}
+
+// ERPExportSaleItemClient is a client for the ERPExportSaleItem schema.
+type ERPExportSaleItemClient struct {
+	config
+}
+
+// NewERPExportSaleItemClient returns a client for the ERPExportSaleItem from the given config.
+func NewERPExportSaleItemClient(c config) *ERPExportSaleItemClient {
+	return &ERPExportSaleItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpexportsaleitem.Hooks(f(g(h())))`.
+func (c *ERPExportSaleItemClient) Use(hooks ...Hook) {
+	c.hooks.ERPExportSaleItem = append(c.hooks.ERPExportSaleItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpexportsaleitem.Intercept(f(g(h())))`.
+func (c *ERPExportSaleItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPExportSaleItem = append(c.inters.ERPExportSaleItem, interceptors...)
+}
+
+// Create returns a builder for creating a ERPExportSaleItem entity.
+func (c *ERPExportSaleItemClient) Create() *ERPExportSaleItemCreate {
+	mutation := newERPExportSaleItemMutation(c.config, OpCreate)
+	return &ERPExportSaleItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPExportSaleItem entities.
+func (c *ERPExportSaleItemClient) CreateBulk(builders ...*ERPExportSaleItemCreate) *ERPExportSaleItemCreateBulk {
+	return &ERPExportSaleItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPExportSaleItemClient) MapCreateBulk(slice any, setFunc func(*ERPExportSaleItemCreate, int)) *ERPExportSaleItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPExportSaleItemCreateBulk{err: fmt.Errorf("calling to ERPExportSaleItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPExportSaleItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPExportSaleItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPExportSaleItem.
+func (c *ERPExportSaleItemClient) Update() *ERPExportSaleItemUpdate {
+	mutation := newERPExportSaleItemMutation(c.config, OpUpdate)
+	return &ERPExportSaleItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPExportSaleItemClient) UpdateOne(_m *ERPExportSaleItem) *ERPExportSaleItemUpdateOne {
+	mutation := newERPExportSaleItemMutation(c.config, OpUpdateOne, withERPExportSaleItem(_m))
+	return &ERPExportSaleItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPExportSaleItemClient) UpdateOneID(id int) *ERPExportSaleItemUpdateOne {
+	mutation := newERPExportSaleItemMutation(c.config, OpUpdateOne, withERPExportSaleItemID(id))
+	return &ERPExportSaleItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPExportSaleItem.
+func (c *ERPExportSaleItemClient) Delete() *ERPExportSaleItemDelete {
+	mutation := newERPExportSaleItemMutation(c.config, OpDelete)
+	return &ERPExportSaleItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPExportSaleItemClient) DeleteOne(_m *ERPExportSaleItem) *ERPExportSaleItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPExportSaleItemClient) DeleteOneID(id int) *ERPExportSaleItemDeleteOne {
+	builder := c.Delete().Where(erpexportsaleitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPExportSaleItemDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPExportSaleItem.
+func (c *ERPExportSaleItemClient) Query() *ERPExportSaleItemQuery {
+	return &ERPExportSaleItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPExportSaleItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPExportSaleItem entity by its id.
+func (c *ERPExportSaleItemClient) Get(ctx context.Context, id int) (*ERPExportSaleItem, error) {
+	return c.Query().Where(erpexportsaleitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPExportSaleItemClient) GetX(ctx context.Context, id int) *ERPExportSaleItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPExportSaleItemClient) Hooks() []Hook {
+	return c.hooks.ERPExportSaleItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPExportSaleItemClient) Interceptors() []Interceptor {
+	return c.inters.ERPExportSaleItem
+}
+
+func (c *ERPExportSaleItemClient) mutate(ctx context.Context, m *ERPExportSaleItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPExportSaleItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPExportSaleItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPExportSaleItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPExportSaleItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPExportSaleItem mutation op: %q", m.Op())
+	}
+}
+
+// ERPInboundNoticeClient is a client for the ERPInboundNotice schema.
+type ERPInboundNoticeClient struct {
+	config
+}
+
+// NewERPInboundNoticeClient returns a client for the ERPInboundNotice from the given config.
+func NewERPInboundNoticeClient(c config) *ERPInboundNoticeClient {
+	return &ERPInboundNoticeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpinboundnotice.Hooks(f(g(h())))`.
+func (c *ERPInboundNoticeClient) Use(hooks ...Hook) {
+	c.hooks.ERPInboundNotice = append(c.hooks.ERPInboundNotice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpinboundnotice.Intercept(f(g(h())))`.
+func (c *ERPInboundNoticeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPInboundNotice = append(c.inters.ERPInboundNotice, interceptors...)
+}
+
+// Create returns a builder for creating a ERPInboundNotice entity.
+func (c *ERPInboundNoticeClient) Create() *ERPInboundNoticeCreate {
+	mutation := newERPInboundNoticeMutation(c.config, OpCreate)
+	return &ERPInboundNoticeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPInboundNotice entities.
+func (c *ERPInboundNoticeClient) CreateBulk(builders ...*ERPInboundNoticeCreate) *ERPInboundNoticeCreateBulk {
+	return &ERPInboundNoticeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPInboundNoticeClient) MapCreateBulk(slice any, setFunc func(*ERPInboundNoticeCreate, int)) *ERPInboundNoticeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPInboundNoticeCreateBulk{err: fmt.Errorf("calling to ERPInboundNoticeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPInboundNoticeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPInboundNoticeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPInboundNotice.
+func (c *ERPInboundNoticeClient) Update() *ERPInboundNoticeUpdate {
+	mutation := newERPInboundNoticeMutation(c.config, OpUpdate)
+	return &ERPInboundNoticeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPInboundNoticeClient) UpdateOne(_m *ERPInboundNotice) *ERPInboundNoticeUpdateOne {
+	mutation := newERPInboundNoticeMutation(c.config, OpUpdateOne, withERPInboundNotice(_m))
+	return &ERPInboundNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPInboundNoticeClient) UpdateOneID(id int) *ERPInboundNoticeUpdateOne {
+	mutation := newERPInboundNoticeMutation(c.config, OpUpdateOne, withERPInboundNoticeID(id))
+	return &ERPInboundNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPInboundNotice.
+func (c *ERPInboundNoticeClient) Delete() *ERPInboundNoticeDelete {
+	mutation := newERPInboundNoticeMutation(c.config, OpDelete)
+	return &ERPInboundNoticeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPInboundNoticeClient) DeleteOne(_m *ERPInboundNotice) *ERPInboundNoticeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPInboundNoticeClient) DeleteOneID(id int) *ERPInboundNoticeDeleteOne {
+	builder := c.Delete().Where(erpinboundnotice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPInboundNoticeDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPInboundNotice.
+func (c *ERPInboundNoticeClient) Query() *ERPInboundNoticeQuery {
+	return &ERPInboundNoticeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPInboundNotice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPInboundNotice entity by its id.
+func (c *ERPInboundNoticeClient) Get(ctx context.Context, id int) (*ERPInboundNotice, error) {
+	return c.Query().Where(erpinboundnotice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPInboundNoticeClient) GetX(ctx context.Context, id int) *ERPInboundNotice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPInboundNoticeClient) Hooks() []Hook {
+	return c.hooks.ERPInboundNotice
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPInboundNoticeClient) Interceptors() []Interceptor {
+	return c.inters.ERPInboundNotice
+}
+
+func (c *ERPInboundNoticeClient) mutate(ctx context.Context, m *ERPInboundNoticeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPInboundNoticeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPInboundNoticeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPInboundNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPInboundNoticeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPInboundNotice mutation op: %q", m.Op())
+	}
+}
+
+// ERPInboundNoticeItemClient is a client for the ERPInboundNoticeItem schema.
+type ERPInboundNoticeItemClient struct {
+	config
+}
+
+// NewERPInboundNoticeItemClient returns a client for the ERPInboundNoticeItem from the given config.
+func NewERPInboundNoticeItemClient(c config) *ERPInboundNoticeItemClient {
+	return &ERPInboundNoticeItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpinboundnoticeitem.Hooks(f(g(h())))`.
+func (c *ERPInboundNoticeItemClient) Use(hooks ...Hook) {
+	c.hooks.ERPInboundNoticeItem = append(c.hooks.ERPInboundNoticeItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpinboundnoticeitem.Intercept(f(g(h())))`.
+func (c *ERPInboundNoticeItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPInboundNoticeItem = append(c.inters.ERPInboundNoticeItem, interceptors...)
+}
+
+// Create returns a builder for creating a ERPInboundNoticeItem entity.
+func (c *ERPInboundNoticeItemClient) Create() *ERPInboundNoticeItemCreate {
+	mutation := newERPInboundNoticeItemMutation(c.config, OpCreate)
+	return &ERPInboundNoticeItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPInboundNoticeItem entities.
+func (c *ERPInboundNoticeItemClient) CreateBulk(builders ...*ERPInboundNoticeItemCreate) *ERPInboundNoticeItemCreateBulk {
+	return &ERPInboundNoticeItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPInboundNoticeItemClient) MapCreateBulk(slice any, setFunc func(*ERPInboundNoticeItemCreate, int)) *ERPInboundNoticeItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPInboundNoticeItemCreateBulk{err: fmt.Errorf("calling to ERPInboundNoticeItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPInboundNoticeItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPInboundNoticeItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPInboundNoticeItem.
+func (c *ERPInboundNoticeItemClient) Update() *ERPInboundNoticeItemUpdate {
+	mutation := newERPInboundNoticeItemMutation(c.config, OpUpdate)
+	return &ERPInboundNoticeItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPInboundNoticeItemClient) UpdateOne(_m *ERPInboundNoticeItem) *ERPInboundNoticeItemUpdateOne {
+	mutation := newERPInboundNoticeItemMutation(c.config, OpUpdateOne, withERPInboundNoticeItem(_m))
+	return &ERPInboundNoticeItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPInboundNoticeItemClient) UpdateOneID(id int) *ERPInboundNoticeItemUpdateOne {
+	mutation := newERPInboundNoticeItemMutation(c.config, OpUpdateOne, withERPInboundNoticeItemID(id))
+	return &ERPInboundNoticeItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPInboundNoticeItem.
+func (c *ERPInboundNoticeItemClient) Delete() *ERPInboundNoticeItemDelete {
+	mutation := newERPInboundNoticeItemMutation(c.config, OpDelete)
+	return &ERPInboundNoticeItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPInboundNoticeItemClient) DeleteOne(_m *ERPInboundNoticeItem) *ERPInboundNoticeItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPInboundNoticeItemClient) DeleteOneID(id int) *ERPInboundNoticeItemDeleteOne {
+	builder := c.Delete().Where(erpinboundnoticeitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPInboundNoticeItemDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPInboundNoticeItem.
+func (c *ERPInboundNoticeItemClient) Query() *ERPInboundNoticeItemQuery {
+	return &ERPInboundNoticeItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPInboundNoticeItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPInboundNoticeItem entity by its id.
+func (c *ERPInboundNoticeItemClient) Get(ctx context.Context, id int) (*ERPInboundNoticeItem, error) {
+	return c.Query().Where(erpinboundnoticeitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPInboundNoticeItemClient) GetX(ctx context.Context, id int) *ERPInboundNoticeItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPInboundNoticeItemClient) Hooks() []Hook {
+	return c.hooks.ERPInboundNoticeItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPInboundNoticeItemClient) Interceptors() []Interceptor {
+	return c.inters.ERPInboundNoticeItem
+}
+
+func (c *ERPInboundNoticeItemClient) mutate(ctx context.Context, m *ERPInboundNoticeItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPInboundNoticeItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPInboundNoticeItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPInboundNoticeItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPInboundNoticeItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPInboundNoticeItem mutation op: %q", m.Op())
+	}
+}
+
+// ERPLocationClient is a client for the ERPLocation schema.
+type ERPLocationClient struct {
+	config
+}
+
+// NewERPLocationClient returns a client for the ERPLocation from the given config.
+func NewERPLocationClient(c config) *ERPLocationClient {
+	return &ERPLocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erplocation.Hooks(f(g(h())))`.
+func (c *ERPLocationClient) Use(hooks ...Hook) {
+	c.hooks.ERPLocation = append(c.hooks.ERPLocation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erplocation.Intercept(f(g(h())))`.
+func (c *ERPLocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPLocation = append(c.inters.ERPLocation, interceptors...)
+}
+
+// Create returns a builder for creating a ERPLocation entity.
+func (c *ERPLocationClient) Create() *ERPLocationCreate {
+	mutation := newERPLocationMutation(c.config, OpCreate)
+	return &ERPLocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPLocation entities.
+func (c *ERPLocationClient) CreateBulk(builders ...*ERPLocationCreate) *ERPLocationCreateBulk {
+	return &ERPLocationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPLocationClient) MapCreateBulk(slice any, setFunc func(*ERPLocationCreate, int)) *ERPLocationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPLocationCreateBulk{err: fmt.Errorf("calling to ERPLocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPLocationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPLocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPLocation.
+func (c *ERPLocationClient) Update() *ERPLocationUpdate {
+	mutation := newERPLocationMutation(c.config, OpUpdate)
+	return &ERPLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPLocationClient) UpdateOne(_m *ERPLocation) *ERPLocationUpdateOne {
+	mutation := newERPLocationMutation(c.config, OpUpdateOne, withERPLocation(_m))
+	return &ERPLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPLocationClient) UpdateOneID(id int) *ERPLocationUpdateOne {
+	mutation := newERPLocationMutation(c.config, OpUpdateOne, withERPLocationID(id))
+	return &ERPLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPLocation.
+func (c *ERPLocationClient) Delete() *ERPLocationDelete {
+	mutation := newERPLocationMutation(c.config, OpDelete)
+	return &ERPLocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPLocationClient) DeleteOne(_m *ERPLocation) *ERPLocationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPLocationClient) DeleteOneID(id int) *ERPLocationDeleteOne {
+	builder := c.Delete().Where(erplocation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPLocationDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPLocation.
+func (c *ERPLocationClient) Query() *ERPLocationQuery {
+	return &ERPLocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPLocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPLocation entity by its id.
+func (c *ERPLocationClient) Get(ctx context.Context, id int) (*ERPLocation, error) {
+	return c.Query().Where(erplocation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPLocationClient) GetX(ctx context.Context, id int) *ERPLocation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPLocationClient) Hooks() []Hook {
+	return c.hooks.ERPLocation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPLocationClient) Interceptors() []Interceptor {
+	return c.inters.ERPLocation
+}
+
+func (c *ERPLocationClient) mutate(ctx context.Context, m *ERPLocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPLocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPLocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPLocation mutation op: %q", m.Op())
+	}
+}
+
+// ERPModuleRecordClient is a client for the ERPModuleRecord schema.
+type ERPModuleRecordClient struct {
+	config
+}
+
+// NewERPModuleRecordClient returns a client for the ERPModuleRecord from the given config.
+func NewERPModuleRecordClient(c config) *ERPModuleRecordClient {
+	return &ERPModuleRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpmodulerecord.Hooks(f(g(h())))`.
+func (c *ERPModuleRecordClient) Use(hooks ...Hook) {
+	c.hooks.ERPModuleRecord = append(c.hooks.ERPModuleRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpmodulerecord.Intercept(f(g(h())))`.
+func (c *ERPModuleRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPModuleRecord = append(c.inters.ERPModuleRecord, interceptors...)
+}
+
+// Create returns a builder for creating a ERPModuleRecord entity.
+func (c *ERPModuleRecordClient) Create() *ERPModuleRecordCreate {
+	mutation := newERPModuleRecordMutation(c.config, OpCreate)
+	return &ERPModuleRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPModuleRecord entities.
+func (c *ERPModuleRecordClient) CreateBulk(builders ...*ERPModuleRecordCreate) *ERPModuleRecordCreateBulk {
+	return &ERPModuleRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPModuleRecordClient) MapCreateBulk(slice any, setFunc func(*ERPModuleRecordCreate, int)) *ERPModuleRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPModuleRecordCreateBulk{err: fmt.Errorf("calling to ERPModuleRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPModuleRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPModuleRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPModuleRecord.
+func (c *ERPModuleRecordClient) Update() *ERPModuleRecordUpdate {
+	mutation := newERPModuleRecordMutation(c.config, OpUpdate)
+	return &ERPModuleRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPModuleRecordClient) UpdateOne(_m *ERPModuleRecord) *ERPModuleRecordUpdateOne {
+	mutation := newERPModuleRecordMutation(c.config, OpUpdateOne, withERPModuleRecord(_m))
+	return &ERPModuleRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPModuleRecordClient) UpdateOneID(id int) *ERPModuleRecordUpdateOne {
+	mutation := newERPModuleRecordMutation(c.config, OpUpdateOne, withERPModuleRecordID(id))
+	return &ERPModuleRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPModuleRecord.
+func (c *ERPModuleRecordClient) Delete() *ERPModuleRecordDelete {
+	mutation := newERPModuleRecordMutation(c.config, OpDelete)
+	return &ERPModuleRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPModuleRecordClient) DeleteOne(_m *ERPModuleRecord) *ERPModuleRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPModuleRecordClient) DeleteOneID(id int) *ERPModuleRecordDeleteOne {
+	builder := c.Delete().Where(erpmodulerecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPModuleRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPModuleRecord.
+func (c *ERPModuleRecordClient) Query() *ERPModuleRecordQuery {
+	return &ERPModuleRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPModuleRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPModuleRecord entity by its id.
+func (c *ERPModuleRecordClient) Get(ctx context.Context, id int) (*ERPModuleRecord, error) {
+	return c.Query().Where(erpmodulerecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPModuleRecordClient) GetX(ctx context.Context, id int) *ERPModuleRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPModuleRecordClient) Hooks() []Hook {
+	return c.hooks.ERPModuleRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPModuleRecordClient) Interceptors() []Interceptor {
+	return c.inters.ERPModuleRecord
+}
+
+func (c *ERPModuleRecordClient) mutate(ctx context.Context, m *ERPModuleRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPModuleRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPModuleRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPModuleRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPModuleRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPModuleRecord mutation op: %q", m.Op())
+	}
+}
+
+// ERPOutboundOrderClient is a client for the ERPOutboundOrder schema.
+type ERPOutboundOrderClient struct {
+	config
+}
+
+// NewERPOutboundOrderClient returns a client for the ERPOutboundOrder from the given config.
+func NewERPOutboundOrderClient(c config) *ERPOutboundOrderClient {
+	return &ERPOutboundOrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpoutboundorder.Hooks(f(g(h())))`.
+func (c *ERPOutboundOrderClient) Use(hooks ...Hook) {
+	c.hooks.ERPOutboundOrder = append(c.hooks.ERPOutboundOrder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpoutboundorder.Intercept(f(g(h())))`.
+func (c *ERPOutboundOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPOutboundOrder = append(c.inters.ERPOutboundOrder, interceptors...)
+}
+
+// Create returns a builder for creating a ERPOutboundOrder entity.
+func (c *ERPOutboundOrderClient) Create() *ERPOutboundOrderCreate {
+	mutation := newERPOutboundOrderMutation(c.config, OpCreate)
+	return &ERPOutboundOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPOutboundOrder entities.
+func (c *ERPOutboundOrderClient) CreateBulk(builders ...*ERPOutboundOrderCreate) *ERPOutboundOrderCreateBulk {
+	return &ERPOutboundOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPOutboundOrderClient) MapCreateBulk(slice any, setFunc func(*ERPOutboundOrderCreate, int)) *ERPOutboundOrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPOutboundOrderCreateBulk{err: fmt.Errorf("calling to ERPOutboundOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPOutboundOrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPOutboundOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPOutboundOrder.
+func (c *ERPOutboundOrderClient) Update() *ERPOutboundOrderUpdate {
+	mutation := newERPOutboundOrderMutation(c.config, OpUpdate)
+	return &ERPOutboundOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPOutboundOrderClient) UpdateOne(_m *ERPOutboundOrder) *ERPOutboundOrderUpdateOne {
+	mutation := newERPOutboundOrderMutation(c.config, OpUpdateOne, withERPOutboundOrder(_m))
+	return &ERPOutboundOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPOutboundOrderClient) UpdateOneID(id int) *ERPOutboundOrderUpdateOne {
+	mutation := newERPOutboundOrderMutation(c.config, OpUpdateOne, withERPOutboundOrderID(id))
+	return &ERPOutboundOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPOutboundOrder.
+func (c *ERPOutboundOrderClient) Delete() *ERPOutboundOrderDelete {
+	mutation := newERPOutboundOrderMutation(c.config, OpDelete)
+	return &ERPOutboundOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPOutboundOrderClient) DeleteOne(_m *ERPOutboundOrder) *ERPOutboundOrderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPOutboundOrderClient) DeleteOneID(id int) *ERPOutboundOrderDeleteOne {
+	builder := c.Delete().Where(erpoutboundorder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPOutboundOrderDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPOutboundOrder.
+func (c *ERPOutboundOrderClient) Query() *ERPOutboundOrderQuery {
+	return &ERPOutboundOrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPOutboundOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPOutboundOrder entity by its id.
+func (c *ERPOutboundOrderClient) Get(ctx context.Context, id int) (*ERPOutboundOrder, error) {
+	return c.Query().Where(erpoutboundorder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPOutboundOrderClient) GetX(ctx context.Context, id int) *ERPOutboundOrder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPOutboundOrderClient) Hooks() []Hook {
+	return c.hooks.ERPOutboundOrder
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPOutboundOrderClient) Interceptors() []Interceptor {
+	return c.inters.ERPOutboundOrder
+}
+
+func (c *ERPOutboundOrderClient) mutate(ctx context.Context, m *ERPOutboundOrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPOutboundOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPOutboundOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPOutboundOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPOutboundOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPOutboundOrder mutation op: %q", m.Op())
+	}
+}
+
+// ERPOutboundOrderItemClient is a client for the ERPOutboundOrderItem schema.
+type ERPOutboundOrderItemClient struct {
+	config
+}
+
+// NewERPOutboundOrderItemClient returns a client for the ERPOutboundOrderItem from the given config.
+func NewERPOutboundOrderItemClient(c config) *ERPOutboundOrderItemClient {
+	return &ERPOutboundOrderItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpoutboundorderitem.Hooks(f(g(h())))`.
+func (c *ERPOutboundOrderItemClient) Use(hooks ...Hook) {
+	c.hooks.ERPOutboundOrderItem = append(c.hooks.ERPOutboundOrderItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpoutboundorderitem.Intercept(f(g(h())))`.
+func (c *ERPOutboundOrderItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPOutboundOrderItem = append(c.inters.ERPOutboundOrderItem, interceptors...)
+}
+
+// Create returns a builder for creating a ERPOutboundOrderItem entity.
+func (c *ERPOutboundOrderItemClient) Create() *ERPOutboundOrderItemCreate {
+	mutation := newERPOutboundOrderItemMutation(c.config, OpCreate)
+	return &ERPOutboundOrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPOutboundOrderItem entities.
+func (c *ERPOutboundOrderItemClient) CreateBulk(builders ...*ERPOutboundOrderItemCreate) *ERPOutboundOrderItemCreateBulk {
+	return &ERPOutboundOrderItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPOutboundOrderItemClient) MapCreateBulk(slice any, setFunc func(*ERPOutboundOrderItemCreate, int)) *ERPOutboundOrderItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPOutboundOrderItemCreateBulk{err: fmt.Errorf("calling to ERPOutboundOrderItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPOutboundOrderItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPOutboundOrderItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPOutboundOrderItem.
+func (c *ERPOutboundOrderItemClient) Update() *ERPOutboundOrderItemUpdate {
+	mutation := newERPOutboundOrderItemMutation(c.config, OpUpdate)
+	return &ERPOutboundOrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPLocationClient) UpdateOne(_m *ERPLocation) *ERPLocationUpdateOne {
-	mutation := newERPLocationMutation(c.config, OpUpdateOne, withERPLocation(_m))
-	return &ERPLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPOutboundOrderItemClient) UpdateOne(_m *ERPOutboundOrderItem) *ERPOutboundOrderItemUpdateOne {
+	mutation := newERPOutboundOrderItemMutation(c.config, OpUpdateOne, withERPOutboundOrderItem(_m))
+	return &ERPOutboundOrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPLocationClient) UpdateOneID(id int) *ERPLocationUpdateOne {
-	mutation := newERPLocationMutation(c.config, OpUpdateOne, withERPLocationID(id))
-	return &ERPLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPOutboundOrderItemClient) UpdateOneID(id int) *ERPOutboundOrderItemUpdateOne {
+	mutation := newERPOutboundOrderItemMutation(c.config, OpUpdateOne, withERPOutboundOrderItemID(id))
+	return &ERPOutboundOrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPLocation.
-func (c *ERPLocationClient) Delete() *ERPLocationDelete {
-	mutation := newERPLocationMutation(c.config, OpDelete)
-	return &ERPLocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ERPOutboundOrderItem.
+func (c *ERPOutboundOrderItemClient) Delete() *ERPOutboundOrderItemDelete {
+	mutation := newERPOutboundOrderItemMutation(c.config, OpDelete)
+	return &ERPOutboundOrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPLocationClient) DeleteOne(_m *ERPLocation) *ERPLocationDeleteOne {
+func (c *ERPOutboundOrderItemClient) DeleteOne(_m *ERPOutboundOrderItem) *ERPOutboundOrderItemDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPLocationClient) DeleteOneID(id int) *ERPLocationDeleteOne {
-	builder := c.Delete().Where(erplocation.ID(id))
+func (c *ERPOutboundOrderItemClient) DeleteOneID(id int) *ERPOutboundOrderItemDeleteOne {
+	builder := c.Delete().Where(erpoutboundorderitem.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPLocationDeleteOne{builder}
+	return &ERPOutboundOrderItemDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPLocation.
-func (c *ERPLocationClient) Query() *ERPLocationQuery {
-	return &ERPLocationQuery{
+// Query returns a query builder for ERPOutboundOrderItem.
+func (c *ERPOutboundOrderItemClient) Query() *ERPOutboundOrderItemQuery {
+	return &ERPOutboundOrderItemQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPLocation},
+		ctx:    &QueryContext{Type: TypeERPOutboundOrderItem},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPLocation entity by its id.
-func (c *ERPLocationClient) Get(ctx context.Context, id int) (*ERPLocation, error) {
-	return c.Query().Where(erplocation.ID(id)).Only(ctx)
+// Get returns a ERPOutboundOrderItem entity by its id.
+func (c *ERPOutboundOrderItemClient) Get(ctx context.Context, id int) (*ERPOutboundOrderItem, error) {
+	return c.Query().Where(erpoutboundorderitem.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPLocationClient) GetX(ctx context.Context, id int) *ERPLocation {
+func (c *ERPOutboundOrderItemClient) GetX(ctx context.Context, id int) *ERPOutboundOrderItem {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1757,131 +3189,131 @@ func (c *ERPLocationClient) GetX(ctx context.Context, id int) *ERPLocation {
 }
 
 // Hooks returns the client hooks.
-func (c *ERPLocationClient) Hooks() []Hook {
-	return c.hooks.ERPLocation
+func (c *ERPOutboundOrderItemClient) Hooks() []Hook {
+	return c.hooks.ERPOutboundOrderItem
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPLocationClient) Interceptors() []Interceptor {
-	return c.inters.ERPLocation
+func (c *ERPOutboundOrderItemClient) Interceptors() []Interceptor {
+	return c.inters.ERPOutboundOrderItem
 }
 
-func (c *ERPLocationClient) mutate(ctx context.Context, m *ERPLocationMutation) (Value, error) {
+func (c *ERPOutboundOrderItemClient) mutate(ctx context.Context, m *ERPOutboundOrderItemMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPLocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPOutboundOrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPOutboundOrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPOutboundOrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPLocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ERPOutboundOrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPLocation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ERPOutboundOrderItem mutation op: %q", m.Op())
 	}
 }
 
-// ERPModuleRecordClient is a client for the ERPModuleRecord schema.
-type ERPModuleRecordClient struct {
+// ERPPartnerClient is a client for the ERPPartner schema.
+type ERPPartnerClient struct {
 	config
 }
 
-// NewERPModuleRecordClient returns a client for the ERPModuleRecord from the given config.
-func NewERPModuleRecordClient(c config) *ERPModuleRecordClient {
-	return &ERPModuleRecordClient{config: c}
+// NewERPPartnerClient returns a client for the ERPPartner from the given config.
+func NewERPPartnerClient(c config) *ERPPartnerClient {
+	return &ERPPartnerClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpmodulerecord.Hooks(f(g(h())))`.
-func (c *ERPModuleRecordClient) Use(hooks ...Hook) {
-	c.hooks.ERPModuleRecord = append(c.hooks.ERPModuleRecord, hooks...)
+// A call to `Use(f, g, h)` equals to `erppartner.Hooks(f(g(h())))`.
+func (c *ERPPartnerClient) Use(hooks ...Hook) {
+	c.hooks.ERPPartner = append(c.hooks.ERPPartner, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpmodulerecord.Intercept(f(g(h())))`.
-func (c *ERPModuleRecordClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPModuleRecord = append(c.inters.ERPModuleRecord, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `erppartner.Intercept(f(g(h())))`.
+func (c *ERPPartnerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPPartner = append(c.inters.ERPPartner, interceptors...)
 }
 
-// Create returns a builder for creating a ERPModuleRecord entity.
-func (c *ERPModuleRecordClient) Create() *ERPModuleRecordCreate {
-	mutation := newERPModuleRecordMutation(c.config, OpCreate)
-	return &ERPModuleRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ERPPartner entity.
+func (c *ERPPartnerClient) Create() *ERPPartnerCreate {
+	mutation := newERPPartnerMutation(c.config, OpCreate)
+	return &ERPPartnerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPModuleRecord entities.
-func (c *ERPModuleRecordClient) CreateBulk(builders ...*ERPModuleRecordCreate) *ERPModuleRecordCreateBulk {
-	return &ERPModuleRecordCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ERPPartner entities.
+func (c *ERPPartnerClient) CreateBulk(builders ...*ERPPartnerCreate) *ERPPartnerCreateBulk {
+	return &ERPPartnerCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPModuleRecordClient) MapCreateBulk(slice any, setFunc func(*ERPModuleRecordCreate, int)) *ERPModuleRecordCreateBulk {
+func (c *ERPPartnerClient) MapCreateBulk(slice any, setFunc func(*ERPPartnerCreate, int)) *ERPPartnerCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPModuleRecordCreateBulk{err: fmt.Errorf("calling to ERPModuleRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ERPPartnerCreateBulk{err: fmt.Errorf("calling to ERPPartnerClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPModuleRecordCreate, rv.Len())
+	builders := make([]*ERPPartnerCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPModuleRecordCreateBulk{config: c.config, builders: builders}
+	return &ERPPartnerCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPModuleRecord.
-func (c *ERPModuleRecordClient) Update() *ERPModuleRecordUpdate {
-	mutation := newERPModuleRecordMutation(c.config, OpUpdate)
-	return &ERPModuleRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ERPPartner.
+func (c *ERPPartnerClient) Update() *ERPPartnerUpdate {
+	mutation := newERPPartnerMutation(c.config, OpUpdate)
+	return &ERPPartnerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPModuleRecordClient) UpdateOne(_m *ERPModuleRecord) *ERPModuleRecordUpdateOne {
-	mutation := newERPModuleRecordMutation(c.config, OpUpdateOne, withERPModuleRecord(_m))
-	return &ERPModuleRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPPartnerClient) UpdateOne(_m *ERPPartner) *ERPPartnerUpdateOne {
+	mutation := newERPPartnerMutation(c.config, OpUpdateOne, withERPPartner(_m))
+	return &ERPPartnerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPModuleRecordClient) UpdateOneID(id int) *ERPModuleRecordUpdateOne {
-	mutation := newERPModuleRecordMutation(c.config, OpUpdateOne, withERPModuleRecordID(id))
-	return &ERPModuleRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPPartnerClient) UpdateOneID(id int) *ERPPartnerUpdateOne {
+	mutation := newERPPartnerMutation(c.config, OpUpdateOne, withERPPartnerID(id))
+	return &ERPPartnerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPModuleRecord.
-func (c *ERPModuleRecordClient) Delete() *ERPModuleRecordDelete {
-	mutation := newERPModuleRecordMutation(c.config, OpDelete)
-	return &ERPModuleRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ERPPartner.
+func (c *ERPPartnerClient) Delete() *ERPPartnerDelete {
+	mutation := newERPPartnerMutation(c.config, OpDelete)
+	return &ERPPartnerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPModuleRecordClient) DeleteOne(_m *ERPModuleRecord) *ERPModuleRecordDeleteOne {
+func (c *ERPPartnerClient) DeleteOne(_m *ERPPartner) *ERPPartnerDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPModuleRecordClient) DeleteOneID(id int) *ERPModuleRecordDeleteOne {
-	builder := c.Delete().Where(erpmodulerecord.ID(id))
+func (c *ERPPartnerClient) DeleteOneID(id int) *ERPPartnerDeleteOne {
+	builder := c.Delete().Where(erppartner.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPModuleRecordDeleteOne{builder}
+	return &ERPPartnerDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPModuleRecord.
-func (c *ERPModuleRecordClient) Query() *ERPModuleRecordQuery {
-	return &ERPModuleRecordQuery{
+// Query returns a query builder for ERPPartner.
+func (c *ERPPartnerClient) Query() *ERPPartnerQuery {
+	return &ERPPartnerQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPModuleRecord},
+		ctx:    &QueryContext{Type: TypeERPPartner},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPModuleRecord entity by its id.
-func (c *ERPModuleRecordClient) Get(ctx context.Context, id int) (*ERPModuleRecord, error) {
-	return c.Query().Where(erpmodulerecord.ID(id)).Only(ctx)
+// Get returns a ERPPartner entity by its id.
+func (c *ERPPartnerClient) Get(ctx context.Context, id int) (*ERPPartner, error) {
+	return c.Query().Where(erppartner.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPModuleRecordClient) GetX(ctx context.Context, id int) *ERPModuleRecord {
+func (c *ERPPartnerClient) GetX(ctx context.Context, id int) *ERPPartner {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1890,131 +3322,131 @@ func (c *ERPModuleRecordClient) GetX(ctx context.Context, id int) *ERPModuleReco
 }
 
 // Hooks returns the client hooks.
-func (c *ERPModuleRecordClient) Hooks() []Hook {
-	return c.hooks.ERPModuleRecord
+func (c *ERPPartnerClient) Hooks() []Hook {
+	return c.hooks.ERPPartner
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPModuleRecordClient) Interceptors() []Interceptor {
-	return c.inters.ERPModuleRecord
+func (c *ERPPartnerClient) Interceptors() []Interceptor {
+	return c.inters.ERPPartner
 }
 
-func (c *ERPModuleRecordClient) mutate(ctx context.Context, m *ERPModuleRecordMutation) (Value, error) {
+func (c *ERPPartnerClient) mutate(ctx context.Context, m *ERPPartnerMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPModuleRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPModuleRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPModuleRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPModuleRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ERPPartnerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPModuleRecord mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ERPPartner mutation op: %q", m.Op())
 	}
 }
 
-// ERPOutboundOrderClient is a client for the ERPOutboundOrder schema.
-type ERPOutboundOrderClient struct {
+// ERPPartnerAddressClient is a client for the ERPPartnerAddress schema.
+type ERPPartnerAddressClient struct {
 	config
 }
 
-// NewERPOutboundOrderClient returns a client for the ERPOutboundOrder from the given config.
-func NewERPOutboundOrderClient(c config) *ERPOutboundOrderClient {
-	return &ERPOutboundOrderClient{config: c}
+// NewERPPartnerAddressClient returns a client for the ERPPartnerAddress from the given config.
+func NewERPPartnerAddressClient(c config) *ERPPartnerAddressClient {
+	return &ERPPartnerAddressClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpoutboundorder.Hooks(f(g(h())))`.
-func (c *ERPOutboundOrderClient) Use(hooks ...Hook) {
-	c.hooks.ERPOutboundOrder = append(c.hooks.ERPOutboundOrder, hooks...)
+// A call to `Use(f, g, h)` equals to `erppartneraddress.Hooks(f(g(h())))`.
+func (c *ERPPartnerAddressClient) Use(hooks ...Hook) {
+	c.hooks.ERPPartnerAddress = append(c.hooks.ERPPartnerAddress, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpoutboundorder.Intercept(f(g(h())))`.
-func (c *ERPOutboundOrderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPOutboundOrder = append(c.inters.ERPOutboundOrder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `erppartneraddress.Intercept(f(g(h())))`.
+func (c *ERPPartnerAddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPPartnerAddress = append(c.inters.ERPPartnerAddress, interceptors...)
 }
 
-// Create returns a builder for creating a ERPOutboundOrder entity.
-func (c *ERPOutboundOrderClient) Create() *ERPOutboundOrderCreate {
-	mutation := newERPOutboundOrderMutation(c.config, OpCreate)
-	return &ERPOutboundOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ERPPartnerAddress entity.
+func (c *ERPPartnerAddressClient) Create() *ERPPartnerAddressCreate {
+	mutation := newERPPartnerAddressMutation(c.config, OpCreate)
+	return &ERPPartnerAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPOutboundOrder entities.
-func (c *ERPOutboundOrderClient) CreateBulk(builders ...*ERPOutboundOrderCreate) *ERPOutboundOrderCreateBulk {
-	return &ERPOutboundOrderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ERPPartnerAddress entities.
+func (c *ERPPartnerAddressClient) CreateBulk(builders ...*ERPPartnerAddressCreate) *ERPPartnerAddressCreateBulk {
+	return &ERPPartnerAddressCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPOutboundOrderClient) MapCreateBulk(slice any, setFunc func(*ERPOutboundOrderCreate, int)) *ERPOutboundOrderCreateBulk {
+func (c *ERPPartnerAddressClient) MapCreateBulk(slice any, setFunc func(*ERPPartnerAddressCreate, int)) *ERPPartnerAddressCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPOutboundOrderCreateBulk{err: fmt.Errorf("calling to ERPOutboundOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ERPPartnerAddressCreateBulk{err: fmt.Errorf("calling to ERPPartnerAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPOutboundOrderCreate, rv.Len())
+	builders := make([]*ERPPartnerAddressCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPOutboundOrderCreateBulk{config: c.config, builders: builders}
+	return &ERPPartnerAddressCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPOutboundOrder.
-func (c *ERPOutboundOrderClient) Update() *ERPOutboundOrderUpdate {
-	mutation := newERPOutboundOrderMutation(c.config, OpUpdate)
-	return &ERPOutboundOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ERPPartnerAddress.
+func (c *ERPPartnerAddressClient) Update() *ERPPartnerAddressUpdate {
+	mutation := newERPPartnerAddressMutation(c.config, OpUpdate)
+	return &ERPPartnerAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPOutboundOrderClient) UpdateOne(_m *ERPOutboundOrder) *ERPOutboundOrderUpdateOne {
-	mutation := newERPOutboundOrderMutation(c.config, OpUpdateOne, withERPOutboundOrder(_m))
-	return &ERPOutboundOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPPartnerAddressClient) UpdateOne(_m *ERPPartnerAddress) *ERPPartnerAddressUpdateOne {
+	mutation := newERPPartnerAddressMutation(c.config, OpUpdateOne, withERPPartnerAddress(_m))
+	return &ERPPartnerAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPOutboundOrderClient) UpdateOneID(id int) *ERPOutboundOrderUpdateOne {
-	mutation := newERPOutboundOrderMutation(c.config, OpUpdateOne, withERPOutboundOrderID(id))
-	return &ERPOutboundOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPPartnerAddressClient) UpdateOneID(id int) *ERPPartnerAddressUpdateOne {
+	mutation := newERPPartnerAddressMutation(c.config, OpUpdateOne, withERPPartnerAddressID(id))
+	return &ERPPartnerAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPOutboundOrder.
-func (c *ERPOutboundOrderClient) Delete() *ERPOutboundOrderDelete {
-	mutation := newERPOutboundOrderMutation(c.config, OpDelete)
-	return &ERPOutboundOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ERPPartnerAddress.
+func (c *ERPPartnerAddressClient) Delete() *ERPPartnerAddressDelete {
+	mutation := newERPPartnerAddressMutation(c.config, OpDelete)
+	return &ERPPartnerAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPOutboundOrderClient) DeleteOne(_m *ERPOutboundOrder) *ERPOutboundOrderDeleteOne {
+func (c *ERPPartnerAddressClient) DeleteOne(_m *ERPPartnerAddress) *ERPPartnerAddressDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPOutboundOrderClient) DeleteOneID(id int) *ERPOutboundOrderDeleteOne {
-	builder := c.Delete().Where(erpoutboundorder.ID(id))
+func (c *ERPPartnerAddressClient) DeleteOneID(id int) *ERPPartnerAddressDeleteOne {
+	builder := c.Delete().Where(erppartneraddress.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPOutboundOrderDeleteOne{builder}
+	return &ERPPartnerAddressDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPOutboundOrder.
-func (c *ERPOutboundOrderClient) Query() *ERPOutboundOrderQuery {
-	return &ERPOutboundOrderQuery{
+// Query returns a query builder for ERPPartnerAddress.
+func (c *ERPPartnerAddressClient) Query() *ERPPartnerAddressQuery {
+	return &ERPPartnerAddressQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPOutboundOrder},
+		ctx:    &QueryContext{Type: TypeERPPartnerAddress},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPOutboundOrder entity by its id.
-func (c *ERPOutboundOrderClient) Get(ctx context.Context, id int) (*ERPOutboundOrder, error) {
-	return c.Query().Where(erpoutboundorder.ID(id)).Only(ctx)
+// Get returns a ERPPartnerAddress entity by its id.
+func (c *ERPPartnerAddressClient) Get(ctx context.Context, id int) (*ERPPartnerAddress, error) {
+	return c.Query().Where(erppartneraddress.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPOutboundOrderClient) GetX(ctx context.Context, id int) *ERPOutboundOrder {
+func (c *ERPPartnerAddressClient) GetX(ctx context.Context, id int) *ERPPartnerAddress {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2023,131 +3455,131 @@ func (c *ERPOutboundOrderClient) GetX(ctx context.Context, id int) *ERPOutboundO
 }
 
 // Hooks returns the client hooks.
-func (c *ERPOutboundOrderClient) Hooks() []Hook {
-	return c.hooks.ERPOutboundOrder
+func (c *ERPPartnerAddressClient) Hooks() []Hook {
+	return c.hooks.ERPPartnerAddress
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPOutboundOrderClient) Interceptors() []Interceptor {
-	return c.inters.ERPOutboundOrder
+func (c *ERPPartnerAddressClient) Interceptors() []Interceptor {
+	return c.inters.ERPPartnerAddress
 }
 
-func (c *ERPOutboundOrderClient) mutate(ctx context.Context, m *ERPOutboundOrderMutation) (Value, error) {
+func (c *ERPPartnerAddressClient) mutate(ctx context.Context, m *ERPPartnerAddressMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPOutboundOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPOutboundOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPOutboundOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPOutboundOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ERPPartnerAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPOutboundOrder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ERPPartnerAddress mutation op: %q", m.Op())
 	}
 }
 
-// ERPOutboundOrderItemClient is a client for the ERPOutboundOrderItem schema.
-type ERPOutboundOrderItemClient struct {
+// ERPPartnerBankAccountClient is a client for the ERPPartnerBankAccount schema.
+type ERPPartnerBankAccountClient struct {
 	config
 }
 
-// NewERPOutboundOrderItemClient returns a client for the ERPOutboundOrderItem from the given config.
-func NewERPOutboundOrderItemClient(c config) *ERPOutboundOrderItemClient {
-	return &ERPOutboundOrderItemClient{config: c}
+// NewERPPartnerBankAccountClient returns a client for the ERPPartnerBankAccount from the given config.
+func NewERPPartnerBankAccountClient(c config) *ERPPartnerBankAccountClient {
+	return &ERPPartnerBankAccountClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erpoutboundorderitem.Hooks(f(g(h())))`.
-func (c *ERPOutboundOrderItemClient) Use(hooks ...Hook) {
-	c.hooks.ERPOutboundOrderItem = append(c.hooks.ERPOutboundOrderItem, hooks...)
+// A call to `Use(f, g, h)` equals to `erppartnerbankaccount.Hooks(f(g(h())))`.
+func (c *ERPPartnerBankAccountClient) Use(hooks ...Hook) {
+	c.hooks.ERPPartnerBankAccount = append(c.hooks.ERPPartnerBankAccount, hooks...)
 }
 
-// Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erpoutboundorderitem.Intercept(f(g(h())))`.
-func (c *ERPOutboundOrderItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPOutboundOrderItem = append(c.inters.ERPOutboundOrderItem, interceptors...)
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erppartnerbankaccount.Intercept(f(g(h())))`.
+func (c *ERPPartnerBankAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPPartnerBankAccount = append(c.inters.ERPPartnerBankAccount, interceptors...)
 }
 
-// Create returns a builder for creating a ERPOutboundOrderItem entity.
-func (c *ERPOutboundOrderItemClient) Create() *ERPOutboundOrderItemCreate {
-	mutation := newERPOutboundOrderItemMutation(c.config, OpCreate)
-	return &ERPOutboundOrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ERPPartnerBankAccount entity.
+func (c *ERPPartnerBankAccountClient) Create() *ERPPartnerBankAccountCreate {
+	mutation := newERPPartnerBankAccountMutation(c.config, OpCreate)
+	return &ERPPartnerBankAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPOutboundOrderItem entities.
-func (c *ERPOutboundOrderItemClient) CreateBulk(builders ...*ERPOutboundOrderItemCreate) *ERPOutboundOrderItemCreateBulk {
-	return &ERPOutboundOrderItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ERPPartnerBankAccount entities.
+func (c *ERPPartnerBankAccountClient) CreateBulk(builders ...*ERPPartnerBankAccountCreate) *ERPPartnerBankAccountCreateBulk {
+	return &ERPPartnerBankAccountCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPOutboundOrderItemClient) MapCreateBulk(slice any, setFunc func(*ERPOutboundOrderItemCreate, int)) *ERPOutboundOrderItemCreateBulk {
+func (c *ERPPartnerBankAccountClient) MapCreateBulk(slice any, setFunc func(*ERPPartnerBankAccountCreate, int)) *ERPPartnerBankAccountCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPOutboundOrderItemCreateBulk{err: fmt.Errorf("calling to ERPOutboundOrderItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ERPPartnerBankAccountCreateBulk{err: fmt.Errorf("calling to ERPPartnerBankAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPOutboundOrderItemCreate, rv.Len())
+	builders := make([]*ERPPartnerBankAccountCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPOutboundOrderItemCreateBulk{config: c.config, builders: builders}
+	return &ERPPartnerBankAccountCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPOutboundOrderItem.
-func (c *ERPOutboundOrderItemClient) Update() *ERPOutboundOrderItemUpdate {
-	mutation := newERPOutboundOrderItemMutation(c.config, OpUpdate)
-	return &ERPOutboundOrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ERPPartnerBankAccount.
+func (c *ERPPartnerBankAccountClient) Update() *ERPPartnerBankAccountUpdate {
+	mutation := newERPPartnerBankAccountMutation(c.config, OpUpdate)
+	return &ERPPartnerBankAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPOutboundOrderItemClient) UpdateOne(_m *ERPOutboundOrderItem) *ERPOutboundOrderItemUpdateOne {
-	mutation := newERPOutboundOrderItemMutation(c.config, OpUpdateOne, withERPOutboundOrderItem(_m))
-	return &ERPOutboundOrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPPartnerBankAccountClient) UpdateOne(_m *ERPPartnerBankAccount) *ERPPartnerBankAccountUpdateOne {
+	mutation := newERPPartnerBankAccountMutation(c.config, OpUpdateOne, withERPPartnerBankAccount(_m))
+	return &ERPPartnerBankAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPOutboundOrderItemClient) UpdateOneID(id int) *ERPOutboundOrderItemUpdateOne {
-	mutation := newERPOutboundOrderItemMutation(c.config, OpUpdateOne, withERPOutboundOrderItemID(id))
-	return &ERPOutboundOrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPPartnerBankAccountClient) UpdateOneID(id int) *ERPPartnerBankAccountUpdateOne {
+	mutation := newERPPartnerBankAccountMutation(c.config, OpUpdateOne, withERPPartnerBankAccountID(id))
+	return &ERPPartnerBankAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPOutboundOrderItem.
-func (c *ERPOutboundOrderItemClient) Delete() *ERPOutboundOrderItemDelete {
-	mutation := newERPOutboundOrderItemMutation(c.config, OpDelete)
-	return &ERPOutboundOrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ERPPartnerBankAccount.
+func (c *ERPPartnerBankAccountClient) Delete() *ERPPartnerBankAccountDelete {
+	mutation := newERPPartnerBankAccountMutation(c.config, OpDelete)
+	return &ERPPartnerBankAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPOutboundOrderItemClient) DeleteOne(_m *ERPOutboundOrderItem) *ERPOutboundOrderItemDeleteOne {
+func (c *ERPPartnerBankAccountClient) DeleteOne(_m *ERPPartnerBankAccount) *ERPPartnerBankAccountDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPOutboundOrderItemClient) DeleteOneID(id int) *ERPOutboundOrderItemDeleteOne {
-	builder := c.Delete().Where(erpoutboundorderitem.ID(id))
+func (c *ERPPartnerBankAccountClient) DeleteOneID(id int) *ERPPartnerBankAccountDeleteOne {
+	builder := c.Delete().Where(erppartnerbankaccount.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPOutboundOrderItemDeleteOne{builder}
+	return &ERPPartnerBankAccountDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPOutboundOrderItem.
-func (c *ERPOutboundOrderItemClient) Query() *ERPOutboundOrderItemQuery {
-	return &ERPOutboundOrderItemQuery{
+// Query returns a query builder for ERPPartnerBankAccount.
+func (c *ERPPartnerBankAccountClient) Query() *ERPPartnerBankAccountQuery {
+	return &ERPPartnerBankAccountQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPOutboundOrderItem},
+		ctx:    &QueryContext{Type: TypeERPPartnerBankAccount},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPOutboundOrderItem entity by its id.
-func (c *ERPOutboundOrderItemClient) Get(ctx context.Context, id int) (*ERPOutboundOrderItem, error) {
-	return c.Query().Where(erpoutboundorderitem.ID(id)).Only(ctx)
+// Get returns a ERPPartnerBankAccount entity by its id.
+func (c *ERPPartnerBankAccountClient) Get(ctx context.Context, id int) (*ERPPartnerBankAccount, error) {
+	return c.Query().Where(erppartnerbankaccount.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPOutboundOrderItemClient) GetX(ctx context.Context, id int) *ERPOutboundOrderItem {
+func (c *ERPPartnerBankAccountClient) GetX(ctx context.Context, id int) *ERPPartnerBankAccount {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2156,131 +3588,131 @@ func (c *ERPOutboundOrderItemClient) GetX(ctx context.Context, id int) *ERPOutbo
 }
 
 // Hooks returns the client hooks.
-func (c *ERPOutboundOrderItemClient) Hooks() []Hook {
-	return c.hooks.ERPOutboundOrderItem
+func (c *ERPPartnerBankAccountClient) Hooks() []Hook {
+	return c.hooks.ERPPartnerBankAccount
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPOutboundOrderItemClient) Interceptors() []Interceptor {
-	return c.inters.ERPOutboundOrderItem
+func (c *ERPPartnerBankAccountClient) Interceptors() []Interceptor {
+	return c.inters.ERPPartnerBankAccount
 }
 
-func (c *ERPOutboundOrderItemClient) mutate(ctx context.Context, m *ERPOutboundOrderItemMutation) (Value, error) {
+func (c *ERPPartnerBankAccountClient) mutate(ctx context.Context, m *ERPPartnerBankAccountMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPOutboundOrderItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerBankAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPOutboundOrderItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerBankAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPOutboundOrderItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerBankAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPOutboundOrderItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ERPPartnerBankAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPOutboundOrderItem mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ERPPartnerBankAccount mutation op: %q", m.Op())
 	}
 }
 
-// ERPPartnerClient is a client for the ERPPartner schema.
-type ERPPartnerClient struct {
+// ERPPartnerContactClient is a client for the ERPPartnerContact schema.
+type ERPPartnerContactClient struct {
 	config
 }
 
-// NewERPPartnerClient returns a client for the ERPPartner from the given config.
-func NewERPPartnerClient(c config) *ERPPartnerClient {
-	return &ERPPartnerClient{config: c}
+// NewERPPartnerContactClient returns a client for the ERPPartnerContact from the given config.
+func NewERPPartnerContactClient(c config) *ERPPartnerContactClient {
+	return &ERPPartnerContactClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `erppartner.Hooks(f(g(h())))`.
-func (c *ERPPartnerClient) Use(hooks ...Hook) {
-	c.hooks.ERPPartner = append(c.hooks.ERPPartner, hooks...)
+// A call to `Use(f, g, h)` equals to `erppartnercontact.Hooks(f(g(h())))`.
+func (c *ERPPartnerContactClient) Use(hooks ...Hook) {
+	c.hooks.ERPPartnerContact = append(c.hooks.ERPPartnerContact, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `erppartner.Intercept(f(g(h())))`.
-func (c *ERPPartnerClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ERPPartner = append(c.inters.ERPPartner, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `erppartnercontact.Intercept(f(g(h())))`.
+func (c *ERPPartnerContactClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPPartnerContact = append(c.inters.ERPPartnerContact, interceptors...)
 }
 
-// Create returns a builder for creating a ERPPartner entity.
-func (c *ERPPartnerClient) Create() *ERPPartnerCreate {
-	mutation := newERPPartnerMutation(c.config, OpCreate)
-	return &ERPPartnerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ERPPartnerContact entity.
+func (c *ERPPartnerContactClient) Create() *ERPPartnerContactCreate {
+	mutation := newERPPartnerContactMutation(c.config, OpCreate)
+	return &ERPPartnerContactCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ERPPartner entities.
-func (c *ERPPartnerClient) CreateBulk(builders ...*ERPPartnerCreate) *ERPPartnerCreateBulk {
-	return &ERPPartnerCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ERPPartnerContact entities.
+func (c *ERPPartnerContactClient) CreateBulk(builders ...*ERPPartnerContactCreate) *ERPPartnerContactCreateBulk {
+	return &ERPPartnerContactCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ERPPartnerClient) MapCreateBulk(slice any, setFunc func(*ERPPartnerCreate, int)) *ERPPartnerCreateBulk {
+func (c *ERPPartnerContactClient) MapCreateBulk(slice any, setFunc func(*ERPPartnerContactCreate, int)) *ERPPartnerContactCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ERPPartnerCreateBulk{err: fmt.Errorf("calling to ERPPartnerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ERPPartnerContactCreateBulk{err: fmt.Errorf("calling to ERPPartnerContactClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ERPPartnerCreate, rv.Len())
+	builders := make([]*ERPPartnerContactCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ERPPartnerCreateBulk{config: c.config, builders: builders}
+	return &ERPPartnerContactCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ERPPartner.
-func (c *ERPPartnerClient) Update() *ERPPartnerUpdate {
-	mutation := newERPPartnerMutation(c.config, OpUpdate)
-	return &ERPPartnerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ERPPartnerContact.
+func (c *ERPPartnerContactClient) Update() *ERPPartnerContactUpdate {
+	mutation := newERPPartnerContactMutation(c.config, OpUpdate)
+	return &ERPPartnerContactUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ERPPartnerClient) UpdateOne(_m *ERPPartner) *ERPPartnerUpdateOne {
-	mutation := newERPPartnerMutation(c.config, OpUpdateOne, withERPPartner(_m))
-	return &ERPPartnerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPPartnerContactClient) UpdateOne(_m *ERPPartnerContact) *ERPPartnerContactUpdateOne {
+	mutation := newERPPartnerContactMutation(c.config, OpUpdateOne, withERPPartnerContact(_m))
+	return &ERPPartnerContactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ERPPartnerClient) UpdateOneID(id int) *ERPPartnerUpdateOne {
-	mutation := newERPPartnerMutation(c.config, OpUpdateOne, withERPPartnerID(id))
-	return &ERPPartnerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ERPPartnerContactClient) UpdateOneID(id int) *ERPPartnerContactUpdateOne {
+	mutation := newERPPartnerContactMutation(c.config, OpUpdateOne, withERPPartnerContactID(id))
+	return &ERPPartnerContactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ERPPartner.
-func (c *ERPPartnerClient) Delete() *ERPPartnerDelete {
-	mutation := newERPPartnerMutation(c.config, OpDelete)
-	return &ERPPartnerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ERPPartnerContact.
+func (c *ERPPartnerContactClient) Delete() *ERPPartnerContactDelete {
+	mutation := newERPPartnerContactMutation(c.config, OpDelete)
+	return &ERPPartnerContactDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ERPPartnerClient) DeleteOne(_m *ERPPartner) *ERPPartnerDeleteOne {
+func (c *ERPPartnerContactClient) DeleteOne(_m *ERPPartnerContact) *ERPPartnerContactDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ERPPartnerClient) DeleteOneID(id int) *ERPPartnerDeleteOne {
-	builder := c.Delete().Where(erppartner.ID(id))
+func (c *ERPPartnerContactClient) DeleteOneID(id int) *ERPPartnerContactDeleteOne {
+	builder := c.Delete().Where(erppartnercontact.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ERPPartnerDeleteOne{builder}
+	return &ERPPartnerContactDeleteOne{builder}
 }
 
-// Query returns a query builder for ERPPartner.
-func (c *ERPPartnerClient) Query() *ERPPartnerQuery {
-	return &ERPPartnerQuery{
+// Query returns a query builder for ERPPartnerContact.
+func (c *ERPPartnerContactClient) Query() *ERPPartnerContactQuery {
+	return &ERPPartnerContactQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeERPPartner},
+		ctx:    &QueryContext{Type: TypeERPPartnerContact},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ERPPartner entity by its id.
-func (c *ERPPartnerClient) Get(ctx context.Context, id int) (*ERPPartner, error) {
-	return c.Query().Where(erppartner.ID(id)).Only(ctx)
+// Get returns a ERPPartnerContact entity by its id.
+func (c *ERPPartnerContactClient) Get(ctx context.Context, id int) (*ERPPartnerContact, error) {
+	return c.Query().Where(erppartnercontact.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ERPPartnerClient) GetX(ctx context.Context, id int) *ERPPartner {
+func (c *ERPPartnerContactClient) GetX(ctx context.Context, id int) *ERPPartnerContact {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2289,27 +3721,27 @@ func (c *ERPPartnerClient) GetX(ctx context.Context, id int) *ERPPartner {
 }
 
 // Hooks returns the client hooks.
-func (c *ERPPartnerClient) Hooks() []Hook {
-	return c.hooks.ERPPartner
+func (c *ERPPartnerContactClient) Hooks() []Hook {
+	return c.hooks.ERPPartnerContact
 }
 
 // Interceptors returns the client interceptors.
-func (c *ERPPartnerClient) Interceptors() []Interceptor {
-	return c.inters.ERPPartner
+func (c *ERPPartnerContactClient) Interceptors() []Interceptor {
+	return c.inters.ERPPartnerContact
 }
 
-func (c *ERPPartnerClient) mutate(ctx context.Context, m *ERPPartnerMutation) (Value, error) {
+func (c *ERPPartnerContactClient) mutate(ctx context.Context, m *ERPPartnerContactMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ERPPartnerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerContactCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ERPPartnerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerContactUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ERPPartnerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ERPPartnerContactUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ERPPartnerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ERPPartnerContactDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ERPPartner mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ERPPartnerContact mutation op: %q", m.Op())
 	}
 }
 
@@ -2978,6 +4410,139 @@ func (c *ERPQuotationItemClient) mutate(ctx context.Context, m *ERPQuotationItem
 	}
 }
 
+// ERPRecordRevisionClient is a client for the ERPRecordRevision schema.
+type ERPRecordRevisionClient struct {
+	config
+}
+
+// NewERPRecordRevisionClient returns a client for the ERPRecordRevision from the given config.
+func NewERPRecordRevisionClient(c config) *ERPRecordRevisionClient {
+	return &ERPRecordRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erprecordrevision.Hooks(f(g(h())))`.
+func (c *ERPRecordRevisionClient) Use(hooks ...Hook) {
+	c.hooks.ERPRecordRevision = append(c.hooks.ERPRecordRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erprecordrevision.Intercept(f(g(h())))`.
+func (c *ERPRecordRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPRecordRevision = append(c.inters.ERPRecordRevision, interceptors...)
+}
+
+// Create returns a builder for creating a ERPRecordRevision entity.
+func (c *ERPRecordRevisionClient) Create() *ERPRecordRevisionCreate {
+	mutation := newERPRecordRevisionMutation(c.config, OpCreate)
+	return &ERPRecordRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPRecordRevision entities.
+func (c *ERPRecordRevisionClient) CreateBulk(builders ...*ERPRecordRevisionCreate) *ERPRecordRevisionCreateBulk {
+	return &ERPRecordRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPRecordRevisionClient) MapCreateBulk(slice any, setFunc func(*ERPRecordRevisionCreate, int)) *ERPRecordRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPRecordRevisionCreateBulk{err: fmt.Errorf("calling to ERPRecordRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPRecordRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPRecordRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPRecordRevision.
+func (c *ERPRecordRevisionClient) Update() *ERPRecordRevisionUpdate {
+	mutation := newERPRecordRevisionMutation(c.config, OpUpdate)
+	return &ERPRecordRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPRecordRevisionClient) UpdateOne(_m *ERPRecordRevision) *ERPRecordRevisionUpdateOne {
+	mutation := newERPRecordRevisionMutation(c.config, OpUpdateOne, withERPRecordRevision(_m))
+	return &ERPRecordRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPRecordRevisionClient) UpdateOneID(id int) *ERPRecordRevisionUpdateOne {
+	mutation := newERPRecordRevisionMutation(c.config, OpUpdateOne, withERPRecordRevisionID(id))
+	return &ERPRecordRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPRecordRevision.
+func (c *ERPRecordRevisionClient) Delete() *ERPRecordRevisionDelete {
+	mutation := newERPRecordRevisionMutation(c.config, OpDelete)
+	return &ERPRecordRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPRecordRevisionClient) DeleteOne(_m *ERPRecordRevision) *ERPRecordRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPRecordRevisionClient) DeleteOneID(id int) *ERPRecordRevisionDeleteOne {
+	builder := c.Delete().Where(erprecordrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPRecordRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPRecordRevision.
+func (c *ERPRecordRevisionClient) Query() *ERPRecordRevisionQuery {
+	return &ERPRecordRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPRecordRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPRecordRevision entity by its id.
+func (c *ERPRecordRevisionClient) Get(ctx context.Context, id int) (*ERPRecordRevision, error) {
+	return c.Query().Where(erprecordrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPRecordRevisionClient) GetX(ctx context.Context, id int) *ERPRecordRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPRecordRevisionClient) Hooks() []Hook {
+	return c.hooks.ERPRecordRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPRecordRevisionClient) Interceptors() []Interceptor {
+	return c.inters.ERPRecordRevision
+}
+
+func (c *ERPRecordRevisionClient) mutate(ctx context.Context, m *ERPRecordRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPRecordRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPRecordRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPRecordRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPRecordRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPRecordRevision mutation op: %q", m.Op())
+	}
+}
+
 // ERPSequenceClient is a client for the ERPSequence schema.
 type ERPSequenceClient struct {
 	config
@@ -3244,6 +4809,139 @@ func (c *ERPSettlementClient) mutate(ctx context.Context, m *ERPSettlementMutati
 	}
 }
 
+// ERPSettlementLineClient is a client for the ERPSettlementLine schema.
+type ERPSettlementLineClient struct {
+	config
+}
+
+// NewERPSettlementLineClient returns a client for the ERPSettlementLine from the given config.
+func NewERPSettlementLineClient(c config) *ERPSettlementLineClient {
+	return &ERPSettlementLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `erpsettlementline.Hooks(f(g(h())))`.
+func (c *ERPSettlementLineClient) Use(hooks ...Hook) {
+	c.hooks.ERPSettlementLine = append(c.hooks.ERPSettlementLine, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `erpsettlementline.Intercept(f(g(h())))`.
+func (c *ERPSettlementLineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ERPSettlementLine = append(c.inters.ERPSettlementLine, interceptors...)
+}
+
+// Create returns a builder for creating a ERPSettlementLine entity.
+func (c *ERPSettlementLineClient) Create() *ERPSettlementLineCreate {
+	mutation := newERPSettlementLineMutation(c.config, OpCreate)
+	return &ERPSettlementLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ERPSettlementLine entities.
+func (c *ERPSettlementLineClient) CreateBulk(builders ...*ERPSettlementLineCreate) *ERPSettlementLineCreateBulk {
+	return &ERPSettlementLineCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ERPSettlementLineClient) MapCreateBulk(slice any, setFunc func(*ERPSettlementLineCreate, int)) *ERPSettlementLineCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ERPSettlementLineCreateBulk{err: fmt.Errorf("calling to ERPSettlementLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ERPSettlementLineCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ERPSettlementLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ERPSettlementLine.
+func (c *ERPSettlementLineClient) Update() *ERPSettlementLineUpdate {
+	mutation := newERPSettlementLineMutation(c.config, OpUpdate)
+	return &ERPSettlementLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ERPSettlementLineClient) UpdateOne(_m *ERPSettlementLine) *ERPSettlementLineUpdateOne {
+	mutation := newERPSettlementLineMutation(c.config, OpUpdateOne, withERPSettlementLine(_m))
+	return &ERPSettlementLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ERPSettlementLineClient) UpdateOneID(id int) *ERPSettlementLineUpdateOne {
+	mutation := newERPSettlementLineMutation(c.config, OpUpdateOne, withERPSettlementLineID(id))
+	return &ERPSettlementLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ERPSettlementLine.
+func (c *ERPSettlementLineClient) Delete() *ERPSettlementLineDelete {
+	mutation := newERPSettlementLineMutation(c.config, OpDelete)
+	return &ERPSettlementLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ERPSettlementLineClient) DeleteOne(_m *ERPSettlementLine) *ERPSettlementLineDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ERPSettlementLineClient) DeleteOneID(id int) *ERPSettlementLineDeleteOne {
+	builder := c.Delete().Where(erpsettlementline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ERPSettlementLineDeleteOne{builder}
+}
+
+// Query returns a query builder for ERPSettlementLine.
+func (c *ERPSettlementLineClient) Query() *ERPSettlementLineQuery {
+	return &ERPSettlementLineQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeERPSettlementLine},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ERPSettlementLine entity by its id.
+func (c *ERPSettlementLineClient) Get(ctx context.Context, id int) (*ERPSettlementLine, error) {
+	return c.Query().Where(erpsettlementline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ERPSettlementLineClient) GetX(ctx context.Context, id int) *ERPSettlementLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ERPSettlementLineClient) Hooks() []Hook {
+	return c.hooks.ERPSettlementLine
+}
+
+// Interceptors returns the client interceptors.
+func (c *ERPSettlementLineClient) Interceptors() []Interceptor {
+	return c.inters.ERPSettlementLine
+}
+
+func (c *ERPSettlementLineClient) mutate(ctx context.Context, m *ERPSettlementLineMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ERPSettlementLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ERPSettlementLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ERPSettlementLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ERPSettlementLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ERPSettlementLine mutation op: %q", m.Op())
+	}
+}
+
 // ERPShipmentDetailClient is a client for the ERPShipmentDetail schema.
 type ERPShipmentDetailClient struct {
 	config
@@ -4444,22 +6142,29 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AdminUser, ERPAttachment, ERPBankReceipt, ERPBankReceiptClaim, ERPDocLink,
-		ERPExportSale, ERPExportSaleItem, ERPInboundNotice, ERPInboundNoticeItem,
-		ERPLocation, ERPModuleRecord, ERPOutboundOrder, ERPOutboundOrderItem,
-		ERPPartner, ERPProduct, ERPPurchaseContract, ERPPurchaseContractItem,
-		ERPQuotation, ERPQuotationItem, ERPSequence, ERPSettlement, ERPShipmentDetail,
-		ERPShipmentDetailItem, ERPStockBalance, ERPStockTransaction, ERPWarehouse,
-		ERPWorkflowActionLog, ERPWorkflowInstance, ERPWorkflowTask, User []ent.Hook
+		AdminLoginAttempt, AdminRecoveryCode, AdminRole, AdminRolePermission,
+		AdminSession, AdminUser, AdminUserRole, ERPAttachment, ERPAuditLog,
+		ERPBankReceipt, ERPBankReceiptClaim, ERPDocLink, ERPExportSale,
+		ERPExportSaleItem, ERPInboundNotice, ERPInboundNoticeItem, ERPLocation,
+		ERPModuleRecord, ERPOutboundOrder, ERPOutboundOrderItem, ERPPartner,
+		ERPPartnerAddress, ERPPartnerBankAccount, ERPPartnerContact, ERPProduct,
+		ERPPurchaseContract, ERPPurchaseContractItem, ERPQuotation, ERPQuotationItem,
+		ERPRecordRevision, ERPSequence, ERPSettlement, ERPSettlementLine,
+		ERPShipmentDetail, ERPShipmentDetailItem, ERPStockBalance, ERPStockTransaction,
+		ERPWarehouse, ERPWorkflowActionLog, ERPWorkflowInstance, ERPWorkflowTask,
+		User []ent.Hook
 	}
 	inters struct {
-		AdminUser, ERPAttachment, ERPBankReceipt, ERPBankReceiptClaim, ERPDocLink,
-		ERPExportSale, ERPExportSaleItem, ERPInboundNotice, ERPInboundNoticeItem,
-		ERPLocation, ERPModuleRecord, ERPOutboundOrder, ERPOutboundOrderItem,
-		ERPPartner, ERPProduct, ERPPurchaseContract, ERPPurchaseContractItem,
-		ERPQuotation, ERPQuotationItem, ERPSequence, ERPSettlement, ERPShipmentDetail,
-		ERPShipmentDetailItem, ERPStockBalance, ERPStockTransaction, ERPWarehouse,
-		ERPWorkflowActionLog, ERPWorkflowInstance, ERPWorkflowTask,
+		AdminLoginAttempt, AdminRecoveryCode, AdminRole, AdminRolePermission,
+		AdminSession, AdminUser, AdminUserRole, ERPAttachment, ERPAuditLog,
+		ERPBankReceipt, ERPBankReceiptClaim, ERPDocLink, ERPExportSale,
+		ERPExportSaleItem, ERPInboundNotice, ERPInboundNoticeItem, ERPLocation,
+		ERPModuleRecord, ERPOutboundOrder, ERPOutboundOrderItem, ERPPartner,
+		ERPPartnerAddress, ERPPartnerBankAccount, ERPPartnerContact, ERPProduct,
+		ERPPurchaseContract, ERPPurchaseContractItem, ERPQuotation, ERPQuotationItem,
+		ERPRecordRevision, ERPSequence, ERPSettlement, ERPSettlementLine,
+		ERPShipmentDetail, ERPShipmentDetailItem, ERPStockBalance, ERPStockTransaction,
+		ERPWarehouse, ERPWorkflowActionLog, ERPWorkflowInstance, ERPWorkflowTask,
 		User []ent.Interceptor
 	}
 )
@@ -7,8 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"server/internal/data/model/ent/adminloginattempt"
+	"server/internal/data/model/ent/adminrecoverycode"
+	"server/internal/data/model/ent/adminrole"
+	"server/internal/data/model/ent/adminrolepermission"
+	"server/internal/data/model/ent/adminsession"
 	"server/internal/data/model/ent/adminuser"
+	"server/internal/data/model/ent/adminuserrole"
 	"server/internal/data/model/ent/erpattachment"
+	"server/internal/data/model/ent/erpauditlog"
 	"server/internal/data/model/ent/erpbankreceipt"
 	"server/internal/data/model/ent/erpbankreceiptclaim"
 	"server/internal/data/model/ent/erpdoclink"
@@ -21,13 +28,18 @@ import (
 	"server/internal/data/model/ent/erpoutboundorder"
 	"server/internal/data/model/ent/erpoutboundorderitem"
 	"server/internal/data/model/ent/erppartner"
+	"server/internal/data/model/ent/erppartneraddress"
+	"server/internal/data/model/ent/erppartnerbankaccount"
+	"server/internal/data/model/ent/erppartnercontact"
 	"server/internal/data/model/ent/erpproduct"
 	"server/internal/data/model/ent/erppurchasecontract"
 	"server/internal/data/model/ent/erppurchasecontractitem"
 	"server/internal/data/model/ent/erpquotation"
 	"server/internal/data/model/ent/erpquotationitem"
+	"server/internal/data/model/ent/erprecordrevision"
 	"server/internal/data/model/ent/erpsequence"
 	"server/internal/data/model/ent/erpsettlement"
+	"server/internal/data/model/ent/erpsettlementline"
 	"server/internal/data/model/ent/erpshipmentdetail"
 	"server/internal/data/model/ent/erpshipmentdetailitem"
 	"server/internal/data/model/ent/erpstockbalance"
@@ -102,8 +114,15 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			adminloginattempt.Table:       adminloginattempt.ValidColumn,
+			adminrecoverycode.Table:       adminrecoverycode.ValidColumn,
+			adminrole.Table:               adminrole.ValidColumn,
+			adminrolepermission.Table:     adminrolepermission.ValidColumn,
+			adminsession.Table:            adminsession.ValidColumn,
 			adminuser.Table:               adminuser.ValidColumn,
+			adminuserrole.Table:           adminuserrole.ValidColumn,
 			erpattachment.Table:           erpattachment.ValidColumn,
+			erpauditlog.Table:             erpauditlog.ValidColumn,
 			erpbankreceipt.Table:          erpbankreceipt.ValidColumn,
 			erpbankreceiptclaim.Table:     erpbankreceiptclaim.ValidColumn,
 			erpdoclink.Table:              erpdoclink.ValidColumn,
@@ -116,13 +135,18 @@ func checkColumn(t, c string) error {
 			erpoutboundorder.Table:        erpoutboundorder.ValidColumn,
 			erpoutboundorderitem.Table:    erpoutboundorderitem.ValidColumn,
 			erppartner.Table:              erppartner.ValidColumn,
+			erppartneraddress.Table:       erppartneraddress.ValidColumn,
+			erppartnerbankaccount.Table:   erppartnerbankaccount.ValidColumn,
+			erppartnercontact.Table:       erppartnercontact.ValidColumn,
 			erpproduct.Table:              erpproduct.ValidColumn,
 			erppurchasecontract.Table:     erppurchasecontract.ValidColumn,
 			erppurchasecontractitem.Table: erppurchasecontractitem.ValidColumn,
 			erpquotation.Table:            erpquotation.ValidColumn,
 			erpquotationitem.Table:        erpquotationitem.ValidColumn,
+			erprecordrevision.Table:       erprecordrevision.ValidColumn,
 			erpsequence.Table:             erpsequence.ValidColumn,
 			erpsettlement.Table:           erpsettlement.ValidColumn,
+			erpsettlementline.Table:       erpsettlementline.ValidColumn,
 			erpshipmentdetail.Table:       erpshipmentdetail.ValidColumn,
 			erpshipmentdetailitem.Table:   erpshipmentdetailitem.ValidColumn,
 			erpstockbalance.Table:         erpstockbalance.ValidColumn,
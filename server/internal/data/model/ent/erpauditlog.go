@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/erpauditlog"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ERPAuditLog is the model entity for the ERPAuditLog schema.
+type ERPAuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// create/update/delete
+	Action string `json:"action,omitempty"`
+	// ModuleKey holds the value of the "module_key" field.
+	ModuleKey string `json:"module_key,omitempty"`
+	// RecordID holds the value of the "record_id" field.
+	RecordID int `json:"record_id,omitempty"`
+	// 变更后的单号；删除时为删除前单号
+	Code string `json:"code,omitempty"`
+	// 操作人，系统任务为空
+	AdminID *int `json:"admin_id,omitempty"`
+	// AdminUsername holds the value of the "admin_username" field.
+	AdminUsername string `json:"admin_username,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID string `json:"request_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// 字段级变更 JSON：[{field, old, new}]
+	Changes string `json:"changes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ERPAuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case erpauditlog.FieldID, erpauditlog.FieldRecordID, erpauditlog.FieldAdminID:
+			values[i] = new(sql.NullInt64)
+		case erpauditlog.FieldAction, erpauditlog.FieldModuleKey, erpauditlog.FieldCode, erpauditlog.FieldAdminUsername, erpauditlog.FieldRequestID, erpauditlog.FieldIP, erpauditlog.FieldChanges:
+			values[i] = new(sql.NullString)
+		case erpauditlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ERPAuditLog fields.
+func (_m *ERPAuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case erpauditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case erpauditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case erpauditlog.FieldModuleKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field module_key", values[i])
+			} else if value.Valid {
+				_m.ModuleKey = value.String
+			}
+		case erpauditlog.FieldRecordID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field record_id", values[i])
+			} else if value.Valid {
+				_m.RecordID = int(value.Int64)
+			}
+		case erpauditlog.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case erpauditlog.FieldAdminID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_id", values[i])
+			} else if value.Valid {
+				_m.AdminID = new(int)
+				*_m.AdminID = int(value.Int64)
+			}
+		case erpauditlog.FieldAdminUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_username", values[i])
+			} else if value.Valid {
+				_m.AdminUsername = value.String
+			}
+		case erpauditlog.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = value.String
+			}
+		case erpauditlog.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case erpauditlog.FieldChanges:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value.Valid {
+				_m.Changes = value.String
+			}
+		case erpauditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ERPAuditLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *ERPAuditLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ERPAuditLog.
+// Note that you need to call ERPAuditLog.Unwrap() before calling this method if this ERPAuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ERPAuditLog) Update() *ERPAuditLogUpdateOne {
+	return NewERPAuditLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ERPAuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ERPAuditLog) Unwrap() *ERPAuditLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ERPAuditLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ERPAuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("ERPAuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("module_key=")
+	builder.WriteString(_m.ModuleKey)
+	builder.WriteString(", ")
+	builder.WriteString("record_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecordID))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	if v := _m.AdminID; v != nil {
+		builder.WriteString("admin_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("admin_username=")
+	builder.WriteString(_m.AdminUsername)
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(_m.RequestID)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(_m.Changes)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ERPAuditLogs is a parsable slice of ERPAuditLog.
+type ERPAuditLogs []*ERPAuditLog
@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package erpauditlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the erpauditlog type in the database.
+	Label = "erp_audit_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldModuleKey holds the string denoting the module_key field in the database.
+	FieldModuleKey = "module_key"
+	// FieldRecordID holds the string denoting the record_id field in the database.
+	FieldRecordID = "record_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldAdminID holds the string denoting the admin_id field in the database.
+	FieldAdminID = "admin_id"
+	// FieldAdminUsername holds the string denoting the admin_username field in the database.
+	FieldAdminUsername = "admin_username"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldChanges holds the string denoting the changes field in the database.
+	FieldChanges = "changes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the erpauditlog in the database.
+	Table = "erp_audit_logs"
+)
+
+// Columns holds all SQL columns for erpauditlog fields.
+var Columns = []string{
+	FieldID,
+	FieldAction,
+	FieldModuleKey,
+	FieldRecordID,
+	FieldCode,
+	FieldAdminID,
+	FieldAdminUsername,
+	FieldRequestID,
+	FieldIP,
+	FieldChanges,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	ActionValidator func(string) error
+	// ModuleKeyValidator is a validator for the "module_key" field. It is called by the builders before save.
+	ModuleKeyValidator func(string) error
+	// DefaultCode holds the default value on creation for the "code" field.
+	DefaultCode string
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// DefaultAdminUsername holds the default value on creation for the "admin_username" field.
+	DefaultAdminUsername string
+	// AdminUsernameValidator is a validator for the "admin_username" field. It is called by the builders before save.
+	AdminUsernameValidator func(string) error
+	// DefaultRequestID holds the default value on creation for the "request_id" field.
+	DefaultRequestID string
+	// RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	RequestIDValidator func(string) error
+	// DefaultIP holds the default value on creation for the "ip" field.
+	DefaultIP string
+	// IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	IPValidator func(string) error
+	// DefaultChanges holds the default value on creation for the "changes" field.
+	DefaultChanges string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ERPAuditLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByModuleKey orders the results by the module_key field.
+func ByModuleKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModuleKey, opts...).ToFunc()
+}
+
+// ByRecordID orders the results by the record_id field.
+func ByRecordID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecordID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByAdminID orders the results by the admin_id field.
+func ByAdminID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdminID, opts...).ToFunc()
+}
+
+// ByAdminUsername orders the results by the admin_username field.
+func ByAdminUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdminUsername, opts...).ToFunc()
+}
+
+// ByRequestID orders the results by the request_id field.
+func ByRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestID, opts...).ToFunc()
+}
+
+// ByIP orders the results by the ip field.
+func ByIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIP, opts...).ToFunc()
+}
+
+// ByChanges orders the results by the changes field.
+func ByChanges(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChanges, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
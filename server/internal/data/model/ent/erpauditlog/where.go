@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package erpauditlog
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldID, id))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldAction, v))
+}
+
+// ModuleKey applies equality check predicate on the "module_key" field. It's identical to ModuleKeyEQ.
+func ModuleKey(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldModuleKey, v))
+}
+
+// RecordID applies equality check predicate on the "record_id" field. It's identical to RecordIDEQ.
+func RecordID(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldRecordID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldCode, v))
+}
+
+// AdminID applies equality check predicate on the "admin_id" field. It's identical to AdminIDEQ.
+func AdminID(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldAdminID, v))
+}
+
+// AdminUsername applies equality check predicate on the "admin_username" field. It's identical to AdminUsernameEQ.
+func AdminUsername(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldAdminUsername, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldRequestID, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldIP, v))
+}
+
+// Changes applies equality check predicate on the "changes" field. It's identical to ChangesEQ.
+func Changes(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldChanges, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContainsFold(FieldAction, v))
+}
+
+// ModuleKeyEQ applies the EQ predicate on the "module_key" field.
+func ModuleKeyEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldModuleKey, v))
+}
+
+// ModuleKeyNEQ applies the NEQ predicate on the "module_key" field.
+func ModuleKeyNEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldModuleKey, v))
+}
+
+// ModuleKeyIn applies the In predicate on the "module_key" field.
+func ModuleKeyIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldModuleKey, vs...))
+}
+
+// ModuleKeyNotIn applies the NotIn predicate on the "module_key" field.
+func ModuleKeyNotIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldModuleKey, vs...))
+}
+
+// ModuleKeyGT applies the GT predicate on the "module_key" field.
+func ModuleKeyGT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldModuleKey, v))
+}
+
+// ModuleKeyGTE applies the GTE predicate on the "module_key" field.
+func ModuleKeyGTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldModuleKey, v))
+}
+
+// ModuleKeyLT applies the LT predicate on the "module_key" field.
+func ModuleKeyLT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldModuleKey, v))
+}
+
+// ModuleKeyLTE applies the LTE predicate on the "module_key" field.
+func ModuleKeyLTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldModuleKey, v))
+}
+
+// ModuleKeyContains applies the Contains predicate on the "module_key" field.
+func ModuleKeyContains(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContains(FieldModuleKey, v))
+}
+
+// ModuleKeyHasPrefix applies the HasPrefix predicate on the "module_key" field.
+func ModuleKeyHasPrefix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasPrefix(FieldModuleKey, v))
+}
+
+// ModuleKeyHasSuffix applies the HasSuffix predicate on the "module_key" field.
+func ModuleKeyHasSuffix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasSuffix(FieldModuleKey, v))
+}
+
+// ModuleKeyEqualFold applies the EqualFold predicate on the "module_key" field.
+func ModuleKeyEqualFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEqualFold(FieldModuleKey, v))
+}
+
+// ModuleKeyContainsFold applies the ContainsFold predicate on the "module_key" field.
+func ModuleKeyContainsFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContainsFold(FieldModuleKey, v))
+}
+
+// RecordIDEQ applies the EQ predicate on the "record_id" field.
+func RecordIDEQ(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldRecordID, v))
+}
+
+// RecordIDNEQ applies the NEQ predicate on the "record_id" field.
+func RecordIDNEQ(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldRecordID, v))
+}
+
+// RecordIDIn applies the In predicate on the "record_id" field.
+func RecordIDIn(vs ...int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldRecordID, vs...))
+}
+
+// RecordIDNotIn applies the NotIn predicate on the "record_id" field.
+func RecordIDNotIn(vs ...int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldRecordID, vs...))
+}
+
+// RecordIDGT applies the GT predicate on the "record_id" field.
+func RecordIDGT(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldRecordID, v))
+}
+
+// RecordIDGTE applies the GTE predicate on the "record_id" field.
+func RecordIDGTE(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldRecordID, v))
+}
+
+// RecordIDLT applies the LT predicate on the "record_id" field.
+func RecordIDLT(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldRecordID, v))
+}
+
+// RecordIDLTE applies the LTE predicate on the "record_id" field.
+func RecordIDLTE(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldRecordID, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContainsFold(FieldCode, v))
+}
+
+// AdminIDEQ applies the EQ predicate on the "admin_id" field.
+func AdminIDEQ(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldAdminID, v))
+}
+
+// AdminIDNEQ applies the NEQ predicate on the "admin_id" field.
+func AdminIDNEQ(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldAdminID, v))
+}
+
+// AdminIDIn applies the In predicate on the "admin_id" field.
+func AdminIDIn(vs ...int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldAdminID, vs...))
+}
+
+// AdminIDNotIn applies the NotIn predicate on the "admin_id" field.
+func AdminIDNotIn(vs ...int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldAdminID, vs...))
+}
+
+// AdminIDGT applies the GT predicate on the "admin_id" field.
+func AdminIDGT(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldAdminID, v))
+}
+
+// AdminIDGTE applies the GTE predicate on the "admin_id" field.
+func AdminIDGTE(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldAdminID, v))
+}
+
+// AdminIDLT applies the LT predicate on the "admin_id" field.
+func AdminIDLT(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldAdminID, v))
+}
+
+// AdminIDLTE applies the LTE predicate on the "admin_id" field.
+func AdminIDLTE(v int) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldAdminID, v))
+}
+
+// AdminIDIsNil applies the IsNil predicate on the "admin_id" field.
+func AdminIDIsNil() predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIsNull(FieldAdminID))
+}
+
+// AdminIDNotNil applies the NotNil predicate on the "admin_id" field.
+func AdminIDNotNil() predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotNull(FieldAdminID))
+}
+
+// AdminUsernameEQ applies the EQ predicate on the "admin_username" field.
+func AdminUsernameEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldAdminUsername, v))
+}
+
+// AdminUsernameNEQ applies the NEQ predicate on the "admin_username" field.
+func AdminUsernameNEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldAdminUsername, v))
+}
+
+// AdminUsernameIn applies the In predicate on the "admin_username" field.
+func AdminUsernameIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldAdminUsername, vs...))
+}
+
+// AdminUsernameNotIn applies the NotIn predicate on the "admin_username" field.
+func AdminUsernameNotIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldAdminUsername, vs...))
+}
+
+// AdminUsernameGT applies the GT predicate on the "admin_username" field.
+func AdminUsernameGT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldAdminUsername, v))
+}
+
+// AdminUsernameGTE applies the GTE predicate on the "admin_username" field.
+func AdminUsernameGTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldAdminUsername, v))
+}
+
+// AdminUsernameLT applies the LT predicate on the "admin_username" field.
+func AdminUsernameLT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldAdminUsername, v))
+}
+
+// AdminUsernameLTE applies the LTE predicate on the "admin_username" field.
+func AdminUsernameLTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldAdminUsername, v))
+}
+
+// AdminUsernameContains applies the Contains predicate on the "admin_username" field.
+func AdminUsernameContains(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContains(FieldAdminUsername, v))
+}
+
+// AdminUsernameHasPrefix applies the HasPrefix predicate on the "admin_username" field.
+func AdminUsernameHasPrefix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasPrefix(FieldAdminUsername, v))
+}
+
+// AdminUsernameHasSuffix applies the HasSuffix predicate on the "admin_username" field.
+func AdminUsernameHasSuffix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasSuffix(FieldAdminUsername, v))
+}
+
+// AdminUsernameEqualFold applies the EqualFold predicate on the "admin_username" field.
+func AdminUsernameEqualFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEqualFold(FieldAdminUsername, v))
+}
+
+// AdminUsernameContainsFold applies the ContainsFold predicate on the "admin_username" field.
+func AdminUsernameContainsFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContainsFold(FieldAdminUsername, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContainsFold(FieldIP, v))
+}
+
+// ChangesEQ applies the EQ predicate on the "changes" field.
+func ChangesEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldChanges, v))
+}
+
+// ChangesNEQ applies the NEQ predicate on the "changes" field.
+func ChangesNEQ(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldChanges, v))
+}
+
+// ChangesIn applies the In predicate on the "changes" field.
+func ChangesIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldChanges, vs...))
+}
+
+// ChangesNotIn applies the NotIn predicate on the "changes" field.
+func ChangesNotIn(vs ...string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldChanges, vs...))
+}
+
+// ChangesGT applies the GT predicate on the "changes" field.
+func ChangesGT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldChanges, v))
+}
+
+// ChangesGTE applies the GTE predicate on the "changes" field.
+func ChangesGTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldChanges, v))
+}
+
+// ChangesLT applies the LT predicate on the "changes" field.
+func ChangesLT(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldChanges, v))
+}
+
+// ChangesLTE applies the LTE predicate on the "changes" field.
+func ChangesLTE(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldChanges, v))
+}
+
+// ChangesContains applies the Contains predicate on the "changes" field.
+func ChangesContains(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContains(FieldChanges, v))
+}
+
+// ChangesHasPrefix applies the HasPrefix predicate on the "changes" field.
+func ChangesHasPrefix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasPrefix(FieldChanges, v))
+}
+
+// ChangesHasSuffix applies the HasSuffix predicate on the "changes" field.
+func ChangesHasSuffix(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldHasSuffix(FieldChanges, v))
+}
+
+// ChangesEqualFold applies the EqualFold predicate on the "changes" field.
+func ChangesEqualFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEqualFold(FieldChanges, v))
+}
+
+// ChangesContainsFold applies the ContainsFold predicate on the "changes" field.
+func ChangesContainsFold(v string) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldContainsFold(FieldChanges, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ERPAuditLog) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ERPAuditLog) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ERPAuditLog) predicate.ERPAuditLog {
+	return predicate.ERPAuditLog(sql.NotPredicates(p))
+}
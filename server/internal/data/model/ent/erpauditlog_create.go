@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erpauditlog"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPAuditLogCreate is the builder for creating a ERPAuditLog entity.
+type ERPAuditLogCreate struct {
+	config
+	mutation *ERPAuditLogMutation
+	hooks    []Hook
+}
+
+// SetAction sets the "action" field.
+func (_c *ERPAuditLogCreate) SetAction(v string) *ERPAuditLogCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetModuleKey sets the "module_key" field.
+func (_c *ERPAuditLogCreate) SetModuleKey(v string) *ERPAuditLogCreate {
+	_c.mutation.SetModuleKey(v)
+	return _c
+}
+
+// SetRecordID sets the "record_id" field.
+func (_c *ERPAuditLogCreate) SetRecordID(v int) *ERPAuditLogCreate {
+	_c.mutation.SetRecordID(v)
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *ERPAuditLogCreate) SetCode(v string) *ERPAuditLogCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_c *ERPAuditLogCreate) SetNillableCode(v *string) *ERPAuditLogCreate {
+	if v != nil {
+		_c.SetCode(*v)
+	}
+	return _c
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_c *ERPAuditLogCreate) SetAdminID(v int) *ERPAuditLogCreate {
+	_c.mutation.SetAdminID(v)
+	return _c
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (_c *ERPAuditLogCreate) SetNillableAdminID(v *int) *ERPAuditLogCreate {
+	if v != nil {
+		_c.SetAdminID(*v)
+	}
+	return _c
+}
+
+// SetAdminUsername sets the "admin_username" field.
+func (_c *ERPAuditLogCreate) SetAdminUsername(v string) *ERPAuditLogCreate {
+	_c.mutation.SetAdminUsername(v)
+	return _c
+}
+
+// SetNillableAdminUsername sets the "admin_username" field if the given value is not nil.
+func (_c *ERPAuditLogCreate) SetNillableAdminUsername(v *string) *ERPAuditLogCreate {
+	if v != nil {
+		_c.SetAdminUsername(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *ERPAuditLogCreate) SetRequestID(v string) *ERPAuditLogCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *ERPAuditLogCreate) SetNillableRequestID(v *string) *ERPAuditLogCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *ERPAuditLogCreate) SetIP(v string) *ERPAuditLogCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *ERPAuditLogCreate) SetNillableIP(v *string) *ERPAuditLogCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *ERPAuditLogCreate) SetChanges(v string) *ERPAuditLogCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_c *ERPAuditLogCreate) SetNillableChanges(v *string) *ERPAuditLogCreate {
+	if v != nil {
+		_c.SetChanges(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ERPAuditLogCreate) SetCreatedAt(v time.Time) *ERPAuditLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ERPAuditLogCreate) SetNillableCreatedAt(v *time.Time) *ERPAuditLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ERPAuditLogMutation object of the builder.
+func (_c *ERPAuditLogCreate) Mutation() *ERPAuditLogMutation {
+	return _c.mutation
+}
+
+// Save creates the ERPAuditLog in the database.
+func (_c *ERPAuditLogCreate) Save(ctx context.Context) (*ERPAuditLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ERPAuditLogCreate) SaveX(ctx context.Context) *ERPAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPAuditLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPAuditLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ERPAuditLogCreate) defaults() {
+	if _, ok := _c.mutation.Code(); !ok {
+		v := erpauditlog.DefaultCode
+		_c.mutation.SetCode(v)
+	}
+	if _, ok := _c.mutation.AdminUsername(); !ok {
+		v := erpauditlog.DefaultAdminUsername
+		_c.mutation.SetAdminUsername(v)
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		v := erpauditlog.DefaultRequestID
+		_c.mutation.SetRequestID(v)
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		v := erpauditlog.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.Changes(); !ok {
+		v := erpauditlog.DefaultChanges
+		_c.mutation.SetChanges(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := erpauditlog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ERPAuditLogCreate) check() error {
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ERPAuditLog.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := erpauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ModuleKey(); !ok {
+		return &ValidationError{Name: "module_key", err: errors.New(`ent: missing required field "ERPAuditLog.module_key"`)}
+	}
+	if v, ok := _c.mutation.ModuleKey(); ok {
+		if err := erpauditlog.ModuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "module_key", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.module_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RecordID(); !ok {
+		return &ValidationError{Name: "record_id", err: errors.New(`ent: missing required field "ERPAuditLog.record_id"`)}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "ERPAuditLog.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := erpauditlog.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AdminUsername(); !ok {
+		return &ValidationError{Name: "admin_username", err: errors.New(`ent: missing required field "ERPAuditLog.admin_username"`)}
+	}
+	if v, ok := _c.mutation.AdminUsername(); ok {
+		if err := erpauditlog.AdminUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "admin_username", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.admin_username": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "ERPAuditLog.request_id"`)}
+	}
+	if v, ok := _c.mutation.RequestID(); ok {
+		if err := erpauditlog.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.request_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "ERPAuditLog.ip"`)}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := erpauditlog.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "ERPAuditLog.changes"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ERPAuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ERPAuditLogCreate) sqlSave(ctx context.Context) (*ERPAuditLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ERPAuditLogCreate) createSpec() (*ERPAuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ERPAuditLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(erpauditlog.Table, sqlgraph.NewFieldSpec(erpauditlog.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(erpauditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.ModuleKey(); ok {
+		_spec.SetField(erpauditlog.FieldModuleKey, field.TypeString, value)
+		_node.ModuleKey = value
+	}
+	if value, ok := _c.mutation.RecordID(); ok {
+		_spec.SetField(erpauditlog.FieldRecordID, field.TypeInt, value)
+		_node.RecordID = value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(erpauditlog.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.AdminID(); ok {
+		_spec.SetField(erpauditlog.FieldAdminID, field.TypeInt, value)
+		_node.AdminID = &value
+	}
+	if value, ok := _c.mutation.AdminUsername(); ok {
+		_spec.SetField(erpauditlog.FieldAdminUsername, field.TypeString, value)
+		_node.AdminUsername = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(erpauditlog.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(erpauditlog.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(erpauditlog.FieldChanges, field.TypeString, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(erpauditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ERPAuditLogCreateBulk is the builder for creating many ERPAuditLog entities in bulk.
+type ERPAuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*ERPAuditLogCreate
+}
+
+// Save creates the ERPAuditLog entities in the database.
+func (_c *ERPAuditLogCreateBulk) Save(ctx context.Context) ([]*ERPAuditLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ERPAuditLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ERPAuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ERPAuditLogCreateBulk) SaveX(ctx context.Context) []*ERPAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPAuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPAuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erpauditlog"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPAuditLogUpdate is the builder for updating ERPAuditLog entities.
+type ERPAuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ERPAuditLogMutation
+}
+
+// Where appends a list predicates to the ERPAuditLogUpdate builder.
+func (_u *ERPAuditLogUpdate) Where(ps ...predicate.ERPAuditLog) *ERPAuditLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ERPAuditLogUpdate) SetAction(v string) *ERPAuditLogUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ERPAuditLogUpdate) SetNillableAction(v *string) *ERPAuditLogUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetModuleKey sets the "module_key" field.
+func (_u *ERPAuditLogUpdate) SetModuleKey(v string) *ERPAuditLogUpdate {
+	_u.mutation.SetModuleKey(v)
+	return _u
+}
+
+// SetNillableModuleKey sets the "module_key" field if the given value is not nil.
+func (_u *ERPAuditLogUpdate) SetNillableModuleKey(v *string) *ERPAuditLogUpdate {
+	if v != nil {
+		_u.SetModuleKey(*v)
+	}
+	return _u
+}
+
+// SetRecordID sets the "record_id" field.
+func (_u *ERPAuditLogUpdate) SetRecordID(v int) *ERPAuditLogUpdate {
+	_u.mutation.ResetRecordID()
+	_u.mutation.SetRecordID(v)
+	return _u
+}
+
+// SetNillableRecordID sets the "record_id" field if the given value is not nil.
+func (_u *ERPAuditLogUpdate) SetNillableRecordID(v *int) *ERPAuditLogUpdate {
+	if v != nil {
+		_u.SetRecordID(*v)
+	}
+	return _u
+}
+
+// AddRecordID adds value to the "record_id" field.
+func (_u *ERPAuditLogUpdate) AddRecordID(v int) *ERPAuditLogUpdate {
+	_u.mutation.AddRecordID(v)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *ERPAuditLogUpdate) SetCode(v string) *ERPAuditLogUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ERPAuditLogUpdate) SetNillableCode(v *string) *ERPAuditLogUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_u *ERPAuditLogUpdate) SetAdminID(v int) *ERPAuditLogUpdate {
+	_u.mutation.ResetAdminID()
+	_u.mutation.SetAdminID(v)
+	return _u
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (_u *ERPAuditLogUpdate) SetNillableAdminID(v *int) *ERPAuditLogUpdate {
+	if v != nil {
+		_u.SetAdminID(*v)
+	}
+	return _u
+}
+
+// AddAdminID adds value to the "admin_id" field.
+func (_u *ERPAuditLogUpdate) AddAdminID(v int) *ERPAuditLogUpdate {
+	_u.mutation.AddAdminID(v)
+	return _u
+}
+
+// ClearAdminID clears the value of the "admin_id" field.
+func (_u *ERPAuditLogUpdate) ClearAdminID() *ERPAuditLogUpdate {
+	_u.mutation.ClearAdminID()
+	return _u
+}
+
+// SetAdminUsername sets the "admin_username" field.
+func (_u *ERPAuditLogUpdate) SetAdminUsername(v string) *ERPAuditLogUpdate {
+	_u.mutation.SetAdminUsername(v)
+	return _u
+}
+
+// SetNillableAdminUsername sets the "admin_username" field if the given value is not nil.
+func (_u *ERPAuditLogUpdate) SetNillableAdminUsername(v *string) *ERPAuditLogUpdate {
+	if v != nil {
+		_u.SetAdminUsername(*v)
+	}
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *ERPAuditLogUpdate) SetRequestID(v string) *ERPAuditLogUpdate {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *ERPAuditLogUpdate) SetNillableRequestID(v *string) *ERPAuditLogUpdate {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *ERPAuditLogUpdate) SetIP(v string) *ERPAuditLogUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *ERPAuditLogUpdate) SetNillableIP(v *string) *ERPAuditLogUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *ERPAuditLogUpdate) SetChanges(v string) *ERPAuditLogUpdate {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_u *ERPAuditLogUpdate) SetNillableChanges(v *string) *ERPAuditLogUpdate {
+	if v != nil {
+		_u.SetChanges(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ERPAuditLogMutation object of the builder.
+func (_u *ERPAuditLogUpdate) Mutation() *ERPAuditLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ERPAuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPAuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ERPAuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPAuditLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPAuditLogUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := erpauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModuleKey(); ok {
+		if err := erpauditlog.ModuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "module_key", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.module_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Code(); ok {
+		if err := erpauditlog.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdminUsername(); ok {
+		if err := erpauditlog.AdminUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "admin_username", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.admin_username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequestID(); ok {
+		if err := erpauditlog.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := erpauditlog.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPAuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erpauditlog.Table, erpauditlog.Columns, sqlgraph.NewFieldSpec(erpauditlog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(erpauditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ModuleKey(); ok {
+		_spec.SetField(erpauditlog.FieldModuleKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecordID(); ok {
+		_spec.SetField(erpauditlog.FieldRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecordID(); ok {
+		_spec.AddField(erpauditlog.FieldRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(erpauditlog.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdminID(); ok {
+		_spec.SetField(erpauditlog.FieldAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminID(); ok {
+		_spec.AddField(erpauditlog.FieldAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.AdminIDCleared() {
+		_spec.ClearField(erpauditlog.FieldAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AdminUsername(); ok {
+		_spec.SetField(erpauditlog.FieldAdminUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(erpauditlog.FieldRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(erpauditlog.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(erpauditlog.FieldChanges, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erpauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ERPAuditLogUpdateOne is the builder for updating a single ERPAuditLog entity.
+type ERPAuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ERPAuditLogMutation
+}
+
+// SetAction sets the "action" field.
+func (_u *ERPAuditLogUpdateOne) SetAction(v string) *ERPAuditLogUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ERPAuditLogUpdateOne) SetNillableAction(v *string) *ERPAuditLogUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetModuleKey sets the "module_key" field.
+func (_u *ERPAuditLogUpdateOne) SetModuleKey(v string) *ERPAuditLogUpdateOne {
+	_u.mutation.SetModuleKey(v)
+	return _u
+}
+
+// SetNillableModuleKey sets the "module_key" field if the given value is not nil.
+func (_u *ERPAuditLogUpdateOne) SetNillableModuleKey(v *string) *ERPAuditLogUpdateOne {
+	if v != nil {
+		_u.SetModuleKey(*v)
+	}
+	return _u
+}
+
+// SetRecordID sets the "record_id" field.
+func (_u *ERPAuditLogUpdateOne) SetRecordID(v int) *ERPAuditLogUpdateOne {
+	_u.mutation.ResetRecordID()
+	_u.mutation.SetRecordID(v)
+	return _u
+}
+
+// SetNillableRecordID sets the "record_id" field if the given value is not nil.
+func (_u *ERPAuditLogUpdateOne) SetNillableRecordID(v *int) *ERPAuditLogUpdateOne {
+	if v != nil {
+		_u.SetRecordID(*v)
+	}
+	return _u
+}
+
+// AddRecordID adds value to the "record_id" field.
+func (_u *ERPAuditLogUpdateOne) AddRecordID(v int) *ERPAuditLogUpdateOne {
+	_u.mutation.AddRecordID(v)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *ERPAuditLogUpdateOne) SetCode(v string) *ERPAuditLogUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ERPAuditLogUpdateOne) SetNillableCode(v *string) *ERPAuditLogUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_u *ERPAuditLogUpdateOne) SetAdminID(v int) *ERPAuditLogUpdateOne {
+	_u.mutation.ResetAdminID()
+	_u.mutation.SetAdminID(v)
+	return _u
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (_u *ERPAuditLogUpdateOne) SetNillableAdminID(v *int) *ERPAuditLogUpdateOne {
+	if v != nil {
+		_u.SetAdminID(*v)
+	}
+	return _u
+}
+
+// AddAdminID adds value to the "admin_id" field.
+func (_u *ERPAuditLogUpdateOne) AddAdminID(v int) *ERPAuditLogUpdateOne {
+	_u.mutation.AddAdminID(v)
+	return _u
+}
+
+// ClearAdminID clears the value of the "admin_id" field.
+func (_u *ERPAuditLogUpdateOne) ClearAdminID() *ERPAuditLogUpdateOne {
+	_u.mutation.ClearAdminID()
+	return _u
+}
+
+// SetAdminUsername sets the "admin_username" field.
+func (_u *ERPAuditLogUpdateOne) SetAdminUsername(v string) *ERPAuditLogUpdateOne {
+	_u.mutation.SetAdminUsername(v)
+	return _u
+}
+
+// SetNillableAdminUsername sets the "admin_username" field if the given value is not nil.
+func (_u *ERPAuditLogUpdateOne) SetNillableAdminUsername(v *string) *ERPAuditLogUpdateOne {
+	if v != nil {
+		_u.SetAdminUsername(*v)
+	}
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *ERPAuditLogUpdateOne) SetRequestID(v string) *ERPAuditLogUpdateOne {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *ERPAuditLogUpdateOne) SetNillableRequestID(v *string) *ERPAuditLogUpdateOne {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *ERPAuditLogUpdateOne) SetIP(v string) *ERPAuditLogUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *ERPAuditLogUpdateOne) SetNillableIP(v *string) *ERPAuditLogUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *ERPAuditLogUpdateOne) SetChanges(v string) *ERPAuditLogUpdateOne {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_u *ERPAuditLogUpdateOne) SetNillableChanges(v *string) *ERPAuditLogUpdateOne {
+	if v != nil {
+		_u.SetChanges(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ERPAuditLogMutation object of the builder.
+func (_u *ERPAuditLogUpdateOne) Mutation() *ERPAuditLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ERPAuditLogUpdate builder.
+func (_u *ERPAuditLogUpdateOne) Where(ps ...predicate.ERPAuditLog) *ERPAuditLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ERPAuditLogUpdateOne) Select(field string, fields ...string) *ERPAuditLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ERPAuditLog entity.
+func (_u *ERPAuditLogUpdateOne) Save(ctx context.Context) (*ERPAuditLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPAuditLogUpdateOne) SaveX(ctx context.Context) *ERPAuditLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ERPAuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPAuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPAuditLogUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := erpauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModuleKey(); ok {
+		if err := erpauditlog.ModuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "module_key", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.module_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Code(); ok {
+		if err := erpauditlog.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdminUsername(); ok {
+		if err := erpauditlog.AdminUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "admin_username", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.admin_username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequestID(); ok {
+		if err := erpauditlog.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := erpauditlog.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "ERPAuditLog.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPAuditLogUpdateOne) sqlSave(ctx context.Context) (_node *ERPAuditLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erpauditlog.Table, erpauditlog.Columns, sqlgraph.NewFieldSpec(erpauditlog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ERPAuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, erpauditlog.FieldID)
+		for _, f := range fields {
+			if !erpauditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != erpauditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(erpauditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ModuleKey(); ok {
+		_spec.SetField(erpauditlog.FieldModuleKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecordID(); ok {
+		_spec.SetField(erpauditlog.FieldRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecordID(); ok {
+		_spec.AddField(erpauditlog.FieldRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(erpauditlog.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdminID(); ok {
+		_spec.SetField(erpauditlog.FieldAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminID(); ok {
+		_spec.AddField(erpauditlog.FieldAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.AdminIDCleared() {
+		_spec.ClearField(erpauditlog.FieldAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AdminUsername(); ok {
+		_spec.SetField(erpauditlog.FieldAdminUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(erpauditlog.FieldRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(erpauditlog.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(erpauditlog.FieldChanges, field.TypeString, value)
+	}
+	_node = &ERPAuditLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erpauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
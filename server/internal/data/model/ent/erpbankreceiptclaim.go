@@ -21,6 +21,8 @@ type ERPBankReceiptClaim struct {
 	ReceiptID int `json:"receipt_id,omitempty"`
 	// SettlementID holds the value of the "settlement_id" field.
 	SettlementID *int `json:"settlement_id,omitempty"`
+	// SettlementLineID holds the value of the "settlement_line_id" field.
+	SettlementLineID *int `json:"settlement_line_id,omitempty"`
 	// 预收/尾款/其他
 	ClaimType string `json:"claim_type,omitempty"`
 	// ClaimAmount holds the value of the "claim_amount" field.
@@ -51,7 +53,7 @@ func (*ERPBankReceiptClaim) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case erpbankreceiptclaim.FieldClaimAmount:
 			values[i] = new(sql.NullFloat64)
-		case erpbankreceiptclaim.FieldID, erpbankreceiptclaim.FieldReceiptID, erpbankreceiptclaim.FieldSettlementID, erpbankreceiptclaim.FieldClaimedByAdminID, erpbankreceiptclaim.FieldConfirmedByAdminID:
+		case erpbankreceiptclaim.FieldID, erpbankreceiptclaim.FieldReceiptID, erpbankreceiptclaim.FieldSettlementID, erpbankreceiptclaim.FieldSettlementLineID, erpbankreceiptclaim.FieldClaimedByAdminID, erpbankreceiptclaim.FieldConfirmedByAdminID:
 			values[i] = new(sql.NullInt64)
 		case erpbankreceiptclaim.FieldClaimType, erpbankreceiptclaim.FieldRemark:
 			values[i] = new(sql.NullString)
@@ -91,6 +93,13 @@ func (_m *ERPBankReceiptClaim) assignValues(columns []string, values []any) erro
 				_m.SettlementID = new(int)
 				*_m.SettlementID = int(value.Int64)
 			}
+		case erpbankreceiptclaim.FieldSettlementLineID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_line_id", values[i])
+			} else if value.Valid {
+				_m.SettlementLineID = new(int)
+				*_m.SettlementLineID = int(value.Int64)
+			}
 		case erpbankreceiptclaim.FieldClaimType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field claim_type", values[i])
@@ -193,6 +202,11 @@ func (_m *ERPBankReceiptClaim) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.SettlementLineID; v != nil {
+		builder.WriteString("settlement_line_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("claim_type=")
 	builder.WriteString(_m.ClaimType)
 	builder.WriteString(", ")
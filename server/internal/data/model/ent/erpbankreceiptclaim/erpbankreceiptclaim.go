@@ -17,6 +17,8 @@ const (
 	FieldReceiptID = "receipt_id"
 	// FieldSettlementID holds the string denoting the settlement_id field in the database.
 	FieldSettlementID = "settlement_id"
+	// FieldSettlementLineID holds the string denoting the settlement_line_id field in the database.
+	FieldSettlementLineID = "settlement_line_id"
 	// FieldClaimType holds the string denoting the claim_type field in the database.
 	FieldClaimType = "claim_type"
 	// FieldClaimAmount holds the string denoting the claim_amount field in the database.
@@ -44,6 +46,7 @@ var Columns = []string{
 	FieldID,
 	FieldReceiptID,
 	FieldSettlementID,
+	FieldSettlementLineID,
 	FieldClaimType,
 	FieldClaimAmount,
 	FieldConfirmed,
@@ -100,6 +103,11 @@ func BySettlementID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSettlementID, opts...).ToFunc()
 }
 
+// BySettlementLineID orders the results by the settlement_line_id field.
+func BySettlementLineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettlementLineID, opts...).ToFunc()
+}
+
 // ByClaimType orders the results by the claim_type field.
 func ByClaimType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClaimType, opts...).ToFunc()
@@ -64,6 +64,11 @@ func SettlementID(v int) predicate.ERPBankReceiptClaim {
 	return predicate.ERPBankReceiptClaim(sql.FieldEQ(FieldSettlementID, v))
 }
 
+// SettlementLineID applies equality check predicate on the "settlement_line_id" field. It's identical to SettlementLineIDEQ.
+func SettlementLineID(v int) predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldEQ(FieldSettlementLineID, v))
+}
+
 // ClaimType applies equality check predicate on the "claim_type" field. It's identical to ClaimTypeEQ.
 func ClaimType(v string) predicate.ERPBankReceiptClaim {
 	return predicate.ERPBankReceiptClaim(sql.FieldEQ(FieldClaimType, v))
@@ -199,6 +204,56 @@ func SettlementIDNotNil() predicate.ERPBankReceiptClaim {
 	return predicate.ERPBankReceiptClaim(sql.FieldNotNull(FieldSettlementID))
 }
 
+// SettlementLineIDEQ applies the EQ predicate on the "settlement_line_id" field.
+func SettlementLineIDEQ(v int) predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldEQ(FieldSettlementLineID, v))
+}
+
+// SettlementLineIDNEQ applies the NEQ predicate on the "settlement_line_id" field.
+func SettlementLineIDNEQ(v int) predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldNEQ(FieldSettlementLineID, v))
+}
+
+// SettlementLineIDIn applies the In predicate on the "settlement_line_id" field.
+func SettlementLineIDIn(vs ...int) predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldIn(FieldSettlementLineID, vs...))
+}
+
+// SettlementLineIDNotIn applies the NotIn predicate on the "settlement_line_id" field.
+func SettlementLineIDNotIn(vs ...int) predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldNotIn(FieldSettlementLineID, vs...))
+}
+
+// SettlementLineIDGT applies the GT predicate on the "settlement_line_id" field.
+func SettlementLineIDGT(v int) predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldGT(FieldSettlementLineID, v))
+}
+
+// SettlementLineIDGTE applies the GTE predicate on the "settlement_line_id" field.
+func SettlementLineIDGTE(v int) predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldGTE(FieldSettlementLineID, v))
+}
+
+// SettlementLineIDLT applies the LT predicate on the "settlement_line_id" field.
+func SettlementLineIDLT(v int) predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldLT(FieldSettlementLineID, v))
+}
+
+// SettlementLineIDLTE applies the LTE predicate on the "settlement_line_id" field.
+func SettlementLineIDLTE(v int) predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldLTE(FieldSettlementLineID, v))
+}
+
+// SettlementLineIDIsNil applies the IsNil predicate on the "settlement_line_id" field.
+func SettlementLineIDIsNil() predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldIsNull(FieldSettlementLineID))
+}
+
+// SettlementLineIDNotNil applies the NotNil predicate on the "settlement_line_id" field.
+func SettlementLineIDNotNil() predicate.ERPBankReceiptClaim {
+	return predicate.ERPBankReceiptClaim(sql.FieldNotNull(FieldSettlementLineID))
+}
+
 // ClaimTypeEQ applies the EQ predicate on the "claim_type" field.
 func ClaimTypeEQ(v string) predicate.ERPBankReceiptClaim {
 	return predicate.ERPBankReceiptClaim(sql.FieldEQ(FieldClaimType, v))
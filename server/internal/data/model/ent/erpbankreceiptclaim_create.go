@@ -40,6 +40,20 @@ func (_c *ERPBankReceiptClaimCreate) SetNillableSettlementID(v *int) *ERPBankRec
 	return _c
 }
 
+// SetSettlementLineID sets the "settlement_line_id" field.
+func (_c *ERPBankReceiptClaimCreate) SetSettlementLineID(v int) *ERPBankReceiptClaimCreate {
+	_c.mutation.SetSettlementLineID(v)
+	return _c
+}
+
+// SetNillableSettlementLineID sets the "settlement_line_id" field if the given value is not nil.
+func (_c *ERPBankReceiptClaimCreate) SetNillableSettlementLineID(v *int) *ERPBankReceiptClaimCreate {
+	if v != nil {
+		_c.SetSettlementLineID(*v)
+	}
+	return _c
+}
+
 // SetClaimType sets the "claim_type" field.
 func (_c *ERPBankReceiptClaimCreate) SetClaimType(v string) *ERPBankReceiptClaimCreate {
 	_c.mutation.SetClaimType(v)
@@ -268,6 +282,10 @@ func (_c *ERPBankReceiptClaimCreate) createSpec() (*ERPBankReceiptClaim, *sqlgra
 		_spec.SetField(erpbankreceiptclaim.FieldSettlementID, field.TypeInt, value)
 		_node.SettlementID = &value
 	}
+	if value, ok := _c.mutation.SettlementLineID(); ok {
+		_spec.SetField(erpbankreceiptclaim.FieldSettlementLineID, field.TypeInt, value)
+		_node.SettlementLineID = &value
+	}
 	if value, ok := _c.mutation.ClaimType(); ok {
 		_spec.SetField(erpbankreceiptclaim.FieldClaimType, field.TypeString, value)
 		_node.ClaimType = value
@@ -76,6 +76,33 @@ func (_u *ERPBankReceiptClaimUpdate) ClearSettlementID() *ERPBankReceiptClaimUpd
 	return _u
 }
 
+// SetSettlementLineID sets the "settlement_line_id" field.
+func (_u *ERPBankReceiptClaimUpdate) SetSettlementLineID(v int) *ERPBankReceiptClaimUpdate {
+	_u.mutation.ResetSettlementLineID()
+	_u.mutation.SetSettlementLineID(v)
+	return _u
+}
+
+// SetNillableSettlementLineID sets the "settlement_line_id" field if the given value is not nil.
+func (_u *ERPBankReceiptClaimUpdate) SetNillableSettlementLineID(v *int) *ERPBankReceiptClaimUpdate {
+	if v != nil {
+		_u.SetSettlementLineID(*v)
+	}
+	return _u
+}
+
+// AddSettlementLineID adds value to the "settlement_line_id" field.
+func (_u *ERPBankReceiptClaimUpdate) AddSettlementLineID(v int) *ERPBankReceiptClaimUpdate {
+	_u.mutation.AddSettlementLineID(v)
+	return _u
+}
+
+// ClearSettlementLineID clears the value of the "settlement_line_id" field.
+func (_u *ERPBankReceiptClaimUpdate) ClearSettlementLineID() *ERPBankReceiptClaimUpdate {
+	_u.mutation.ClearSettlementLineID()
+	return _u
+}
+
 // SetClaimType sets the "claim_type" field.
 func (_u *ERPBankReceiptClaimUpdate) SetClaimType(v string) *ERPBankReceiptClaimUpdate {
 	_u.mutation.SetClaimType(v)
@@ -313,6 +340,15 @@ func (_u *ERPBankReceiptClaimUpdate) sqlSave(ctx context.Context) (_node int, er
 	if _u.mutation.SettlementIDCleared() {
 		_spec.ClearField(erpbankreceiptclaim.FieldSettlementID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.SettlementLineID(); ok {
+		_spec.SetField(erpbankreceiptclaim.FieldSettlementLineID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSettlementLineID(); ok {
+		_spec.AddField(erpbankreceiptclaim.FieldSettlementLineID, field.TypeInt, value)
+	}
+	if _u.mutation.SettlementLineIDCleared() {
+		_spec.ClearField(erpbankreceiptclaim.FieldSettlementLineID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.ClaimType(); ok {
 		_spec.SetField(erpbankreceiptclaim.FieldClaimType, field.TypeString, value)
 	}
@@ -426,6 +462,33 @@ func (_u *ERPBankReceiptClaimUpdateOne) ClearSettlementID() *ERPBankReceiptClaim
 	return _u
 }
 
+// SetSettlementLineID sets the "settlement_line_id" field.
+func (_u *ERPBankReceiptClaimUpdateOne) SetSettlementLineID(v int) *ERPBankReceiptClaimUpdateOne {
+	_u.mutation.ResetSettlementLineID()
+	_u.mutation.SetSettlementLineID(v)
+	return _u
+}
+
+// SetNillableSettlementLineID sets the "settlement_line_id" field if the given value is not nil.
+func (_u *ERPBankReceiptClaimUpdateOne) SetNillableSettlementLineID(v *int) *ERPBankReceiptClaimUpdateOne {
+	if v != nil {
+		_u.SetSettlementLineID(*v)
+	}
+	return _u
+}
+
+// AddSettlementLineID adds value to the "settlement_line_id" field.
+func (_u *ERPBankReceiptClaimUpdateOne) AddSettlementLineID(v int) *ERPBankReceiptClaimUpdateOne {
+	_u.mutation.AddSettlementLineID(v)
+	return _u
+}
+
+// ClearSettlementLineID clears the value of the "settlement_line_id" field.
+func (_u *ERPBankReceiptClaimUpdateOne) ClearSettlementLineID() *ERPBankReceiptClaimUpdateOne {
+	_u.mutation.ClearSettlementLineID()
+	return _u
+}
+
 // SetClaimType sets the "claim_type" field.
 func (_u *ERPBankReceiptClaimUpdateOne) SetClaimType(v string) *ERPBankReceiptClaimUpdateOne {
 	_u.mutation.SetClaimType(v)
@@ -693,6 +756,15 @@ func (_u *ERPBankReceiptClaimUpdateOne) sqlSave(ctx context.Context) (_node *ERP
 	if _u.mutation.SettlementIDCleared() {
 		_spec.ClearField(erpbankreceiptclaim.FieldSettlementID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.SettlementLineID(); ok {
+		_spec.SetField(erpbankreceiptclaim.FieldSettlementLineID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSettlementLineID(); ok {
+		_spec.AddField(erpbankreceiptclaim.FieldSettlementLineID, field.TypeInt, value)
+	}
+	if _u.mutation.SettlementLineIDCleared() {
+		_spec.ClearField(erpbankreceiptclaim.FieldSettlementLineID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.ClaimType(); ok {
 		_spec.SetField(erpbankreceiptclaim.FieldClaimType, field.TypeString, value)
 	}
@@ -29,6 +29,8 @@ type ERPModuleRecord struct {
 	CreatedByAdminID *int `json:"created_by_admin_id,omitempty"`
 	// UpdatedByAdminID holds the value of the "updated_by_admin_id" field.
 	UpdatedByAdminID *int `json:"updated_by_admin_id,omitempty"`
+	// 乐观锁版本，每次修改加 1
+	Version int `json:"version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -41,7 +43,7 @@ func (*ERPModuleRecord) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case erpmodulerecord.FieldID, erpmodulerecord.FieldCreatedByAdminID, erpmodulerecord.FieldUpdatedByAdminID:
+		case erpmodulerecord.FieldID, erpmodulerecord.FieldCreatedByAdminID, erpmodulerecord.FieldUpdatedByAdminID, erpmodulerecord.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case erpmodulerecord.FieldModuleKey, erpmodulerecord.FieldCode, erpmodulerecord.FieldBox, erpmodulerecord.FieldPayload:
 			values[i] = new(sql.NullString)
@@ -108,6 +110,12 @@ func (_m *ERPModuleRecord) assignValues(columns []string, values []any) error {
 				_m.UpdatedByAdminID = new(int)
 				*_m.UpdatedByAdminID = int(value.Int64)
 			}
+		case erpmodulerecord.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case erpmodulerecord.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -182,6 +190,9 @@ func (_m *ERPModuleRecord) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -25,6 +25,8 @@ const (
 	FieldCreatedByAdminID = "created_by_admin_id"
 	// FieldUpdatedByAdminID holds the string denoting the updated_by_admin_id field in the database.
 	FieldUpdatedByAdminID = "updated_by_admin_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -42,6 +44,7 @@ var Columns = []string{
 	FieldPayload,
 	FieldCreatedByAdminID,
 	FieldUpdatedByAdminID,
+	FieldVersion,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -65,6 +68,10 @@ var (
 	BoxValidator func(string) error
 	// DefaultPayload holds the default value on creation for the "payload" field.
 	DefaultPayload string
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -111,6 +118,11 @@ func ByUpdatedByAdminID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedByAdminID, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
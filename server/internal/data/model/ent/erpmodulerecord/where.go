@@ -84,6 +84,11 @@ func UpdatedByAdminID(v int) predicate.ERPModuleRecord {
 	return predicate.ERPModuleRecord(sql.FieldEQ(FieldUpdatedByAdminID, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.ERPModuleRecord {
+	return predicate.ERPModuleRecord(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ERPModuleRecord {
 	return predicate.ERPModuleRecord(sql.FieldEQ(FieldCreatedAt, v))
@@ -474,6 +479,46 @@ func UpdatedByAdminIDNotNil() predicate.ERPModuleRecord {
 	return predicate.ERPModuleRecord(sql.FieldNotNull(FieldUpdatedByAdminID))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.ERPModuleRecord {
+	return predicate.ERPModuleRecord(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.ERPModuleRecord {
+	return predicate.ERPModuleRecord(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.ERPModuleRecord {
+	return predicate.ERPModuleRecord(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.ERPModuleRecord {
+	return predicate.ERPModuleRecord(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.ERPModuleRecord {
+	return predicate.ERPModuleRecord(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.ERPModuleRecord {
+	return predicate.ERPModuleRecord(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.ERPModuleRecord {
+	return predicate.ERPModuleRecord(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.ERPModuleRecord {
+	return predicate.ERPModuleRecord(sql.FieldLTE(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ERPModuleRecord {
 	return predicate.ERPModuleRecord(sql.FieldEQ(FieldCreatedAt, v))
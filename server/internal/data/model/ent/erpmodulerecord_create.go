@@ -96,6 +96,20 @@ func (_c *ERPModuleRecordCreate) SetNillableUpdatedByAdminID(v *int) *ERPModuleR
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *ERPModuleRecordCreate) SetVersion(v int) *ERPModuleRecordCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *ERPModuleRecordCreate) SetNillableVersion(v *int) *ERPModuleRecordCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ERPModuleRecordCreate) SetCreatedAt(v time.Time) *ERPModuleRecordCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -163,6 +177,10 @@ func (_c *ERPModuleRecordCreate) defaults() {
 		v := erpmodulerecord.DefaultPayload
 		_c.mutation.SetPayload(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := erpmodulerecord.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := erpmodulerecord.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -196,6 +214,14 @@ func (_c *ERPModuleRecordCreate) check() error {
 	if _, ok := _c.mutation.Payload(); !ok {
 		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "ERPModuleRecord.payload"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ERPModuleRecord.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := erpmodulerecord.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ERPModuleRecord.version": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ERPModuleRecord.created_at"`)}
 	}
@@ -252,6 +278,10 @@ func (_c *ERPModuleRecordCreate) createSpec() (*ERPModuleRecord, *sqlgraph.Creat
 		_spec.SetField(erpmodulerecord.FieldUpdatedByAdminID, field.TypeInt, value)
 		_node.UpdatedByAdminID = &value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(erpmodulerecord.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(erpmodulerecord.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -150,6 +150,27 @@ func (_u *ERPModuleRecordUpdate) ClearUpdatedByAdminID() *ERPModuleRecordUpdate
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *ERPModuleRecordUpdate) SetVersion(v int) *ERPModuleRecordUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ERPModuleRecordUpdate) SetNillableVersion(v *int) *ERPModuleRecordUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ERPModuleRecordUpdate) AddVersion(v int) *ERPModuleRecordUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ERPModuleRecordUpdate) SetUpdatedAt(v time.Time) *ERPModuleRecordUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -214,6 +235,11 @@ func (_u *ERPModuleRecordUpdate) check() error {
 			return &ValidationError{Name: "box", err: fmt.Errorf(`ent: validator failed for field "ERPModuleRecord.box": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := erpmodulerecord.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ERPModuleRecord.version": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -265,6 +291,12 @@ func (_u *ERPModuleRecordUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.UpdatedByAdminIDCleared() {
 		_spec.ClearField(erpmodulerecord.FieldUpdatedByAdminID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(erpmodulerecord.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(erpmodulerecord.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(erpmodulerecord.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -410,6 +442,27 @@ func (_u *ERPModuleRecordUpdateOne) ClearUpdatedByAdminID() *ERPModuleRecordUpda
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *ERPModuleRecordUpdateOne) SetVersion(v int) *ERPModuleRecordUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ERPModuleRecordUpdateOne) SetNillableVersion(v *int) *ERPModuleRecordUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ERPModuleRecordUpdateOne) AddVersion(v int) *ERPModuleRecordUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ERPModuleRecordUpdateOne) SetUpdatedAt(v time.Time) *ERPModuleRecordUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -487,6 +540,11 @@ func (_u *ERPModuleRecordUpdateOne) check() error {
 			return &ValidationError{Name: "box", err: fmt.Errorf(`ent: validator failed for field "ERPModuleRecord.box": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := erpmodulerecord.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ERPModuleRecord.version": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -555,6 +613,12 @@ func (_u *ERPModuleRecordUpdateOne) sqlSave(ctx context.Context) (_node *ERPModu
 	if _u.mutation.UpdatedByAdminIDCleared() {
 		_spec.ClearField(erpmodulerecord.FieldUpdatedByAdminID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(erpmodulerecord.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(erpmodulerecord.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(erpmodulerecord.FieldUpdatedAt, field.TypeTime, value)
 	}
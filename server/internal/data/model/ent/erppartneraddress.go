@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/erppartneraddress"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ERPPartnerAddress is the model entity for the ERPPartnerAddress schema.
+type ERPPartnerAddress struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PartnerID holds the value of the "partner_id" field.
+	PartnerID int `json:"partner_id,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder int `json:"sort_order,omitempty"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label,omitempty"`
+	// 收货人/通知方/账单
+	AddressType string `json:"address_type,omitempty"`
+	// PartyName holds the value of the "party_name" field.
+	PartyName *string `json:"party_name,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Country holds the value of the "country" field.
+	Country *string `json:"country,omitempty"`
+	// Contact holds the value of the "contact" field.
+	Contact *string `json:"contact,omitempty"`
+	// Phone holds the value of the "phone" field.
+	Phone *string `json:"phone,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ERPPartnerAddress) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case erppartneraddress.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case erppartneraddress.FieldID, erppartneraddress.FieldPartnerID, erppartneraddress.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case erppartneraddress.FieldLabel, erppartneraddress.FieldAddressType, erppartneraddress.FieldPartyName, erppartneraddress.FieldAddress, erppartneraddress.FieldCountry, erppartneraddress.FieldContact, erppartneraddress.FieldPhone:
+			values[i] = new(sql.NullString)
+		case erppartneraddress.FieldCreatedAt, erppartneraddress.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ERPPartnerAddress fields.
+func (_m *ERPPartnerAddress) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case erppartneraddress.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case erppartneraddress.FieldPartnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field partner_id", values[i])
+			} else if value.Valid {
+				_m.PartnerID = int(value.Int64)
+			}
+		case erppartneraddress.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				_m.SortOrder = int(value.Int64)
+			}
+		case erppartneraddress.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = value.String
+			}
+		case erppartneraddress.FieldAddressType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_type", values[i])
+			} else if value.Valid {
+				_m.AddressType = value.String
+			}
+		case erppartneraddress.FieldPartyName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field party_name", values[i])
+			} else if value.Valid {
+				_m.PartyName = new(string)
+				*_m.PartyName = value.String
+			}
+		case erppartneraddress.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case erppartneraddress.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = new(string)
+				*_m.Country = value.String
+			}
+		case erppartneraddress.FieldContact:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contact", values[i])
+			} else if value.Valid {
+				_m.Contact = new(string)
+				*_m.Contact = value.String
+			}
+		case erppartneraddress.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				_m.Phone = new(string)
+				*_m.Phone = value.String
+			}
+		case erppartneraddress.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case erppartneraddress.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case erppartneraddress.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ERPPartnerAddress.
+// This includes values selected through modifiers, order, etc.
+func (_m *ERPPartnerAddress) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ERPPartnerAddress.
+// Note that you need to call ERPPartnerAddress.Unwrap() before calling this method if this ERPPartnerAddress
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ERPPartnerAddress) Update() *ERPPartnerAddressUpdateOne {
+	return NewERPPartnerAddressClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ERPPartnerAddress entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ERPPartnerAddress) Unwrap() *ERPPartnerAddress {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ERPPartnerAddress is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ERPPartnerAddress) String() string {
+	var builder strings.Builder
+	builder.WriteString("ERPPartnerAddress(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("partner_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PartnerID))
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SortOrder))
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(_m.Label)
+	builder.WriteString(", ")
+	builder.WriteString("address_type=")
+	builder.WriteString(_m.AddressType)
+	builder.WriteString(", ")
+	if v := _m.PartyName; v != nil {
+		builder.WriteString("party_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	if v := _m.Country; v != nil {
+		builder.WriteString("country=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Contact; v != nil {
+		builder.WriteString("contact=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Phone; v != nil {
+		builder.WriteString("phone=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ERPPartnerAddresses is a parsable slice of ERPPartnerAddress.
+type ERPPartnerAddresses []*ERPPartnerAddress
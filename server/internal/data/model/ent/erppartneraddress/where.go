@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package erppartneraddress
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldID, id))
+}
+
+// PartnerID applies equality check predicate on the "partner_id" field. It's identical to PartnerIDEQ.
+func PartnerID(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldPartnerID, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// AddressType applies equality check predicate on the "address_type" field. It's identical to AddressTypeEQ.
+func AddressType(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldAddressType, v))
+}
+
+// PartyName applies equality check predicate on the "party_name" field. It's identical to PartyNameEQ.
+func PartyName(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldPartyName, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldAddress, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldCountry, v))
+}
+
+// Contact applies equality check predicate on the "contact" field. It's identical to ContactEQ.
+func Contact(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldContact, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldPhone, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PartnerIDEQ applies the EQ predicate on the "partner_id" field.
+func PartnerIDEQ(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldPartnerID, v))
+}
+
+// PartnerIDNEQ applies the NEQ predicate on the "partner_id" field.
+func PartnerIDNEQ(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldPartnerID, v))
+}
+
+// PartnerIDIn applies the In predicate on the "partner_id" field.
+func PartnerIDIn(vs ...int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldPartnerID, vs...))
+}
+
+// PartnerIDNotIn applies the NotIn predicate on the "partner_id" field.
+func PartnerIDNotIn(vs ...int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldPartnerID, vs...))
+}
+
+// PartnerIDGT applies the GT predicate on the "partner_id" field.
+func PartnerIDGT(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldPartnerID, v))
+}
+
+// PartnerIDGTE applies the GTE predicate on the "partner_id" field.
+func PartnerIDGTE(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldPartnerID, v))
+}
+
+// PartnerIDLT applies the LT predicate on the "partner_id" field.
+func PartnerIDLT(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldPartnerID, v))
+}
+
+// PartnerIDLTE applies the LTE predicate on the "partner_id" field.
+func PartnerIDLTE(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldPartnerID, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// AddressTypeEQ applies the EQ predicate on the "address_type" field.
+func AddressTypeEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldAddressType, v))
+}
+
+// AddressTypeNEQ applies the NEQ predicate on the "address_type" field.
+func AddressTypeNEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldAddressType, v))
+}
+
+// AddressTypeIn applies the In predicate on the "address_type" field.
+func AddressTypeIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldAddressType, vs...))
+}
+
+// AddressTypeNotIn applies the NotIn predicate on the "address_type" field.
+func AddressTypeNotIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldAddressType, vs...))
+}
+
+// AddressTypeGT applies the GT predicate on the "address_type" field.
+func AddressTypeGT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldAddressType, v))
+}
+
+// AddressTypeGTE applies the GTE predicate on the "address_type" field.
+func AddressTypeGTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldAddressType, v))
+}
+
+// AddressTypeLT applies the LT predicate on the "address_type" field.
+func AddressTypeLT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldAddressType, v))
+}
+
+// AddressTypeLTE applies the LTE predicate on the "address_type" field.
+func AddressTypeLTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldAddressType, v))
+}
+
+// AddressTypeContains applies the Contains predicate on the "address_type" field.
+func AddressTypeContains(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContains(FieldAddressType, v))
+}
+
+// AddressTypeHasPrefix applies the HasPrefix predicate on the "address_type" field.
+func AddressTypeHasPrefix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasPrefix(FieldAddressType, v))
+}
+
+// AddressTypeHasSuffix applies the HasSuffix predicate on the "address_type" field.
+func AddressTypeHasSuffix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasSuffix(FieldAddressType, v))
+}
+
+// AddressTypeEqualFold applies the EqualFold predicate on the "address_type" field.
+func AddressTypeEqualFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEqualFold(FieldAddressType, v))
+}
+
+// AddressTypeContainsFold applies the ContainsFold predicate on the "address_type" field.
+func AddressTypeContainsFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContainsFold(FieldAddressType, v))
+}
+
+// PartyNameEQ applies the EQ predicate on the "party_name" field.
+func PartyNameEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldPartyName, v))
+}
+
+// PartyNameNEQ applies the NEQ predicate on the "party_name" field.
+func PartyNameNEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldPartyName, v))
+}
+
+// PartyNameIn applies the In predicate on the "party_name" field.
+func PartyNameIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldPartyName, vs...))
+}
+
+// PartyNameNotIn applies the NotIn predicate on the "party_name" field.
+func PartyNameNotIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldPartyName, vs...))
+}
+
+// PartyNameGT applies the GT predicate on the "party_name" field.
+func PartyNameGT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldPartyName, v))
+}
+
+// PartyNameGTE applies the GTE predicate on the "party_name" field.
+func PartyNameGTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldPartyName, v))
+}
+
+// PartyNameLT applies the LT predicate on the "party_name" field.
+func PartyNameLT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldPartyName, v))
+}
+
+// PartyNameLTE applies the LTE predicate on the "party_name" field.
+func PartyNameLTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldPartyName, v))
+}
+
+// PartyNameContains applies the Contains predicate on the "party_name" field.
+func PartyNameContains(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContains(FieldPartyName, v))
+}
+
+// PartyNameHasPrefix applies the HasPrefix predicate on the "party_name" field.
+func PartyNameHasPrefix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasPrefix(FieldPartyName, v))
+}
+
+// PartyNameHasSuffix applies the HasSuffix predicate on the "party_name" field.
+func PartyNameHasSuffix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasSuffix(FieldPartyName, v))
+}
+
+// PartyNameIsNil applies the IsNil predicate on the "party_name" field.
+func PartyNameIsNil() predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIsNull(FieldPartyName))
+}
+
+// PartyNameNotNil applies the NotNil predicate on the "party_name" field.
+func PartyNameNotNil() predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotNull(FieldPartyName))
+}
+
+// PartyNameEqualFold applies the EqualFold predicate on the "party_name" field.
+func PartyNameEqualFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEqualFold(FieldPartyName, v))
+}
+
+// PartyNameContainsFold applies the ContainsFold predicate on the "party_name" field.
+func PartyNameContainsFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContainsFold(FieldPartyName, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryIsNil applies the IsNil predicate on the "country" field.
+func CountryIsNil() predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIsNull(FieldCountry))
+}
+
+// CountryNotNil applies the NotNil predicate on the "country" field.
+func CountryNotNil() predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotNull(FieldCountry))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// ContactEQ applies the EQ predicate on the "contact" field.
+func ContactEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldContact, v))
+}
+
+// ContactNEQ applies the NEQ predicate on the "contact" field.
+func ContactNEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldContact, v))
+}
+
+// ContactIn applies the In predicate on the "contact" field.
+func ContactIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldContact, vs...))
+}
+
+// ContactNotIn applies the NotIn predicate on the "contact" field.
+func ContactNotIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldContact, vs...))
+}
+
+// ContactGT applies the GT predicate on the "contact" field.
+func ContactGT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldContact, v))
+}
+
+// ContactGTE applies the GTE predicate on the "contact" field.
+func ContactGTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldContact, v))
+}
+
+// ContactLT applies the LT predicate on the "contact" field.
+func ContactLT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldContact, v))
+}
+
+// ContactLTE applies the LTE predicate on the "contact" field.
+func ContactLTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldContact, v))
+}
+
+// ContactContains applies the Contains predicate on the "contact" field.
+func ContactContains(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContains(FieldContact, v))
+}
+
+// ContactHasPrefix applies the HasPrefix predicate on the "contact" field.
+func ContactHasPrefix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasPrefix(FieldContact, v))
+}
+
+// ContactHasSuffix applies the HasSuffix predicate on the "contact" field.
+func ContactHasSuffix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasSuffix(FieldContact, v))
+}
+
+// ContactIsNil applies the IsNil predicate on the "contact" field.
+func ContactIsNil() predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIsNull(FieldContact))
+}
+
+// ContactNotNil applies the NotNil predicate on the "contact" field.
+func ContactNotNil() predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotNull(FieldContact))
+}
+
+// ContactEqualFold applies the EqualFold predicate on the "contact" field.
+func ContactEqualFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEqualFold(FieldContact, v))
+}
+
+// ContactContainsFold applies the ContainsFold predicate on the "contact" field.
+func ContactContainsFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContainsFold(FieldContact, v))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIsNull(FieldPhone))
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotNull(FieldPhone))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ERPPartnerAddress) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ERPPartnerAddress) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ERPPartnerAddress) predicate.ERPPartnerAddress {
+	return predicate.ERPPartnerAddress(sql.NotPredicates(p))
+}
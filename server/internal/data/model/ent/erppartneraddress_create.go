@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erppartneraddress"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPPartnerAddressCreate is the builder for creating a ERPPartnerAddress entity.
+type ERPPartnerAddressCreate struct {
+	config
+	mutation *ERPPartnerAddressMutation
+	hooks    []Hook
+}
+
+// SetPartnerID sets the "partner_id" field.
+func (_c *ERPPartnerAddressCreate) SetPartnerID(v int) *ERPPartnerAddressCreate {
+	_c.mutation.SetPartnerID(v)
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *ERPPartnerAddressCreate) SetSortOrder(v int) *ERPPartnerAddressCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *ERPPartnerAddressCreate) SetLabel(v string) *ERPPartnerAddressCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetAddressType sets the "address_type" field.
+func (_c *ERPPartnerAddressCreate) SetAddressType(v string) *ERPPartnerAddressCreate {
+	_c.mutation.SetAddressType(v)
+	return _c
+}
+
+// SetPartyName sets the "party_name" field.
+func (_c *ERPPartnerAddressCreate) SetPartyName(v string) *ERPPartnerAddressCreate {
+	_c.mutation.SetPartyName(v)
+	return _c
+}
+
+// SetNillablePartyName sets the "party_name" field if the given value is not nil.
+func (_c *ERPPartnerAddressCreate) SetNillablePartyName(v *string) *ERPPartnerAddressCreate {
+	if v != nil {
+		_c.SetPartyName(*v)
+	}
+	return _c
+}
+
+// SetAddress sets the "address" field.
+func (_c *ERPPartnerAddressCreate) SetAddress(v string) *ERPPartnerAddressCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *ERPPartnerAddressCreate) SetCountry(v string) *ERPPartnerAddressCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_c *ERPPartnerAddressCreate) SetNillableCountry(v *string) *ERPPartnerAddressCreate {
+	if v != nil {
+		_c.SetCountry(*v)
+	}
+	return _c
+}
+
+// SetContact sets the "contact" field.
+func (_c *ERPPartnerAddressCreate) SetContact(v string) *ERPPartnerAddressCreate {
+	_c.mutation.SetContact(v)
+	return _c
+}
+
+// SetNillableContact sets the "contact" field if the given value is not nil.
+func (_c *ERPPartnerAddressCreate) SetNillableContact(v *string) *ERPPartnerAddressCreate {
+	if v != nil {
+		_c.SetContact(*v)
+	}
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *ERPPartnerAddressCreate) SetPhone(v string) *ERPPartnerAddressCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *ERPPartnerAddressCreate) SetNillablePhone(v *string) *ERPPartnerAddressCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *ERPPartnerAddressCreate) SetIsDefault(v bool) *ERPPartnerAddressCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *ERPPartnerAddressCreate) SetNillableIsDefault(v *bool) *ERPPartnerAddressCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ERPPartnerAddressCreate) SetCreatedAt(v time.Time) *ERPPartnerAddressCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ERPPartnerAddressCreate) SetNillableCreatedAt(v *time.Time) *ERPPartnerAddressCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ERPPartnerAddressCreate) SetUpdatedAt(v time.Time) *ERPPartnerAddressCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ERPPartnerAddressCreate) SetNillableUpdatedAt(v *time.Time) *ERPPartnerAddressCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ERPPartnerAddressMutation object of the builder.
+func (_c *ERPPartnerAddressCreate) Mutation() *ERPPartnerAddressMutation {
+	return _c.mutation
+}
+
+// Save creates the ERPPartnerAddress in the database.
+func (_c *ERPPartnerAddressCreate) Save(ctx context.Context) (*ERPPartnerAddress, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ERPPartnerAddressCreate) SaveX(ctx context.Context) *ERPPartnerAddress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPPartnerAddressCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPPartnerAddressCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ERPPartnerAddressCreate) defaults() {
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		v := erppartneraddress.DefaultIsDefault
+		_c.mutation.SetIsDefault(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := erppartneraddress.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := erppartneraddress.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ERPPartnerAddressCreate) check() error {
+	if _, ok := _c.mutation.PartnerID(); !ok {
+		return &ValidationError{Name: "partner_id", err: errors.New(`ent: missing required field "ERPPartnerAddress.partner_id"`)}
+	}
+	if v, ok := _c.mutation.PartnerID(); ok {
+		if err := erppartneraddress.PartnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "partner_id", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.partner_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "ERPPartnerAddress.sort_order"`)}
+	}
+	if v, ok := _c.mutation.SortOrder(); ok {
+		if err := erppartneraddress.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.sort_order": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "ERPPartnerAddress.label"`)}
+	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := erppartneraddress.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressType(); !ok {
+		return &ValidationError{Name: "address_type", err: errors.New(`ent: missing required field "ERPPartnerAddress.address_type"`)}
+	}
+	if v, ok := _c.mutation.AddressType(); ok {
+		if err := erppartneraddress.AddressTypeValidator(v); err != nil {
+			return &ValidationError{Name: "address_type", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.address_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PartyName(); ok {
+		if err := erppartneraddress.PartyNameValidator(v); err != nil {
+			return &ValidationError{Name: "party_name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.party_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "ERPPartnerAddress.address"`)}
+	}
+	if v, ok := _c.mutation.Address(); ok {
+		if err := erppartneraddress.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.address": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Country(); ok {
+		if err := erppartneraddress.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.country": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Contact(); ok {
+		if err := erppartneraddress.ContactValidator(v); err != nil {
+			return &ValidationError{Name: "contact", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.contact": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Phone(); ok {
+		if err := erppartneraddress.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.phone": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "ERPPartnerAddress.is_default"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ERPPartnerAddress.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ERPPartnerAddress.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ERPPartnerAddressCreate) sqlSave(ctx context.Context) (*ERPPartnerAddress, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ERPPartnerAddressCreate) createSpec() (*ERPPartnerAddress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ERPPartnerAddress{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(erppartneraddress.Table, sqlgraph.NewFieldSpec(erppartneraddress.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.PartnerID(); ok {
+		_spec.SetField(erppartneraddress.FieldPartnerID, field.TypeInt, value)
+		_node.PartnerID = value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(erppartneraddress.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(erppartneraddress.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.AddressType(); ok {
+		_spec.SetField(erppartneraddress.FieldAddressType, field.TypeString, value)
+		_node.AddressType = value
+	}
+	if value, ok := _c.mutation.PartyName(); ok {
+		_spec.SetField(erppartneraddress.FieldPartyName, field.TypeString, value)
+		_node.PartyName = &value
+	}
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(erppartneraddress.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(erppartneraddress.FieldCountry, field.TypeString, value)
+		_node.Country = &value
+	}
+	if value, ok := _c.mutation.Contact(); ok {
+		_spec.SetField(erppartneraddress.FieldContact, field.TypeString, value)
+		_node.Contact = &value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(erppartneraddress.FieldPhone, field.TypeString, value)
+		_node.Phone = &value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(erppartneraddress.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(erppartneraddress.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(erppartneraddress.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ERPPartnerAddressCreateBulk is the builder for creating many ERPPartnerAddress entities in bulk.
+type ERPPartnerAddressCreateBulk struct {
+	config
+	err      error
+	builders []*ERPPartnerAddressCreate
+}
+
+// Save creates the ERPPartnerAddress entities in the database.
+func (_c *ERPPartnerAddressCreateBulk) Save(ctx context.Context) ([]*ERPPartnerAddress, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ERPPartnerAddress, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ERPPartnerAddressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ERPPartnerAddressCreateBulk) SaveX(ctx context.Context) []*ERPPartnerAddress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPPartnerAddressCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPPartnerAddressCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"server/internal/data/model/ent/erppartneraddress"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPPartnerAddressDelete is the builder for deleting a ERPPartnerAddress entity.
+type ERPPartnerAddressDelete struct {
+	config
+	hooks    []Hook
+	mutation *ERPPartnerAddressMutation
+}
+
+// Where appends a list predicates to the ERPPartnerAddressDelete builder.
+func (_d *ERPPartnerAddressDelete) Where(ps ...predicate.ERPPartnerAddress) *ERPPartnerAddressDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ERPPartnerAddressDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ERPPartnerAddressDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ERPPartnerAddressDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(erppartneraddress.Table, sqlgraph.NewFieldSpec(erppartneraddress.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ERPPartnerAddressDeleteOne is the builder for deleting a single ERPPartnerAddress entity.
+type ERPPartnerAddressDeleteOne struct {
+	_d *ERPPartnerAddressDelete
+}
+
+// Where appends a list predicates to the ERPPartnerAddressDelete builder.
+func (_d *ERPPartnerAddressDeleteOne) Where(ps ...predicate.ERPPartnerAddress) *ERPPartnerAddressDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ERPPartnerAddressDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{erppartneraddress.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ERPPartnerAddressDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erppartneraddress"
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPPartnerAddressUpdate is the builder for updating ERPPartnerAddress entities.
+type ERPPartnerAddressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ERPPartnerAddressMutation
+}
+
+// Where appends a list predicates to the ERPPartnerAddressUpdate builder.
+func (_u *ERPPartnerAddressUpdate) Where(ps ...predicate.ERPPartnerAddress) *ERPPartnerAddressUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPartnerID sets the "partner_id" field.
+func (_u *ERPPartnerAddressUpdate) SetPartnerID(v int) *ERPPartnerAddressUpdate {
+	_u.mutation.ResetPartnerID()
+	_u.mutation.SetPartnerID(v)
+	return _u
+}
+
+// SetNillablePartnerID sets the "partner_id" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillablePartnerID(v *int) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetPartnerID(*v)
+	}
+	return _u
+}
+
+// AddPartnerID adds value to the "partner_id" field.
+func (_u *ERPPartnerAddressUpdate) AddPartnerID(v int) *ERPPartnerAddressUpdate {
+	_u.mutation.AddPartnerID(v)
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ERPPartnerAddressUpdate) SetSortOrder(v int) *ERPPartnerAddressUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillableSortOrder(v *int) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ERPPartnerAddressUpdate) AddSortOrder(v int) *ERPPartnerAddressUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *ERPPartnerAddressUpdate) SetLabel(v string) *ERPPartnerAddressUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillableLabel(v *string) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetAddressType sets the "address_type" field.
+func (_u *ERPPartnerAddressUpdate) SetAddressType(v string) *ERPPartnerAddressUpdate {
+	_u.mutation.SetAddressType(v)
+	return _u
+}
+
+// SetNillableAddressType sets the "address_type" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillableAddressType(v *string) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetAddressType(*v)
+	}
+	return _u
+}
+
+// SetPartyName sets the "party_name" field.
+func (_u *ERPPartnerAddressUpdate) SetPartyName(v string) *ERPPartnerAddressUpdate {
+	_u.mutation.SetPartyName(v)
+	return _u
+}
+
+// SetNillablePartyName sets the "party_name" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillablePartyName(v *string) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetPartyName(*v)
+	}
+	return _u
+}
+
+// ClearPartyName clears the value of the "party_name" field.
+func (_u *ERPPartnerAddressUpdate) ClearPartyName() *ERPPartnerAddressUpdate {
+	_u.mutation.ClearPartyName()
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *ERPPartnerAddressUpdate) SetAddress(v string) *ERPPartnerAddressUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillableAddress(v *string) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *ERPPartnerAddressUpdate) SetCountry(v string) *ERPPartnerAddressUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillableCountry(v *string) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *ERPPartnerAddressUpdate) ClearCountry() *ERPPartnerAddressUpdate {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetContact sets the "contact" field.
+func (_u *ERPPartnerAddressUpdate) SetContact(v string) *ERPPartnerAddressUpdate {
+	_u.mutation.SetContact(v)
+	return _u
+}
+
+// SetNillableContact sets the "contact" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillableContact(v *string) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetContact(*v)
+	}
+	return _u
+}
+
+// ClearContact clears the value of the "contact" field.
+func (_u *ERPPartnerAddressUpdate) ClearContact() *ERPPartnerAddressUpdate {
+	_u.mutation.ClearContact()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *ERPPartnerAddressUpdate) SetPhone(v string) *ERPPartnerAddressUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillablePhone(v *string) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *ERPPartnerAddressUpdate) ClearPhone() *ERPPartnerAddressUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *ERPPartnerAddressUpdate) SetIsDefault(v bool) *ERPPartnerAddressUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdate) SetNillableIsDefault(v *bool) *ERPPartnerAddressUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ERPPartnerAddressUpdate) SetUpdatedAt(v time.Time) *ERPPartnerAddressUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ERPPartnerAddressMutation object of the builder.
+func (_u *ERPPartnerAddressUpdate) Mutation() *ERPPartnerAddressMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ERPPartnerAddressUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPPartnerAddressUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ERPPartnerAddressUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPPartnerAddressUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ERPPartnerAddressUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := erppartneraddress.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPPartnerAddressUpdate) check() error {
+	if v, ok := _u.mutation.PartnerID(); ok {
+		if err := erppartneraddress.PartnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "partner_id", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.partner_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SortOrder(); ok {
+		if err := erppartneraddress.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.sort_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := erppartneraddress.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.label": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressType(); ok {
+		if err := erppartneraddress.AddressTypeValidator(v); err != nil {
+			return &ValidationError{Name: "address_type", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.address_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PartyName(); ok {
+		if err := erppartneraddress.PartyNameValidator(v); err != nil {
+			return &ValidationError{Name: "party_name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.party_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Address(); ok {
+		if err := erppartneraddress.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := erppartneraddress.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Contact(); ok {
+		if err := erppartneraddress.ContactValidator(v); err != nil {
+			return &ValidationError{Name: "contact", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.contact": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phone(); ok {
+		if err := erppartneraddress.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.phone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPPartnerAddressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erppartneraddress.Table, erppartneraddress.Columns, sqlgraph.NewFieldSpec(erppartneraddress.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PartnerID(); ok {
+		_spec.SetField(erppartneraddress.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartnerID(); ok {
+		_spec.AddField(erppartneraddress.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(erppartneraddress.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(erppartneraddress.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(erppartneraddress.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressType(); ok {
+		_spec.SetField(erppartneraddress.FieldAddressType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PartyName(); ok {
+		_spec.SetField(erppartneraddress.FieldPartyName, field.TypeString, value)
+	}
+	if _u.mutation.PartyNameCleared() {
+		_spec.ClearField(erppartneraddress.FieldPartyName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(erppartneraddress.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(erppartneraddress.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(erppartneraddress.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.Contact(); ok {
+		_spec.SetField(erppartneraddress.FieldContact, field.TypeString, value)
+	}
+	if _u.mutation.ContactCleared() {
+		_spec.ClearField(erppartneraddress.FieldContact, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(erppartneraddress.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(erppartneraddress.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(erppartneraddress.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(erppartneraddress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erppartneraddress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ERPPartnerAddressUpdateOne is the builder for updating a single ERPPartnerAddress entity.
+type ERPPartnerAddressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ERPPartnerAddressMutation
+}
+
+// SetPartnerID sets the "partner_id" field.
+func (_u *ERPPartnerAddressUpdateOne) SetPartnerID(v int) *ERPPartnerAddressUpdateOne {
+	_u.mutation.ResetPartnerID()
+	_u.mutation.SetPartnerID(v)
+	return _u
+}
+
+// SetNillablePartnerID sets the "partner_id" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillablePartnerID(v *int) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetPartnerID(*v)
+	}
+	return _u
+}
+
+// AddPartnerID adds value to the "partner_id" field.
+func (_u *ERPPartnerAddressUpdateOne) AddPartnerID(v int) *ERPPartnerAddressUpdateOne {
+	_u.mutation.AddPartnerID(v)
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ERPPartnerAddressUpdateOne) SetSortOrder(v int) *ERPPartnerAddressUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillableSortOrder(v *int) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ERPPartnerAddressUpdateOne) AddSortOrder(v int) *ERPPartnerAddressUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *ERPPartnerAddressUpdateOne) SetLabel(v string) *ERPPartnerAddressUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillableLabel(v *string) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetAddressType sets the "address_type" field.
+func (_u *ERPPartnerAddressUpdateOne) SetAddressType(v string) *ERPPartnerAddressUpdateOne {
+	_u.mutation.SetAddressType(v)
+	return _u
+}
+
+// SetNillableAddressType sets the "address_type" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillableAddressType(v *string) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetAddressType(*v)
+	}
+	return _u
+}
+
+// SetPartyName sets the "party_name" field.
+func (_u *ERPPartnerAddressUpdateOne) SetPartyName(v string) *ERPPartnerAddressUpdateOne {
+	_u.mutation.SetPartyName(v)
+	return _u
+}
+
+// SetNillablePartyName sets the "party_name" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillablePartyName(v *string) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetPartyName(*v)
+	}
+	return _u
+}
+
+// ClearPartyName clears the value of the "party_name" field.
+func (_u *ERPPartnerAddressUpdateOne) ClearPartyName() *ERPPartnerAddressUpdateOne {
+	_u.mutation.ClearPartyName()
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *ERPPartnerAddressUpdateOne) SetAddress(v string) *ERPPartnerAddressUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillableAddress(v *string) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *ERPPartnerAddressUpdateOne) SetCountry(v string) *ERPPartnerAddressUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillableCountry(v *string) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *ERPPartnerAddressUpdateOne) ClearCountry() *ERPPartnerAddressUpdateOne {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetContact sets the "contact" field.
+func (_u *ERPPartnerAddressUpdateOne) SetContact(v string) *ERPPartnerAddressUpdateOne {
+	_u.mutation.SetContact(v)
+	return _u
+}
+
+// SetNillableContact sets the "contact" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillableContact(v *string) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetContact(*v)
+	}
+	return _u
+}
+
+// ClearContact clears the value of the "contact" field.
+func (_u *ERPPartnerAddressUpdateOne) ClearContact() *ERPPartnerAddressUpdateOne {
+	_u.mutation.ClearContact()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *ERPPartnerAddressUpdateOne) SetPhone(v string) *ERPPartnerAddressUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillablePhone(v *string) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *ERPPartnerAddressUpdateOne) ClearPhone() *ERPPartnerAddressUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *ERPPartnerAddressUpdateOne) SetIsDefault(v bool) *ERPPartnerAddressUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *ERPPartnerAddressUpdateOne) SetNillableIsDefault(v *bool) *ERPPartnerAddressUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ERPPartnerAddressUpdateOne) SetUpdatedAt(v time.Time) *ERPPartnerAddressUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ERPPartnerAddressMutation object of the builder.
+func (_u *ERPPartnerAddressUpdateOne) Mutation() *ERPPartnerAddressMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ERPPartnerAddressUpdate builder.
+func (_u *ERPPartnerAddressUpdateOne) Where(ps ...predicate.ERPPartnerAddress) *ERPPartnerAddressUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ERPPartnerAddressUpdateOne) Select(field string, fields ...string) *ERPPartnerAddressUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ERPPartnerAddress entity.
+func (_u *ERPPartnerAddressUpdateOne) Save(ctx context.Context) (*ERPPartnerAddress, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPPartnerAddressUpdateOne) SaveX(ctx context.Context) *ERPPartnerAddress {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ERPPartnerAddressUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPPartnerAddressUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ERPPartnerAddressUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := erppartneraddress.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPPartnerAddressUpdateOne) check() error {
+	if v, ok := _u.mutation.PartnerID(); ok {
+		if err := erppartneraddress.PartnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "partner_id", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.partner_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SortOrder(); ok {
+		if err := erppartneraddress.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.sort_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := erppartneraddress.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.label": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressType(); ok {
+		if err := erppartneraddress.AddressTypeValidator(v); err != nil {
+			return &ValidationError{Name: "address_type", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.address_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PartyName(); ok {
+		if err := erppartneraddress.PartyNameValidator(v); err != nil {
+			return &ValidationError{Name: "party_name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.party_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Address(); ok {
+		if err := erppartneraddress.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := erppartneraddress.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Contact(); ok {
+		if err := erppartneraddress.ContactValidator(v); err != nil {
+			return &ValidationError{Name: "contact", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.contact": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phone(); ok {
+		if err := erppartneraddress.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerAddress.phone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPPartnerAddressUpdateOne) sqlSave(ctx context.Context) (_node *ERPPartnerAddress, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erppartneraddress.Table, erppartneraddress.Columns, sqlgraph.NewFieldSpec(erppartneraddress.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ERPPartnerAddress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, erppartneraddress.FieldID)
+		for _, f := range fields {
+			if !erppartneraddress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != erppartneraddress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PartnerID(); ok {
+		_spec.SetField(erppartneraddress.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartnerID(); ok {
+		_spec.AddField(erppartneraddress.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(erppartneraddress.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(erppartneraddress.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(erppartneraddress.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressType(); ok {
+		_spec.SetField(erppartneraddress.FieldAddressType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PartyName(); ok {
+		_spec.SetField(erppartneraddress.FieldPartyName, field.TypeString, value)
+	}
+	if _u.mutation.PartyNameCleared() {
+		_spec.ClearField(erppartneraddress.FieldPartyName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(erppartneraddress.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(erppartneraddress.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(erppartneraddress.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.Contact(); ok {
+		_spec.SetField(erppartneraddress.FieldContact, field.TypeString, value)
+	}
+	if _u.mutation.ContactCleared() {
+		_spec.ClearField(erppartneraddress.FieldContact, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(erppartneraddress.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(erppartneraddress.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(erppartneraddress.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(erppartneraddress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ERPPartnerAddress{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erppartneraddress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/erppartnerbankaccount"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ERPPartnerBankAccount is the model entity for the ERPPartnerBankAccount schema.
+type ERPPartnerBankAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PartnerID holds the value of the "partner_id" field.
+	PartnerID int `json:"partner_id,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder int `json:"sort_order,omitempty"`
+	// BankName holds the value of the "bank_name" field.
+	BankName string `json:"bank_name,omitempty"`
+	// AccountName holds the value of the "account_name" field.
+	AccountName *string `json:"account_name,omitempty"`
+	// AccountNo holds the value of the "account_no" field.
+	AccountNo string `json:"account_no,omitempty"`
+	// SwiftCode holds the value of the "swift_code" field.
+	SwiftCode *string `json:"swift_code,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency *string `json:"currency,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ERPPartnerBankAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case erppartnerbankaccount.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case erppartnerbankaccount.FieldID, erppartnerbankaccount.FieldPartnerID, erppartnerbankaccount.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case erppartnerbankaccount.FieldBankName, erppartnerbankaccount.FieldAccountName, erppartnerbankaccount.FieldAccountNo, erppartnerbankaccount.FieldSwiftCode, erppartnerbankaccount.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case erppartnerbankaccount.FieldCreatedAt, erppartnerbankaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ERPPartnerBankAccount fields.
+func (_m *ERPPartnerBankAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case erppartnerbankaccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case erppartnerbankaccount.FieldPartnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field partner_id", values[i])
+			} else if value.Valid {
+				_m.PartnerID = int(value.Int64)
+			}
+		case erppartnerbankaccount.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				_m.SortOrder = int(value.Int64)
+			}
+		case erppartnerbankaccount.FieldBankName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_name", values[i])
+			} else if value.Valid {
+				_m.BankName = value.String
+			}
+		case erppartnerbankaccount.FieldAccountName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_name", values[i])
+			} else if value.Valid {
+				_m.AccountName = new(string)
+				*_m.AccountName = value.String
+			}
+		case erppartnerbankaccount.FieldAccountNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_no", values[i])
+			} else if value.Valid {
+				_m.AccountNo = value.String
+			}
+		case erppartnerbankaccount.FieldSwiftCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field swift_code", values[i])
+			} else if value.Valid {
+				_m.SwiftCode = new(string)
+				*_m.SwiftCode = value.String
+			}
+		case erppartnerbankaccount.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = new(string)
+				*_m.Currency = value.String
+			}
+		case erppartnerbankaccount.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case erppartnerbankaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case erppartnerbankaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ERPPartnerBankAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *ERPPartnerBankAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ERPPartnerBankAccount.
+// Note that you need to call ERPPartnerBankAccount.Unwrap() before calling this method if this ERPPartnerBankAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ERPPartnerBankAccount) Update() *ERPPartnerBankAccountUpdateOne {
+	return NewERPPartnerBankAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ERPPartnerBankAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ERPPartnerBankAccount) Unwrap() *ERPPartnerBankAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ERPPartnerBankAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ERPPartnerBankAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("ERPPartnerBankAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("partner_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PartnerID))
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SortOrder))
+	builder.WriteString(", ")
+	builder.WriteString("bank_name=")
+	builder.WriteString(_m.BankName)
+	builder.WriteString(", ")
+	if v := _m.AccountName; v != nil {
+		builder.WriteString("account_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("account_no=")
+	builder.WriteString(_m.AccountNo)
+	builder.WriteString(", ")
+	if v := _m.SwiftCode; v != nil {
+		builder.WriteString("swift_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Currency; v != nil {
+		builder.WriteString("currency=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ERPPartnerBankAccounts is a parsable slice of ERPPartnerBankAccount.
+type ERPPartnerBankAccounts []*ERPPartnerBankAccount
@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package erppartnerbankaccount
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldID, id))
+}
+
+// PartnerID applies equality check predicate on the "partner_id" field. It's identical to PartnerIDEQ.
+func PartnerID(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldPartnerID, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// BankName applies equality check predicate on the "bank_name" field. It's identical to BankNameEQ.
+func BankName(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldBankName, v))
+}
+
+// AccountName applies equality check predicate on the "account_name" field. It's identical to AccountNameEQ.
+func AccountName(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldAccountName, v))
+}
+
+// AccountNo applies equality check predicate on the "account_no" field. It's identical to AccountNoEQ.
+func AccountNo(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldAccountNo, v))
+}
+
+// SwiftCode applies equality check predicate on the "swift_code" field. It's identical to SwiftCodeEQ.
+func SwiftCode(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldSwiftCode, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldCurrency, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PartnerIDEQ applies the EQ predicate on the "partner_id" field.
+func PartnerIDEQ(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldPartnerID, v))
+}
+
+// PartnerIDNEQ applies the NEQ predicate on the "partner_id" field.
+func PartnerIDNEQ(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldPartnerID, v))
+}
+
+// PartnerIDIn applies the In predicate on the "partner_id" field.
+func PartnerIDIn(vs ...int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldPartnerID, vs...))
+}
+
+// PartnerIDNotIn applies the NotIn predicate on the "partner_id" field.
+func PartnerIDNotIn(vs ...int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldPartnerID, vs...))
+}
+
+// PartnerIDGT applies the GT predicate on the "partner_id" field.
+func PartnerIDGT(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldPartnerID, v))
+}
+
+// PartnerIDGTE applies the GTE predicate on the "partner_id" field.
+func PartnerIDGTE(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldPartnerID, v))
+}
+
+// PartnerIDLT applies the LT predicate on the "partner_id" field.
+func PartnerIDLT(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldPartnerID, v))
+}
+
+// PartnerIDLTE applies the LTE predicate on the "partner_id" field.
+func PartnerIDLTE(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldPartnerID, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// BankNameEQ applies the EQ predicate on the "bank_name" field.
+func BankNameEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldBankName, v))
+}
+
+// BankNameNEQ applies the NEQ predicate on the "bank_name" field.
+func BankNameNEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldBankName, v))
+}
+
+// BankNameIn applies the In predicate on the "bank_name" field.
+func BankNameIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldBankName, vs...))
+}
+
+// BankNameNotIn applies the NotIn predicate on the "bank_name" field.
+func BankNameNotIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldBankName, vs...))
+}
+
+// BankNameGT applies the GT predicate on the "bank_name" field.
+func BankNameGT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldBankName, v))
+}
+
+// BankNameGTE applies the GTE predicate on the "bank_name" field.
+func BankNameGTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldBankName, v))
+}
+
+// BankNameLT applies the LT predicate on the "bank_name" field.
+func BankNameLT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldBankName, v))
+}
+
+// BankNameLTE applies the LTE predicate on the "bank_name" field.
+func BankNameLTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldBankName, v))
+}
+
+// BankNameContains applies the Contains predicate on the "bank_name" field.
+func BankNameContains(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContains(FieldBankName, v))
+}
+
+// BankNameHasPrefix applies the HasPrefix predicate on the "bank_name" field.
+func BankNameHasPrefix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasPrefix(FieldBankName, v))
+}
+
+// BankNameHasSuffix applies the HasSuffix predicate on the "bank_name" field.
+func BankNameHasSuffix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasSuffix(FieldBankName, v))
+}
+
+// BankNameEqualFold applies the EqualFold predicate on the "bank_name" field.
+func BankNameEqualFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEqualFold(FieldBankName, v))
+}
+
+// BankNameContainsFold applies the ContainsFold predicate on the "bank_name" field.
+func BankNameContainsFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContainsFold(FieldBankName, v))
+}
+
+// AccountNameEQ applies the EQ predicate on the "account_name" field.
+func AccountNameEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldAccountName, v))
+}
+
+// AccountNameNEQ applies the NEQ predicate on the "account_name" field.
+func AccountNameNEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldAccountName, v))
+}
+
+// AccountNameIn applies the In predicate on the "account_name" field.
+func AccountNameIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldAccountName, vs...))
+}
+
+// AccountNameNotIn applies the NotIn predicate on the "account_name" field.
+func AccountNameNotIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldAccountName, vs...))
+}
+
+// AccountNameGT applies the GT predicate on the "account_name" field.
+func AccountNameGT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldAccountName, v))
+}
+
+// AccountNameGTE applies the GTE predicate on the "account_name" field.
+func AccountNameGTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldAccountName, v))
+}
+
+// AccountNameLT applies the LT predicate on the "account_name" field.
+func AccountNameLT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldAccountName, v))
+}
+
+// AccountNameLTE applies the LTE predicate on the "account_name" field.
+func AccountNameLTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldAccountName, v))
+}
+
+// AccountNameContains applies the Contains predicate on the "account_name" field.
+func AccountNameContains(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContains(FieldAccountName, v))
+}
+
+// AccountNameHasPrefix applies the HasPrefix predicate on the "account_name" field.
+func AccountNameHasPrefix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasPrefix(FieldAccountName, v))
+}
+
+// AccountNameHasSuffix applies the HasSuffix predicate on the "account_name" field.
+func AccountNameHasSuffix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasSuffix(FieldAccountName, v))
+}
+
+// AccountNameIsNil applies the IsNil predicate on the "account_name" field.
+func AccountNameIsNil() predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIsNull(FieldAccountName))
+}
+
+// AccountNameNotNil applies the NotNil predicate on the "account_name" field.
+func AccountNameNotNil() predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotNull(FieldAccountName))
+}
+
+// AccountNameEqualFold applies the EqualFold predicate on the "account_name" field.
+func AccountNameEqualFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEqualFold(FieldAccountName, v))
+}
+
+// AccountNameContainsFold applies the ContainsFold predicate on the "account_name" field.
+func AccountNameContainsFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContainsFold(FieldAccountName, v))
+}
+
+// AccountNoEQ applies the EQ predicate on the "account_no" field.
+func AccountNoEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldAccountNo, v))
+}
+
+// AccountNoNEQ applies the NEQ predicate on the "account_no" field.
+func AccountNoNEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldAccountNo, v))
+}
+
+// AccountNoIn applies the In predicate on the "account_no" field.
+func AccountNoIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldAccountNo, vs...))
+}
+
+// AccountNoNotIn applies the NotIn predicate on the "account_no" field.
+func AccountNoNotIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldAccountNo, vs...))
+}
+
+// AccountNoGT applies the GT predicate on the "account_no" field.
+func AccountNoGT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldAccountNo, v))
+}
+
+// AccountNoGTE applies the GTE predicate on the "account_no" field.
+func AccountNoGTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldAccountNo, v))
+}
+
+// AccountNoLT applies the LT predicate on the "account_no" field.
+func AccountNoLT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldAccountNo, v))
+}
+
+// AccountNoLTE applies the LTE predicate on the "account_no" field.
+func AccountNoLTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldAccountNo, v))
+}
+
+// AccountNoContains applies the Contains predicate on the "account_no" field.
+func AccountNoContains(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContains(FieldAccountNo, v))
+}
+
+// AccountNoHasPrefix applies the HasPrefix predicate on the "account_no" field.
+func AccountNoHasPrefix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasPrefix(FieldAccountNo, v))
+}
+
+// AccountNoHasSuffix applies the HasSuffix predicate on the "account_no" field.
+func AccountNoHasSuffix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasSuffix(FieldAccountNo, v))
+}
+
+// AccountNoEqualFold applies the EqualFold predicate on the "account_no" field.
+func AccountNoEqualFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEqualFold(FieldAccountNo, v))
+}
+
+// AccountNoContainsFold applies the ContainsFold predicate on the "account_no" field.
+func AccountNoContainsFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContainsFold(FieldAccountNo, v))
+}
+
+// SwiftCodeEQ applies the EQ predicate on the "swift_code" field.
+func SwiftCodeEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldSwiftCode, v))
+}
+
+// SwiftCodeNEQ applies the NEQ predicate on the "swift_code" field.
+func SwiftCodeNEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldSwiftCode, v))
+}
+
+// SwiftCodeIn applies the In predicate on the "swift_code" field.
+func SwiftCodeIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldSwiftCode, vs...))
+}
+
+// SwiftCodeNotIn applies the NotIn predicate on the "swift_code" field.
+func SwiftCodeNotIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldSwiftCode, vs...))
+}
+
+// SwiftCodeGT applies the GT predicate on the "swift_code" field.
+func SwiftCodeGT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldSwiftCode, v))
+}
+
+// SwiftCodeGTE applies the GTE predicate on the "swift_code" field.
+func SwiftCodeGTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldSwiftCode, v))
+}
+
+// SwiftCodeLT applies the LT predicate on the "swift_code" field.
+func SwiftCodeLT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldSwiftCode, v))
+}
+
+// SwiftCodeLTE applies the LTE predicate on the "swift_code" field.
+func SwiftCodeLTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldSwiftCode, v))
+}
+
+// SwiftCodeContains applies the Contains predicate on the "swift_code" field.
+func SwiftCodeContains(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContains(FieldSwiftCode, v))
+}
+
+// SwiftCodeHasPrefix applies the HasPrefix predicate on the "swift_code" field.
+func SwiftCodeHasPrefix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasPrefix(FieldSwiftCode, v))
+}
+
+// SwiftCodeHasSuffix applies the HasSuffix predicate on the "swift_code" field.
+func SwiftCodeHasSuffix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasSuffix(FieldSwiftCode, v))
+}
+
+// SwiftCodeIsNil applies the IsNil predicate on the "swift_code" field.
+func SwiftCodeIsNil() predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIsNull(FieldSwiftCode))
+}
+
+// SwiftCodeNotNil applies the NotNil predicate on the "swift_code" field.
+func SwiftCodeNotNil() predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotNull(FieldSwiftCode))
+}
+
+// SwiftCodeEqualFold applies the EqualFold predicate on the "swift_code" field.
+func SwiftCodeEqualFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEqualFold(FieldSwiftCode, v))
+}
+
+// SwiftCodeContainsFold applies the ContainsFold predicate on the "swift_code" field.
+func SwiftCodeContainsFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContainsFold(FieldSwiftCode, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ERPPartnerBankAccount) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ERPPartnerBankAccount) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ERPPartnerBankAccount) predicate.ERPPartnerBankAccount {
+	return predicate.ERPPartnerBankAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erppartnerbankaccount"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPPartnerBankAccountCreate is the builder for creating a ERPPartnerBankAccount entity.
+type ERPPartnerBankAccountCreate struct {
+	config
+	mutation *ERPPartnerBankAccountMutation
+	hooks    []Hook
+}
+
+// SetPartnerID sets the "partner_id" field.
+func (_c *ERPPartnerBankAccountCreate) SetPartnerID(v int) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetPartnerID(v)
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *ERPPartnerBankAccountCreate) SetSortOrder(v int) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetBankName sets the "bank_name" field.
+func (_c *ERPPartnerBankAccountCreate) SetBankName(v string) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetBankName(v)
+	return _c
+}
+
+// SetAccountName sets the "account_name" field.
+func (_c *ERPPartnerBankAccountCreate) SetAccountName(v string) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetAccountName(v)
+	return _c
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (_c *ERPPartnerBankAccountCreate) SetNillableAccountName(v *string) *ERPPartnerBankAccountCreate {
+	if v != nil {
+		_c.SetAccountName(*v)
+	}
+	return _c
+}
+
+// SetAccountNo sets the "account_no" field.
+func (_c *ERPPartnerBankAccountCreate) SetAccountNo(v string) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetAccountNo(v)
+	return _c
+}
+
+// SetSwiftCode sets the "swift_code" field.
+func (_c *ERPPartnerBankAccountCreate) SetSwiftCode(v string) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetSwiftCode(v)
+	return _c
+}
+
+// SetNillableSwiftCode sets the "swift_code" field if the given value is not nil.
+func (_c *ERPPartnerBankAccountCreate) SetNillableSwiftCode(v *string) *ERPPartnerBankAccountCreate {
+	if v != nil {
+		_c.SetSwiftCode(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *ERPPartnerBankAccountCreate) SetCurrency(v string) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *ERPPartnerBankAccountCreate) SetNillableCurrency(v *string) *ERPPartnerBankAccountCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *ERPPartnerBankAccountCreate) SetIsDefault(v bool) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *ERPPartnerBankAccountCreate) SetNillableIsDefault(v *bool) *ERPPartnerBankAccountCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ERPPartnerBankAccountCreate) SetCreatedAt(v time.Time) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ERPPartnerBankAccountCreate) SetNillableCreatedAt(v *time.Time) *ERPPartnerBankAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ERPPartnerBankAccountCreate) SetUpdatedAt(v time.Time) *ERPPartnerBankAccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ERPPartnerBankAccountCreate) SetNillableUpdatedAt(v *time.Time) *ERPPartnerBankAccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ERPPartnerBankAccountMutation object of the builder.
+func (_c *ERPPartnerBankAccountCreate) Mutation() *ERPPartnerBankAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the ERPPartnerBankAccount in the database.
+func (_c *ERPPartnerBankAccountCreate) Save(ctx context.Context) (*ERPPartnerBankAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ERPPartnerBankAccountCreate) SaveX(ctx context.Context) *ERPPartnerBankAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPPartnerBankAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPPartnerBankAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ERPPartnerBankAccountCreate) defaults() {
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		v := erppartnerbankaccount.DefaultIsDefault
+		_c.mutation.SetIsDefault(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := erppartnerbankaccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := erppartnerbankaccount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ERPPartnerBankAccountCreate) check() error {
+	if _, ok := _c.mutation.PartnerID(); !ok {
+		return &ValidationError{Name: "partner_id", err: errors.New(`ent: missing required field "ERPPartnerBankAccount.partner_id"`)}
+	}
+	if v, ok := _c.mutation.PartnerID(); ok {
+		if err := erppartnerbankaccount.PartnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "partner_id", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.partner_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "ERPPartnerBankAccount.sort_order"`)}
+	}
+	if v, ok := _c.mutation.SortOrder(); ok {
+		if err := erppartnerbankaccount.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.sort_order": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BankName(); !ok {
+		return &ValidationError{Name: "bank_name", err: errors.New(`ent: missing required field "ERPPartnerBankAccount.bank_name"`)}
+	}
+	if v, ok := _c.mutation.BankName(); ok {
+		if err := erppartnerbankaccount.BankNameValidator(v); err != nil {
+			return &ValidationError{Name: "bank_name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.bank_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AccountName(); ok {
+		if err := erppartnerbankaccount.AccountNameValidator(v); err != nil {
+			return &ValidationError{Name: "account_name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.account_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountNo(); !ok {
+		return &ValidationError{Name: "account_no", err: errors.New(`ent: missing required field "ERPPartnerBankAccount.account_no"`)}
+	}
+	if v, ok := _c.mutation.AccountNo(); ok {
+		if err := erppartnerbankaccount.AccountNoValidator(v); err != nil {
+			return &ValidationError{Name: "account_no", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.account_no": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SwiftCode(); ok {
+		if err := erppartnerbankaccount.SwiftCodeValidator(v); err != nil {
+			return &ValidationError{Name: "swift_code", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.swift_code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := erppartnerbankaccount.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "ERPPartnerBankAccount.is_default"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ERPPartnerBankAccount.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ERPPartnerBankAccount.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ERPPartnerBankAccountCreate) sqlSave(ctx context.Context) (*ERPPartnerBankAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ERPPartnerBankAccountCreate) createSpec() (*ERPPartnerBankAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ERPPartnerBankAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(erppartnerbankaccount.Table, sqlgraph.NewFieldSpec(erppartnerbankaccount.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.PartnerID(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldPartnerID, field.TypeInt, value)
+		_node.PartnerID = value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	if value, ok := _c.mutation.BankName(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldBankName, field.TypeString, value)
+		_node.BankName = value
+	}
+	if value, ok := _c.mutation.AccountName(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldAccountName, field.TypeString, value)
+		_node.AccountName = &value
+	}
+	if value, ok := _c.mutation.AccountNo(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldAccountNo, field.TypeString, value)
+		_node.AccountNo = value
+	}
+	if value, ok := _c.mutation.SwiftCode(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldSwiftCode, field.TypeString, value)
+		_node.SwiftCode = &value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldCurrency, field.TypeString, value)
+		_node.Currency = &value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ERPPartnerBankAccountCreateBulk is the builder for creating many ERPPartnerBankAccount entities in bulk.
+type ERPPartnerBankAccountCreateBulk struct {
+	config
+	err      error
+	builders []*ERPPartnerBankAccountCreate
+}
+
+// Save creates the ERPPartnerBankAccount entities in the database.
+func (_c *ERPPartnerBankAccountCreateBulk) Save(ctx context.Context) ([]*ERPPartnerBankAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ERPPartnerBankAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ERPPartnerBankAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ERPPartnerBankAccountCreateBulk) SaveX(ctx context.Context) []*ERPPartnerBankAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPPartnerBankAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPPartnerBankAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
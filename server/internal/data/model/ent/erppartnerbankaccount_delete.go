@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"server/internal/data/model/ent/erppartnerbankaccount"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPPartnerBankAccountDelete is the builder for deleting a ERPPartnerBankAccount entity.
+type ERPPartnerBankAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *ERPPartnerBankAccountMutation
+}
+
+// Where appends a list predicates to the ERPPartnerBankAccountDelete builder.
+func (_d *ERPPartnerBankAccountDelete) Where(ps ...predicate.ERPPartnerBankAccount) *ERPPartnerBankAccountDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ERPPartnerBankAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ERPPartnerBankAccountDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ERPPartnerBankAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(erppartnerbankaccount.Table, sqlgraph.NewFieldSpec(erppartnerbankaccount.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ERPPartnerBankAccountDeleteOne is the builder for deleting a single ERPPartnerBankAccount entity.
+type ERPPartnerBankAccountDeleteOne struct {
+	_d *ERPPartnerBankAccountDelete
+}
+
+// Where appends a list predicates to the ERPPartnerBankAccountDelete builder.
+func (_d *ERPPartnerBankAccountDeleteOne) Where(ps ...predicate.ERPPartnerBankAccount) *ERPPartnerBankAccountDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ERPPartnerBankAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{erppartnerbankaccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ERPPartnerBankAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erppartnerbankaccount"
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPPartnerBankAccountUpdate is the builder for updating ERPPartnerBankAccount entities.
+type ERPPartnerBankAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ERPPartnerBankAccountMutation
+}
+
+// Where appends a list predicates to the ERPPartnerBankAccountUpdate builder.
+func (_u *ERPPartnerBankAccountUpdate) Where(ps ...predicate.ERPPartnerBankAccount) *ERPPartnerBankAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPartnerID sets the "partner_id" field.
+func (_u *ERPPartnerBankAccountUpdate) SetPartnerID(v int) *ERPPartnerBankAccountUpdate {
+	_u.mutation.ResetPartnerID()
+	_u.mutation.SetPartnerID(v)
+	return _u
+}
+
+// SetNillablePartnerID sets the "partner_id" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdate) SetNillablePartnerID(v *int) *ERPPartnerBankAccountUpdate {
+	if v != nil {
+		_u.SetPartnerID(*v)
+	}
+	return _u
+}
+
+// AddPartnerID adds value to the "partner_id" field.
+func (_u *ERPPartnerBankAccountUpdate) AddPartnerID(v int) *ERPPartnerBankAccountUpdate {
+	_u.mutation.AddPartnerID(v)
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ERPPartnerBankAccountUpdate) SetSortOrder(v int) *ERPPartnerBankAccountUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdate) SetNillableSortOrder(v *int) *ERPPartnerBankAccountUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ERPPartnerBankAccountUpdate) AddSortOrder(v int) *ERPPartnerBankAccountUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetBankName sets the "bank_name" field.
+func (_u *ERPPartnerBankAccountUpdate) SetBankName(v string) *ERPPartnerBankAccountUpdate {
+	_u.mutation.SetBankName(v)
+	return _u
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdate) SetNillableBankName(v *string) *ERPPartnerBankAccountUpdate {
+	if v != nil {
+		_u.SetBankName(*v)
+	}
+	return _u
+}
+
+// SetAccountName sets the "account_name" field.
+func (_u *ERPPartnerBankAccountUpdate) SetAccountName(v string) *ERPPartnerBankAccountUpdate {
+	_u.mutation.SetAccountName(v)
+	return _u
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdate) SetNillableAccountName(v *string) *ERPPartnerBankAccountUpdate {
+	if v != nil {
+		_u.SetAccountName(*v)
+	}
+	return _u
+}
+
+// ClearAccountName clears the value of the "account_name" field.
+func (_u *ERPPartnerBankAccountUpdate) ClearAccountName() *ERPPartnerBankAccountUpdate {
+	_u.mutation.ClearAccountName()
+	return _u
+}
+
+// SetAccountNo sets the "account_no" field.
+func (_u *ERPPartnerBankAccountUpdate) SetAccountNo(v string) *ERPPartnerBankAccountUpdate {
+	_u.mutation.SetAccountNo(v)
+	return _u
+}
+
+// SetNillableAccountNo sets the "account_no" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdate) SetNillableAccountNo(v *string) *ERPPartnerBankAccountUpdate {
+	if v != nil {
+		_u.SetAccountNo(*v)
+	}
+	return _u
+}
+
+// SetSwiftCode sets the "swift_code" field.
+func (_u *ERPPartnerBankAccountUpdate) SetSwiftCode(v string) *ERPPartnerBankAccountUpdate {
+	_u.mutation.SetSwiftCode(v)
+	return _u
+}
+
+// SetNillableSwiftCode sets the "swift_code" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdate) SetNillableSwiftCode(v *string) *ERPPartnerBankAccountUpdate {
+	if v != nil {
+		_u.SetSwiftCode(*v)
+	}
+	return _u
+}
+
+// ClearSwiftCode clears the value of the "swift_code" field.
+func (_u *ERPPartnerBankAccountUpdate) ClearSwiftCode() *ERPPartnerBankAccountUpdate {
+	_u.mutation.ClearSwiftCode()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *ERPPartnerBankAccountUpdate) SetCurrency(v string) *ERPPartnerBankAccountUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdate) SetNillableCurrency(v *string) *ERPPartnerBankAccountUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *ERPPartnerBankAccountUpdate) ClearCurrency() *ERPPartnerBankAccountUpdate {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *ERPPartnerBankAccountUpdate) SetIsDefault(v bool) *ERPPartnerBankAccountUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdate) SetNillableIsDefault(v *bool) *ERPPartnerBankAccountUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ERPPartnerBankAccountUpdate) SetUpdatedAt(v time.Time) *ERPPartnerBankAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ERPPartnerBankAccountMutation object of the builder.
+func (_u *ERPPartnerBankAccountUpdate) Mutation() *ERPPartnerBankAccountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ERPPartnerBankAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPPartnerBankAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ERPPartnerBankAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPPartnerBankAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ERPPartnerBankAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := erppartnerbankaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPPartnerBankAccountUpdate) check() error {
+	if v, ok := _u.mutation.PartnerID(); ok {
+		if err := erppartnerbankaccount.PartnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "partner_id", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.partner_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SortOrder(); ok {
+		if err := erppartnerbankaccount.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.sort_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BankName(); ok {
+		if err := erppartnerbankaccount.BankNameValidator(v); err != nil {
+			return &ValidationError{Name: "bank_name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.bank_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountName(); ok {
+		if err := erppartnerbankaccount.AccountNameValidator(v); err != nil {
+			return &ValidationError{Name: "account_name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.account_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountNo(); ok {
+		if err := erppartnerbankaccount.AccountNoValidator(v); err != nil {
+			return &ValidationError{Name: "account_no", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.account_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SwiftCode(); ok {
+		if err := erppartnerbankaccount.SwiftCodeValidator(v); err != nil {
+			return &ValidationError{Name: "swift_code", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.swift_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := erppartnerbankaccount.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.currency": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPPartnerBankAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erppartnerbankaccount.Table, erppartnerbankaccount.Columns, sqlgraph.NewFieldSpec(erppartnerbankaccount.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PartnerID(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartnerID(); ok {
+		_spec.AddField(erppartnerbankaccount.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(erppartnerbankaccount.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BankName(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldBankName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountName(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldAccountName, field.TypeString, value)
+	}
+	if _u.mutation.AccountNameCleared() {
+		_spec.ClearField(erppartnerbankaccount.FieldAccountName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountNo(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldAccountNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SwiftCode(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldSwiftCode, field.TypeString, value)
+	}
+	if _u.mutation.SwiftCodeCleared() {
+		_spec.ClearField(erppartnerbankaccount.FieldSwiftCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(erppartnerbankaccount.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erppartnerbankaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ERPPartnerBankAccountUpdateOne is the builder for updating a single ERPPartnerBankAccount entity.
+type ERPPartnerBankAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ERPPartnerBankAccountMutation
+}
+
+// SetPartnerID sets the "partner_id" field.
+func (_u *ERPPartnerBankAccountUpdateOne) SetPartnerID(v int) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.ResetPartnerID()
+	_u.mutation.SetPartnerID(v)
+	return _u
+}
+
+// SetNillablePartnerID sets the "partner_id" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdateOne) SetNillablePartnerID(v *int) *ERPPartnerBankAccountUpdateOne {
+	if v != nil {
+		_u.SetPartnerID(*v)
+	}
+	return _u
+}
+
+// AddPartnerID adds value to the "partner_id" field.
+func (_u *ERPPartnerBankAccountUpdateOne) AddPartnerID(v int) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.AddPartnerID(v)
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ERPPartnerBankAccountUpdateOne) SetSortOrder(v int) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdateOne) SetNillableSortOrder(v *int) *ERPPartnerBankAccountUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ERPPartnerBankAccountUpdateOne) AddSortOrder(v int) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetBankName sets the "bank_name" field.
+func (_u *ERPPartnerBankAccountUpdateOne) SetBankName(v string) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.SetBankName(v)
+	return _u
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdateOne) SetNillableBankName(v *string) *ERPPartnerBankAccountUpdateOne {
+	if v != nil {
+		_u.SetBankName(*v)
+	}
+	return _u
+}
+
+// SetAccountName sets the "account_name" field.
+func (_u *ERPPartnerBankAccountUpdateOne) SetAccountName(v string) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.SetAccountName(v)
+	return _u
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdateOne) SetNillableAccountName(v *string) *ERPPartnerBankAccountUpdateOne {
+	if v != nil {
+		_u.SetAccountName(*v)
+	}
+	return _u
+}
+
+// ClearAccountName clears the value of the "account_name" field.
+func (_u *ERPPartnerBankAccountUpdateOne) ClearAccountName() *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.ClearAccountName()
+	return _u
+}
+
+// SetAccountNo sets the "account_no" field.
+func (_u *ERPPartnerBankAccountUpdateOne) SetAccountNo(v string) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.SetAccountNo(v)
+	return _u
+}
+
+// SetNillableAccountNo sets the "account_no" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdateOne) SetNillableAccountNo(v *string) *ERPPartnerBankAccountUpdateOne {
+	if v != nil {
+		_u.SetAccountNo(*v)
+	}
+	return _u
+}
+
+// SetSwiftCode sets the "swift_code" field.
+func (_u *ERPPartnerBankAccountUpdateOne) SetSwiftCode(v string) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.SetSwiftCode(v)
+	return _u
+}
+
+// SetNillableSwiftCode sets the "swift_code" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdateOne) SetNillableSwiftCode(v *string) *ERPPartnerBankAccountUpdateOne {
+	if v != nil {
+		_u.SetSwiftCode(*v)
+	}
+	return _u
+}
+
+// ClearSwiftCode clears the value of the "swift_code" field.
+func (_u *ERPPartnerBankAccountUpdateOne) ClearSwiftCode() *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.ClearSwiftCode()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *ERPPartnerBankAccountUpdateOne) SetCurrency(v string) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdateOne) SetNillableCurrency(v *string) *ERPPartnerBankAccountUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *ERPPartnerBankAccountUpdateOne) ClearCurrency() *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *ERPPartnerBankAccountUpdateOne) SetIsDefault(v bool) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *ERPPartnerBankAccountUpdateOne) SetNillableIsDefault(v *bool) *ERPPartnerBankAccountUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ERPPartnerBankAccountUpdateOne) SetUpdatedAt(v time.Time) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ERPPartnerBankAccountMutation object of the builder.
+func (_u *ERPPartnerBankAccountUpdateOne) Mutation() *ERPPartnerBankAccountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ERPPartnerBankAccountUpdate builder.
+func (_u *ERPPartnerBankAccountUpdateOne) Where(ps ...predicate.ERPPartnerBankAccount) *ERPPartnerBankAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ERPPartnerBankAccountUpdateOne) Select(field string, fields ...string) *ERPPartnerBankAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ERPPartnerBankAccount entity.
+func (_u *ERPPartnerBankAccountUpdateOne) Save(ctx context.Context) (*ERPPartnerBankAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPPartnerBankAccountUpdateOne) SaveX(ctx context.Context) *ERPPartnerBankAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ERPPartnerBankAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPPartnerBankAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ERPPartnerBankAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := erppartnerbankaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPPartnerBankAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.PartnerID(); ok {
+		if err := erppartnerbankaccount.PartnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "partner_id", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.partner_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SortOrder(); ok {
+		if err := erppartnerbankaccount.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.sort_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BankName(); ok {
+		if err := erppartnerbankaccount.BankNameValidator(v); err != nil {
+			return &ValidationError{Name: "bank_name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.bank_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountName(); ok {
+		if err := erppartnerbankaccount.AccountNameValidator(v); err != nil {
+			return &ValidationError{Name: "account_name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.account_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountNo(); ok {
+		if err := erppartnerbankaccount.AccountNoValidator(v); err != nil {
+			return &ValidationError{Name: "account_no", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.account_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SwiftCode(); ok {
+		if err := erppartnerbankaccount.SwiftCodeValidator(v); err != nil {
+			return &ValidationError{Name: "swift_code", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.swift_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := erppartnerbankaccount.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerBankAccount.currency": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPPartnerBankAccountUpdateOne) sqlSave(ctx context.Context) (_node *ERPPartnerBankAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erppartnerbankaccount.Table, erppartnerbankaccount.Columns, sqlgraph.NewFieldSpec(erppartnerbankaccount.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ERPPartnerBankAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, erppartnerbankaccount.FieldID)
+		for _, f := range fields {
+			if !erppartnerbankaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != erppartnerbankaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PartnerID(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartnerID(); ok {
+		_spec.AddField(erppartnerbankaccount.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(erppartnerbankaccount.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BankName(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldBankName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountName(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldAccountName, field.TypeString, value)
+	}
+	if _u.mutation.AccountNameCleared() {
+		_spec.ClearField(erppartnerbankaccount.FieldAccountName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountNo(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldAccountNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SwiftCode(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldSwiftCode, field.TypeString, value)
+	}
+	if _u.mutation.SwiftCodeCleared() {
+		_spec.ClearField(erppartnerbankaccount.FieldSwiftCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(erppartnerbankaccount.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(erppartnerbankaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ERPPartnerBankAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erppartnerbankaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
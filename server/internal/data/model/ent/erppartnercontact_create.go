@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erppartnercontact"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPPartnerContactCreate is the builder for creating a ERPPartnerContact entity.
+type ERPPartnerContactCreate struct {
+	config
+	mutation *ERPPartnerContactMutation
+	hooks    []Hook
+}
+
+// SetPartnerID sets the "partner_id" field.
+func (_c *ERPPartnerContactCreate) SetPartnerID(v int) *ERPPartnerContactCreate {
+	_c.mutation.SetPartnerID(v)
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *ERPPartnerContactCreate) SetSortOrder(v int) *ERPPartnerContactCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ERPPartnerContactCreate) SetName(v string) *ERPPartnerContactCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *ERPPartnerContactCreate) SetRole(v string) *ERPPartnerContactCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *ERPPartnerContactCreate) SetNillableRole(v *string) *ERPPartnerContactCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *ERPPartnerContactCreate) SetPhone(v string) *ERPPartnerContactCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *ERPPartnerContactCreate) SetNillablePhone(v *string) *ERPPartnerContactCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *ERPPartnerContactCreate) SetEmail(v string) *ERPPartnerContactCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *ERPPartnerContactCreate) SetNillableEmail(v *string) *ERPPartnerContactCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *ERPPartnerContactCreate) SetIsDefault(v bool) *ERPPartnerContactCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *ERPPartnerContactCreate) SetNillableIsDefault(v *bool) *ERPPartnerContactCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ERPPartnerContactCreate) SetCreatedAt(v time.Time) *ERPPartnerContactCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ERPPartnerContactCreate) SetNillableCreatedAt(v *time.Time) *ERPPartnerContactCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ERPPartnerContactCreate) SetUpdatedAt(v time.Time) *ERPPartnerContactCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ERPPartnerContactCreate) SetNillableUpdatedAt(v *time.Time) *ERPPartnerContactCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ERPPartnerContactMutation object of the builder.
+func (_c *ERPPartnerContactCreate) Mutation() *ERPPartnerContactMutation {
+	return _c.mutation
+}
+
+// Save creates the ERPPartnerContact in the database.
+func (_c *ERPPartnerContactCreate) Save(ctx context.Context) (*ERPPartnerContact, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ERPPartnerContactCreate) SaveX(ctx context.Context) *ERPPartnerContact {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPPartnerContactCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPPartnerContactCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ERPPartnerContactCreate) defaults() {
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		v := erppartnercontact.DefaultIsDefault
+		_c.mutation.SetIsDefault(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := erppartnercontact.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := erppartnercontact.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ERPPartnerContactCreate) check() error {
+	if _, ok := _c.mutation.PartnerID(); !ok {
+		return &ValidationError{Name: "partner_id", err: errors.New(`ent: missing required field "ERPPartnerContact.partner_id"`)}
+	}
+	if v, ok := _c.mutation.PartnerID(); ok {
+		if err := erppartnercontact.PartnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "partner_id", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.partner_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "ERPPartnerContact.sort_order"`)}
+	}
+	if v, ok := _c.mutation.SortOrder(); ok {
+		if err := erppartnercontact.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.sort_order": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ERPPartnerContact.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := erppartnercontact.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := erppartnercontact.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.role": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Phone(); ok {
+		if err := erppartnercontact.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.phone": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := erppartnercontact.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "ERPPartnerContact.is_default"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ERPPartnerContact.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ERPPartnerContact.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ERPPartnerContactCreate) sqlSave(ctx context.Context) (*ERPPartnerContact, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ERPPartnerContactCreate) createSpec() (*ERPPartnerContact, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ERPPartnerContact{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(erppartnercontact.Table, sqlgraph.NewFieldSpec(erppartnercontact.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.PartnerID(); ok {
+		_spec.SetField(erppartnercontact.FieldPartnerID, field.TypeInt, value)
+		_node.PartnerID = value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(erppartnercontact.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(erppartnercontact.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(erppartnercontact.FieldRole, field.TypeString, value)
+		_node.Role = &value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(erppartnercontact.FieldPhone, field.TypeString, value)
+		_node.Phone = &value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(erppartnercontact.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(erppartnercontact.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(erppartnercontact.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(erppartnercontact.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ERPPartnerContactCreateBulk is the builder for creating many ERPPartnerContact entities in bulk.
+type ERPPartnerContactCreateBulk struct {
+	config
+	err      error
+	builders []*ERPPartnerContactCreate
+}
+
+// Save creates the ERPPartnerContact entities in the database.
+func (_c *ERPPartnerContactCreateBulk) Save(ctx context.Context) ([]*ERPPartnerContact, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ERPPartnerContact, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ERPPartnerContactMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ERPPartnerContactCreateBulk) SaveX(ctx context.Context) []*ERPPartnerContact {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPPartnerContactCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPPartnerContactCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"server/internal/data/model/ent/erppartnercontact"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPPartnerContactDelete is the builder for deleting a ERPPartnerContact entity.
+type ERPPartnerContactDelete struct {
+	config
+	hooks    []Hook
+	mutation *ERPPartnerContactMutation
+}
+
+// Where appends a list predicates to the ERPPartnerContactDelete builder.
+func (_d *ERPPartnerContactDelete) Where(ps ...predicate.ERPPartnerContact) *ERPPartnerContactDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ERPPartnerContactDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ERPPartnerContactDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ERPPartnerContactDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(erppartnercontact.Table, sqlgraph.NewFieldSpec(erppartnercontact.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ERPPartnerContactDeleteOne is the builder for deleting a single ERPPartnerContact entity.
+type ERPPartnerContactDeleteOne struct {
+	_d *ERPPartnerContactDelete
+}
+
+// Where appends a list predicates to the ERPPartnerContactDelete builder.
+func (_d *ERPPartnerContactDeleteOne) Where(ps ...predicate.ERPPartnerContact) *ERPPartnerContactDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ERPPartnerContactDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{erppartnercontact.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ERPPartnerContactDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
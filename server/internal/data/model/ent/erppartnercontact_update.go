@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erppartnercontact"
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPPartnerContactUpdate is the builder for updating ERPPartnerContact entities.
+type ERPPartnerContactUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ERPPartnerContactMutation
+}
+
+// Where appends a list predicates to the ERPPartnerContactUpdate builder.
+func (_u *ERPPartnerContactUpdate) Where(ps ...predicate.ERPPartnerContact) *ERPPartnerContactUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPartnerID sets the "partner_id" field.
+func (_u *ERPPartnerContactUpdate) SetPartnerID(v int) *ERPPartnerContactUpdate {
+	_u.mutation.ResetPartnerID()
+	_u.mutation.SetPartnerID(v)
+	return _u
+}
+
+// SetNillablePartnerID sets the "partner_id" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdate) SetNillablePartnerID(v *int) *ERPPartnerContactUpdate {
+	if v != nil {
+		_u.SetPartnerID(*v)
+	}
+	return _u
+}
+
+// AddPartnerID adds value to the "partner_id" field.
+func (_u *ERPPartnerContactUpdate) AddPartnerID(v int) *ERPPartnerContactUpdate {
+	_u.mutation.AddPartnerID(v)
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ERPPartnerContactUpdate) SetSortOrder(v int) *ERPPartnerContactUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdate) SetNillableSortOrder(v *int) *ERPPartnerContactUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ERPPartnerContactUpdate) AddSortOrder(v int) *ERPPartnerContactUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ERPPartnerContactUpdate) SetName(v string) *ERPPartnerContactUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdate) SetNillableName(v *string) *ERPPartnerContactUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *ERPPartnerContactUpdate) SetRole(v string) *ERPPartnerContactUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdate) SetNillableRole(v *string) *ERPPartnerContactUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *ERPPartnerContactUpdate) ClearRole() *ERPPartnerContactUpdate {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *ERPPartnerContactUpdate) SetPhone(v string) *ERPPartnerContactUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdate) SetNillablePhone(v *string) *ERPPartnerContactUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *ERPPartnerContactUpdate) ClearPhone() *ERPPartnerContactUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *ERPPartnerContactUpdate) SetEmail(v string) *ERPPartnerContactUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdate) SetNillableEmail(v *string) *ERPPartnerContactUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *ERPPartnerContactUpdate) ClearEmail() *ERPPartnerContactUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *ERPPartnerContactUpdate) SetIsDefault(v bool) *ERPPartnerContactUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdate) SetNillableIsDefault(v *bool) *ERPPartnerContactUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ERPPartnerContactUpdate) SetUpdatedAt(v time.Time) *ERPPartnerContactUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ERPPartnerContactMutation object of the builder.
+func (_u *ERPPartnerContactUpdate) Mutation() *ERPPartnerContactMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ERPPartnerContactUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPPartnerContactUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ERPPartnerContactUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPPartnerContactUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ERPPartnerContactUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := erppartnercontact.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPPartnerContactUpdate) check() error {
+	if v, ok := _u.mutation.PartnerID(); ok {
+		if err := erppartnercontact.PartnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "partner_id", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.partner_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SortOrder(); ok {
+		if err := erppartnercontact.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.sort_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := erppartnercontact.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := erppartnercontact.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phone(); ok {
+		if err := erppartnercontact.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.phone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := erppartnercontact.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPPartnerContactUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erppartnercontact.Table, erppartnercontact.Columns, sqlgraph.NewFieldSpec(erppartnercontact.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PartnerID(); ok {
+		_spec.SetField(erppartnercontact.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartnerID(); ok {
+		_spec.AddField(erppartnercontact.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(erppartnercontact.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(erppartnercontact.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(erppartnercontact.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(erppartnercontact.FieldRole, field.TypeString, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(erppartnercontact.FieldRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(erppartnercontact.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(erppartnercontact.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(erppartnercontact.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(erppartnercontact.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(erppartnercontact.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(erppartnercontact.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erppartnercontact.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ERPPartnerContactUpdateOne is the builder for updating a single ERPPartnerContact entity.
+type ERPPartnerContactUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ERPPartnerContactMutation
+}
+
+// SetPartnerID sets the "partner_id" field.
+func (_u *ERPPartnerContactUpdateOne) SetPartnerID(v int) *ERPPartnerContactUpdateOne {
+	_u.mutation.ResetPartnerID()
+	_u.mutation.SetPartnerID(v)
+	return _u
+}
+
+// SetNillablePartnerID sets the "partner_id" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdateOne) SetNillablePartnerID(v *int) *ERPPartnerContactUpdateOne {
+	if v != nil {
+		_u.SetPartnerID(*v)
+	}
+	return _u
+}
+
+// AddPartnerID adds value to the "partner_id" field.
+func (_u *ERPPartnerContactUpdateOne) AddPartnerID(v int) *ERPPartnerContactUpdateOne {
+	_u.mutation.AddPartnerID(v)
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ERPPartnerContactUpdateOne) SetSortOrder(v int) *ERPPartnerContactUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdateOne) SetNillableSortOrder(v *int) *ERPPartnerContactUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ERPPartnerContactUpdateOne) AddSortOrder(v int) *ERPPartnerContactUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ERPPartnerContactUpdateOne) SetName(v string) *ERPPartnerContactUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdateOne) SetNillableName(v *string) *ERPPartnerContactUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *ERPPartnerContactUpdateOne) SetRole(v string) *ERPPartnerContactUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdateOne) SetNillableRole(v *string) *ERPPartnerContactUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *ERPPartnerContactUpdateOne) ClearRole() *ERPPartnerContactUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *ERPPartnerContactUpdateOne) SetPhone(v string) *ERPPartnerContactUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdateOne) SetNillablePhone(v *string) *ERPPartnerContactUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *ERPPartnerContactUpdateOne) ClearPhone() *ERPPartnerContactUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *ERPPartnerContactUpdateOne) SetEmail(v string) *ERPPartnerContactUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdateOne) SetNillableEmail(v *string) *ERPPartnerContactUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *ERPPartnerContactUpdateOne) ClearEmail() *ERPPartnerContactUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *ERPPartnerContactUpdateOne) SetIsDefault(v bool) *ERPPartnerContactUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *ERPPartnerContactUpdateOne) SetNillableIsDefault(v *bool) *ERPPartnerContactUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ERPPartnerContactUpdateOne) SetUpdatedAt(v time.Time) *ERPPartnerContactUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ERPPartnerContactMutation object of the builder.
+func (_u *ERPPartnerContactUpdateOne) Mutation() *ERPPartnerContactMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ERPPartnerContactUpdate builder.
+func (_u *ERPPartnerContactUpdateOne) Where(ps ...predicate.ERPPartnerContact) *ERPPartnerContactUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ERPPartnerContactUpdateOne) Select(field string, fields ...string) *ERPPartnerContactUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ERPPartnerContact entity.
+func (_u *ERPPartnerContactUpdateOne) Save(ctx context.Context) (*ERPPartnerContact, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPPartnerContactUpdateOne) SaveX(ctx context.Context) *ERPPartnerContact {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ERPPartnerContactUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPPartnerContactUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ERPPartnerContactUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := erppartnercontact.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPPartnerContactUpdateOne) check() error {
+	if v, ok := _u.mutation.PartnerID(); ok {
+		if err := erppartnercontact.PartnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "partner_id", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.partner_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SortOrder(); ok {
+		if err := erppartnercontact.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.sort_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := erppartnercontact.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := erppartnercontact.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phone(); ok {
+		if err := erppartnercontact.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.phone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := erppartnercontact.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "ERPPartnerContact.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPPartnerContactUpdateOne) sqlSave(ctx context.Context) (_node *ERPPartnerContact, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erppartnercontact.Table, erppartnercontact.Columns, sqlgraph.NewFieldSpec(erppartnercontact.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ERPPartnerContact.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, erppartnercontact.FieldID)
+		for _, f := range fields {
+			if !erppartnercontact.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != erppartnercontact.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PartnerID(); ok {
+		_spec.SetField(erppartnercontact.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartnerID(); ok {
+		_spec.AddField(erppartnercontact.FieldPartnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(erppartnercontact.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(erppartnercontact.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(erppartnercontact.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(erppartnercontact.FieldRole, field.TypeString, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(erppartnercontact.FieldRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(erppartnercontact.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(erppartnercontact.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(erppartnercontact.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(erppartnercontact.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(erppartnercontact.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(erppartnercontact.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ERPPartnerContact{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erppartnercontact.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/erprecordrevision"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ERPRecordRevision is the model entity for the ERPRecordRevision schema.
+type ERPRecordRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ModuleKey holds the value of the "module_key" field.
+	ModuleKey string `json:"module_key,omitempty"`
+	// RecordID holds the value of the "record_id" field.
+	RecordID int `json:"record_id,omitempty"`
+	// 版本号，从 1 开始；当前内容的版本号为最大版本号 + 1
+	Revision int `json:"revision,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload string `json:"payload,omitempty"`
+	// 保存该版本的管理员
+	SavedByAdminID *int `json:"saved_by_admin_id,omitempty"`
+	// 该版本的保存时间
+	SavedAt time.Time `json:"saved_at,omitempty"`
+	// 被新版本替换的时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ERPRecordRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case erprecordrevision.FieldID, erprecordrevision.FieldRecordID, erprecordrevision.FieldRevision, erprecordrevision.FieldSavedByAdminID:
+			values[i] = new(sql.NullInt64)
+		case erprecordrevision.FieldModuleKey, erprecordrevision.FieldCode, erprecordrevision.FieldPayload:
+			values[i] = new(sql.NullString)
+		case erprecordrevision.FieldSavedAt, erprecordrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ERPRecordRevision fields.
+func (_m *ERPRecordRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case erprecordrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case erprecordrevision.FieldModuleKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field module_key", values[i])
+			} else if value.Valid {
+				_m.ModuleKey = value.String
+			}
+		case erprecordrevision.FieldRecordID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field record_id", values[i])
+			} else if value.Valid {
+				_m.RecordID = int(value.Int64)
+			}
+		case erprecordrevision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case erprecordrevision.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case erprecordrevision.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				_m.Payload = value.String
+			}
+		case erprecordrevision.FieldSavedByAdminID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_by_admin_id", values[i])
+			} else if value.Valid {
+				_m.SavedByAdminID = new(int)
+				*_m.SavedByAdminID = int(value.Int64)
+			}
+		case erprecordrevision.FieldSavedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_at", values[i])
+			} else if value.Valid {
+				_m.SavedAt = value.Time
+			}
+		case erprecordrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ERPRecordRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *ERPRecordRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ERPRecordRevision.
+// Note that you need to call ERPRecordRevision.Unwrap() before calling this method if this ERPRecordRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ERPRecordRevision) Update() *ERPRecordRevisionUpdateOne {
+	return NewERPRecordRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ERPRecordRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ERPRecordRevision) Unwrap() *ERPRecordRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ERPRecordRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ERPRecordRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ERPRecordRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("module_key=")
+	builder.WriteString(_m.ModuleKey)
+	builder.WriteString(", ")
+	builder.WriteString("record_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecordID))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(_m.Payload)
+	builder.WriteString(", ")
+	if v := _m.SavedByAdminID; v != nil {
+		builder.WriteString("saved_by_admin_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("saved_at=")
+	builder.WriteString(_m.SavedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ERPRecordRevisions is a parsable slice of ERPRecordRevision.
+type ERPRecordRevisions []*ERPRecordRevision
@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package erprecordrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the erprecordrevision type in the database.
+	Label = "erp_record_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldModuleKey holds the string denoting the module_key field in the database.
+	FieldModuleKey = "module_key"
+	// FieldRecordID holds the string denoting the record_id field in the database.
+	FieldRecordID = "record_id"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldSavedByAdminID holds the string denoting the saved_by_admin_id field in the database.
+	FieldSavedByAdminID = "saved_by_admin_id"
+	// FieldSavedAt holds the string denoting the saved_at field in the database.
+	FieldSavedAt = "saved_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the erprecordrevision in the database.
+	Table = "erp_record_revisions"
+)
+
+// Columns holds all SQL columns for erprecordrevision fields.
+var Columns = []string{
+	FieldID,
+	FieldModuleKey,
+	FieldRecordID,
+	FieldRevision,
+	FieldCode,
+	FieldPayload,
+	FieldSavedByAdminID,
+	FieldSavedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ModuleKeyValidator is a validator for the "module_key" field. It is called by the builders before save.
+	ModuleKeyValidator func(string) error
+	// RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	RevisionValidator func(int) error
+	// DefaultCode holds the default value on creation for the "code" field.
+	DefaultCode string
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ERPRecordRevision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByModuleKey orders the results by the module_key field.
+func ByModuleKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModuleKey, opts...).ToFunc()
+}
+
+// ByRecordID orders the results by the record_id field.
+func ByRecordID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecordID, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByPayload orders the results by the payload field.
+func ByPayload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayload, opts...).ToFunc()
+}
+
+// BySavedByAdminID orders the results by the saved_by_admin_id field.
+func BySavedByAdminID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSavedByAdminID, opts...).ToFunc()
+}
+
+// BySavedAt orders the results by the saved_at field.
+func BySavedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSavedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
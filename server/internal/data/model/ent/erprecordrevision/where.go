@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package erprecordrevision
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLTE(FieldID, id))
+}
+
+// ModuleKey applies equality check predicate on the "module_key" field. It's identical to ModuleKeyEQ.
+func ModuleKey(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldModuleKey, v))
+}
+
+// RecordID applies equality check predicate on the "record_id" field. It's identical to RecordIDEQ.
+func RecordID(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldRecordID, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldCode, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldPayload, v))
+}
+
+// SavedByAdminID applies equality check predicate on the "saved_by_admin_id" field. It's identical to SavedByAdminIDEQ.
+func SavedByAdminID(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldSavedByAdminID, v))
+}
+
+// SavedAt applies equality check predicate on the "saved_at" field. It's identical to SavedAtEQ.
+func SavedAt(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldSavedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModuleKeyEQ applies the EQ predicate on the "module_key" field.
+func ModuleKeyEQ(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldModuleKey, v))
+}
+
+// ModuleKeyNEQ applies the NEQ predicate on the "module_key" field.
+func ModuleKeyNEQ(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNEQ(FieldModuleKey, v))
+}
+
+// ModuleKeyIn applies the In predicate on the "module_key" field.
+func ModuleKeyIn(vs ...string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIn(FieldModuleKey, vs...))
+}
+
+// ModuleKeyNotIn applies the NotIn predicate on the "module_key" field.
+func ModuleKeyNotIn(vs ...string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotIn(FieldModuleKey, vs...))
+}
+
+// ModuleKeyGT applies the GT predicate on the "module_key" field.
+func ModuleKeyGT(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGT(FieldModuleKey, v))
+}
+
+// ModuleKeyGTE applies the GTE predicate on the "module_key" field.
+func ModuleKeyGTE(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGTE(FieldModuleKey, v))
+}
+
+// ModuleKeyLT applies the LT predicate on the "module_key" field.
+func ModuleKeyLT(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLT(FieldModuleKey, v))
+}
+
+// ModuleKeyLTE applies the LTE predicate on the "module_key" field.
+func ModuleKeyLTE(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLTE(FieldModuleKey, v))
+}
+
+// ModuleKeyContains applies the Contains predicate on the "module_key" field.
+func ModuleKeyContains(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldContains(FieldModuleKey, v))
+}
+
+// ModuleKeyHasPrefix applies the HasPrefix predicate on the "module_key" field.
+func ModuleKeyHasPrefix(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldHasPrefix(FieldModuleKey, v))
+}
+
+// ModuleKeyHasSuffix applies the HasSuffix predicate on the "module_key" field.
+func ModuleKeyHasSuffix(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldHasSuffix(FieldModuleKey, v))
+}
+
+// ModuleKeyEqualFold applies the EqualFold predicate on the "module_key" field.
+func ModuleKeyEqualFold(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEqualFold(FieldModuleKey, v))
+}
+
+// ModuleKeyContainsFold applies the ContainsFold predicate on the "module_key" field.
+func ModuleKeyContainsFold(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldContainsFold(FieldModuleKey, v))
+}
+
+// RecordIDEQ applies the EQ predicate on the "record_id" field.
+func RecordIDEQ(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldRecordID, v))
+}
+
+// RecordIDNEQ applies the NEQ predicate on the "record_id" field.
+func RecordIDNEQ(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNEQ(FieldRecordID, v))
+}
+
+// RecordIDIn applies the In predicate on the "record_id" field.
+func RecordIDIn(vs ...int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIn(FieldRecordID, vs...))
+}
+
+// RecordIDNotIn applies the NotIn predicate on the "record_id" field.
+func RecordIDNotIn(vs ...int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotIn(FieldRecordID, vs...))
+}
+
+// RecordIDGT applies the GT predicate on the "record_id" field.
+func RecordIDGT(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGT(FieldRecordID, v))
+}
+
+// RecordIDGTE applies the GTE predicate on the "record_id" field.
+func RecordIDGTE(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGTE(FieldRecordID, v))
+}
+
+// RecordIDLT applies the LT predicate on the "record_id" field.
+func RecordIDLT(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLT(FieldRecordID, v))
+}
+
+// RecordIDLTE applies the LTE predicate on the "record_id" field.
+func RecordIDLTE(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLTE(FieldRecordID, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLTE(FieldRevision, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldContainsFold(FieldCode, v))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLTE(FieldPayload, v))
+}
+
+// PayloadContains applies the Contains predicate on the "payload" field.
+func PayloadContains(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldContains(FieldPayload, v))
+}
+
+// PayloadHasPrefix applies the HasPrefix predicate on the "payload" field.
+func PayloadHasPrefix(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldHasPrefix(FieldPayload, v))
+}
+
+// PayloadHasSuffix applies the HasSuffix predicate on the "payload" field.
+func PayloadHasSuffix(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldHasSuffix(FieldPayload, v))
+}
+
+// PayloadEqualFold applies the EqualFold predicate on the "payload" field.
+func PayloadEqualFold(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEqualFold(FieldPayload, v))
+}
+
+// PayloadContainsFold applies the ContainsFold predicate on the "payload" field.
+func PayloadContainsFold(v string) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldContainsFold(FieldPayload, v))
+}
+
+// SavedByAdminIDEQ applies the EQ predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDEQ(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldSavedByAdminID, v))
+}
+
+// SavedByAdminIDNEQ applies the NEQ predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDNEQ(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNEQ(FieldSavedByAdminID, v))
+}
+
+// SavedByAdminIDIn applies the In predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDIn(vs ...int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIn(FieldSavedByAdminID, vs...))
+}
+
+// SavedByAdminIDNotIn applies the NotIn predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDNotIn(vs ...int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotIn(FieldSavedByAdminID, vs...))
+}
+
+// SavedByAdminIDGT applies the GT predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDGT(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGT(FieldSavedByAdminID, v))
+}
+
+// SavedByAdminIDGTE applies the GTE predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDGTE(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGTE(FieldSavedByAdminID, v))
+}
+
+// SavedByAdminIDLT applies the LT predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDLT(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLT(FieldSavedByAdminID, v))
+}
+
+// SavedByAdminIDLTE applies the LTE predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDLTE(v int) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLTE(FieldSavedByAdminID, v))
+}
+
+// SavedByAdminIDIsNil applies the IsNil predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDIsNil() predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIsNull(FieldSavedByAdminID))
+}
+
+// SavedByAdminIDNotNil applies the NotNil predicate on the "saved_by_admin_id" field.
+func SavedByAdminIDNotNil() predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotNull(FieldSavedByAdminID))
+}
+
+// SavedAtEQ applies the EQ predicate on the "saved_at" field.
+func SavedAtEQ(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldSavedAt, v))
+}
+
+// SavedAtNEQ applies the NEQ predicate on the "saved_at" field.
+func SavedAtNEQ(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNEQ(FieldSavedAt, v))
+}
+
+// SavedAtIn applies the In predicate on the "saved_at" field.
+func SavedAtIn(vs ...time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIn(FieldSavedAt, vs...))
+}
+
+// SavedAtNotIn applies the NotIn predicate on the "saved_at" field.
+func SavedAtNotIn(vs ...time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotIn(FieldSavedAt, vs...))
+}
+
+// SavedAtGT applies the GT predicate on the "saved_at" field.
+func SavedAtGT(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGT(FieldSavedAt, v))
+}
+
+// SavedAtGTE applies the GTE predicate on the "saved_at" field.
+func SavedAtGTE(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGTE(FieldSavedAt, v))
+}
+
+// SavedAtLT applies the LT predicate on the "saved_at" field.
+func SavedAtLT(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLT(FieldSavedAt, v))
+}
+
+// SavedAtLTE applies the LTE predicate on the "saved_at" field.
+func SavedAtLTE(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLTE(FieldSavedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ERPRecordRevision) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ERPRecordRevision) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ERPRecordRevision) predicate.ERPRecordRevision {
+	return predicate.ERPRecordRevision(sql.NotPredicates(p))
+}
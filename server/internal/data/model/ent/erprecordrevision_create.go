@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erprecordrevision"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPRecordRevisionCreate is the builder for creating a ERPRecordRevision entity.
+type ERPRecordRevisionCreate struct {
+	config
+	mutation *ERPRecordRevisionMutation
+	hooks    []Hook
+}
+
+// SetModuleKey sets the "module_key" field.
+func (_c *ERPRecordRevisionCreate) SetModuleKey(v string) *ERPRecordRevisionCreate {
+	_c.mutation.SetModuleKey(v)
+	return _c
+}
+
+// SetRecordID sets the "record_id" field.
+func (_c *ERPRecordRevisionCreate) SetRecordID(v int) *ERPRecordRevisionCreate {
+	_c.mutation.SetRecordID(v)
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *ERPRecordRevisionCreate) SetRevision(v int) *ERPRecordRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *ERPRecordRevisionCreate) SetCode(v string) *ERPRecordRevisionCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_c *ERPRecordRevisionCreate) SetNillableCode(v *string) *ERPRecordRevisionCreate {
+	if v != nil {
+		_c.SetCode(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *ERPRecordRevisionCreate) SetPayload(v string) *ERPRecordRevisionCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetSavedByAdminID sets the "saved_by_admin_id" field.
+func (_c *ERPRecordRevisionCreate) SetSavedByAdminID(v int) *ERPRecordRevisionCreate {
+	_c.mutation.SetSavedByAdminID(v)
+	return _c
+}
+
+// SetNillableSavedByAdminID sets the "saved_by_admin_id" field if the given value is not nil.
+func (_c *ERPRecordRevisionCreate) SetNillableSavedByAdminID(v *int) *ERPRecordRevisionCreate {
+	if v != nil {
+		_c.SetSavedByAdminID(*v)
+	}
+	return _c
+}
+
+// SetSavedAt sets the "saved_at" field.
+func (_c *ERPRecordRevisionCreate) SetSavedAt(v time.Time) *ERPRecordRevisionCreate {
+	_c.mutation.SetSavedAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ERPRecordRevisionCreate) SetCreatedAt(v time.Time) *ERPRecordRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ERPRecordRevisionCreate) SetNillableCreatedAt(v *time.Time) *ERPRecordRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ERPRecordRevisionMutation object of the builder.
+func (_c *ERPRecordRevisionCreate) Mutation() *ERPRecordRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the ERPRecordRevision in the database.
+func (_c *ERPRecordRevisionCreate) Save(ctx context.Context) (*ERPRecordRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ERPRecordRevisionCreate) SaveX(ctx context.Context) *ERPRecordRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPRecordRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPRecordRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ERPRecordRevisionCreate) defaults() {
+	if _, ok := _c.mutation.Code(); !ok {
+		v := erprecordrevision.DefaultCode
+		_c.mutation.SetCode(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := erprecordrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ERPRecordRevisionCreate) check() error {
+	if _, ok := _c.mutation.ModuleKey(); !ok {
+		return &ValidationError{Name: "module_key", err: errors.New(`ent: missing required field "ERPRecordRevision.module_key"`)}
+	}
+	if v, ok := _c.mutation.ModuleKey(); ok {
+		if err := erprecordrevision.ModuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "module_key", err: fmt.Errorf(`ent: validator failed for field "ERPRecordRevision.module_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RecordID(); !ok {
+		return &ValidationError{Name: "record_id", err: errors.New(`ent: missing required field "ERPRecordRevision.record_id"`)}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "ERPRecordRevision.revision"`)}
+	}
+	if v, ok := _c.mutation.Revision(); ok {
+		if err := erprecordrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "ERPRecordRevision.revision": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "ERPRecordRevision.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := erprecordrevision.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ERPRecordRevision.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "ERPRecordRevision.payload"`)}
+	}
+	if _, ok := _c.mutation.SavedAt(); !ok {
+		return &ValidationError{Name: "saved_at", err: errors.New(`ent: missing required field "ERPRecordRevision.saved_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ERPRecordRevision.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ERPRecordRevisionCreate) sqlSave(ctx context.Context) (*ERPRecordRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ERPRecordRevisionCreate) createSpec() (*ERPRecordRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ERPRecordRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(erprecordrevision.Table, sqlgraph.NewFieldSpec(erprecordrevision.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ModuleKey(); ok {
+		_spec.SetField(erprecordrevision.FieldModuleKey, field.TypeString, value)
+		_node.ModuleKey = value
+	}
+	if value, ok := _c.mutation.RecordID(); ok {
+		_spec.SetField(erprecordrevision.FieldRecordID, field.TypeInt, value)
+		_node.RecordID = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(erprecordrevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(erprecordrevision.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(erprecordrevision.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.SavedByAdminID(); ok {
+		_spec.SetField(erprecordrevision.FieldSavedByAdminID, field.TypeInt, value)
+		_node.SavedByAdminID = &value
+	}
+	if value, ok := _c.mutation.SavedAt(); ok {
+		_spec.SetField(erprecordrevision.FieldSavedAt, field.TypeTime, value)
+		_node.SavedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(erprecordrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ERPRecordRevisionCreateBulk is the builder for creating many ERPRecordRevision entities in bulk.
+type ERPRecordRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*ERPRecordRevisionCreate
+}
+
+// Save creates the ERPRecordRevision entities in the database.
+func (_c *ERPRecordRevisionCreateBulk) Save(ctx context.Context) ([]*ERPRecordRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ERPRecordRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ERPRecordRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ERPRecordRevisionCreateBulk) SaveX(ctx context.Context) []*ERPRecordRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPRecordRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPRecordRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
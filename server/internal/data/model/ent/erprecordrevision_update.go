@@ -0,0 +1,538 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erprecordrevision"
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPRecordRevisionUpdate is the builder for updating ERPRecordRevision entities.
+type ERPRecordRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ERPRecordRevisionMutation
+}
+
+// Where appends a list predicates to the ERPRecordRevisionUpdate builder.
+func (_u *ERPRecordRevisionUpdate) Where(ps ...predicate.ERPRecordRevision) *ERPRecordRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetModuleKey sets the "module_key" field.
+func (_u *ERPRecordRevisionUpdate) SetModuleKey(v string) *ERPRecordRevisionUpdate {
+	_u.mutation.SetModuleKey(v)
+	return _u
+}
+
+// SetNillableModuleKey sets the "module_key" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdate) SetNillableModuleKey(v *string) *ERPRecordRevisionUpdate {
+	if v != nil {
+		_u.SetModuleKey(*v)
+	}
+	return _u
+}
+
+// SetRecordID sets the "record_id" field.
+func (_u *ERPRecordRevisionUpdate) SetRecordID(v int) *ERPRecordRevisionUpdate {
+	_u.mutation.ResetRecordID()
+	_u.mutation.SetRecordID(v)
+	return _u
+}
+
+// SetNillableRecordID sets the "record_id" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdate) SetNillableRecordID(v *int) *ERPRecordRevisionUpdate {
+	if v != nil {
+		_u.SetRecordID(*v)
+	}
+	return _u
+}
+
+// AddRecordID adds value to the "record_id" field.
+func (_u *ERPRecordRevisionUpdate) AddRecordID(v int) *ERPRecordRevisionUpdate {
+	_u.mutation.AddRecordID(v)
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *ERPRecordRevisionUpdate) SetRevision(v int) *ERPRecordRevisionUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdate) SetNillableRevision(v *int) *ERPRecordRevisionUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *ERPRecordRevisionUpdate) AddRevision(v int) *ERPRecordRevisionUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *ERPRecordRevisionUpdate) SetCode(v string) *ERPRecordRevisionUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdate) SetNillableCode(v *string) *ERPRecordRevisionUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *ERPRecordRevisionUpdate) SetPayload(v string) *ERPRecordRevisionUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdate) SetNillablePayload(v *string) *ERPRecordRevisionUpdate {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetSavedByAdminID sets the "saved_by_admin_id" field.
+func (_u *ERPRecordRevisionUpdate) SetSavedByAdminID(v int) *ERPRecordRevisionUpdate {
+	_u.mutation.ResetSavedByAdminID()
+	_u.mutation.SetSavedByAdminID(v)
+	return _u
+}
+
+// SetNillableSavedByAdminID sets the "saved_by_admin_id" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdate) SetNillableSavedByAdminID(v *int) *ERPRecordRevisionUpdate {
+	if v != nil {
+		_u.SetSavedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddSavedByAdminID adds value to the "saved_by_admin_id" field.
+func (_u *ERPRecordRevisionUpdate) AddSavedByAdminID(v int) *ERPRecordRevisionUpdate {
+	_u.mutation.AddSavedByAdminID(v)
+	return _u
+}
+
+// ClearSavedByAdminID clears the value of the "saved_by_admin_id" field.
+func (_u *ERPRecordRevisionUpdate) ClearSavedByAdminID() *ERPRecordRevisionUpdate {
+	_u.mutation.ClearSavedByAdminID()
+	return _u
+}
+
+// SetSavedAt sets the "saved_at" field.
+func (_u *ERPRecordRevisionUpdate) SetSavedAt(v time.Time) *ERPRecordRevisionUpdate {
+	_u.mutation.SetSavedAt(v)
+	return _u
+}
+
+// SetNillableSavedAt sets the "saved_at" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdate) SetNillableSavedAt(v *time.Time) *ERPRecordRevisionUpdate {
+	if v != nil {
+		_u.SetSavedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ERPRecordRevisionMutation object of the builder.
+func (_u *ERPRecordRevisionUpdate) Mutation() *ERPRecordRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ERPRecordRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPRecordRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ERPRecordRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPRecordRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPRecordRevisionUpdate) check() error {
+	if v, ok := _u.mutation.ModuleKey(); ok {
+		if err := erprecordrevision.ModuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "module_key", err: fmt.Errorf(`ent: validator failed for field "ERPRecordRevision.module_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Revision(); ok {
+		if err := erprecordrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "ERPRecordRevision.revision": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Code(); ok {
+		if err := erprecordrevision.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ERPRecordRevision.code": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPRecordRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erprecordrevision.Table, erprecordrevision.Columns, sqlgraph.NewFieldSpec(erprecordrevision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ModuleKey(); ok {
+		_spec.SetField(erprecordrevision.FieldModuleKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecordID(); ok {
+		_spec.SetField(erprecordrevision.FieldRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecordID(); ok {
+		_spec.AddField(erprecordrevision.FieldRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(erprecordrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(erprecordrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(erprecordrevision.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(erprecordrevision.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SavedByAdminID(); ok {
+		_spec.SetField(erprecordrevision.FieldSavedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSavedByAdminID(); ok {
+		_spec.AddField(erprecordrevision.FieldSavedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.SavedByAdminIDCleared() {
+		_spec.ClearField(erprecordrevision.FieldSavedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SavedAt(); ok {
+		_spec.SetField(erprecordrevision.FieldSavedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erprecordrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ERPRecordRevisionUpdateOne is the builder for updating a single ERPRecordRevision entity.
+type ERPRecordRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ERPRecordRevisionMutation
+}
+
+// SetModuleKey sets the "module_key" field.
+func (_u *ERPRecordRevisionUpdateOne) SetModuleKey(v string) *ERPRecordRevisionUpdateOne {
+	_u.mutation.SetModuleKey(v)
+	return _u
+}
+
+// SetNillableModuleKey sets the "module_key" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdateOne) SetNillableModuleKey(v *string) *ERPRecordRevisionUpdateOne {
+	if v != nil {
+		_u.SetModuleKey(*v)
+	}
+	return _u
+}
+
+// SetRecordID sets the "record_id" field.
+func (_u *ERPRecordRevisionUpdateOne) SetRecordID(v int) *ERPRecordRevisionUpdateOne {
+	_u.mutation.ResetRecordID()
+	_u.mutation.SetRecordID(v)
+	return _u
+}
+
+// SetNillableRecordID sets the "record_id" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdateOne) SetNillableRecordID(v *int) *ERPRecordRevisionUpdateOne {
+	if v != nil {
+		_u.SetRecordID(*v)
+	}
+	return _u
+}
+
+// AddRecordID adds value to the "record_id" field.
+func (_u *ERPRecordRevisionUpdateOne) AddRecordID(v int) *ERPRecordRevisionUpdateOne {
+	_u.mutation.AddRecordID(v)
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *ERPRecordRevisionUpdateOne) SetRevision(v int) *ERPRecordRevisionUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdateOne) SetNillableRevision(v *int) *ERPRecordRevisionUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *ERPRecordRevisionUpdateOne) AddRevision(v int) *ERPRecordRevisionUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *ERPRecordRevisionUpdateOne) SetCode(v string) *ERPRecordRevisionUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdateOne) SetNillableCode(v *string) *ERPRecordRevisionUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *ERPRecordRevisionUpdateOne) SetPayload(v string) *ERPRecordRevisionUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdateOne) SetNillablePayload(v *string) *ERPRecordRevisionUpdateOne {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetSavedByAdminID sets the "saved_by_admin_id" field.
+func (_u *ERPRecordRevisionUpdateOne) SetSavedByAdminID(v int) *ERPRecordRevisionUpdateOne {
+	_u.mutation.ResetSavedByAdminID()
+	_u.mutation.SetSavedByAdminID(v)
+	return _u
+}
+
+// SetNillableSavedByAdminID sets the "saved_by_admin_id" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdateOne) SetNillableSavedByAdminID(v *int) *ERPRecordRevisionUpdateOne {
+	if v != nil {
+		_u.SetSavedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddSavedByAdminID adds value to the "saved_by_admin_id" field.
+func (_u *ERPRecordRevisionUpdateOne) AddSavedByAdminID(v int) *ERPRecordRevisionUpdateOne {
+	_u.mutation.AddSavedByAdminID(v)
+	return _u
+}
+
+// ClearSavedByAdminID clears the value of the "saved_by_admin_id" field.
+func (_u *ERPRecordRevisionUpdateOne) ClearSavedByAdminID() *ERPRecordRevisionUpdateOne {
+	_u.mutation.ClearSavedByAdminID()
+	return _u
+}
+
+// SetSavedAt sets the "saved_at" field.
+func (_u *ERPRecordRevisionUpdateOne) SetSavedAt(v time.Time) *ERPRecordRevisionUpdateOne {
+	_u.mutation.SetSavedAt(v)
+	return _u
+}
+
+// SetNillableSavedAt sets the "saved_at" field if the given value is not nil.
+func (_u *ERPRecordRevisionUpdateOne) SetNillableSavedAt(v *time.Time) *ERPRecordRevisionUpdateOne {
+	if v != nil {
+		_u.SetSavedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ERPRecordRevisionMutation object of the builder.
+func (_u *ERPRecordRevisionUpdateOne) Mutation() *ERPRecordRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ERPRecordRevisionUpdate builder.
+func (_u *ERPRecordRevisionUpdateOne) Where(ps ...predicate.ERPRecordRevision) *ERPRecordRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ERPRecordRevisionUpdateOne) Select(field string, fields ...string) *ERPRecordRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ERPRecordRevision entity.
+func (_u *ERPRecordRevisionUpdateOne) Save(ctx context.Context) (*ERPRecordRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPRecordRevisionUpdateOne) SaveX(ctx context.Context) *ERPRecordRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ERPRecordRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPRecordRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPRecordRevisionUpdateOne) check() error {
+	if v, ok := _u.mutation.ModuleKey(); ok {
+		if err := erprecordrevision.ModuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "module_key", err: fmt.Errorf(`ent: validator failed for field "ERPRecordRevision.module_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Revision(); ok {
+		if err := erprecordrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "ERPRecordRevision.revision": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Code(); ok {
+		if err := erprecordrevision.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ERPRecordRevision.code": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPRecordRevisionUpdateOne) sqlSave(ctx context.Context) (_node *ERPRecordRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erprecordrevision.Table, erprecordrevision.Columns, sqlgraph.NewFieldSpec(erprecordrevision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ERPRecordRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, erprecordrevision.FieldID)
+		for _, f := range fields {
+			if !erprecordrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != erprecordrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ModuleKey(); ok {
+		_spec.SetField(erprecordrevision.FieldModuleKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecordID(); ok {
+		_spec.SetField(erprecordrevision.FieldRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecordID(); ok {
+		_spec.AddField(erprecordrevision.FieldRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(erprecordrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(erprecordrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(erprecordrevision.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(erprecordrevision.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SavedByAdminID(); ok {
+		_spec.SetField(erprecordrevision.FieldSavedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSavedByAdminID(); ok {
+		_spec.AddField(erprecordrevision.FieldSavedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.SavedByAdminIDCleared() {
+		_spec.ClearField(erprecordrevision.FieldSavedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SavedAt(); ok {
+		_spec.SetField(erprecordrevision.FieldSavedAt, field.TypeTime, value)
+	}
+	_node = &ERPRecordRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erprecordrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/erpsettlementline"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ERPSettlementLine is the model entity for the ERPSettlementLine schema.
+type ERPSettlementLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SettlementID holds the value of the "settlement_id" field.
+	SettlementID int `json:"settlement_id,omitempty"`
+	// LineNo holds the value of the "line_no" field.
+	LineNo int `json:"line_no,omitempty"`
+	// ShipmentCode holds the value of the "shipment_code" field.
+	ShipmentCode *string `json:"shipment_code,omitempty"`
+	// ShipmentLineNo holds the value of the "shipment_line_no" field.
+	ShipmentLineNo *int `json:"shipment_line_no,omitempty"`
+	// ShipmentDetailItemID holds the value of the "shipment_detail_item_id" field.
+	ShipmentDetailItemID *int `json:"shipment_detail_item_id,omitempty"`
+	// ProductModel holds the value of the "product_model" field.
+	ProductModel *string `json:"product_model,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity float64 `json:"quantity,omitempty"`
+	// UnitPrice holds the value of the "unit_price" field.
+	UnitPrice float64 `json:"unit_price,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// ReceivedAmount holds the value of the "received_amount" field.
+	ReceivedAmount float64 `json:"received_amount,omitempty"`
+	// OutstandingAmount holds the value of the "outstanding_amount" field.
+	OutstandingAmount float64 `json:"outstanding_amount,omitempty"`
+	// pending/partial/closed
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ERPSettlementLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case erpsettlementline.FieldQuantity, erpsettlementline.FieldUnitPrice, erpsettlementline.FieldAmount, erpsettlementline.FieldReceivedAmount, erpsettlementline.FieldOutstandingAmount:
+			values[i] = new(sql.NullFloat64)
+		case erpsettlementline.FieldID, erpsettlementline.FieldSettlementID, erpsettlementline.FieldLineNo, erpsettlementline.FieldShipmentLineNo, erpsettlementline.FieldShipmentDetailItemID:
+			values[i] = new(sql.NullInt64)
+		case erpsettlementline.FieldShipmentCode, erpsettlementline.FieldProductModel, erpsettlementline.FieldStatus:
+			values[i] = new(sql.NullString)
+		case erpsettlementline.FieldCreatedAt, erpsettlementline.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ERPSettlementLine fields.
+func (_m *ERPSettlementLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case erpsettlementline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case erpsettlementline.FieldSettlementID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_id", values[i])
+			} else if value.Valid {
+				_m.SettlementID = int(value.Int64)
+			}
+		case erpsettlementline.FieldLineNo:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field line_no", values[i])
+			} else if value.Valid {
+				_m.LineNo = int(value.Int64)
+			}
+		case erpsettlementline.FieldShipmentCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipment_code", values[i])
+			} else if value.Valid {
+				_m.ShipmentCode = new(string)
+				*_m.ShipmentCode = value.String
+			}
+		case erpsettlementline.FieldShipmentLineNo:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shipment_line_no", values[i])
+			} else if value.Valid {
+				_m.ShipmentLineNo = new(int)
+				*_m.ShipmentLineNo = int(value.Int64)
+			}
+		case erpsettlementline.FieldShipmentDetailItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shipment_detail_item_id", values[i])
+			} else if value.Valid {
+				_m.ShipmentDetailItemID = new(int)
+				*_m.ShipmentDetailItemID = int(value.Int64)
+			}
+		case erpsettlementline.FieldProductModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_model", values[i])
+			} else if value.Valid {
+				_m.ProductModel = new(string)
+				*_m.ProductModel = value.String
+			}
+		case erpsettlementline.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = value.Float64
+			}
+		case erpsettlementline.FieldUnitPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value.Valid {
+				_m.UnitPrice = value.Float64
+			}
+		case erpsettlementline.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Float64
+			}
+		case erpsettlementline.FieldReceivedAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field received_amount", values[i])
+			} else if value.Valid {
+				_m.ReceivedAmount = value.Float64
+			}
+		case erpsettlementline.FieldOutstandingAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field outstanding_amount", values[i])
+			} else if value.Valid {
+				_m.OutstandingAmount = value.Float64
+			}
+		case erpsettlementline.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case erpsettlementline.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case erpsettlementline.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ERPSettlementLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *ERPSettlementLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ERPSettlementLine.
+// Note that you need to call ERPSettlementLine.Unwrap() before calling this method if this ERPSettlementLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ERPSettlementLine) Update() *ERPSettlementLineUpdateOne {
+	return NewERPSettlementLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ERPSettlementLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ERPSettlementLine) Unwrap() *ERPSettlementLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ERPSettlementLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ERPSettlementLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("ERPSettlementLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("settlement_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SettlementID))
+	builder.WriteString(", ")
+	builder.WriteString("line_no=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LineNo))
+	builder.WriteString(", ")
+	if v := _m.ShipmentCode; v != nil {
+		builder.WriteString("shipment_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ShipmentLineNo; v != nil {
+		builder.WriteString("shipment_line_no=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ShipmentDetailItemID; v != nil {
+		builder.WriteString("shipment_detail_item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ProductModel; v != nil {
+		builder.WriteString("product_model=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitPrice))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("received_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReceivedAmount))
+	builder.WriteString(", ")
+	builder.WriteString("outstanding_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutstandingAmount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ERPSettlementLines is a parsable slice of ERPSettlementLine.
+type ERPSettlementLines []*ERPSettlementLine
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package erpsettlementline
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the erpsettlementline type in the database.
+	Label = "erp_settlement_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSettlementID holds the string denoting the settlement_id field in the database.
+	FieldSettlementID = "settlement_id"
+	// FieldLineNo holds the string denoting the line_no field in the database.
+	FieldLineNo = "line_no"
+	// FieldShipmentCode holds the string denoting the shipment_code field in the database.
+	FieldShipmentCode = "shipment_code"
+	// FieldShipmentLineNo holds the string denoting the shipment_line_no field in the database.
+	FieldShipmentLineNo = "shipment_line_no"
+	// FieldShipmentDetailItemID holds the string denoting the shipment_detail_item_id field in the database.
+	FieldShipmentDetailItemID = "shipment_detail_item_id"
+	// FieldProductModel holds the string denoting the product_model field in the database.
+	FieldProductModel = "product_model"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldUnitPrice holds the string denoting the unit_price field in the database.
+	FieldUnitPrice = "unit_price"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldReceivedAmount holds the string denoting the received_amount field in the database.
+	FieldReceivedAmount = "received_amount"
+	// FieldOutstandingAmount holds the string denoting the outstanding_amount field in the database.
+	FieldOutstandingAmount = "outstanding_amount"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the erpsettlementline in the database.
+	Table = "erp_settlement_lines"
+)
+
+// Columns holds all SQL columns for erpsettlementline fields.
+var Columns = []string{
+	FieldID,
+	FieldSettlementID,
+	FieldLineNo,
+	FieldShipmentCode,
+	FieldShipmentLineNo,
+	FieldShipmentDetailItemID,
+	FieldProductModel,
+	FieldQuantity,
+	FieldUnitPrice,
+	FieldAmount,
+	FieldReceivedAmount,
+	FieldOutstandingAmount,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SettlementIDValidator is a validator for the "settlement_id" field. It is called by the builders before save.
+	SettlementIDValidator func(int) error
+	// LineNoValidator is a validator for the "line_no" field. It is called by the builders before save.
+	LineNoValidator func(int) error
+	// ShipmentCodeValidator is a validator for the "shipment_code" field. It is called by the builders before save.
+	ShipmentCodeValidator func(string) error
+	// ProductModelValidator is a validator for the "product_model" field. It is called by the builders before save.
+	ProductModelValidator func(string) error
+	// DefaultQuantity holds the default value on creation for the "quantity" field.
+	DefaultQuantity float64
+	// DefaultUnitPrice holds the default value on creation for the "unit_price" field.
+	DefaultUnitPrice float64
+	// DefaultReceivedAmount holds the default value on creation for the "received_amount" field.
+	DefaultReceivedAmount float64
+	// DefaultOutstandingAmount holds the default value on creation for the "outstanding_amount" field.
+	DefaultOutstandingAmount float64
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ERPSettlementLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySettlementID orders the results by the settlement_id field.
+func BySettlementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettlementID, opts...).ToFunc()
+}
+
+// ByLineNo orders the results by the line_no field.
+func ByLineNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLineNo, opts...).ToFunc()
+}
+
+// ByShipmentCode orders the results by the shipment_code field.
+func ByShipmentCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShipmentCode, opts...).ToFunc()
+}
+
+// ByShipmentLineNo orders the results by the shipment_line_no field.
+func ByShipmentLineNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShipmentLineNo, opts...).ToFunc()
+}
+
+// ByShipmentDetailItemID orders the results by the shipment_detail_item_id field.
+func ByShipmentDetailItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShipmentDetailItemID, opts...).ToFunc()
+}
+
+// ByProductModel orders the results by the product_model field.
+func ByProductModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductModel, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByUnitPrice orders the results by the unit_price field.
+func ByUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnitPrice, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByReceivedAmount orders the results by the received_amount field.
+func ByReceivedAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAmount, opts...).ToFunc()
+}
+
+// ByOutstandingAmount orders the results by the outstanding_amount field.
+func ByOutstandingAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutstandingAmount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
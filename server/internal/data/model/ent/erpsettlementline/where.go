@@ -0,0 +1,815 @@
+// Code generated by ent, DO NOT EDIT.
+
+package erpsettlementline
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldID, id))
+}
+
+// SettlementID applies equality check predicate on the "settlement_id" field. It's identical to SettlementIDEQ.
+func SettlementID(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// LineNo applies equality check predicate on the "line_no" field. It's identical to LineNoEQ.
+func LineNo(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldLineNo, v))
+}
+
+// ShipmentCode applies equality check predicate on the "shipment_code" field. It's identical to ShipmentCodeEQ.
+func ShipmentCode(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldShipmentCode, v))
+}
+
+// ShipmentLineNo applies equality check predicate on the "shipment_line_no" field. It's identical to ShipmentLineNoEQ.
+func ShipmentLineNo(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldShipmentLineNo, v))
+}
+
+// ShipmentDetailItemID applies equality check predicate on the "shipment_detail_item_id" field. It's identical to ShipmentDetailItemIDEQ.
+func ShipmentDetailItemID(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldShipmentDetailItemID, v))
+}
+
+// ProductModel applies equality check predicate on the "product_model" field. It's identical to ProductModelEQ.
+func ProductModel(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldProductModel, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldAmount, v))
+}
+
+// ReceivedAmount applies equality check predicate on the "received_amount" field. It's identical to ReceivedAmountEQ.
+func ReceivedAmount(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldReceivedAmount, v))
+}
+
+// OutstandingAmount applies equality check predicate on the "outstanding_amount" field. It's identical to OutstandingAmountEQ.
+func OutstandingAmount(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldOutstandingAmount, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SettlementIDEQ applies the EQ predicate on the "settlement_id" field.
+func SettlementIDEQ(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// SettlementIDNEQ applies the NEQ predicate on the "settlement_id" field.
+func SettlementIDNEQ(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldSettlementID, v))
+}
+
+// SettlementIDIn applies the In predicate on the "settlement_id" field.
+func SettlementIDIn(vs ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldSettlementID, vs...))
+}
+
+// SettlementIDNotIn applies the NotIn predicate on the "settlement_id" field.
+func SettlementIDNotIn(vs ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldSettlementID, vs...))
+}
+
+// SettlementIDGT applies the GT predicate on the "settlement_id" field.
+func SettlementIDGT(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldSettlementID, v))
+}
+
+// SettlementIDGTE applies the GTE predicate on the "settlement_id" field.
+func SettlementIDGTE(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldSettlementID, v))
+}
+
+// SettlementIDLT applies the LT predicate on the "settlement_id" field.
+func SettlementIDLT(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldSettlementID, v))
+}
+
+// SettlementIDLTE applies the LTE predicate on the "settlement_id" field.
+func SettlementIDLTE(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldSettlementID, v))
+}
+
+// LineNoEQ applies the EQ predicate on the "line_no" field.
+func LineNoEQ(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldLineNo, v))
+}
+
+// LineNoNEQ applies the NEQ predicate on the "line_no" field.
+func LineNoNEQ(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldLineNo, v))
+}
+
+// LineNoIn applies the In predicate on the "line_no" field.
+func LineNoIn(vs ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldLineNo, vs...))
+}
+
+// LineNoNotIn applies the NotIn predicate on the "line_no" field.
+func LineNoNotIn(vs ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldLineNo, vs...))
+}
+
+// LineNoGT applies the GT predicate on the "line_no" field.
+func LineNoGT(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldLineNo, v))
+}
+
+// LineNoGTE applies the GTE predicate on the "line_no" field.
+func LineNoGTE(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldLineNo, v))
+}
+
+// LineNoLT applies the LT predicate on the "line_no" field.
+func LineNoLT(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldLineNo, v))
+}
+
+// LineNoLTE applies the LTE predicate on the "line_no" field.
+func LineNoLTE(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldLineNo, v))
+}
+
+// ShipmentCodeEQ applies the EQ predicate on the "shipment_code" field.
+func ShipmentCodeEQ(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldShipmentCode, v))
+}
+
+// ShipmentCodeNEQ applies the NEQ predicate on the "shipment_code" field.
+func ShipmentCodeNEQ(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldShipmentCode, v))
+}
+
+// ShipmentCodeIn applies the In predicate on the "shipment_code" field.
+func ShipmentCodeIn(vs ...string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldShipmentCode, vs...))
+}
+
+// ShipmentCodeNotIn applies the NotIn predicate on the "shipment_code" field.
+func ShipmentCodeNotIn(vs ...string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldShipmentCode, vs...))
+}
+
+// ShipmentCodeGT applies the GT predicate on the "shipment_code" field.
+func ShipmentCodeGT(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldShipmentCode, v))
+}
+
+// ShipmentCodeGTE applies the GTE predicate on the "shipment_code" field.
+func ShipmentCodeGTE(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldShipmentCode, v))
+}
+
+// ShipmentCodeLT applies the LT predicate on the "shipment_code" field.
+func ShipmentCodeLT(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldShipmentCode, v))
+}
+
+// ShipmentCodeLTE applies the LTE predicate on the "shipment_code" field.
+func ShipmentCodeLTE(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldShipmentCode, v))
+}
+
+// ShipmentCodeContains applies the Contains predicate on the "shipment_code" field.
+func ShipmentCodeContains(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldContains(FieldShipmentCode, v))
+}
+
+// ShipmentCodeHasPrefix applies the HasPrefix predicate on the "shipment_code" field.
+func ShipmentCodeHasPrefix(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldHasPrefix(FieldShipmentCode, v))
+}
+
+// ShipmentCodeHasSuffix applies the HasSuffix predicate on the "shipment_code" field.
+func ShipmentCodeHasSuffix(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldHasSuffix(FieldShipmentCode, v))
+}
+
+// ShipmentCodeIsNil applies the IsNil predicate on the "shipment_code" field.
+func ShipmentCodeIsNil() predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIsNull(FieldShipmentCode))
+}
+
+// ShipmentCodeNotNil applies the NotNil predicate on the "shipment_code" field.
+func ShipmentCodeNotNil() predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotNull(FieldShipmentCode))
+}
+
+// ShipmentCodeEqualFold applies the EqualFold predicate on the "shipment_code" field.
+func ShipmentCodeEqualFold(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEqualFold(FieldShipmentCode, v))
+}
+
+// ShipmentCodeContainsFold applies the ContainsFold predicate on the "shipment_code" field.
+func ShipmentCodeContainsFold(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldContainsFold(FieldShipmentCode, v))
+}
+
+// ShipmentLineNoEQ applies the EQ predicate on the "shipment_line_no" field.
+func ShipmentLineNoEQ(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldShipmentLineNo, v))
+}
+
+// ShipmentLineNoNEQ applies the NEQ predicate on the "shipment_line_no" field.
+func ShipmentLineNoNEQ(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldShipmentLineNo, v))
+}
+
+// ShipmentLineNoIn applies the In predicate on the "shipment_line_no" field.
+func ShipmentLineNoIn(vs ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldShipmentLineNo, vs...))
+}
+
+// ShipmentLineNoNotIn applies the NotIn predicate on the "shipment_line_no" field.
+func ShipmentLineNoNotIn(vs ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldShipmentLineNo, vs...))
+}
+
+// ShipmentLineNoGT applies the GT predicate on the "shipment_line_no" field.
+func ShipmentLineNoGT(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldShipmentLineNo, v))
+}
+
+// ShipmentLineNoGTE applies the GTE predicate on the "shipment_line_no" field.
+func ShipmentLineNoGTE(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldShipmentLineNo, v))
+}
+
+// ShipmentLineNoLT applies the LT predicate on the "shipment_line_no" field.
+func ShipmentLineNoLT(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldShipmentLineNo, v))
+}
+
+// ShipmentLineNoLTE applies the LTE predicate on the "shipment_line_no" field.
+func ShipmentLineNoLTE(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldShipmentLineNo, v))
+}
+
+// ShipmentLineNoIsNil applies the IsNil predicate on the "shipment_line_no" field.
+func ShipmentLineNoIsNil() predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIsNull(FieldShipmentLineNo))
+}
+
+// ShipmentLineNoNotNil applies the NotNil predicate on the "shipment_line_no" field.
+func ShipmentLineNoNotNil() predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotNull(FieldShipmentLineNo))
+}
+
+// ShipmentDetailItemIDEQ applies the EQ predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDEQ(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldShipmentDetailItemID, v))
+}
+
+// ShipmentDetailItemIDNEQ applies the NEQ predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDNEQ(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldShipmentDetailItemID, v))
+}
+
+// ShipmentDetailItemIDIn applies the In predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDIn(vs ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldShipmentDetailItemID, vs...))
+}
+
+// ShipmentDetailItemIDNotIn applies the NotIn predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDNotIn(vs ...int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldShipmentDetailItemID, vs...))
+}
+
+// ShipmentDetailItemIDGT applies the GT predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDGT(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldShipmentDetailItemID, v))
+}
+
+// ShipmentDetailItemIDGTE applies the GTE predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDGTE(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldShipmentDetailItemID, v))
+}
+
+// ShipmentDetailItemIDLT applies the LT predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDLT(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldShipmentDetailItemID, v))
+}
+
+// ShipmentDetailItemIDLTE applies the LTE predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDLTE(v int) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldShipmentDetailItemID, v))
+}
+
+// ShipmentDetailItemIDIsNil applies the IsNil predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDIsNil() predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIsNull(FieldShipmentDetailItemID))
+}
+
+// ShipmentDetailItemIDNotNil applies the NotNil predicate on the "shipment_detail_item_id" field.
+func ShipmentDetailItemIDNotNil() predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotNull(FieldShipmentDetailItemID))
+}
+
+// ProductModelEQ applies the EQ predicate on the "product_model" field.
+func ProductModelEQ(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldProductModel, v))
+}
+
+// ProductModelNEQ applies the NEQ predicate on the "product_model" field.
+func ProductModelNEQ(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldProductModel, v))
+}
+
+// ProductModelIn applies the In predicate on the "product_model" field.
+func ProductModelIn(vs ...string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldProductModel, vs...))
+}
+
+// ProductModelNotIn applies the NotIn predicate on the "product_model" field.
+func ProductModelNotIn(vs ...string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldProductModel, vs...))
+}
+
+// ProductModelGT applies the GT predicate on the "product_model" field.
+func ProductModelGT(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldProductModel, v))
+}
+
+// ProductModelGTE applies the GTE predicate on the "product_model" field.
+func ProductModelGTE(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldProductModel, v))
+}
+
+// ProductModelLT applies the LT predicate on the "product_model" field.
+func ProductModelLT(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldProductModel, v))
+}
+
+// ProductModelLTE applies the LTE predicate on the "product_model" field.
+func ProductModelLTE(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldProductModel, v))
+}
+
+// ProductModelContains applies the Contains predicate on the "product_model" field.
+func ProductModelContains(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldContains(FieldProductModel, v))
+}
+
+// ProductModelHasPrefix applies the HasPrefix predicate on the "product_model" field.
+func ProductModelHasPrefix(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldHasPrefix(FieldProductModel, v))
+}
+
+// ProductModelHasSuffix applies the HasSuffix predicate on the "product_model" field.
+func ProductModelHasSuffix(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldHasSuffix(FieldProductModel, v))
+}
+
+// ProductModelIsNil applies the IsNil predicate on the "product_model" field.
+func ProductModelIsNil() predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIsNull(FieldProductModel))
+}
+
+// ProductModelNotNil applies the NotNil predicate on the "product_model" field.
+func ProductModelNotNil() predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotNull(FieldProductModel))
+}
+
+// ProductModelEqualFold applies the EqualFold predicate on the "product_model" field.
+func ProductModelEqualFold(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEqualFold(FieldProductModel, v))
+}
+
+// ProductModelContainsFold applies the ContainsFold predicate on the "product_model" field.
+func ProductModelContainsFold(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldContainsFold(FieldProductModel, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldAmount, v))
+}
+
+// ReceivedAmountEQ applies the EQ predicate on the "received_amount" field.
+func ReceivedAmountEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldReceivedAmount, v))
+}
+
+// ReceivedAmountNEQ applies the NEQ predicate on the "received_amount" field.
+func ReceivedAmountNEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldReceivedAmount, v))
+}
+
+// ReceivedAmountIn applies the In predicate on the "received_amount" field.
+func ReceivedAmountIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldReceivedAmount, vs...))
+}
+
+// ReceivedAmountNotIn applies the NotIn predicate on the "received_amount" field.
+func ReceivedAmountNotIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldReceivedAmount, vs...))
+}
+
+// ReceivedAmountGT applies the GT predicate on the "received_amount" field.
+func ReceivedAmountGT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldReceivedAmount, v))
+}
+
+// ReceivedAmountGTE applies the GTE predicate on the "received_amount" field.
+func ReceivedAmountGTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldReceivedAmount, v))
+}
+
+// ReceivedAmountLT applies the LT predicate on the "received_amount" field.
+func ReceivedAmountLT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldReceivedAmount, v))
+}
+
+// ReceivedAmountLTE applies the LTE predicate on the "received_amount" field.
+func ReceivedAmountLTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldReceivedAmount, v))
+}
+
+// OutstandingAmountEQ applies the EQ predicate on the "outstanding_amount" field.
+func OutstandingAmountEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldOutstandingAmount, v))
+}
+
+// OutstandingAmountNEQ applies the NEQ predicate on the "outstanding_amount" field.
+func OutstandingAmountNEQ(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldOutstandingAmount, v))
+}
+
+// OutstandingAmountIn applies the In predicate on the "outstanding_amount" field.
+func OutstandingAmountIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldOutstandingAmount, vs...))
+}
+
+// OutstandingAmountNotIn applies the NotIn predicate on the "outstanding_amount" field.
+func OutstandingAmountNotIn(vs ...float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldOutstandingAmount, vs...))
+}
+
+// OutstandingAmountGT applies the GT predicate on the "outstanding_amount" field.
+func OutstandingAmountGT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldOutstandingAmount, v))
+}
+
+// OutstandingAmountGTE applies the GTE predicate on the "outstanding_amount" field.
+func OutstandingAmountGTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldOutstandingAmount, v))
+}
+
+// OutstandingAmountLT applies the LT predicate on the "outstanding_amount" field.
+func OutstandingAmountLT(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldOutstandingAmount, v))
+}
+
+// OutstandingAmountLTE applies the LTE predicate on the "outstanding_amount" field.
+func OutstandingAmountLTE(v float64) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldOutstandingAmount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ERPSettlementLine) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ERPSettlementLine) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ERPSettlementLine) predicate.ERPSettlementLine {
+	return predicate.ERPSettlementLine(sql.NotPredicates(p))
+}
@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erpsettlementline"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPSettlementLineCreate is the builder for creating a ERPSettlementLine entity.
+type ERPSettlementLineCreate struct {
+	config
+	mutation *ERPSettlementLineMutation
+	hooks    []Hook
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (_c *ERPSettlementLineCreate) SetSettlementID(v int) *ERPSettlementLineCreate {
+	_c.mutation.SetSettlementID(v)
+	return _c
+}
+
+// SetLineNo sets the "line_no" field.
+func (_c *ERPSettlementLineCreate) SetLineNo(v int) *ERPSettlementLineCreate {
+	_c.mutation.SetLineNo(v)
+	return _c
+}
+
+// SetShipmentCode sets the "shipment_code" field.
+func (_c *ERPSettlementLineCreate) SetShipmentCode(v string) *ERPSettlementLineCreate {
+	_c.mutation.SetShipmentCode(v)
+	return _c
+}
+
+// SetNillableShipmentCode sets the "shipment_code" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableShipmentCode(v *string) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetShipmentCode(*v)
+	}
+	return _c
+}
+
+// SetShipmentLineNo sets the "shipment_line_no" field.
+func (_c *ERPSettlementLineCreate) SetShipmentLineNo(v int) *ERPSettlementLineCreate {
+	_c.mutation.SetShipmentLineNo(v)
+	return _c
+}
+
+// SetNillableShipmentLineNo sets the "shipment_line_no" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableShipmentLineNo(v *int) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetShipmentLineNo(*v)
+	}
+	return _c
+}
+
+// SetShipmentDetailItemID sets the "shipment_detail_item_id" field.
+func (_c *ERPSettlementLineCreate) SetShipmentDetailItemID(v int) *ERPSettlementLineCreate {
+	_c.mutation.SetShipmentDetailItemID(v)
+	return _c
+}
+
+// SetNillableShipmentDetailItemID sets the "shipment_detail_item_id" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableShipmentDetailItemID(v *int) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetShipmentDetailItemID(*v)
+	}
+	return _c
+}
+
+// SetProductModel sets the "product_model" field.
+func (_c *ERPSettlementLineCreate) SetProductModel(v string) *ERPSettlementLineCreate {
+	_c.mutation.SetProductModel(v)
+	return _c
+}
+
+// SetNillableProductModel sets the "product_model" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableProductModel(v *string) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetProductModel(*v)
+	}
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *ERPSettlementLineCreate) SetQuantity(v float64) *ERPSettlementLineCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableQuantity(v *float64) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *ERPSettlementLineCreate) SetUnitPrice(v float64) *ERPSettlementLineCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableUnitPrice(v *float64) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *ERPSettlementLineCreate) SetAmount(v float64) *ERPSettlementLineCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetReceivedAmount sets the "received_amount" field.
+func (_c *ERPSettlementLineCreate) SetReceivedAmount(v float64) *ERPSettlementLineCreate {
+	_c.mutation.SetReceivedAmount(v)
+	return _c
+}
+
+// SetNillableReceivedAmount sets the "received_amount" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableReceivedAmount(v *float64) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetReceivedAmount(*v)
+	}
+	return _c
+}
+
+// SetOutstandingAmount sets the "outstanding_amount" field.
+func (_c *ERPSettlementLineCreate) SetOutstandingAmount(v float64) *ERPSettlementLineCreate {
+	_c.mutation.SetOutstandingAmount(v)
+	return _c
+}
+
+// SetNillableOutstandingAmount sets the "outstanding_amount" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableOutstandingAmount(v *float64) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetOutstandingAmount(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ERPSettlementLineCreate) SetStatus(v string) *ERPSettlementLineCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableStatus(v *string) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ERPSettlementLineCreate) SetCreatedAt(v time.Time) *ERPSettlementLineCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableCreatedAt(v *time.Time) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ERPSettlementLineCreate) SetUpdatedAt(v time.Time) *ERPSettlementLineCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ERPSettlementLineCreate) SetNillableUpdatedAt(v *time.Time) *ERPSettlementLineCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ERPSettlementLineMutation object of the builder.
+func (_c *ERPSettlementLineCreate) Mutation() *ERPSettlementLineMutation {
+	return _c.mutation
+}
+
+// Save creates the ERPSettlementLine in the database.
+func (_c *ERPSettlementLineCreate) Save(ctx context.Context) (*ERPSettlementLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ERPSettlementLineCreate) SaveX(ctx context.Context) *ERPSettlementLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPSettlementLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPSettlementLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ERPSettlementLineCreate) defaults() {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := erpsettlementline.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		v := erpsettlementline.DefaultUnitPrice
+		_c.mutation.SetUnitPrice(v)
+	}
+	if _, ok := _c.mutation.ReceivedAmount(); !ok {
+		v := erpsettlementline.DefaultReceivedAmount
+		_c.mutation.SetReceivedAmount(v)
+	}
+	if _, ok := _c.mutation.OutstandingAmount(); !ok {
+		v := erpsettlementline.DefaultOutstandingAmount
+		_c.mutation.SetOutstandingAmount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := erpsettlementline.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := erpsettlementline.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := erpsettlementline.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ERPSettlementLineCreate) check() error {
+	if _, ok := _c.mutation.SettlementID(); !ok {
+		return &ValidationError{Name: "settlement_id", err: errors.New(`ent: missing required field "ERPSettlementLine.settlement_id"`)}
+	}
+	if v, ok := _c.mutation.SettlementID(); ok {
+		if err := erpsettlementline.SettlementIDValidator(v); err != nil {
+			return &ValidationError{Name: "settlement_id", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.settlement_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LineNo(); !ok {
+		return &ValidationError{Name: "line_no", err: errors.New(`ent: missing required field "ERPSettlementLine.line_no"`)}
+	}
+	if v, ok := _c.mutation.LineNo(); ok {
+		if err := erpsettlementline.LineNoValidator(v); err != nil {
+			return &ValidationError{Name: "line_no", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.line_no": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ShipmentCode(); ok {
+		if err := erpsettlementline.ShipmentCodeValidator(v); err != nil {
+			return &ValidationError{Name: "shipment_code", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.shipment_code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ProductModel(); ok {
+		if err := erpsettlementline.ProductModelValidator(v); err != nil {
+			return &ValidationError{Name: "product_model", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.product_model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "ERPSettlementLine.quantity"`)}
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "ERPSettlementLine.unit_price"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "ERPSettlementLine.amount"`)}
+	}
+	if _, ok := _c.mutation.ReceivedAmount(); !ok {
+		return &ValidationError{Name: "received_amount", err: errors.New(`ent: missing required field "ERPSettlementLine.received_amount"`)}
+	}
+	if _, ok := _c.mutation.OutstandingAmount(); !ok {
+		return &ValidationError{Name: "outstanding_amount", err: errors.New(`ent: missing required field "ERPSettlementLine.outstanding_amount"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ERPSettlementLine.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := erpsettlementline.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ERPSettlementLine.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ERPSettlementLine.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ERPSettlementLineCreate) sqlSave(ctx context.Context) (*ERPSettlementLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ERPSettlementLineCreate) createSpec() (*ERPSettlementLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ERPSettlementLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(erpsettlementline.Table, sqlgraph.NewFieldSpec(erpsettlementline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.SettlementID(); ok {
+		_spec.SetField(erpsettlementline.FieldSettlementID, field.TypeInt, value)
+		_node.SettlementID = value
+	}
+	if value, ok := _c.mutation.LineNo(); ok {
+		_spec.SetField(erpsettlementline.FieldLineNo, field.TypeInt, value)
+		_node.LineNo = value
+	}
+	if value, ok := _c.mutation.ShipmentCode(); ok {
+		_spec.SetField(erpsettlementline.FieldShipmentCode, field.TypeString, value)
+		_node.ShipmentCode = &value
+	}
+	if value, ok := _c.mutation.ShipmentLineNo(); ok {
+		_spec.SetField(erpsettlementline.FieldShipmentLineNo, field.TypeInt, value)
+		_node.ShipmentLineNo = &value
+	}
+	if value, ok := _c.mutation.ShipmentDetailItemID(); ok {
+		_spec.SetField(erpsettlementline.FieldShipmentDetailItemID, field.TypeInt, value)
+		_node.ShipmentDetailItemID = &value
+	}
+	if value, ok := _c.mutation.ProductModel(); ok {
+		_spec.SetField(erpsettlementline.FieldProductModel, field.TypeString, value)
+		_node.ProductModel = &value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(erpsettlementline.FieldQuantity, field.TypeFloat64, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(erpsettlementline.FieldUnitPrice, field.TypeFloat64, value)
+		_node.UnitPrice = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(erpsettlementline.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.ReceivedAmount(); ok {
+		_spec.SetField(erpsettlementline.FieldReceivedAmount, field.TypeFloat64, value)
+		_node.ReceivedAmount = value
+	}
+	if value, ok := _c.mutation.OutstandingAmount(); ok {
+		_spec.SetField(erpsettlementline.FieldOutstandingAmount, field.TypeFloat64, value)
+		_node.OutstandingAmount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(erpsettlementline.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(erpsettlementline.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(erpsettlementline.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ERPSettlementLineCreateBulk is the builder for creating many ERPSettlementLine entities in bulk.
+type ERPSettlementLineCreateBulk struct {
+	config
+	err      error
+	builders []*ERPSettlementLineCreate
+}
+
+// Save creates the ERPSettlementLine entities in the database.
+func (_c *ERPSettlementLineCreateBulk) Save(ctx context.Context) ([]*ERPSettlementLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ERPSettlementLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ERPSettlementLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ERPSettlementLineCreateBulk) SaveX(ctx context.Context) []*ERPSettlementLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ERPSettlementLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ERPSettlementLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,938 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/erpsettlementline"
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ERPSettlementLineUpdate is the builder for updating ERPSettlementLine entities.
+type ERPSettlementLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ERPSettlementLineMutation
+}
+
+// Where appends a list predicates to the ERPSettlementLineUpdate builder.
+func (_u *ERPSettlementLineUpdate) Where(ps ...predicate.ERPSettlementLine) *ERPSettlementLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (_u *ERPSettlementLineUpdate) SetSettlementID(v int) *ERPSettlementLineUpdate {
+	_u.mutation.ResetSettlementID()
+	_u.mutation.SetSettlementID(v)
+	return _u
+}
+
+// SetNillableSettlementID sets the "settlement_id" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableSettlementID(v *int) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetSettlementID(*v)
+	}
+	return _u
+}
+
+// AddSettlementID adds value to the "settlement_id" field.
+func (_u *ERPSettlementLineUpdate) AddSettlementID(v int) *ERPSettlementLineUpdate {
+	_u.mutation.AddSettlementID(v)
+	return _u
+}
+
+// SetLineNo sets the "line_no" field.
+func (_u *ERPSettlementLineUpdate) SetLineNo(v int) *ERPSettlementLineUpdate {
+	_u.mutation.ResetLineNo()
+	_u.mutation.SetLineNo(v)
+	return _u
+}
+
+// SetNillableLineNo sets the "line_no" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableLineNo(v *int) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetLineNo(*v)
+	}
+	return _u
+}
+
+// AddLineNo adds value to the "line_no" field.
+func (_u *ERPSettlementLineUpdate) AddLineNo(v int) *ERPSettlementLineUpdate {
+	_u.mutation.AddLineNo(v)
+	return _u
+}
+
+// SetShipmentCode sets the "shipment_code" field.
+func (_u *ERPSettlementLineUpdate) SetShipmentCode(v string) *ERPSettlementLineUpdate {
+	_u.mutation.SetShipmentCode(v)
+	return _u
+}
+
+// SetNillableShipmentCode sets the "shipment_code" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableShipmentCode(v *string) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetShipmentCode(*v)
+	}
+	return _u
+}
+
+// ClearShipmentCode clears the value of the "shipment_code" field.
+func (_u *ERPSettlementLineUpdate) ClearShipmentCode() *ERPSettlementLineUpdate {
+	_u.mutation.ClearShipmentCode()
+	return _u
+}
+
+// SetShipmentLineNo sets the "shipment_line_no" field.
+func (_u *ERPSettlementLineUpdate) SetShipmentLineNo(v int) *ERPSettlementLineUpdate {
+	_u.mutation.ResetShipmentLineNo()
+	_u.mutation.SetShipmentLineNo(v)
+	return _u
+}
+
+// SetNillableShipmentLineNo sets the "shipment_line_no" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableShipmentLineNo(v *int) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetShipmentLineNo(*v)
+	}
+	return _u
+}
+
+// AddShipmentLineNo adds value to the "shipment_line_no" field.
+func (_u *ERPSettlementLineUpdate) AddShipmentLineNo(v int) *ERPSettlementLineUpdate {
+	_u.mutation.AddShipmentLineNo(v)
+	return _u
+}
+
+// ClearShipmentLineNo clears the value of the "shipment_line_no" field.
+func (_u *ERPSettlementLineUpdate) ClearShipmentLineNo() *ERPSettlementLineUpdate {
+	_u.mutation.ClearShipmentLineNo()
+	return _u
+}
+
+// SetShipmentDetailItemID sets the "shipment_detail_item_id" field.
+func (_u *ERPSettlementLineUpdate) SetShipmentDetailItemID(v int) *ERPSettlementLineUpdate {
+	_u.mutation.ResetShipmentDetailItemID()
+	_u.mutation.SetShipmentDetailItemID(v)
+	return _u
+}
+
+// SetNillableShipmentDetailItemID sets the "shipment_detail_item_id" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableShipmentDetailItemID(v *int) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetShipmentDetailItemID(*v)
+	}
+	return _u
+}
+
+// AddShipmentDetailItemID adds value to the "shipment_detail_item_id" field.
+func (_u *ERPSettlementLineUpdate) AddShipmentDetailItemID(v int) *ERPSettlementLineUpdate {
+	_u.mutation.AddShipmentDetailItemID(v)
+	return _u
+}
+
+// ClearShipmentDetailItemID clears the value of the "shipment_detail_item_id" field.
+func (_u *ERPSettlementLineUpdate) ClearShipmentDetailItemID() *ERPSettlementLineUpdate {
+	_u.mutation.ClearShipmentDetailItemID()
+	return _u
+}
+
+// SetProductModel sets the "product_model" field.
+func (_u *ERPSettlementLineUpdate) SetProductModel(v string) *ERPSettlementLineUpdate {
+	_u.mutation.SetProductModel(v)
+	return _u
+}
+
+// SetNillableProductModel sets the "product_model" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableProductModel(v *string) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetProductModel(*v)
+	}
+	return _u
+}
+
+// ClearProductModel clears the value of the "product_model" field.
+func (_u *ERPSettlementLineUpdate) ClearProductModel() *ERPSettlementLineUpdate {
+	_u.mutation.ClearProductModel()
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *ERPSettlementLineUpdate) SetQuantity(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableQuantity(v *float64) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *ERPSettlementLineUpdate) AddQuantity(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *ERPSettlementLineUpdate) SetUnitPrice(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableUnitPrice(v *float64) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *ERPSettlementLineUpdate) AddUnitPrice(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *ERPSettlementLineUpdate) SetAmount(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableAmount(v *float64) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *ERPSettlementLineUpdate) AddAmount(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetReceivedAmount sets the "received_amount" field.
+func (_u *ERPSettlementLineUpdate) SetReceivedAmount(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.ResetReceivedAmount()
+	_u.mutation.SetReceivedAmount(v)
+	return _u
+}
+
+// SetNillableReceivedAmount sets the "received_amount" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableReceivedAmount(v *float64) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetReceivedAmount(*v)
+	}
+	return _u
+}
+
+// AddReceivedAmount adds value to the "received_amount" field.
+func (_u *ERPSettlementLineUpdate) AddReceivedAmount(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.AddReceivedAmount(v)
+	return _u
+}
+
+// SetOutstandingAmount sets the "outstanding_amount" field.
+func (_u *ERPSettlementLineUpdate) SetOutstandingAmount(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.ResetOutstandingAmount()
+	_u.mutation.SetOutstandingAmount(v)
+	return _u
+}
+
+// SetNillableOutstandingAmount sets the "outstanding_amount" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableOutstandingAmount(v *float64) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetOutstandingAmount(*v)
+	}
+	return _u
+}
+
+// AddOutstandingAmount adds value to the "outstanding_amount" field.
+func (_u *ERPSettlementLineUpdate) AddOutstandingAmount(v float64) *ERPSettlementLineUpdate {
+	_u.mutation.AddOutstandingAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ERPSettlementLineUpdate) SetStatus(v string) *ERPSettlementLineUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdate) SetNillableStatus(v *string) *ERPSettlementLineUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ERPSettlementLineUpdate) SetUpdatedAt(v time.Time) *ERPSettlementLineUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ERPSettlementLineMutation object of the builder.
+func (_u *ERPSettlementLineUpdate) Mutation() *ERPSettlementLineMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ERPSettlementLineUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPSettlementLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ERPSettlementLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPSettlementLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ERPSettlementLineUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := erpsettlementline.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPSettlementLineUpdate) check() error {
+	if v, ok := _u.mutation.SettlementID(); ok {
+		if err := erpsettlementline.SettlementIDValidator(v); err != nil {
+			return &ValidationError{Name: "settlement_id", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.settlement_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LineNo(); ok {
+		if err := erpsettlementline.LineNoValidator(v); err != nil {
+			return &ValidationError{Name: "line_no", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.line_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShipmentCode(); ok {
+		if err := erpsettlementline.ShipmentCodeValidator(v); err != nil {
+			return &ValidationError{Name: "shipment_code", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.shipment_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProductModel(); ok {
+		if err := erpsettlementline.ProductModelValidator(v); err != nil {
+			return &ValidationError{Name: "product_model", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.product_model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := erpsettlementline.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPSettlementLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erpsettlementline.Table, erpsettlementline.Columns, sqlgraph.NewFieldSpec(erpsettlementline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SettlementID(); ok {
+		_spec.SetField(erpsettlementline.FieldSettlementID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSettlementID(); ok {
+		_spec.AddField(erpsettlementline.FieldSettlementID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LineNo(); ok {
+		_spec.SetField(erpsettlementline.FieldLineNo, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLineNo(); ok {
+		_spec.AddField(erpsettlementline.FieldLineNo, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShipmentCode(); ok {
+		_spec.SetField(erpsettlementline.FieldShipmentCode, field.TypeString, value)
+	}
+	if _u.mutation.ShipmentCodeCleared() {
+		_spec.ClearField(erpsettlementline.FieldShipmentCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShipmentLineNo(); ok {
+		_spec.SetField(erpsettlementline.FieldShipmentLineNo, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShipmentLineNo(); ok {
+		_spec.AddField(erpsettlementline.FieldShipmentLineNo, field.TypeInt, value)
+	}
+	if _u.mutation.ShipmentLineNoCleared() {
+		_spec.ClearField(erpsettlementline.FieldShipmentLineNo, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ShipmentDetailItemID(); ok {
+		_spec.SetField(erpsettlementline.FieldShipmentDetailItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShipmentDetailItemID(); ok {
+		_spec.AddField(erpsettlementline.FieldShipmentDetailItemID, field.TypeInt, value)
+	}
+	if _u.mutation.ShipmentDetailItemIDCleared() {
+		_spec.ClearField(erpsettlementline.FieldShipmentDetailItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ProductModel(); ok {
+		_spec.SetField(erpsettlementline.FieldProductModel, field.TypeString, value)
+	}
+	if _u.mutation.ProductModelCleared() {
+		_spec.ClearField(erpsettlementline.FieldProductModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(erpsettlementline.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(erpsettlementline.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(erpsettlementline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(erpsettlementline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(erpsettlementline.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(erpsettlementline.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ReceivedAmount(); ok {
+		_spec.SetField(erpsettlementline.FieldReceivedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReceivedAmount(); ok {
+		_spec.AddField(erpsettlementline.FieldReceivedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OutstandingAmount(); ok {
+		_spec.SetField(erpsettlementline.FieldOutstandingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutstandingAmount(); ok {
+		_spec.AddField(erpsettlementline.FieldOutstandingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(erpsettlementline.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(erpsettlementline.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erpsettlementline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ERPSettlementLineUpdateOne is the builder for updating a single ERPSettlementLine entity.
+type ERPSettlementLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ERPSettlementLineMutation
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (_u *ERPSettlementLineUpdateOne) SetSettlementID(v int) *ERPSettlementLineUpdateOne {
+	_u.mutation.ResetSettlementID()
+	_u.mutation.SetSettlementID(v)
+	return _u
+}
+
+// SetNillableSettlementID sets the "settlement_id" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableSettlementID(v *int) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetSettlementID(*v)
+	}
+	return _u
+}
+
+// AddSettlementID adds value to the "settlement_id" field.
+func (_u *ERPSettlementLineUpdateOne) AddSettlementID(v int) *ERPSettlementLineUpdateOne {
+	_u.mutation.AddSettlementID(v)
+	return _u
+}
+
+// SetLineNo sets the "line_no" field.
+func (_u *ERPSettlementLineUpdateOne) SetLineNo(v int) *ERPSettlementLineUpdateOne {
+	_u.mutation.ResetLineNo()
+	_u.mutation.SetLineNo(v)
+	return _u
+}
+
+// SetNillableLineNo sets the "line_no" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableLineNo(v *int) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetLineNo(*v)
+	}
+	return _u
+}
+
+// AddLineNo adds value to the "line_no" field.
+func (_u *ERPSettlementLineUpdateOne) AddLineNo(v int) *ERPSettlementLineUpdateOne {
+	_u.mutation.AddLineNo(v)
+	return _u
+}
+
+// SetShipmentCode sets the "shipment_code" field.
+func (_u *ERPSettlementLineUpdateOne) SetShipmentCode(v string) *ERPSettlementLineUpdateOne {
+	_u.mutation.SetShipmentCode(v)
+	return _u
+}
+
+// SetNillableShipmentCode sets the "shipment_code" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableShipmentCode(v *string) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetShipmentCode(*v)
+	}
+	return _u
+}
+
+// ClearShipmentCode clears the value of the "shipment_code" field.
+func (_u *ERPSettlementLineUpdateOne) ClearShipmentCode() *ERPSettlementLineUpdateOne {
+	_u.mutation.ClearShipmentCode()
+	return _u
+}
+
+// SetShipmentLineNo sets the "shipment_line_no" field.
+func (_u *ERPSettlementLineUpdateOne) SetShipmentLineNo(v int) *ERPSettlementLineUpdateOne {
+	_u.mutation.ResetShipmentLineNo()
+	_u.mutation.SetShipmentLineNo(v)
+	return _u
+}
+
+// SetNillableShipmentLineNo sets the "shipment_line_no" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableShipmentLineNo(v *int) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetShipmentLineNo(*v)
+	}
+	return _u
+}
+
+// AddShipmentLineNo adds value to the "shipment_line_no" field.
+func (_u *ERPSettlementLineUpdateOne) AddShipmentLineNo(v int) *ERPSettlementLineUpdateOne {
+	_u.mutation.AddShipmentLineNo(v)
+	return _u
+}
+
+// ClearShipmentLineNo clears the value of the "shipment_line_no" field.
+func (_u *ERPSettlementLineUpdateOne) ClearShipmentLineNo() *ERPSettlementLineUpdateOne {
+	_u.mutation.ClearShipmentLineNo()
+	return _u
+}
+
+// SetShipmentDetailItemID sets the "shipment_detail_item_id" field.
+func (_u *ERPSettlementLineUpdateOne) SetShipmentDetailItemID(v int) *ERPSettlementLineUpdateOne {
+	_u.mutation.ResetShipmentDetailItemID()
+	_u.mutation.SetShipmentDetailItemID(v)
+	return _u
+}
+
+// SetNillableShipmentDetailItemID sets the "shipment_detail_item_id" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableShipmentDetailItemID(v *int) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetShipmentDetailItemID(*v)
+	}
+	return _u
+}
+
+// AddShipmentDetailItemID adds value to the "shipment_detail_item_id" field.
+func (_u *ERPSettlementLineUpdateOne) AddShipmentDetailItemID(v int) *ERPSettlementLineUpdateOne {
+	_u.mutation.AddShipmentDetailItemID(v)
+	return _u
+}
+
+// ClearShipmentDetailItemID clears the value of the "shipment_detail_item_id" field.
+func (_u *ERPSettlementLineUpdateOne) ClearShipmentDetailItemID() *ERPSettlementLineUpdateOne {
+	_u.mutation.ClearShipmentDetailItemID()
+	return _u
+}
+
+// SetProductModel sets the "product_model" field.
+func (_u *ERPSettlementLineUpdateOne) SetProductModel(v string) *ERPSettlementLineUpdateOne {
+	_u.mutation.SetProductModel(v)
+	return _u
+}
+
+// SetNillableProductModel sets the "product_model" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableProductModel(v *string) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetProductModel(*v)
+	}
+	return _u
+}
+
+// ClearProductModel clears the value of the "product_model" field.
+func (_u *ERPSettlementLineUpdateOne) ClearProductModel() *ERPSettlementLineUpdateOne {
+	_u.mutation.ClearProductModel()
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *ERPSettlementLineUpdateOne) SetQuantity(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableQuantity(v *float64) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *ERPSettlementLineUpdateOne) AddQuantity(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *ERPSettlementLineUpdateOne) SetUnitPrice(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableUnitPrice(v *float64) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *ERPSettlementLineUpdateOne) AddUnitPrice(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *ERPSettlementLineUpdateOne) SetAmount(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableAmount(v *float64) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *ERPSettlementLineUpdateOne) AddAmount(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetReceivedAmount sets the "received_amount" field.
+func (_u *ERPSettlementLineUpdateOne) SetReceivedAmount(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.ResetReceivedAmount()
+	_u.mutation.SetReceivedAmount(v)
+	return _u
+}
+
+// SetNillableReceivedAmount sets the "received_amount" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableReceivedAmount(v *float64) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetReceivedAmount(*v)
+	}
+	return _u
+}
+
+// AddReceivedAmount adds value to the "received_amount" field.
+func (_u *ERPSettlementLineUpdateOne) AddReceivedAmount(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.AddReceivedAmount(v)
+	return _u
+}
+
+// SetOutstandingAmount sets the "outstanding_amount" field.
+func (_u *ERPSettlementLineUpdateOne) SetOutstandingAmount(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.ResetOutstandingAmount()
+	_u.mutation.SetOutstandingAmount(v)
+	return _u
+}
+
+// SetNillableOutstandingAmount sets the "outstanding_amount" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableOutstandingAmount(v *float64) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetOutstandingAmount(*v)
+	}
+	return _u
+}
+
+// AddOutstandingAmount adds value to the "outstanding_amount" field.
+func (_u *ERPSettlementLineUpdateOne) AddOutstandingAmount(v float64) *ERPSettlementLineUpdateOne {
+	_u.mutation.AddOutstandingAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ERPSettlementLineUpdateOne) SetStatus(v string) *ERPSettlementLineUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ERPSettlementLineUpdateOne) SetNillableStatus(v *string) *ERPSettlementLineUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ERPSettlementLineUpdateOne) SetUpdatedAt(v time.Time) *ERPSettlementLineUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ERPSettlementLineMutation object of the builder.
+func (_u *ERPSettlementLineUpdateOne) Mutation() *ERPSettlementLineMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ERPSettlementLineUpdate builder.
+func (_u *ERPSettlementLineUpdateOne) Where(ps ...predicate.ERPSettlementLine) *ERPSettlementLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ERPSettlementLineUpdateOne) Select(field string, fields ...string) *ERPSettlementLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ERPSettlementLine entity.
+func (_u *ERPSettlementLineUpdateOne) Save(ctx context.Context) (*ERPSettlementLine, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ERPSettlementLineUpdateOne) SaveX(ctx context.Context) *ERPSettlementLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ERPSettlementLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ERPSettlementLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ERPSettlementLineUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := erpsettlementline.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ERPSettlementLineUpdateOne) check() error {
+	if v, ok := _u.mutation.SettlementID(); ok {
+		if err := erpsettlementline.SettlementIDValidator(v); err != nil {
+			return &ValidationError{Name: "settlement_id", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.settlement_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LineNo(); ok {
+		if err := erpsettlementline.LineNoValidator(v); err != nil {
+			return &ValidationError{Name: "line_no", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.line_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShipmentCode(); ok {
+		if err := erpsettlementline.ShipmentCodeValidator(v); err != nil {
+			return &ValidationError{Name: "shipment_code", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.shipment_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProductModel(); ok {
+		if err := erpsettlementline.ProductModelValidator(v); err != nil {
+			return &ValidationError{Name: "product_model", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.product_model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := erpsettlementline.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ERPSettlementLine.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ERPSettlementLineUpdateOne) sqlSave(ctx context.Context) (_node *ERPSettlementLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(erpsettlementline.Table, erpsettlementline.Columns, sqlgraph.NewFieldSpec(erpsettlementline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ERPSettlementLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, erpsettlementline.FieldID)
+		for _, f := range fields {
+			if !erpsettlementline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != erpsettlementline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SettlementID(); ok {
+		_spec.SetField(erpsettlementline.FieldSettlementID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSettlementID(); ok {
+		_spec.AddField(erpsettlementline.FieldSettlementID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LineNo(); ok {
+		_spec.SetField(erpsettlementline.FieldLineNo, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLineNo(); ok {
+		_spec.AddField(erpsettlementline.FieldLineNo, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShipmentCode(); ok {
+		_spec.SetField(erpsettlementline.FieldShipmentCode, field.TypeString, value)
+	}
+	if _u.mutation.ShipmentCodeCleared() {
+		_spec.ClearField(erpsettlementline.FieldShipmentCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShipmentLineNo(); ok {
+		_spec.SetField(erpsettlementline.FieldShipmentLineNo, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShipmentLineNo(); ok {
+		_spec.AddField(erpsettlementline.FieldShipmentLineNo, field.TypeInt, value)
+	}
+	if _u.mutation.ShipmentLineNoCleared() {
+		_spec.ClearField(erpsettlementline.FieldShipmentLineNo, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ShipmentDetailItemID(); ok {
+		_spec.SetField(erpsettlementline.FieldShipmentDetailItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShipmentDetailItemID(); ok {
+		_spec.AddField(erpsettlementline.FieldShipmentDetailItemID, field.TypeInt, value)
+	}
+	if _u.mutation.ShipmentDetailItemIDCleared() {
+		_spec.ClearField(erpsettlementline.FieldShipmentDetailItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ProductModel(); ok {
+		_spec.SetField(erpsettlementline.FieldProductModel, field.TypeString, value)
+	}
+	if _u.mutation.ProductModelCleared() {
+		_spec.ClearField(erpsettlementline.FieldProductModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(erpsettlementline.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(erpsettlementline.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(erpsettlementline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(erpsettlementline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(erpsettlementline.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(erpsettlementline.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ReceivedAmount(); ok {
+		_spec.SetField(erpsettlementline.FieldReceivedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReceivedAmount(); ok {
+		_spec.AddField(erpsettlementline.FieldReceivedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OutstandingAmount(); ok {
+		_spec.SetField(erpsettlementline.FieldOutstandingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutstandingAmount(); ok {
+		_spec.AddField(erpsettlementline.FieldOutstandingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(erpsettlementline.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(erpsettlementline.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ERPSettlementLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{erpsettlementline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
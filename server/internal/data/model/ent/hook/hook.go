@@ -8,6 +8,66 @@ import (
 	"server/internal/data/model/ent"
 )
 
+// The AdminLoginAttemptFunc type is an adapter to allow the use of ordinary
+// function as AdminLoginAttempt mutator.
+type AdminLoginAttemptFunc func(context.Context, *ent.AdminLoginAttemptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminLoginAttemptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminLoginAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminLoginAttemptMutation", m)
+}
+
+// The AdminRecoveryCodeFunc type is an adapter to allow the use of ordinary
+// function as AdminRecoveryCode mutator.
+type AdminRecoveryCodeFunc func(context.Context, *ent.AdminRecoveryCodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminRecoveryCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminRecoveryCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminRecoveryCodeMutation", m)
+}
+
+// The AdminRoleFunc type is an adapter to allow the use of ordinary
+// function as AdminRole mutator.
+type AdminRoleFunc func(context.Context, *ent.AdminRoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminRoleMutation", m)
+}
+
+// The AdminRolePermissionFunc type is an adapter to allow the use of ordinary
+// function as AdminRolePermission mutator.
+type AdminRolePermissionFunc func(context.Context, *ent.AdminRolePermissionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminRolePermissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminRolePermissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminRolePermissionMutation", m)
+}
+
+// The AdminSessionFunc type is an adapter to allow the use of ordinary
+// function as AdminSession mutator.
+type AdminSessionFunc func(context.Context, *ent.AdminSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminSessionMutation", m)
+}
+
 // The AdminUserFunc type is an adapter to allow the use of ordinary
 // function as AdminUser mutator.
 type AdminUserFunc func(context.Context, *ent.AdminUserMutation) (ent.Value, error)
@@ -20,6 +80,18 @@ func (f AdminUserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminUserMutation", m)
 }
 
+// The AdminUserRoleFunc type is an adapter to allow the use of ordinary
+// function as AdminUserRole mutator.
+type AdminUserRoleFunc func(context.Context, *ent.AdminUserRoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminUserRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminUserRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminUserRoleMutation", m)
+}
+
 // The ERPAttachmentFunc type is an adapter to allow the use of ordinary
 // function as ERPAttachment mutator.
 type ERPAttachmentFunc func(context.Context, *ent.ERPAttachmentMutation) (ent.Value, error)
@@ -32,6 +104,18 @@ func (f ERPAttachmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPAttachmentMutation", m)
 }
 
+// The ERPAuditLogFunc type is an adapter to allow the use of ordinary
+// function as ERPAuditLog mutator.
+type ERPAuditLogFunc func(context.Context, *ent.ERPAuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ERPAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ERPAuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPAuditLogMutation", m)
+}
+
 // The ERPBankReceiptFunc type is an adapter to allow the use of ordinary
 // function as ERPBankReceipt mutator.
 type ERPBankReceiptFunc func(context.Context, *ent.ERPBankReceiptMutation) (ent.Value, error)
@@ -176,6 +260,42 @@ func (f ERPPartnerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPPartnerMutation", m)
 }
 
+// The ERPPartnerAddressFunc type is an adapter to allow the use of ordinary
+// function as ERPPartnerAddress mutator.
+type ERPPartnerAddressFunc func(context.Context, *ent.ERPPartnerAddressMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ERPPartnerAddressFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ERPPartnerAddressMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPPartnerAddressMutation", m)
+}
+
+// The ERPPartnerBankAccountFunc type is an adapter to allow the use of ordinary
+// function as ERPPartnerBankAccount mutator.
+type ERPPartnerBankAccountFunc func(context.Context, *ent.ERPPartnerBankAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ERPPartnerBankAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ERPPartnerBankAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPPartnerBankAccountMutation", m)
+}
+
+// The ERPPartnerContactFunc type is an adapter to allow the use of ordinary
+// function as ERPPartnerContact mutator.
+type ERPPartnerContactFunc func(context.Context, *ent.ERPPartnerContactMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ERPPartnerContactFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ERPPartnerContactMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPPartnerContactMutation", m)
+}
+
 // The ERPProductFunc type is an adapter to allow the use of ordinary
 // function as ERPProduct mutator.
 type ERPProductFunc func(context.Context, *ent.ERPProductMutation) (ent.Value, error)
@@ -236,6 +356,18 @@ func (f ERPQuotationItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPQuotationItemMutation", m)
 }
 
+// The ERPRecordRevisionFunc type is an adapter to allow the use of ordinary
+// function as ERPRecordRevision mutator.
+type ERPRecordRevisionFunc func(context.Context, *ent.ERPRecordRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ERPRecordRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ERPRecordRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPRecordRevisionMutation", m)
+}
+
 // The ERPSequenceFunc type is an adapter to allow the use of ordinary
 // function as ERPSequence mutator.
 type ERPSequenceFunc func(context.Context, *ent.ERPSequenceMutation) (ent.Value, error)
@@ -260,6 +392,18 @@ func (f ERPSettlementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPSettlementMutation", m)
 }
 
+// The ERPSettlementLineFunc type is an adapter to allow the use of ordinary
+// function as ERPSettlementLine mutator.
+type ERPSettlementLineFunc func(context.Context, *ent.ERPSettlementLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ERPSettlementLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ERPSettlementLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ERPSettlementLineMutation", m)
+}
+
 // The ERPShipmentDetailFunc type is an adapter to allow the use of ordinary
 // function as ERPShipmentDetail mutator.
 type ERPShipmentDetailFunc func(context.Context, *ent.ERPShipmentDetailMutation) (ent.Value, error)
@@ -8,6 +8,138 @@ import (
 )
 
 var (
+	// AdminLoginAttemptsColumns holds the columns for the "admin_login_attempts" table.
+	AdminLoginAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString, Size: 64},
+		{Name: "admin_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "success", Type: field.TypeBool, Default: false},
+		{Name: "reason", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "ip", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AdminLoginAttemptsTable holds the schema information for the "admin_login_attempts" table.
+	AdminLoginAttemptsTable = &schema.Table{
+		Name:       "admin_login_attempts",
+		Columns:    AdminLoginAttemptsColumns,
+		PrimaryKey: []*schema.Column{AdminLoginAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminloginattempt_admin_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminLoginAttemptsColumns[2], AdminLoginAttemptsColumns[7]},
+			},
+			{
+				Name:    "adminloginattempt_username_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminLoginAttemptsColumns[1], AdminLoginAttemptsColumns[7]},
+			},
+		},
+	}
+	// AdminRecoveryCodesColumns holds the columns for the "admin_recovery_codes" table.
+	AdminRecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "admin_user_id", Type: field.TypeInt},
+		{Name: "code_hash", Type: field.TypeString, Size: 64},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AdminRecoveryCodesTable holds the schema information for the "admin_recovery_codes" table.
+	AdminRecoveryCodesTable = &schema.Table{
+		Name:       "admin_recovery_codes",
+		Columns:    AdminRecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{AdminRecoveryCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminrecoverycode_admin_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdminRecoveryCodesColumns[1]},
+			},
+			{
+				Name:    "adminrecoverycode_code_hash",
+				Unique:  true,
+				Columns: []*schema.Column{AdminRecoveryCodesColumns[2]},
+			},
+		},
+	}
+	// AdminRolesColumns holds the columns for the "admin_roles" table.
+	AdminRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role_key", Type: field.TypeString, Size: 64},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "builtin", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AdminRolesTable holds the schema information for the "admin_roles" table.
+	AdminRolesTable = &schema.Table{
+		Name:       "admin_roles",
+		Columns:    AdminRolesColumns,
+		PrimaryKey: []*schema.Column{AdminRolesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminrole_role_key",
+				Unique:  true,
+				Columns: []*schema.Column{AdminRolesColumns[1]},
+			},
+		},
+	}
+	// AdminRolePermissionsColumns holds the columns for the "admin_role_permissions" table.
+	AdminRolePermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role_id", Type: field.TypeInt},
+		{Name: "module_key", Type: field.TypeString, Size: 64},
+		{Name: "action", Type: field.TypeString, Size: 32},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AdminRolePermissionsTable holds the schema information for the "admin_role_permissions" table.
+	AdminRolePermissionsTable = &schema.Table{
+		Name:       "admin_role_permissions",
+		Columns:    AdminRolePermissionsColumns,
+		PrimaryKey: []*schema.Column{AdminRolePermissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminrolepermission_role_id_module_key_action",
+				Unique:  true,
+				Columns: []*schema.Column{AdminRolePermissionsColumns[1], AdminRolePermissionsColumns[2], AdminRolePermissionsColumns[3]},
+			},
+		},
+	}
+	// AdminSessionsColumns holds the columns for the "admin_sessions" table.
+	AdminSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "session_key", Type: field.TypeString, Size: 64},
+		{Name: "admin_user_id", Type: field.TypeInt},
+		{Name: "access_token_id", Type: field.TypeString, Size: 64},
+		{Name: "refresh_token_hash", Type: field.TypeString, Size: 64},
+		{Name: "ip", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoke_reason", Type: field.TypeString, Size: 32, Default: ""},
+	}
+	// AdminSessionsTable holds the schema information for the "admin_sessions" table.
+	AdminSessionsTable = &schema.Table{
+		Name:       "admin_sessions",
+		Columns:    AdminSessionsColumns,
+		PrimaryKey: []*schema.Column{AdminSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminsession_session_key",
+				Unique:  true,
+				Columns: []*schema.Column{AdminSessionsColumns[1]},
+			},
+			{
+				Name:    "adminsession_admin_user_id_revoked_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminSessionsColumns[2], AdminSessionsColumns[10]},
+			},
+		},
+	}
 	// AdminUsersColumns holds the columns for the "admin_users" table.
 	AdminUsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -15,9 +147,17 @@ var (
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "level", Type: field.TypeInt8, Default: 2},
 		{Name: "menu_permissions", Type: field.TypeString, Size: 4096, Default: ""},
+		{Name: "role_based", Type: field.TypeBool, Default: false},
 		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
 		{Name: "disabled", Type: field.TypeBool, Default: false},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "must_change_password", Type: field.TypeBool, Default: false},
+		{Name: "password_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -40,7 +180,32 @@ var (
 			{
 				Name:    "adminuser_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{AdminUsersColumns[5]},
+				Columns: []*schema.Column{AdminUsersColumns[6]},
+			},
+		},
+	}
+	// AdminUserRolesColumns holds the columns for the "admin_user_roles" table.
+	AdminUserRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "admin_user_id", Type: field.TypeInt},
+		{Name: "role_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AdminUserRolesTable holds the schema information for the "admin_user_roles" table.
+	AdminUserRolesTable = &schema.Table{
+		Name:       "admin_user_roles",
+		Columns:    AdminUserRolesColumns,
+		PrimaryKey: []*schema.Column{AdminUserRolesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminuserrole_admin_user_id_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{AdminUserRolesColumns[1], AdminUserRolesColumns[2]},
+			},
+			{
+				Name:    "adminuserrole_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdminUserRolesColumns[2]},
 			},
 		},
 	}
@@ -75,6 +240,48 @@ var (
 			},
 		},
 	}
+	// ErpAuditLogsColumns holds the columns for the "erp_audit_logs" table.
+	ErpAuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeString, Size: 16},
+		{Name: "module_key", Type: field.TypeString, Size: 64},
+		{Name: "record_id", Type: field.TypeInt},
+		{Name: "code", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "admin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "admin_username", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "request_id", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "ip", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "changes", Type: field.TypeString, Size: 2147483647, Default: "[]"},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ErpAuditLogsTable holds the schema information for the "erp_audit_logs" table.
+	ErpAuditLogsTable = &schema.Table{
+		Name:       "erp_audit_logs",
+		Columns:    ErpAuditLogsColumns,
+		PrimaryKey: []*schema.Column{ErpAuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "erpauditlog_module_key_record_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ErpAuditLogsColumns[2], ErpAuditLogsColumns[3], ErpAuditLogsColumns[10]},
+			},
+			{
+				Name:    "erpauditlog_module_key_code",
+				Unique:  false,
+				Columns: []*schema.Column{ErpAuditLogsColumns[2], ErpAuditLogsColumns[4]},
+			},
+			{
+				Name:    "erpauditlog_admin_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ErpAuditLogsColumns[5], ErpAuditLogsColumns[10]},
+			},
+			{
+				Name:    "erpauditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ErpAuditLogsColumns[10]},
+			},
+		},
+	}
 	// ErpBankReceiptsColumns holds the columns for the "erp_bank_receipts" table.
 	ErpBankReceiptsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -120,6 +327,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "receipt_id", Type: field.TypeInt},
 		{Name: "settlement_id", Type: field.TypeInt, Nullable: true},
+		{Name: "settlement_line_id", Type: field.TypeInt, Nullable: true},
 		{Name: "claim_type", Type: field.TypeString, Size: 32},
 		{Name: "claim_amount", Type: field.TypeFloat64, SchemaType: map[string]string{"mysql": "decimal(20,6)"}},
 		{Name: "confirmed", Type: field.TypeBool, Default: false},
@@ -146,10 +354,15 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{ErpBankReceiptClaimsColumns[2]},
 			},
+			{
+				Name:    "erpbankreceiptclaim_settlement_line_id",
+				Unique:  false,
+				Columns: []*schema.Column{ErpBankReceiptClaimsColumns[3]},
+			},
 			{
 				Name:    "erpbankreceiptclaim_confirmed_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ErpBankReceiptClaimsColumns[5], ErpBankReceiptClaimsColumns[10]},
+				Columns: []*schema.Column{ErpBankReceiptClaimsColumns[6], ErpBankReceiptClaimsColumns[11]},
 			},
 		},
 	}
@@ -403,6 +616,7 @@ var (
 		{Name: "payload", Type: field.TypeString, Size: 2147483647, Default: "{}"},
 		{Name: "created_by_admin_id", Type: field.TypeInt, Nullable: true},
 		{Name: "updated_by_admin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -548,6 +762,93 @@ var (
 			},
 		},
 	}
+	// ErpPartnerAddressesColumns holds the columns for the "erp_partner_addresses" table.
+	ErpPartnerAddressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "partner_id", Type: field.TypeInt},
+		{Name: "sort_order", Type: field.TypeInt},
+		{Name: "label", Type: field.TypeString, Size: 64},
+		{Name: "address_type", Type: field.TypeString, Size: 16},
+		{Name: "party_name", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "address", Type: field.TypeString, Size: 255},
+		{Name: "country", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "contact", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "phone", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ErpPartnerAddressesTable holds the schema information for the "erp_partner_addresses" table.
+	ErpPartnerAddressesTable = &schema.Table{
+		Name:       "erp_partner_addresses",
+		Columns:    ErpPartnerAddressesColumns,
+		PrimaryKey: []*schema.Column{ErpPartnerAddressesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "erppartneraddress_partner_id_label",
+				Unique:  true,
+				Columns: []*schema.Column{ErpPartnerAddressesColumns[1], ErpPartnerAddressesColumns[3]},
+			},
+			{
+				Name:    "erppartneraddress_partner_id_address_type",
+				Unique:  false,
+				Columns: []*schema.Column{ErpPartnerAddressesColumns[1], ErpPartnerAddressesColumns[4]},
+			},
+		},
+	}
+	// ErpPartnerBankAccountsColumns holds the columns for the "erp_partner_bank_accounts" table.
+	ErpPartnerBankAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "partner_id", Type: field.TypeInt},
+		{Name: "sort_order", Type: field.TypeInt},
+		{Name: "bank_name", Type: field.TypeString, Size: 128},
+		{Name: "account_name", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "account_no", Type: field.TypeString, Size: 64},
+		{Name: "swift_code", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "currency", Type: field.TypeString, Nullable: true, Size: 16},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ErpPartnerBankAccountsTable holds the schema information for the "erp_partner_bank_accounts" table.
+	ErpPartnerBankAccountsTable = &schema.Table{
+		Name:       "erp_partner_bank_accounts",
+		Columns:    ErpPartnerBankAccountsColumns,
+		PrimaryKey: []*schema.Column{ErpPartnerBankAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "erppartnerbankaccount_partner_id_account_no",
+				Unique:  true,
+				Columns: []*schema.Column{ErpPartnerBankAccountsColumns[1], ErpPartnerBankAccountsColumns[5]},
+			},
+		},
+	}
+	// ErpPartnerContactsColumns holds the columns for the "erp_partner_contacts" table.
+	ErpPartnerContactsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "partner_id", Type: field.TypeInt},
+		{Name: "sort_order", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "role", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "phone", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ErpPartnerContactsTable holds the schema information for the "erp_partner_contacts" table.
+	ErpPartnerContactsTable = &schema.Table{
+		Name:       "erp_partner_contacts",
+		Columns:    ErpPartnerContactsColumns,
+		PrimaryKey: []*schema.Column{ErpPartnerContactsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "erppartnercontact_partner_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ErpPartnerContactsColumns[1], ErpPartnerContactsColumns[3]},
+			},
+		},
+	}
 	// ErpProductsColumns holds the columns for the "erp_products" table.
 	ErpProductsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -761,6 +1062,36 @@ var (
 			},
 		},
 	}
+	// ErpRecordRevisionsColumns holds the columns for the "erp_record_revisions" table.
+	ErpRecordRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "module_key", Type: field.TypeString, Size: 64},
+		{Name: "record_id", Type: field.TypeInt},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "code", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "saved_by_admin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "saved_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ErpRecordRevisionsTable holds the schema information for the "erp_record_revisions" table.
+	ErpRecordRevisionsTable = &schema.Table{
+		Name:       "erp_record_revisions",
+		Columns:    ErpRecordRevisionsColumns,
+		PrimaryKey: []*schema.Column{ErpRecordRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "erprecordrevision_record_id_revision",
+				Unique:  true,
+				Columns: []*schema.Column{ErpRecordRevisionsColumns[2], ErpRecordRevisionsColumns[3]},
+			},
+			{
+				Name:    "erprecordrevision_module_key_record_id",
+				Unique:  false,
+				Columns: []*schema.Column{ErpRecordRevisionsColumns[1], ErpRecordRevisionsColumns[2]},
+			},
+		},
+	}
 	// ErpSequencesColumns holds the columns for the "erp_sequences" table.
 	ErpSequencesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -829,6 +1160,47 @@ var (
 			},
 		},
 	}
+	// ErpSettlementLinesColumns holds the columns for the "erp_settlement_lines" table.
+	ErpSettlementLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "settlement_id", Type: field.TypeInt},
+		{Name: "line_no", Type: field.TypeInt},
+		{Name: "shipment_code", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "shipment_line_no", Type: field.TypeInt, Nullable: true},
+		{Name: "shipment_detail_item_id", Type: field.TypeInt, Nullable: true},
+		{Name: "product_model", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "quantity", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"mysql": "decimal(20,6)"}},
+		{Name: "unit_price", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"mysql": "decimal(20,6)"}},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"mysql": "decimal(20,6)"}},
+		{Name: "received_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"mysql": "decimal(20,6)"}},
+		{Name: "outstanding_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"mysql": "decimal(20,6)"}},
+		{Name: "status", Type: field.TypeString, Size: 32, Default: "pending"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ErpSettlementLinesTable holds the schema information for the "erp_settlement_lines" table.
+	ErpSettlementLinesTable = &schema.Table{
+		Name:       "erp_settlement_lines",
+		Columns:    ErpSettlementLinesColumns,
+		PrimaryKey: []*schema.Column{ErpSettlementLinesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "erpsettlementline_settlement_id_line_no",
+				Unique:  true,
+				Columns: []*schema.Column{ErpSettlementLinesColumns[1], ErpSettlementLinesColumns[2]},
+			},
+			{
+				Name:    "erpsettlementline_shipment_detail_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{ErpSettlementLinesColumns[5]},
+			},
+			{
+				Name:    "erpsettlementline_shipment_code_shipment_line_no",
+				Unique:  false,
+				Columns: []*schema.Column{ErpSettlementLinesColumns[3], ErpSettlementLinesColumns[4]},
+			},
+		},
+	}
 	// ErpShipmentDetailsColumns holds the columns for the "erp_shipment_details" table.
 	ErpShipmentDetailsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1150,8 +1522,15 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AdminLoginAttemptsTable,
+		AdminRecoveryCodesTable,
+		AdminRolesTable,
+		AdminRolePermissionsTable,
+		AdminSessionsTable,
 		AdminUsersTable,
+		AdminUserRolesTable,
 		ErpAttachmentsTable,
+		ErpAuditLogsTable,
 		ErpBankReceiptsTable,
 		ErpBankReceiptClaimsTable,
 		ErpDocLinksTable,
@@ -1164,13 +1543,18 @@ var (
 		ErpOutboundOrdersTable,
 		ErpOutboundOrderItemsTable,
 		ErpPartnersTable,
+		ErpPartnerAddressesTable,
+		ErpPartnerBankAccountsTable,
+		ErpPartnerContactsTable,
 		ErpProductsTable,
 		ErpPurchaseContractsTable,
 		ErpPurchaseContractItemsTable,
 		ErpQuotationsTable,
 		ErpQuotationItemsTable,
+		ErpRecordRevisionsTable,
 		ErpSequencesTable,
 		ErpSettlementsTable,
+		ErpSettlementLinesTable,
 		ErpShipmentDetailsTable,
 		ErpShipmentDetailItemsTable,
 		ErpStockBalancesTable,
@@ -6,12 +6,33 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AdminLoginAttempt is the predicate function for adminloginattempt builders.
+type AdminLoginAttempt func(*sql.Selector)
+
+// AdminRecoveryCode is the predicate function for adminrecoverycode builders.
+type AdminRecoveryCode func(*sql.Selector)
+
+// AdminRole is the predicate function for adminrole builders.
+type AdminRole func(*sql.Selector)
+
+// AdminRolePermission is the predicate function for adminrolepermission builders.
+type AdminRolePermission func(*sql.Selector)
+
+// AdminSession is the predicate function for adminsession builders.
+type AdminSession func(*sql.Selector)
+
 // AdminUser is the predicate function for adminuser builders.
 type AdminUser func(*sql.Selector)
 
+// AdminUserRole is the predicate function for adminuserrole builders.
+type AdminUserRole func(*sql.Selector)
+
 // ERPAttachment is the predicate function for erpattachment builders.
 type ERPAttachment func(*sql.Selector)
 
+// ERPAuditLog is the predicate function for erpauditlog builders.
+type ERPAuditLog func(*sql.Selector)
+
 // ERPBankReceipt is the predicate function for erpbankreceipt builders.
 type ERPBankReceipt func(*sql.Selector)
 
@@ -48,6 +69,15 @@ type ERPOutboundOrderItem func(*sql.Selector)
 // ERPPartner is the predicate function for erppartner builders.
 type ERPPartner func(*sql.Selector)
 
+// ERPPartnerAddress is the predicate function for erppartneraddress builders.
+type ERPPartnerAddress func(*sql.Selector)
+
+// ERPPartnerBankAccount is the predicate function for erppartnerbankaccount builders.
+type ERPPartnerBankAccount func(*sql.Selector)
+
+// ERPPartnerContact is the predicate function for erppartnercontact builders.
+type ERPPartnerContact func(*sql.Selector)
+
 // ERPProduct is the predicate function for erpproduct builders.
 type ERPProduct func(*sql.Selector)
 
@@ -63,12 +93,18 @@ type ERPQuotation func(*sql.Selector)
 // ERPQuotationItem is the predicate function for erpquotationitem builders.
 type ERPQuotationItem func(*sql.Selector)
 
+// ERPRecordRevision is the predicate function for erprecordrevision builders.
+type ERPRecordRevision func(*sql.Selector)
+
 // ERPSequence is the predicate function for erpsequence builders.
 type ERPSequence func(*sql.Selector)
 
 // ERPSettlement is the predicate function for erpsettlement builders.
 type ERPSettlement func(*sql.Selector)
 
+// ERPSettlementLine is the predicate function for erpsettlementline builders.
+type ERPSettlementLine func(*sql.Selector)
+
 // ERPShipmentDetail is the predicate function for erpshipmentdetail builders.
 type ERPShipmentDetail func(*sql.Selector)
 
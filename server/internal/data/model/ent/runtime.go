@@ -3,8 +3,15 @@
 package ent
 
 import (
+	"server/internal/data/model/ent/adminloginattempt"
+	"server/internal/data/model/ent/adminrecoverycode"
+	"server/internal/data/model/ent/adminrole"
+	"server/internal/data/model/ent/adminrolepermission"
+	"server/internal/data/model/ent/adminsession"
 	"server/internal/data/model/ent/adminuser"
+	"server/internal/data/model/ent/adminuserrole"
 	"server/internal/data/model/ent/erpattachment"
+	"server/internal/data/model/ent/erpauditlog"
 	"server/internal/data/model/ent/erpbankreceipt"
 	"server/internal/data/model/ent/erpbankreceiptclaim"
 	"server/internal/data/model/ent/erpdoclink"
@@ -17,13 +24,18 @@ import (
 	"server/internal/data/model/ent/erpoutboundorder"
 	"server/internal/data/model/ent/erpoutboundorderitem"
 	"server/internal/data/model/ent/erppartner"
+	"server/internal/data/model/ent/erppartneraddress"
+	"server/internal/data/model/ent/erppartnerbankaccount"
+	"server/internal/data/model/ent/erppartnercontact"
 	"server/internal/data/model/ent/erpproduct"
 	"server/internal/data/model/ent/erppurchasecontract"
 	"server/internal/data/model/ent/erppurchasecontractitem"
 	"server/internal/data/model/ent/erpquotation"
 	"server/internal/data/model/ent/erpquotationitem"
+	"server/internal/data/model/ent/erprecordrevision"
 	"server/internal/data/model/ent/erpsequence"
 	"server/internal/data/model/ent/erpsettlement"
+	"server/internal/data/model/ent/erpsettlementline"
 	"server/internal/data/model/ent/erpshipmentdetail"
 	"server/internal/data/model/ent/erpshipmentdetailitem"
 	"server/internal/data/model/ent/erpstockbalance"
@@ -41,6 +53,244 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	adminloginattemptFields := schema.AdminLoginAttempt{}.Fields()
+	_ = adminloginattemptFields
+	// adminloginattemptDescUsername is the schema descriptor for username field.
+	adminloginattemptDescUsername := adminloginattemptFields[0].Descriptor()
+	// adminloginattempt.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	adminloginattempt.UsernameValidator = adminloginattemptDescUsername.Validators[0].(func(string) error)
+	// adminloginattemptDescSuccess is the schema descriptor for success field.
+	adminloginattemptDescSuccess := adminloginattemptFields[2].Descriptor()
+	// adminloginattempt.DefaultSuccess holds the default value on creation for the success field.
+	adminloginattempt.DefaultSuccess = adminloginattemptDescSuccess.Default.(bool)
+	// adminloginattemptDescReason is the schema descriptor for reason field.
+	adminloginattemptDescReason := adminloginattemptFields[3].Descriptor()
+	// adminloginattempt.DefaultReason holds the default value on creation for the reason field.
+	adminloginattempt.DefaultReason = adminloginattemptDescReason.Default.(string)
+	// adminloginattempt.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	adminloginattempt.ReasonValidator = adminloginattemptDescReason.Validators[0].(func(string) error)
+	// adminloginattemptDescIP is the schema descriptor for ip field.
+	adminloginattemptDescIP := adminloginattemptFields[4].Descriptor()
+	// adminloginattempt.DefaultIP holds the default value on creation for the ip field.
+	adminloginattempt.DefaultIP = adminloginattemptDescIP.Default.(string)
+	// adminloginattempt.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	adminloginattempt.IPValidator = adminloginattemptDescIP.Validators[0].(func(string) error)
+	// adminloginattemptDescUserAgent is the schema descriptor for user_agent field.
+	adminloginattemptDescUserAgent := adminloginattemptFields[5].Descriptor()
+	// adminloginattempt.DefaultUserAgent holds the default value on creation for the user_agent field.
+	adminloginattempt.DefaultUserAgent = adminloginattemptDescUserAgent.Default.(string)
+	// adminloginattempt.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	adminloginattempt.UserAgentValidator = adminloginattemptDescUserAgent.Validators[0].(func(string) error)
+	// adminloginattemptDescCreatedAt is the schema descriptor for created_at field.
+	adminloginattemptDescCreatedAt := adminloginattemptFields[6].Descriptor()
+	// adminloginattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminloginattempt.DefaultCreatedAt = adminloginattemptDescCreatedAt.Default.(func() time.Time)
+	adminrecoverycodeFields := schema.AdminRecoveryCode{}.Fields()
+	_ = adminrecoverycodeFields
+	// adminrecoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	adminrecoverycodeDescCodeHash := adminrecoverycodeFields[1].Descriptor()
+	// adminrecoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	adminrecoverycode.CodeHashValidator = func() func(string) error {
+		validators := adminrecoverycodeDescCodeHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code_hash string) error {
+			for _, fn := range fns {
+				if err := fn(code_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminrecoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	adminrecoverycodeDescCreatedAt := adminrecoverycodeFields[3].Descriptor()
+	// adminrecoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminrecoverycode.DefaultCreatedAt = adminrecoverycodeDescCreatedAt.Default.(func() time.Time)
+	adminroleFields := schema.AdminRole{}.Fields()
+	_ = adminroleFields
+	// adminroleDescRoleKey is the schema descriptor for role_key field.
+	adminroleDescRoleKey := adminroleFields[0].Descriptor()
+	// adminrole.RoleKeyValidator is a validator for the "role_key" field. It is called by the builders before save.
+	adminrole.RoleKeyValidator = func() func(string) error {
+		validators := adminroleDescRoleKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(role_key string) error {
+			for _, fn := range fns {
+				if err := fn(role_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminroleDescName is the schema descriptor for name field.
+	adminroleDescName := adminroleFields[1].Descriptor()
+	// adminrole.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	adminrole.NameValidator = func() func(string) error {
+		validators := adminroleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminroleDescDescription is the schema descriptor for description field.
+	adminroleDescDescription := adminroleFields[2].Descriptor()
+	// adminrole.DefaultDescription holds the default value on creation for the description field.
+	adminrole.DefaultDescription = adminroleDescDescription.Default.(string)
+	// adminrole.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	adminrole.DescriptionValidator = adminroleDescDescription.Validators[0].(func(string) error)
+	// adminroleDescBuiltin is the schema descriptor for builtin field.
+	adminroleDescBuiltin := adminroleFields[3].Descriptor()
+	// adminrole.DefaultBuiltin holds the default value on creation for the builtin field.
+	adminrole.DefaultBuiltin = adminroleDescBuiltin.Default.(bool)
+	// adminroleDescCreatedAt is the schema descriptor for created_at field.
+	adminroleDescCreatedAt := adminroleFields[4].Descriptor()
+	// adminrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminrole.DefaultCreatedAt = adminroleDescCreatedAt.Default.(func() time.Time)
+	// adminroleDescUpdatedAt is the schema descriptor for updated_at field.
+	adminroleDescUpdatedAt := adminroleFields[5].Descriptor()
+	// adminrole.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	adminrole.DefaultUpdatedAt = adminroleDescUpdatedAt.Default.(func() time.Time)
+	// adminrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	adminrole.UpdateDefaultUpdatedAt = adminroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	adminrolepermissionFields := schema.AdminRolePermission{}.Fields()
+	_ = adminrolepermissionFields
+	// adminrolepermissionDescModuleKey is the schema descriptor for module_key field.
+	adminrolepermissionDescModuleKey := adminrolepermissionFields[1].Descriptor()
+	// adminrolepermission.ModuleKeyValidator is a validator for the "module_key" field. It is called by the builders before save.
+	adminrolepermission.ModuleKeyValidator = func() func(string) error {
+		validators := adminrolepermissionDescModuleKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(module_key string) error {
+			for _, fn := range fns {
+				if err := fn(module_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminrolepermissionDescAction is the schema descriptor for action field.
+	adminrolepermissionDescAction := adminrolepermissionFields[2].Descriptor()
+	// adminrolepermission.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	adminrolepermission.ActionValidator = func() func(string) error {
+		validators := adminrolepermissionDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminrolepermissionDescCreatedAt is the schema descriptor for created_at field.
+	adminrolepermissionDescCreatedAt := adminrolepermissionFields[3].Descriptor()
+	// adminrolepermission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminrolepermission.DefaultCreatedAt = adminrolepermissionDescCreatedAt.Default.(func() time.Time)
+	adminsessionFields := schema.AdminSession{}.Fields()
+	_ = adminsessionFields
+	// adminsessionDescSessionKey is the schema descriptor for session_key field.
+	adminsessionDescSessionKey := adminsessionFields[0].Descriptor()
+	// adminsession.SessionKeyValidator is a validator for the "session_key" field. It is called by the builders before save.
+	adminsession.SessionKeyValidator = func() func(string) error {
+		validators := adminsessionDescSessionKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(session_key string) error {
+			for _, fn := range fns {
+				if err := fn(session_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminsessionDescAccessTokenID is the schema descriptor for access_token_id field.
+	adminsessionDescAccessTokenID := adminsessionFields[2].Descriptor()
+	// adminsession.AccessTokenIDValidator is a validator for the "access_token_id" field. It is called by the builders before save.
+	adminsession.AccessTokenIDValidator = func() func(string) error {
+		validators := adminsessionDescAccessTokenID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(access_token_id string) error {
+			for _, fn := range fns {
+				if err := fn(access_token_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminsessionDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
+	adminsessionDescRefreshTokenHash := adminsessionFields[3].Descriptor()
+	// adminsession.RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	adminsession.RefreshTokenHashValidator = func() func(string) error {
+		validators := adminsessionDescRefreshTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(refresh_token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(refresh_token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminsessionDescIP is the schema descriptor for ip field.
+	adminsessionDescIP := adminsessionFields[4].Descriptor()
+	// adminsession.DefaultIP holds the default value on creation for the ip field.
+	adminsession.DefaultIP = adminsessionDescIP.Default.(string)
+	// adminsession.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	adminsession.IPValidator = adminsessionDescIP.Validators[0].(func(string) error)
+	// adminsessionDescUserAgent is the schema descriptor for user_agent field.
+	adminsessionDescUserAgent := adminsessionFields[5].Descriptor()
+	// adminsession.DefaultUserAgent holds the default value on creation for the user_agent field.
+	adminsession.DefaultUserAgent = adminsessionDescUserAgent.Default.(string)
+	// adminsession.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	adminsession.UserAgentValidator = adminsessionDescUserAgent.Validators[0].(func(string) error)
+	// adminsessionDescCreatedAt is the schema descriptor for created_at field.
+	adminsessionDescCreatedAt := adminsessionFields[6].Descriptor()
+	// adminsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminsession.DefaultCreatedAt = adminsessionDescCreatedAt.Default.(func() time.Time)
+	// adminsessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	adminsessionDescLastSeenAt := adminsessionFields[7].Descriptor()
+	// adminsession.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	adminsession.DefaultLastSeenAt = adminsessionDescLastSeenAt.Default.(func() time.Time)
+	// adminsessionDescRevokeReason is the schema descriptor for revoke_reason field.
+	adminsessionDescRevokeReason := adminsessionFields[10].Descriptor()
+	// adminsession.DefaultRevokeReason holds the default value on creation for the revoke_reason field.
+	adminsession.DefaultRevokeReason = adminsessionDescRevokeReason.Default.(string)
+	// adminsession.RevokeReasonValidator is a validator for the "revoke_reason" field. It is called by the builders before save.
+	adminsession.RevokeReasonValidator = adminsessionDescRevokeReason.Validators[0].(func(string) error)
 	adminuserFields := schema.AdminUser{}.Fields()
 	_ = adminuserFields
 	// adminuserDescUsername is the schema descriptor for username field.
@@ -75,20 +325,48 @@ func init() {
 	adminuser.DefaultMenuPermissions = adminuserDescMenuPermissions.Default.(string)
 	// adminuser.MenuPermissionsValidator is a validator for the "menu_permissions" field. It is called by the builders before save.
 	adminuser.MenuPermissionsValidator = adminuserDescMenuPermissions.Validators[0].(func(string) error)
+	// adminuserDescRoleBased is the schema descriptor for role_based field.
+	adminuserDescRoleBased := adminuserFields[4].Descriptor()
+	// adminuser.DefaultRoleBased holds the default value on creation for the role_based field.
+	adminuser.DefaultRoleBased = adminuserDescRoleBased.Default.(bool)
 	// adminuserDescDisabled is the schema descriptor for disabled field.
-	adminuserDescDisabled := adminuserFields[5].Descriptor()
+	adminuserDescDisabled := adminuserFields[6].Descriptor()
 	// adminuser.DefaultDisabled holds the default value on creation for the disabled field.
 	adminuser.DefaultDisabled = adminuserDescDisabled.Default.(bool)
+	// adminuserDescMustChangePassword is the schema descriptor for must_change_password field.
+	adminuserDescMustChangePassword := adminuserFields[8].Descriptor()
+	// adminuser.DefaultMustChangePassword holds the default value on creation for the must_change_password field.
+	adminuser.DefaultMustChangePassword = adminuserDescMustChangePassword.Default.(bool)
+	// adminuserDescTotpSecret is the schema descriptor for totp_secret field.
+	adminuserDescTotpSecret := adminuserFields[11].Descriptor()
+	// adminuser.DefaultTotpSecret holds the default value on creation for the totp_secret field.
+	adminuser.DefaultTotpSecret = adminuserDescTotpSecret.Default.(string)
+	// adminuser.TotpSecretValidator is a validator for the "totp_secret" field. It is called by the builders before save.
+	adminuser.TotpSecretValidator = adminuserDescTotpSecret.Validators[0].(func(string) error)
+	// adminuserDescTotpEnabled is the schema descriptor for totp_enabled field.
+	adminuserDescTotpEnabled := adminuserFields[12].Descriptor()
+	// adminuser.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	adminuser.DefaultTotpEnabled = adminuserDescTotpEnabled.Default.(bool)
+	// adminuserDescTotpLastStep is the schema descriptor for totp_last_step field.
+	adminuserDescTotpLastStep := adminuserFields[14].Descriptor()
+	// adminuser.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	adminuser.DefaultTotpLastStep = adminuserDescTotpLastStep.Default.(int64)
 	// adminuserDescCreatedAt is the schema descriptor for created_at field.
-	adminuserDescCreatedAt := adminuserFields[7].Descriptor()
+	adminuserDescCreatedAt := adminuserFields[15].Descriptor()
 	// adminuser.DefaultCreatedAt holds the default value on creation for the created_at field.
 	adminuser.DefaultCreatedAt = adminuserDescCreatedAt.Default.(func() time.Time)
 	// adminuserDescUpdatedAt is the schema descriptor for updated_at field.
-	adminuserDescUpdatedAt := adminuserFields[8].Descriptor()
+	adminuserDescUpdatedAt := adminuserFields[16].Descriptor()
 	// adminuser.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	adminuser.DefaultUpdatedAt = adminuserDescUpdatedAt.Default.(func() time.Time)
 	// adminuser.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	adminuser.UpdateDefaultUpdatedAt = adminuserDescUpdatedAt.UpdateDefault.(func() time.Time)
+	adminuserroleFields := schema.AdminUserRole{}.Fields()
+	_ = adminuserroleFields
+	// adminuserroleDescCreatedAt is the schema descriptor for created_at field.
+	adminuserroleDescCreatedAt := adminuserroleFields[2].Descriptor()
+	// adminuserrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminuserrole.DefaultCreatedAt = adminuserroleDescCreatedAt.Default.(func() time.Time)
 	erpattachmentFields := schema.ERPAttachment{}.Fields()
 	_ = erpattachmentFields
 	// erpattachmentDescCategory is the schema descriptor for category field.
@@ -181,6 +459,76 @@ func init() {
 	erpattachmentDescCreatedAt := erpattachmentFields[8].Descriptor()
 	// erpattachment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	erpattachment.DefaultCreatedAt = erpattachmentDescCreatedAt.Default.(func() time.Time)
+	erpauditlogFields := schema.ERPAuditLog{}.Fields()
+	_ = erpauditlogFields
+	// erpauditlogDescAction is the schema descriptor for action field.
+	erpauditlogDescAction := erpauditlogFields[0].Descriptor()
+	// erpauditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	erpauditlog.ActionValidator = func() func(string) error {
+		validators := erpauditlogDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// erpauditlogDescModuleKey is the schema descriptor for module_key field.
+	erpauditlogDescModuleKey := erpauditlogFields[1].Descriptor()
+	// erpauditlog.ModuleKeyValidator is a validator for the "module_key" field. It is called by the builders before save.
+	erpauditlog.ModuleKeyValidator = func() func(string) error {
+		validators := erpauditlogDescModuleKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(module_key string) error {
+			for _, fn := range fns {
+				if err := fn(module_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// erpauditlogDescCode is the schema descriptor for code field.
+	erpauditlogDescCode := erpauditlogFields[3].Descriptor()
+	// erpauditlog.DefaultCode holds the default value on creation for the code field.
+	erpauditlog.DefaultCode = erpauditlogDescCode.Default.(string)
+	// erpauditlog.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	erpauditlog.CodeValidator = erpauditlogDescCode.Validators[0].(func(string) error)
+	// erpauditlogDescAdminUsername is the schema descriptor for admin_username field.
+	erpauditlogDescAdminUsername := erpauditlogFields[5].Descriptor()
+	// erpauditlog.DefaultAdminUsername holds the default value on creation for the admin_username field.
+	erpauditlog.DefaultAdminUsername = erpauditlogDescAdminUsername.Default.(string)
+	// erpauditlog.AdminUsernameValidator is a validator for the "admin_username" field. It is called by the builders before save.
+	erpauditlog.AdminUsernameValidator = erpauditlogDescAdminUsername.Validators[0].(func(string) error)
+	// erpauditlogDescRequestID is the schema descriptor for request_id field.
+	erpauditlogDescRequestID := erpauditlogFields[6].Descriptor()
+	// erpauditlog.DefaultRequestID holds the default value on creation for the request_id field.
+	erpauditlog.DefaultRequestID = erpauditlogDescRequestID.Default.(string)
+	// erpauditlog.RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	erpauditlog.RequestIDValidator = erpauditlogDescRequestID.Validators[0].(func(string) error)
+	// erpauditlogDescIP is the schema descriptor for ip field.
+	erpauditlogDescIP := erpauditlogFields[7].Descriptor()
+	// erpauditlog.DefaultIP holds the default value on creation for the ip field.
+	erpauditlog.DefaultIP = erpauditlogDescIP.Default.(string)
+	// erpauditlog.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	erpauditlog.IPValidator = erpauditlogDescIP.Validators[0].(func(string) error)
+	// erpauditlogDescChanges is the schema descriptor for changes field.
+	erpauditlogDescChanges := erpauditlogFields[8].Descriptor()
+	// erpauditlog.DefaultChanges holds the default value on creation for the changes field.
+	erpauditlog.DefaultChanges = erpauditlogDescChanges.Default.(string)
+	// erpauditlogDescCreatedAt is the schema descriptor for created_at field.
+	erpauditlogDescCreatedAt := erpauditlogFields[9].Descriptor()
+	// erpauditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	erpauditlog.DefaultCreatedAt = erpauditlogDescCreatedAt.Default.(func() time.Time)
 	erpbankreceiptFields := schema.ERPBankReceipt{}.Fields()
 	_ = erpbankreceiptFields
 	// erpbankreceiptDescCode is the schema descriptor for code field.
@@ -260,7 +608,7 @@ func init() {
 	// erpbankreceiptclaim.ReceiptIDValidator is a validator for the "receipt_id" field. It is called by the builders before save.
 	erpbankreceiptclaim.ReceiptIDValidator = erpbankreceiptclaimDescReceiptID.Validators[0].(func(int) error)
 	// erpbankreceiptclaimDescClaimType is the schema descriptor for claim_type field.
-	erpbankreceiptclaimDescClaimType := erpbankreceiptclaimFields[2].Descriptor()
+	erpbankreceiptclaimDescClaimType := erpbankreceiptclaimFields[3].Descriptor()
 	// erpbankreceiptclaim.ClaimTypeValidator is a validator for the "claim_type" field. It is called by the builders before save.
 	erpbankreceiptclaim.ClaimTypeValidator = func() func(string) error {
 		validators := erpbankreceiptclaimDescClaimType.Validators
@@ -278,19 +626,19 @@ func init() {
 		}
 	}()
 	// erpbankreceiptclaimDescConfirmed is the schema descriptor for confirmed field.
-	erpbankreceiptclaimDescConfirmed := erpbankreceiptclaimFields[4].Descriptor()
+	erpbankreceiptclaimDescConfirmed := erpbankreceiptclaimFields[5].Descriptor()
 	// erpbankreceiptclaim.DefaultConfirmed holds the default value on creation for the confirmed field.
 	erpbankreceiptclaim.DefaultConfirmed = erpbankreceiptclaimDescConfirmed.Default.(bool)
 	// erpbankreceiptclaimDescRemark is the schema descriptor for remark field.
-	erpbankreceiptclaimDescRemark := erpbankreceiptclaimFields[8].Descriptor()
+	erpbankreceiptclaimDescRemark := erpbankreceiptclaimFields[9].Descriptor()
 	// erpbankreceiptclaim.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
 	erpbankreceiptclaim.RemarkValidator = erpbankreceiptclaimDescRemark.Validators[0].(func(string) error)
 	// erpbankreceiptclaimDescCreatedAt is the schema descriptor for created_at field.
-	erpbankreceiptclaimDescCreatedAt := erpbankreceiptclaimFields[9].Descriptor()
+	erpbankreceiptclaimDescCreatedAt := erpbankreceiptclaimFields[10].Descriptor()
 	// erpbankreceiptclaim.DefaultCreatedAt holds the default value on creation for the created_at field.
 	erpbankreceiptclaim.DefaultCreatedAt = erpbankreceiptclaimDescCreatedAt.Default.(func() time.Time)
 	// erpbankreceiptclaimDescUpdatedAt is the schema descriptor for updated_at field.
-	erpbankreceiptclaimDescUpdatedAt := erpbankreceiptclaimFields[10].Descriptor()
+	erpbankreceiptclaimDescUpdatedAt := erpbankreceiptclaimFields[11].Descriptor()
 	// erpbankreceiptclaim.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	erpbankreceiptclaim.DefaultUpdatedAt = erpbankreceiptclaimDescUpdatedAt.Default.(func() time.Time)
 	// erpbankreceiptclaim.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -687,12 +1035,18 @@ func init() {
 	erpmodulerecordDescPayload := erpmodulerecordFields[3].Descriptor()
 	// erpmodulerecord.DefaultPayload holds the default value on creation for the payload field.
 	erpmodulerecord.DefaultPayload = erpmodulerecordDescPayload.Default.(string)
+	// erpmodulerecordDescVersion is the schema descriptor for version field.
+	erpmodulerecordDescVersion := erpmodulerecordFields[6].Descriptor()
+	// erpmodulerecord.DefaultVersion holds the default value on creation for the version field.
+	erpmodulerecord.DefaultVersion = erpmodulerecordDescVersion.Default.(int)
+	// erpmodulerecord.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	erpmodulerecord.VersionValidator = erpmodulerecordDescVersion.Validators[0].(func(int) error)
 	// erpmodulerecordDescCreatedAt is the schema descriptor for created_at field.
-	erpmodulerecordDescCreatedAt := erpmodulerecordFields[6].Descriptor()
+	erpmodulerecordDescCreatedAt := erpmodulerecordFields[7].Descriptor()
 	// erpmodulerecord.DefaultCreatedAt holds the default value on creation for the created_at field.
 	erpmodulerecord.DefaultCreatedAt = erpmodulerecordDescCreatedAt.Default.(func() time.Time)
 	// erpmodulerecordDescUpdatedAt is the schema descriptor for updated_at field.
-	erpmodulerecordDescUpdatedAt := erpmodulerecordFields[7].Descriptor()
+	erpmodulerecordDescUpdatedAt := erpmodulerecordFields[8].Descriptor()
 	// erpmodulerecord.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	erpmodulerecord.DefaultUpdatedAt = erpmodulerecordDescUpdatedAt.Default.(func() time.Time)
 	// erpmodulerecord.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -881,6 +1235,226 @@ func init() {
 	erppartner.DefaultUpdatedAt = erppartnerDescUpdatedAt.Default.(func() time.Time)
 	// erppartner.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	erppartner.UpdateDefaultUpdatedAt = erppartnerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	erppartneraddressFields := schema.ERPPartnerAddress{}.Fields()
+	_ = erppartneraddressFields
+	// erppartneraddressDescPartnerID is the schema descriptor for partner_id field.
+	erppartneraddressDescPartnerID := erppartneraddressFields[0].Descriptor()
+	// erppartneraddress.PartnerIDValidator is a validator for the "partner_id" field. It is called by the builders before save.
+	erppartneraddress.PartnerIDValidator = erppartneraddressDescPartnerID.Validators[0].(func(int) error)
+	// erppartneraddressDescSortOrder is the schema descriptor for sort_order field.
+	erppartneraddressDescSortOrder := erppartneraddressFields[1].Descriptor()
+	// erppartneraddress.SortOrderValidator is a validator for the "sort_order" field. It is called by the builders before save.
+	erppartneraddress.SortOrderValidator = erppartneraddressDescSortOrder.Validators[0].(func(int) error)
+	// erppartneraddressDescLabel is the schema descriptor for label field.
+	erppartneraddressDescLabel := erppartneraddressFields[2].Descriptor()
+	// erppartneraddress.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	erppartneraddress.LabelValidator = func() func(string) error {
+		validators := erppartneraddressDescLabel.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(label string) error {
+			for _, fn := range fns {
+				if err := fn(label); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// erppartneraddressDescAddressType is the schema descriptor for address_type field.
+	erppartneraddressDescAddressType := erppartneraddressFields[3].Descriptor()
+	// erppartneraddress.AddressTypeValidator is a validator for the "address_type" field. It is called by the builders before save.
+	erppartneraddress.AddressTypeValidator = func() func(string) error {
+		validators := erppartneraddressDescAddressType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(address_type string) error {
+			for _, fn := range fns {
+				if err := fn(address_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// erppartneraddressDescPartyName is the schema descriptor for party_name field.
+	erppartneraddressDescPartyName := erppartneraddressFields[4].Descriptor()
+	// erppartneraddress.PartyNameValidator is a validator for the "party_name" field. It is called by the builders before save.
+	erppartneraddress.PartyNameValidator = erppartneraddressDescPartyName.Validators[0].(func(string) error)
+	// erppartneraddressDescAddress is the schema descriptor for address field.
+	erppartneraddressDescAddress := erppartneraddressFields[5].Descriptor()
+	// erppartneraddress.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	erppartneraddress.AddressValidator = func() func(string) error {
+		validators := erppartneraddressDescAddress.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(address string) error {
+			for _, fn := range fns {
+				if err := fn(address); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// erppartneraddressDescCountry is the schema descriptor for country field.
+	erppartneraddressDescCountry := erppartneraddressFields[6].Descriptor()
+	// erppartneraddress.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	erppartneraddress.CountryValidator = erppartneraddressDescCountry.Validators[0].(func(string) error)
+	// erppartneraddressDescContact is the schema descriptor for contact field.
+	erppartneraddressDescContact := erppartneraddressFields[7].Descriptor()
+	// erppartneraddress.ContactValidator is a validator for the "contact" field. It is called by the builders before save.
+	erppartneraddress.ContactValidator = erppartneraddressDescContact.Validators[0].(func(string) error)
+	// erppartneraddressDescPhone is the schema descriptor for phone field.
+	erppartneraddressDescPhone := erppartneraddressFields[8].Descriptor()
+	// erppartneraddress.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	erppartneraddress.PhoneValidator = erppartneraddressDescPhone.Validators[0].(func(string) error)
+	// erppartneraddressDescIsDefault is the schema descriptor for is_default field.
+	erppartneraddressDescIsDefault := erppartneraddressFields[9].Descriptor()
+	// erppartneraddress.DefaultIsDefault holds the default value on creation for the is_default field.
+	erppartneraddress.DefaultIsDefault = erppartneraddressDescIsDefault.Default.(bool)
+	// erppartneraddressDescCreatedAt is the schema descriptor for created_at field.
+	erppartneraddressDescCreatedAt := erppartneraddressFields[10].Descriptor()
+	// erppartneraddress.DefaultCreatedAt holds the default value on creation for the created_at field.
+	erppartneraddress.DefaultCreatedAt = erppartneraddressDescCreatedAt.Default.(func() time.Time)
+	// erppartneraddressDescUpdatedAt is the schema descriptor for updated_at field.
+	erppartneraddressDescUpdatedAt := erppartneraddressFields[11].Descriptor()
+	// erppartneraddress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	erppartneraddress.DefaultUpdatedAt = erppartneraddressDescUpdatedAt.Default.(func() time.Time)
+	// erppartneraddress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	erppartneraddress.UpdateDefaultUpdatedAt = erppartneraddressDescUpdatedAt.UpdateDefault.(func() time.Time)
+	erppartnerbankaccountFields := schema.ERPPartnerBankAccount{}.Fields()
+	_ = erppartnerbankaccountFields
+	// erppartnerbankaccountDescPartnerID is the schema descriptor for partner_id field.
+	erppartnerbankaccountDescPartnerID := erppartnerbankaccountFields[0].Descriptor()
+	// erppartnerbankaccount.PartnerIDValidator is a validator for the "partner_id" field. It is called by the builders before save.
+	erppartnerbankaccount.PartnerIDValidator = erppartnerbankaccountDescPartnerID.Validators[0].(func(int) error)
+	// erppartnerbankaccountDescSortOrder is the schema descriptor for sort_order field.
+	erppartnerbankaccountDescSortOrder := erppartnerbankaccountFields[1].Descriptor()
+	// erppartnerbankaccount.SortOrderValidator is a validator for the "sort_order" field. It is called by the builders before save.
+	erppartnerbankaccount.SortOrderValidator = erppartnerbankaccountDescSortOrder.Validators[0].(func(int) error)
+	// erppartnerbankaccountDescBankName is the schema descriptor for bank_name field.
+	erppartnerbankaccountDescBankName := erppartnerbankaccountFields[2].Descriptor()
+	// erppartnerbankaccount.BankNameValidator is a validator for the "bank_name" field. It is called by the builders before save.
+	erppartnerbankaccount.BankNameValidator = func() func(string) error {
+		validators := erppartnerbankaccountDescBankName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(bank_name string) error {
+			for _, fn := range fns {
+				if err := fn(bank_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// erppartnerbankaccountDescAccountName is the schema descriptor for account_name field.
+	erppartnerbankaccountDescAccountName := erppartnerbankaccountFields[3].Descriptor()
+	// erppartnerbankaccount.AccountNameValidator is a validator for the "account_name" field. It is called by the builders before save.
+	erppartnerbankaccount.AccountNameValidator = erppartnerbankaccountDescAccountName.Validators[0].(func(string) error)
+	// erppartnerbankaccountDescAccountNo is the schema descriptor for account_no field.
+	erppartnerbankaccountDescAccountNo := erppartnerbankaccountFields[4].Descriptor()
+	// erppartnerbankaccount.AccountNoValidator is a validator for the "account_no" field. It is called by the builders before save.
+	erppartnerbankaccount.AccountNoValidator = func() func(string) error {
+		validators := erppartnerbankaccountDescAccountNo.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(account_no string) error {
+			for _, fn := range fns {
+				if err := fn(account_no); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// erppartnerbankaccountDescSwiftCode is the schema descriptor for swift_code field.
+	erppartnerbankaccountDescSwiftCode := erppartnerbankaccountFields[5].Descriptor()
+	// erppartnerbankaccount.SwiftCodeValidator is a validator for the "swift_code" field. It is called by the builders before save.
+	erppartnerbankaccount.SwiftCodeValidator = erppartnerbankaccountDescSwiftCode.Validators[0].(func(string) error)
+	// erppartnerbankaccountDescCurrency is the schema descriptor for currency field.
+	erppartnerbankaccountDescCurrency := erppartnerbankaccountFields[6].Descriptor()
+	// erppartnerbankaccount.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	erppartnerbankaccount.CurrencyValidator = erppartnerbankaccountDescCurrency.Validators[0].(func(string) error)
+	// erppartnerbankaccountDescIsDefault is the schema descriptor for is_default field.
+	erppartnerbankaccountDescIsDefault := erppartnerbankaccountFields[7].Descriptor()
+	// erppartnerbankaccount.DefaultIsDefault holds the default value on creation for the is_default field.
+	erppartnerbankaccount.DefaultIsDefault = erppartnerbankaccountDescIsDefault.Default.(bool)
+	// erppartnerbankaccountDescCreatedAt is the schema descriptor for created_at field.
+	erppartnerbankaccountDescCreatedAt := erppartnerbankaccountFields[8].Descriptor()
+	// erppartnerbankaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	erppartnerbankaccount.DefaultCreatedAt = erppartnerbankaccountDescCreatedAt.Default.(func() time.Time)
+	// erppartnerbankaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	erppartnerbankaccountDescUpdatedAt := erppartnerbankaccountFields[9].Descriptor()
+	// erppartnerbankaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	erppartnerbankaccount.DefaultUpdatedAt = erppartnerbankaccountDescUpdatedAt.Default.(func() time.Time)
+	// erppartnerbankaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	erppartnerbankaccount.UpdateDefaultUpdatedAt = erppartnerbankaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	erppartnercontactFields := schema.ERPPartnerContact{}.Fields()
+	_ = erppartnercontactFields
+	// erppartnercontactDescPartnerID is the schema descriptor for partner_id field.
+	erppartnercontactDescPartnerID := erppartnercontactFields[0].Descriptor()
+	// erppartnercontact.PartnerIDValidator is a validator for the "partner_id" field. It is called by the builders before save.
+	erppartnercontact.PartnerIDValidator = erppartnercontactDescPartnerID.Validators[0].(func(int) error)
+	// erppartnercontactDescSortOrder is the schema descriptor for sort_order field.
+	erppartnercontactDescSortOrder := erppartnercontactFields[1].Descriptor()
+	// erppartnercontact.SortOrderValidator is a validator for the "sort_order" field. It is called by the builders before save.
+	erppartnercontact.SortOrderValidator = erppartnercontactDescSortOrder.Validators[0].(func(int) error)
+	// erppartnercontactDescName is the schema descriptor for name field.
+	erppartnercontactDescName := erppartnercontactFields[2].Descriptor()
+	// erppartnercontact.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	erppartnercontact.NameValidator = func() func(string) error {
+		validators := erppartnercontactDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// erppartnercontactDescRole is the schema descriptor for role field.
+	erppartnercontactDescRole := erppartnercontactFields[3].Descriptor()
+	// erppartnercontact.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	erppartnercontact.RoleValidator = erppartnercontactDescRole.Validators[0].(func(string) error)
+	// erppartnercontactDescPhone is the schema descriptor for phone field.
+	erppartnercontactDescPhone := erppartnercontactFields[4].Descriptor()
+	// erppartnercontact.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	erppartnercontact.PhoneValidator = erppartnercontactDescPhone.Validators[0].(func(string) error)
+	// erppartnercontactDescEmail is the schema descriptor for email field.
+	erppartnercontactDescEmail := erppartnercontactFields[5].Descriptor()
+	// erppartnercontact.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	erppartnercontact.EmailValidator = erppartnercontactDescEmail.Validators[0].(func(string) error)
+	// erppartnercontactDescIsDefault is the schema descriptor for is_default field.
+	erppartnercontactDescIsDefault := erppartnercontactFields[6].Descriptor()
+	// erppartnercontact.DefaultIsDefault holds the default value on creation for the is_default field.
+	erppartnercontact.DefaultIsDefault = erppartnercontactDescIsDefault.Default.(bool)
+	// erppartnercontactDescCreatedAt is the schema descriptor for created_at field.
+	erppartnercontactDescCreatedAt := erppartnercontactFields[7].Descriptor()
+	// erppartnercontact.DefaultCreatedAt holds the default value on creation for the created_at field.
+	erppartnercontact.DefaultCreatedAt = erppartnercontactDescCreatedAt.Default.(func() time.Time)
+	// erppartnercontactDescUpdatedAt is the schema descriptor for updated_at field.
+	erppartnercontactDescUpdatedAt := erppartnercontactFields[8].Descriptor()
+	// erppartnercontact.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	erppartnercontact.DefaultUpdatedAt = erppartnercontactDescUpdatedAt.Default.(func() time.Time)
+	// erppartnercontact.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	erppartnercontact.UpdateDefaultUpdatedAt = erppartnercontactDescUpdatedAt.UpdateDefault.(func() time.Time)
 	erpproductFields := schema.ERPProduct{}.Fields()
 	_ = erpproductFields
 	// erpproductDescCode is the schema descriptor for code field.
@@ -1179,6 +1753,40 @@ func init() {
 	erpquotationitem.DefaultUpdatedAt = erpquotationitemDescUpdatedAt.Default.(func() time.Time)
 	// erpquotationitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	erpquotationitem.UpdateDefaultUpdatedAt = erpquotationitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	erprecordrevisionFields := schema.ERPRecordRevision{}.Fields()
+	_ = erprecordrevisionFields
+	// erprecordrevisionDescModuleKey is the schema descriptor for module_key field.
+	erprecordrevisionDescModuleKey := erprecordrevisionFields[0].Descriptor()
+	// erprecordrevision.ModuleKeyValidator is a validator for the "module_key" field. It is called by the builders before save.
+	erprecordrevision.ModuleKeyValidator = func() func(string) error {
+		validators := erprecordrevisionDescModuleKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(module_key string) error {
+			for _, fn := range fns {
+				if err := fn(module_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// erprecordrevisionDescRevision is the schema descriptor for revision field.
+	erprecordrevisionDescRevision := erprecordrevisionFields[2].Descriptor()
+	// erprecordrevision.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	erprecordrevision.RevisionValidator = erprecordrevisionDescRevision.Validators[0].(func(int) error)
+	// erprecordrevisionDescCode is the schema descriptor for code field.
+	erprecordrevisionDescCode := erprecordrevisionFields[3].Descriptor()
+	// erprecordrevision.DefaultCode holds the default value on creation for the code field.
+	erprecordrevision.DefaultCode = erprecordrevisionDescCode.Default.(string)
+	// erprecordrevision.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	erprecordrevision.CodeValidator = erprecordrevisionDescCode.Validators[0].(func(string) error)
+	// erprecordrevisionDescCreatedAt is the schema descriptor for created_at field.
+	erprecordrevisionDescCreatedAt := erprecordrevisionFields[7].Descriptor()
+	// erprecordrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	erprecordrevision.DefaultCreatedAt = erprecordrevisionDescCreatedAt.Default.(func() time.Time)
 	erpsequenceFields := schema.ERPSequence{}.Fields()
 	_ = erpsequenceFields
 	// erpsequenceDescBizType is the schema descriptor for biz_type field.
@@ -1303,6 +1911,56 @@ func init() {
 	erpsettlement.DefaultUpdatedAt = erpsettlementDescUpdatedAt.Default.(func() time.Time)
 	// erpsettlement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	erpsettlement.UpdateDefaultUpdatedAt = erpsettlementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	erpsettlementlineFields := schema.ERPSettlementLine{}.Fields()
+	_ = erpsettlementlineFields
+	// erpsettlementlineDescSettlementID is the schema descriptor for settlement_id field.
+	erpsettlementlineDescSettlementID := erpsettlementlineFields[0].Descriptor()
+	// erpsettlementline.SettlementIDValidator is a validator for the "settlement_id" field. It is called by the builders before save.
+	erpsettlementline.SettlementIDValidator = erpsettlementlineDescSettlementID.Validators[0].(func(int) error)
+	// erpsettlementlineDescLineNo is the schema descriptor for line_no field.
+	erpsettlementlineDescLineNo := erpsettlementlineFields[1].Descriptor()
+	// erpsettlementline.LineNoValidator is a validator for the "line_no" field. It is called by the builders before save.
+	erpsettlementline.LineNoValidator = erpsettlementlineDescLineNo.Validators[0].(func(int) error)
+	// erpsettlementlineDescShipmentCode is the schema descriptor for shipment_code field.
+	erpsettlementlineDescShipmentCode := erpsettlementlineFields[2].Descriptor()
+	// erpsettlementline.ShipmentCodeValidator is a validator for the "shipment_code" field. It is called by the builders before save.
+	erpsettlementline.ShipmentCodeValidator = erpsettlementlineDescShipmentCode.Validators[0].(func(string) error)
+	// erpsettlementlineDescProductModel is the schema descriptor for product_model field.
+	erpsettlementlineDescProductModel := erpsettlementlineFields[5].Descriptor()
+	// erpsettlementline.ProductModelValidator is a validator for the "product_model" field. It is called by the builders before save.
+	erpsettlementline.ProductModelValidator = erpsettlementlineDescProductModel.Validators[0].(func(string) error)
+	// erpsettlementlineDescQuantity is the schema descriptor for quantity field.
+	erpsettlementlineDescQuantity := erpsettlementlineFields[6].Descriptor()
+	// erpsettlementline.DefaultQuantity holds the default value on creation for the quantity field.
+	erpsettlementline.DefaultQuantity = erpsettlementlineDescQuantity.Default.(float64)
+	// erpsettlementlineDescUnitPrice is the schema descriptor for unit_price field.
+	erpsettlementlineDescUnitPrice := erpsettlementlineFields[7].Descriptor()
+	// erpsettlementline.DefaultUnitPrice holds the default value on creation for the unit_price field.
+	erpsettlementline.DefaultUnitPrice = erpsettlementlineDescUnitPrice.Default.(float64)
+	// erpsettlementlineDescReceivedAmount is the schema descriptor for received_amount field.
+	erpsettlementlineDescReceivedAmount := erpsettlementlineFields[9].Descriptor()
+	// erpsettlementline.DefaultReceivedAmount holds the default value on creation for the received_amount field.
+	erpsettlementline.DefaultReceivedAmount = erpsettlementlineDescReceivedAmount.Default.(float64)
+	// erpsettlementlineDescOutstandingAmount is the schema descriptor for outstanding_amount field.
+	erpsettlementlineDescOutstandingAmount := erpsettlementlineFields[10].Descriptor()
+	// erpsettlementline.DefaultOutstandingAmount holds the default value on creation for the outstanding_amount field.
+	erpsettlementline.DefaultOutstandingAmount = erpsettlementlineDescOutstandingAmount.Default.(float64)
+	// erpsettlementlineDescStatus is the schema descriptor for status field.
+	erpsettlementlineDescStatus := erpsettlementlineFields[11].Descriptor()
+	// erpsettlementline.DefaultStatus holds the default value on creation for the status field.
+	erpsettlementline.DefaultStatus = erpsettlementlineDescStatus.Default.(string)
+	// erpsettlementline.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	erpsettlementline.StatusValidator = erpsettlementlineDescStatus.Validators[0].(func(string) error)
+	// erpsettlementlineDescCreatedAt is the schema descriptor for created_at field.
+	erpsettlementlineDescCreatedAt := erpsettlementlineFields[12].Descriptor()
+	// erpsettlementline.DefaultCreatedAt holds the default value on creation for the created_at field.
+	erpsettlementline.DefaultCreatedAt = erpsettlementlineDescCreatedAt.Default.(func() time.Time)
+	// erpsettlementlineDescUpdatedAt is the schema descriptor for updated_at field.
+	erpsettlementlineDescUpdatedAt := erpsettlementlineFields[13].Descriptor()
+	// erpsettlementline.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	erpsettlementline.DefaultUpdatedAt = erpsettlementlineDescUpdatedAt.Default.(func() time.Time)
+	// erpsettlementline.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	erpsettlementline.UpdateDefaultUpdatedAt = erpsettlementlineDescUpdatedAt.UpdateDefault.(func() time.Time)
 	erpshipmentdetailFields := schema.ERPShipmentDetail{}.Fields()
 	_ = erpshipmentdetailFields
 	// erpshipmentdetailDescCode is the schema descriptor for code field.
@@ -12,10 +12,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AdminLoginAttempt is the client for interacting with the AdminLoginAttempt builders.
+	AdminLoginAttempt *AdminLoginAttemptClient
+	// AdminRecoveryCode is the client for interacting with the AdminRecoveryCode builders.
+	AdminRecoveryCode *AdminRecoveryCodeClient
+	// AdminRole is the client for interacting with the AdminRole builders.
+	AdminRole *AdminRoleClient
+	// AdminRolePermission is the client for interacting with the AdminRolePermission builders.
+	AdminRolePermission *AdminRolePermissionClient
+	// AdminSession is the client for interacting with the AdminSession builders.
+	AdminSession *AdminSessionClient
 	// AdminUser is the client for interacting with the AdminUser builders.
 	AdminUser *AdminUserClient
+	// AdminUserRole is the client for interacting with the AdminUserRole builders.
+	AdminUserRole *AdminUserRoleClient
 	// ERPAttachment is the client for interacting with the ERPAttachment builders.
 	ERPAttachment *ERPAttachmentClient
+	// ERPAuditLog is the client for interacting with the ERPAuditLog builders.
+	ERPAuditLog *ERPAuditLogClient
 	// ERPBankReceipt is the client for interacting with the ERPBankReceipt builders.
 	ERPBankReceipt *ERPBankReceiptClient
 	// ERPBankReceiptClaim is the client for interacting with the ERPBankReceiptClaim builders.
@@ -40,6 +54,12 @@ type Tx struct {
 	ERPOutboundOrderItem *ERPOutboundOrderItemClient
 	// ERPPartner is the client for interacting with the ERPPartner builders.
 	ERPPartner *ERPPartnerClient
+	// ERPPartnerAddress is the client for interacting with the ERPPartnerAddress builders.
+	ERPPartnerAddress *ERPPartnerAddressClient
+	// ERPPartnerBankAccount is the client for interacting with the ERPPartnerBankAccount builders.
+	ERPPartnerBankAccount *ERPPartnerBankAccountClient
+	// ERPPartnerContact is the client for interacting with the ERPPartnerContact builders.
+	ERPPartnerContact *ERPPartnerContactClient
 	// ERPProduct is the client for interacting with the ERPProduct builders.
 	ERPProduct *ERPProductClient
 	// ERPPurchaseContract is the client for interacting with the ERPPurchaseContract builders.
@@ -50,10 +70,14 @@ type Tx struct {
 	ERPQuotation *ERPQuotationClient
 	// ERPQuotationItem is the client for interacting with the ERPQuotationItem builders.
 	ERPQuotationItem *ERPQuotationItemClient
+	// ERPRecordRevision is the client for interacting with the ERPRecordRevision builders.
+	ERPRecordRevision *ERPRecordRevisionClient
 	// ERPSequence is the client for interacting with the ERPSequence builders.
 	ERPSequence *ERPSequenceClient
 	// ERPSettlement is the client for interacting with the ERPSettlement builders.
 	ERPSettlement *ERPSettlementClient
+	// ERPSettlementLine is the client for interacting with the ERPSettlementLine builders.
+	ERPSettlementLine *ERPSettlementLineClient
 	// ERPShipmentDetail is the client for interacting with the ERPShipmentDetail builders.
 	ERPShipmentDetail *ERPShipmentDetailClient
 	// ERPShipmentDetailItem is the client for interacting with the ERPShipmentDetailItem builders.
@@ -203,8 +227,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AdminLoginAttempt = NewAdminLoginAttemptClient(tx.config)
+	tx.AdminRecoveryCode = NewAdminRecoveryCodeClient(tx.config)
+	tx.AdminRole = NewAdminRoleClient(tx.config)
+	tx.AdminRolePermission = NewAdminRolePermissionClient(tx.config)
+	tx.AdminSession = NewAdminSessionClient(tx.config)
 	tx.AdminUser = NewAdminUserClient(tx.config)
+	tx.AdminUserRole = NewAdminUserRoleClient(tx.config)
 	tx.ERPAttachment = NewERPAttachmentClient(tx.config)
+	tx.ERPAuditLog = NewERPAuditLogClient(tx.config)
 	tx.ERPBankReceipt = NewERPBankReceiptClient(tx.config)
 	tx.ERPBankReceiptClaim = NewERPBankReceiptClaimClient(tx.config)
 	tx.ERPDocLink = NewERPDocLinkClient(tx.config)
@@ -217,13 +248,18 @@ func (tx *Tx) init() {
 	tx.ERPOutboundOrder = NewERPOutboundOrderClient(tx.config)
 	tx.ERPOutboundOrderItem = NewERPOutboundOrderItemClient(tx.config)
 	tx.ERPPartner = NewERPPartnerClient(tx.config)
+	tx.ERPPartnerAddress = NewERPPartnerAddressClient(tx.config)
+	tx.ERPPartnerBankAccount = NewERPPartnerBankAccountClient(tx.config)
+	tx.ERPPartnerContact = NewERPPartnerContactClient(tx.config)
 	tx.ERPProduct = NewERPProductClient(tx.config)
 	tx.ERPPurchaseContract = NewERPPurchaseContractClient(tx.config)
 	tx.ERPPurchaseContractItem = NewERPPurchaseContractItemClient(tx.config)
 	tx.ERPQuotation = NewERPQuotationClient(tx.config)
 	tx.ERPQuotationItem = NewERPQuotationItemClient(tx.config)
+	tx.ERPRecordRevision = NewERPRecordRevisionClient(tx.config)
 	tx.ERPSequence = NewERPSequenceClient(tx.config)
 	tx.ERPSettlement = NewERPSettlementClient(tx.config)
+	tx.ERPSettlementLine = NewERPSettlementLineClient(tx.config)
 	tx.ERPShipmentDetail = NewERPShipmentDetailClient(tx.config)
 	tx.ERPShipmentDetailItem = NewERPShipmentDetailItemClient(tx.config)
 	tx.ERPStockBalance = NewERPStockBalanceClient(tx.config)
@@ -242,7 +278,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AdminUser.QueryXXX(), the query will be executed
+// applies a query, for example: AdminLoginAttempt.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
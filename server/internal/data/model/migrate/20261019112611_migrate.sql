@@ -0,0 +1,4 @@
+-- Modify "erp_bank_receipt_claims" table
+ALTER TABLE `erp_bank_receipt_claims` ADD COLUMN `settlement_line_id` bigint NULL, ADD INDEX `erpbankreceiptclaim_settlement_line_id` (`settlement_line_id`);
+-- Create "erp_settlement_lines" table
+CREATE TABLE `erp_settlement_lines` (`id` bigint NOT NULL AUTO_INCREMENT, `settlement_id` bigint NOT NULL, `line_no` bigint NOT NULL, `shipment_code` varchar(128) NULL, `shipment_line_no` bigint NULL, `shipment_detail_item_id` bigint NULL, `product_model` varchar(255) NULL, `quantity` decimal(20,6) NOT NULL DEFAULT 0, `unit_price` decimal(20,6) NOT NULL DEFAULT 0, `amount` decimal(20,6) NOT NULL, `received_amount` decimal(20,6) NOT NULL DEFAULT 0, `outstanding_amount` decimal(20,6) NOT NULL DEFAULT 0, `status` varchar(32) NOT NULL DEFAULT "pending", `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `erpsettlementline_settlement_id_line_no` (`settlement_id`, `line_no`), INDEX `erpsettlementline_shipment_code_shipment_line_no` (`shipment_code`, `shipment_line_no`), INDEX `erpsettlementline_shipment_detail_item_id` (`shipment_detail_item_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
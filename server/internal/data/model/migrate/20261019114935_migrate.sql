@@ -0,0 +1,8 @@
+-- Modify "admin_users" table
+ALTER TABLE `admin_users` ADD COLUMN `role_based` bool NOT NULL DEFAULT 0;
+-- Create "admin_roles" table
+CREATE TABLE `admin_roles` (`id` bigint NOT NULL AUTO_INCREMENT, `role_key` varchar(64) NOT NULL, `name` varchar(255) NOT NULL, `description` varchar(255) NOT NULL DEFAULT "", `builtin` bool NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `adminrole_role_key` (`role_key`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "admin_role_permissions" table
+CREATE TABLE `admin_role_permissions` (`id` bigint NOT NULL AUTO_INCREMENT, `role_id` bigint NOT NULL, `module_key` varchar(64) NOT NULL, `action` varchar(32) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `adminrolepermission_role_id_module_key_action` (`role_id`, `module_key`, `action`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "admin_user_roles" table
+CREATE TABLE `admin_user_roles` (`id` bigint NOT NULL AUTO_INCREMENT, `admin_user_id` bigint NOT NULL, `role_id` bigint NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `adminuserrole_admin_user_id_role_id` (`admin_user_id`, `role_id`), INDEX `adminuserrole_role_id` (`role_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- Create "admin_sessions" table
+CREATE TABLE `admin_sessions` (`id` bigint NOT NULL AUTO_INCREMENT, `session_key` varchar(64) NOT NULL, `admin_user_id` bigint NOT NULL, `access_token_id` varchar(64) NOT NULL, `refresh_token_hash` varchar(64) NOT NULL, `ip` varchar(64) NOT NULL DEFAULT "", `user_agent` varchar(255) NOT NULL DEFAULT "", `created_at` timestamp NOT NULL, `last_seen_at` timestamp NOT NULL, `expires_at` timestamp NOT NULL, `revoked_at` timestamp NULL, `revoke_reason` varchar(32) NOT NULL DEFAULT "", PRIMARY KEY (`id`), INDEX `adminsession_admin_user_id_revoked_at` (`admin_user_id`, `revoked_at`), UNIQUE INDEX `adminsession_session_key` (`session_key`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,4 @@
+-- Modify "admin_users" table
+ALTER TABLE `admin_users` ADD COLUMN `must_change_password` bool NOT NULL DEFAULT 0, ADD COLUMN `password_changed_at` timestamp NULL, ADD COLUMN `locked_until` timestamp NULL;
+-- Create "admin_login_attempts" table
+CREATE TABLE `admin_login_attempts` (`id` bigint NOT NULL AUTO_INCREMENT, `username` varchar(64) NOT NULL, `admin_user_id` bigint NULL, `success` bool NOT NULL DEFAULT 0, `reason` varchar(32) NOT NULL DEFAULT "", `ip` varchar(64) NOT NULL DEFAULT "", `user_agent` varchar(255) NOT NULL DEFAULT "", `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `adminloginattempt_admin_user_id_created_at` (`admin_user_id`, `created_at`), INDEX `adminloginattempt_username_created_at` (`username`, `created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
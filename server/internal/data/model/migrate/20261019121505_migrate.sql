@@ -0,0 +1,4 @@
+-- Modify "admin_users" table
+ALTER TABLE `admin_users` ADD COLUMN `totp_secret` varchar(64) NOT NULL DEFAULT "", ADD COLUMN `totp_enabled` bool NOT NULL DEFAULT 0, ADD COLUMN `totp_enabled_at` timestamp NULL, ADD COLUMN `totp_last_step` bigint NOT NULL DEFAULT 0;
+-- Create "admin_recovery_codes" table
+CREATE TABLE `admin_recovery_codes` (`id` bigint NOT NULL AUTO_INCREMENT, `admin_user_id` bigint NOT NULL, `code_hash` varchar(64) NOT NULL, `used_at` timestamp NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `adminrecoverycode_admin_user_id` (`admin_user_id`), UNIQUE INDEX `adminrecoverycode_code_hash` (`code_hash`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- Create "erp_audit_logs" table
+CREATE TABLE `erp_audit_logs` (`id` bigint NOT NULL AUTO_INCREMENT, `action` varchar(16) NOT NULL, `module_key` varchar(64) NOT NULL, `record_id` bigint NOT NULL, `code` varchar(128) NOT NULL DEFAULT "", `admin_id` bigint NULL, `admin_username` varchar(64) NOT NULL DEFAULT "", `request_id` varchar(64) NOT NULL DEFAULT "", `ip` varchar(64) NOT NULL DEFAULT "", `changes` longtext NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `erpauditlog_admin_id_created_at` (`admin_id`, `created_at`), INDEX `erpauditlog_created_at` (`created_at`), INDEX `erpauditlog_module_key_code` (`module_key`, `code`), INDEX `erpauditlog_module_key_record_id_created_at` (`module_key`, `record_id`, `created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
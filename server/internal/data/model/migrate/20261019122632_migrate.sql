@@ -0,0 +1,2 @@
+-- Create "erp_record_revisions" table
+CREATE TABLE `erp_record_revisions` (`id` bigint NOT NULL AUTO_INCREMENT, `module_key` varchar(64) NOT NULL, `record_id` bigint NOT NULL, `revision` bigint NOT NULL, `code` varchar(128) NOT NULL DEFAULT "", `payload` longtext NOT NULL, `saved_by_admin_id` bigint NULL, `saved_at` timestamp NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `erprecordrevision_module_key_record_id` (`module_key`, `record_id`), UNIQUE INDEX `erprecordrevision_record_id_revision` (`record_id`, `revision`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
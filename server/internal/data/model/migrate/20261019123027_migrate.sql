@@ -0,0 +1,2 @@
+-- Modify "erp_module_records" table
+ALTER TABLE `erp_module_records` ADD COLUMN `version` bigint NOT NULL DEFAULT 1;
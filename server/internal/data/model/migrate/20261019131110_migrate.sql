@@ -0,0 +1,6 @@
+-- Create "erp_partner_addresses" table
+CREATE TABLE `erp_partner_addresses` (`id` bigint NOT NULL AUTO_INCREMENT, `partner_id` bigint NOT NULL, `sort_order` bigint NOT NULL, `label` varchar(64) NOT NULL, `address_type` varchar(16) NOT NULL, `party_name` varchar(128) NULL, `address` varchar(255) NOT NULL, `country` varchar(64) NULL, `contact` varchar(64) NULL, `phone` varchar(64) NULL, `is_default` bool NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `erppartneraddress_partner_id_address_type` (`partner_id`, `address_type`), UNIQUE INDEX `erppartneraddress_partner_id_label` (`partner_id`, `label`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "erp_partner_bank_accounts" table
+CREATE TABLE `erp_partner_bank_accounts` (`id` bigint NOT NULL AUTO_INCREMENT, `partner_id` bigint NOT NULL, `sort_order` bigint NOT NULL, `bank_name` varchar(128) NOT NULL, `account_name` varchar(128) NULL, `account_no` varchar(64) NOT NULL, `swift_code` varchar(32) NULL, `currency` varchar(16) NULL, `is_default` bool NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `erppartnerbankaccount_partner_id_account_no` (`partner_id`, `account_no`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "erp_partner_contacts" table
+CREATE TABLE `erp_partner_contacts` (`id` bigint NOT NULL AUTO_INCREMENT, `partner_id` bigint NOT NULL, `sort_order` bigint NOT NULL, `name` varchar(64) NOT NULL, `role` varchar(32) NULL, `phone` varchar(64) NULL, `email` varchar(128) NULL, `is_default` bool NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `erppartnercontact_partner_id_name` (`partner_id`, `name`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
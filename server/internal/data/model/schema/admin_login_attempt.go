@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminLoginAttempt 管理员登录尝试记录，用于失败锁定与审计。
+type AdminLoginAttempt struct {
+	ent.Schema
+}
+
+func (AdminLoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").
+			MaxLen(64),
+		field.Int("admin_user_id").
+			Optional().
+			Nillable().
+			Comment("账号不存在时为空"),
+		field.Bool("success").
+			Default(false),
+		field.String("reason").
+			Default("").
+			MaxLen(32).
+			Comment("ok/not_found/disabled/locked/invalid_password/totp_required/invalid_totp"),
+		field.String("ip").
+			Default("").
+			MaxLen(64),
+		field.String("user_agent").
+			Default("").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AdminLoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("admin_user_id", "created_at"),
+		index.Fields("username", "created_at"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminRecoveryCode 两步验证恢复码，只存 sha256，每个只能使用一次。
+type AdminRecoveryCode struct {
+	ent.Schema
+}
+
+func (AdminRecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("admin_user_id"),
+		field.String("code_hash").
+			NotEmpty().
+			MaxLen(64).
+			Sensitive(),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AdminRecoveryCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("admin_user_id"),
+		index.Fields("code_hash").Unique(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminRole 管理员角色，授权明细见 admin_role_permissions。
+type AdminRole struct {
+	ent.Schema
+}
+
+func (AdminRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("role_key").
+			NotEmpty().
+			MaxLen(64),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.String("description").
+			Default("").
+			MaxLen(255),
+		field.Bool("builtin").
+			Default(false).
+			Comment("系统内置角色不可删除"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (AdminRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_key").Unique(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminRolePermission 角色授权：一行授予一个模块的一个动作。
+type AdminRolePermission struct {
+	ent.Schema
+}
+
+func (AdminRolePermission) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("role_id"),
+		field.String("module_key").
+			NotEmpty().
+			MaxLen(64),
+		field.String("action").
+			NotEmpty().
+			MaxLen(32).
+			Comment("view/create/edit/delete/submit/approve/print/export/view_amounts"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AdminRolePermission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_id", "module_key", "action").Unique(),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminSession 管理员登录会话：access token 的 jti 与 refresh token 摘要，注销或踢出后立即失效。
+type AdminSession struct {
+	ent.Schema
+}
+
+func (AdminSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("session_key").
+			NotEmpty().
+			MaxLen(64).
+			Comment("写入 token 的 sid"),
+		field.Int("admin_user_id"),
+		field.String("access_token_id").
+			NotEmpty().
+			MaxLen(64).
+			Comment("当前有效 access token 的 jti，刷新后旧 token 失效"),
+		field.String("refresh_token_hash").
+			NotEmpty().
+			MaxLen(64).
+			Sensitive().
+			Comment("refresh token 的 sha256"),
+		field.String("ip").
+			Default("").
+			MaxLen(64),
+		field.String("user_agent").
+			Default("").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_seen_at").
+			Default(time.Now),
+		field.Time("expires_at").
+			Comment("会话（refresh token）到期时间，刷新不延长"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.String("revoke_reason").
+			Default("").
+			MaxLen(32),
+	}
+}
+
+func (AdminSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_key").Unique(),
+		index.Fields("admin_user_id", "revoked_at"),
+	}
+}
@@ -28,6 +28,9 @@ func (AdminUser) Fields() []ent.Field {
 			Default("").
 			MaxLen(4096).
 			Comment("逗号分隔菜单权限"),
+		field.Bool("role_based").
+			Default(false).
+			Comment("按角色鉴权，忽略 menu_permissions"),
 		field.Int("parent_id").
 			Optional().
 			Nillable().
@@ -37,6 +40,29 @@ func (AdminUser) Fields() []ent.Field {
 		field.Time("last_login_at").
 			Optional().
 			Nillable(),
+		field.Bool("must_change_password").
+			Default(false).
+			Comment("重置密码后下次登录须先修改密码"),
+		field.Time("password_changed_at").
+			Optional().
+			Nillable(),
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Comment("登录失败次数过多时锁定到该时间"),
+		field.String("totp_secret").
+			Default("").
+			MaxLen(64).
+			Sensitive().
+			Comment("TOTP 密钥（base32）；已生成未启用时为待确认绑定"),
+		field.Bool("totp_enabled").
+			Default(false),
+		field.Time("totp_enabled_at").
+			Optional().
+			Nillable(),
+		field.Int64("totp_last_step").
+			Default(0).
+			Comment("最近一次验证通过的时间步，防止验证码重放"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ERPAuditLog ERP 记录变更审计，只追加不修改；与记录写入在同一事务。
+type ERPAuditLog struct {
+	ent.Schema
+}
+
+func (ERPAuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("action").
+			NotEmpty().
+			MaxLen(16).
+			Comment("create/update/delete"),
+		field.String("module_key").
+			NotEmpty().
+			MaxLen(64),
+		field.Int("record_id"),
+		field.String("code").
+			Default("").
+			MaxLen(128).
+			Comment("变更后的单号；删除时为删除前单号"),
+		field.Int("admin_id").
+			Optional().
+			Nillable().
+			Comment("操作人，系统任务为空"),
+		field.String("admin_username").
+			Default("").
+			MaxLen(64),
+		field.String("request_id").
+			Default("").
+			MaxLen(64),
+		field.String("ip").
+			Default("").
+			MaxLen(64),
+		field.Text("changes").
+			Default("[]").
+			Comment("字段级变更 JSON：[{field, old, new}]"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ERPAuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("module_key", "record_id", "created_at"),
+		index.Fields("module_key", "code"),
+		index.Fields("admin_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
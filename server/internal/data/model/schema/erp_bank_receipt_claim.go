@@ -21,6 +21,9 @@ func (ERPBankReceiptClaim) Fields() []ent.Field {
 		field.Int("settlement_id").
 			Optional().
 			Nillable(),
+		field.Int("settlement_line_id").
+			Optional().
+			Nillable(),
 		field.String("claim_type").
 			NotEmpty().
 			MaxLen(32).
@@ -55,6 +58,7 @@ func (ERPBankReceiptClaim) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("receipt_id"),
 		index.Fields("settlement_id"),
+		index.Fields("settlement_line_id"),
 		index.Fields("confirmed", "created_at"),
 	}
 }
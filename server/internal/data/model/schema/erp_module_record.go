@@ -33,6 +33,10 @@ func (ERPModuleRecord) Fields() []ent.Field {
 		field.Int("updated_by_admin_id").
 			Optional().
 			Nillable(),
+		field.Int("version").
+			Default(1).
+			Positive().
+			Comment("乐观锁版本，每次修改加 1"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ERPPartnerAddress 往来单位地址（收货人、通知方、账单，每种类型最多一个默认地址），单证按标签选用。
+type ERPPartnerAddress struct {
+	ent.Schema
+}
+
+func (ERPPartnerAddress) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("partner_id").
+			Positive(),
+		field.Int("sort_order").
+			NonNegative(),
+		field.String("label").
+			NotEmpty().
+			MaxLen(64),
+		field.String("address_type").
+			NotEmpty().
+			MaxLen(16).
+			Comment("收货人/通知方/账单"),
+		field.String("party_name").
+			Optional().
+			Nillable().
+			MaxLen(128),
+		field.String("address").
+			NotEmpty().
+			MaxLen(255),
+		field.String("country").
+			Optional().
+			Nillable().
+			MaxLen(64),
+		field.String("contact").
+			Optional().
+			Nillable().
+			MaxLen(64),
+		field.String("phone").
+			Optional().
+			Nillable().
+			MaxLen(64),
+		field.Bool("is_default").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (ERPPartnerAddress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("partner_id", "label").Unique(),
+		index.Fields("partner_id", "address_type"),
+	}
+}
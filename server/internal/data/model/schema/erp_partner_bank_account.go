@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ERPPartnerBankAccount 往来单位银行账户（每个币种最多一个默认账户）。
+type ERPPartnerBankAccount struct {
+	ent.Schema
+}
+
+func (ERPPartnerBankAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("partner_id").
+			Positive(),
+		field.Int("sort_order").
+			NonNegative(),
+		field.String("bank_name").
+			NotEmpty().
+			MaxLen(128),
+		field.String("account_name").
+			Optional().
+			Nillable().
+			MaxLen(128),
+		field.String("account_no").
+			NotEmpty().
+			MaxLen(64),
+		field.String("swift_code").
+			Optional().
+			Nillable().
+			MaxLen(32),
+		field.String("currency").
+			Optional().
+			Nillable().
+			MaxLen(16),
+		field.Bool("is_default").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (ERPPartnerBankAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("partner_id", "account_no").Unique(),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ERPPartnerContact 往来单位联系人（采购、质量、财务等，每个往来单位最多一个默认联系人）。
+type ERPPartnerContact struct {
+	ent.Schema
+}
+
+func (ERPPartnerContact) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("partner_id").
+			Positive(),
+		field.Int("sort_order").
+			NonNegative(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(64),
+		field.String("role").
+			Optional().
+			Nillable().
+			MaxLen(32),
+		field.String("phone").
+			Optional().
+			Nillable().
+			MaxLen(64),
+		field.String("email").
+			Optional().
+			Nillable().
+			MaxLen(128),
+		field.Bool("is_default").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (ERPPartnerContact) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("partner_id", "name").Unique(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ERPRecordRevision ERP 记录的历史版本：每次修改前保存当时的 payload，版本号按记录递增。
+type ERPRecordRevision struct {
+	ent.Schema
+}
+
+func (ERPRecordRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("module_key").
+			NotEmpty().
+			MaxLen(64),
+		field.Int("record_id"),
+		field.Int("revision").
+			Positive().
+			Comment("版本号，从 1 开始；当前内容的版本号为最大版本号 + 1"),
+		field.String("code").
+			Default("").
+			MaxLen(128),
+		field.Text("payload"),
+		field.Int("saved_by_admin_id").
+			Optional().
+			Nillable().
+			Comment("保存该版本的管理员"),
+		field.Time("saved_at").
+			Comment("该版本的保存时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("被新版本替换的时间"),
+	}
+}
+
+func (ERPRecordRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("record_id", "revision").Unique(),
+		index.Fields("module_key", "record_id"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ERPSettlementLine 结汇明细行（结汇分摊到出运行，支持一票多行与部分收汇）。
+type ERPSettlementLine struct {
+	ent.Schema
+}
+
+func (ERPSettlementLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("settlement_id").
+			Positive(),
+		field.Int("line_no").
+			NonNegative(),
+		field.String("shipment_code").
+			Optional().
+			Nillable().
+			MaxLen(128),
+		field.Int("shipment_line_no").
+			Optional().
+			Nillable(),
+		field.Int("shipment_detail_item_id").
+			Optional().
+			Nillable(),
+		field.String("product_model").
+			Optional().
+			Nillable().
+			MaxLen(255),
+		field.Float("quantity").
+			Default(0).
+			SchemaType(map[string]string{dialect.MySQL: "decimal(20,6)"}),
+		field.Float("unit_price").
+			Default(0).
+			SchemaType(map[string]string{dialect.MySQL: "decimal(20,6)"}),
+		field.Float("amount").
+			SchemaType(map[string]string{dialect.MySQL: "decimal(20,6)"}),
+		field.Float("received_amount").
+			Default(0).
+			SchemaType(map[string]string{dialect.MySQL: "decimal(20,6)"}),
+		field.Float("outstanding_amount").
+			Default(0).
+			SchemaType(map[string]string{dialect.MySQL: "decimal(20,6)"}),
+		field.String("status").
+			Default("pending").
+			MaxLen(32).
+			Comment("pending/partial/closed"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (ERPSettlementLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settlement_id", "line_no").Unique(),
+		index.Fields("shipment_detail_item_id"),
+		index.Fields("shipment_code", "shipment_line_no"),
+	}
+}
@@ -3,15 +3,18 @@ package service
 
 import (
 	"context"
+	"net"
 	"strings"
 	"time"
 
 	v1 "server/api/jsonrpc/v1"
 	"server/internal/biz"
+	"server/internal/conf"
 	"server/pkg/requestid"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -20,20 +23,24 @@ import (
 type JsonrpcService struct {
 	v1.UnimplementedJsonrpcServer
 
-	uc  *biz.JsonrpcUsecase
-	log *log.Helper
+	uc             *biz.JsonrpcUsecase
+	log            *log.Helper
+	trustedProxies []*net.IPNet
 }
 
-func NewJsonrpcService(uc *biz.JsonrpcUsecase, logger log.Logger) *JsonrpcService {
+func NewJsonrpcService(c *conf.Server, uc *biz.JsonrpcUsecase, logger log.Logger) *JsonrpcService {
+	l := log.NewHelper(logger)
 	return &JsonrpcService{
-		uc:  uc,
-		log: log.NewHelper(logger),
+		uc:             uc,
+		log:            l,
+		trustedProxies: parseTrustedProxies(c.GetHttp().GetTrustedProxies(), l),
 	}
 }
 
 // GetJsonrpc 对应 GET /rpc/{url}
 func (s *JsonrpcService) GetJsonrpc(ctx context.Context, req *v1.GetJsonrpcRequest) (*v1.GetJsonrpcReply, error) {
 	ctx = injectRequestID(ctx)
+	ctx = s.injectClientInfo(ctx)
 	ctx, span := otel.Tracer("service.jsonrpc").Start(ctx, "jsonrpc.get")
 	span.SetAttributes(
 		attribute.String("jsonrpc.url", req.GetUrl()),
@@ -79,6 +86,7 @@ func (s *JsonrpcService) GetJsonrpc(ctx context.Context, req *v1.GetJsonrpcReque
 // PostJsonrpc 对应 POST /rpc/{url}
 func (s *JsonrpcService) PostJsonrpc(ctx context.Context, req *v1.PostJsonrpcRequest) (*v1.PostJsonrpcReply, error) {
 	ctx = injectRequestID(ctx)
+	ctx = s.injectClientInfo(ctx)
 	ctx, span := otel.Tracer("service.jsonrpc").Start(ctx, "jsonrpc.post")
 	span.SetAttributes(
 		attribute.String("jsonrpc.url", req.GetUrl()),
@@ -142,3 +150,89 @@ func injectRequestID(ctx context.Context) context.Context {
 	}
 	return requestid.NewContext(ctx, reqID)
 }
+
+// injectClientInfo 记录客户端 IP 与 User-Agent。
+// IP 默认取连接来源地址；仅当来源是配置的可信反向代理时，才采用 X-Forwarded-For / X-Real-IP。
+func (s *JsonrpcService) injectClientInfo(ctx context.Context) context.Context {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok || tr == nil {
+		return ctx
+	}
+	info := biz.ClientInfo{UserAgent: strings.TrimSpace(tr.RequestHeader().Get("User-Agent"))}
+	ht, ok := tr.(khttp.Transporter)
+	if !ok || ht.Request() == nil {
+		return biz.NewContextWithClientInfo(ctx, info)
+	}
+	info.IP = ht.Request().RemoteAddr
+	if host, _, err := net.SplitHostPort(info.IP); err == nil {
+		info.IP = host
+	}
+	if !s.isTrustedProxy(info.IP) {
+		return biz.NewContextWithClientInfo(ctx, info)
+	}
+	if ip := s.forwardedClientIP(tr.RequestHeader().Get("X-Forwarded-For")); ip != "" {
+		info.IP = ip
+	} else if ip := strings.TrimSpace(tr.RequestHeader().Get("X-Real-IP")); net.ParseIP(ip) != nil {
+		info.IP = ip
+	}
+	return biz.NewContextWithClientInfo(ctx, info)
+}
+
+// forwardedClientIP 从右向左跳过可信代理，返回第一个不可信地址；左侧条目可由客户端伪造，不直接采用。
+func (s *JsonrpcService) forwardedClientIP(header string) string {
+	if strings.TrimSpace(header) == "" {
+		return ""
+	}
+	hops := strings.Split(header, ",")
+	ip := ""
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !s.isTrustedProxy(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+func (s *JsonrpcService) isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range s.trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies 解析可信代理配置，单个 IP 视为 /32 或 /128；无法解析的条目记录警告后忽略。
+func parseTrustedProxies(items []string, l *log.Helper) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if _, n, err := net.ParseCIDR(item); err == nil {
+			nets = append(nets, n)
+			continue
+		}
+		ip := net.ParseIP(item)
+		if ip == nil {
+			l.Warnf("ignore invalid trusted proxy %q", item)
+			continue
+		}
+		bits := 128
+		if v4 := ip.To4(); v4 != nil {
+			ip, bits = v4, 32
+		}
+		nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return nets
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"server/internal/biz"
+	"server/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+type fakeHTTPTransport struct {
+	req *http.Request
+}
+
+func (t *fakeHTTPTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *fakeHTTPTransport) Endpoint() string                { return "" }
+func (t *fakeHTTPTransport) Operation() string               { return "" }
+func (t *fakeHTTPTransport) RequestHeader() transport.Header { return headerCarrier(t.req.Header) }
+func (t *fakeHTTPTransport) ReplyHeader() transport.Header   { return headerCarrier(http.Header{}) }
+func (t *fakeHTTPTransport) Request() *http.Request          { return t.req }
+func (t *fakeHTTPTransport) PathTemplate() string            { return "" }
+
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestJsonrpcService_InjectClientInfo_TrustedProxies(t *testing.T) {
+	svc := NewJsonrpcService(&conf.Server{Http: &conf.Server_HTTP{
+		TrustedProxies: []string{"10.0.0.1", "172.16.0.0/12", "bad-entry"},
+	}}, nil, log.DefaultLogger)
+
+	cases := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		realIP     string
+		want       string
+	}{
+		{name: "untrusted remote ignores headers", remoteAddr: "203.0.113.9:5000", forwarded: "1.2.3.4", realIP: "5.6.7.8", want: "203.0.113.9"},
+		{name: "trusted remote uses forwarded", remoteAddr: "10.0.0.1:5000", forwarded: "198.51.100.7", want: "198.51.100.7"},
+		{name: "spoofed left hop skipped", remoteAddr: "10.0.0.1:5000", forwarded: "1.2.3.4, 198.51.100.7, 172.16.5.5", want: "198.51.100.7"},
+		{name: "trusted remote falls back to real ip", remoteAddr: "172.20.0.3:5000", realIP: "198.51.100.8", want: "198.51.100.8"},
+		{name: "trusted remote without headers", remoteAddr: "10.0.0.1:5000", want: "10.0.0.1"},
+		{name: "invalid forwarded ignored", remoteAddr: "10.0.0.1:5000", forwarded: "unknown", realIP: "not-an-ip", want: "10.0.0.1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/rpc/erp", nil)
+			req.RemoteAddr = tc.remoteAddr
+			req.Header.Set("User-Agent", "ua")
+			if tc.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tc.forwarded)
+			}
+			if tc.realIP != "" {
+				req.Header.Set("X-Real-IP", tc.realIP)
+			}
+			ctx := transport.NewServerContext(context.Background(), &fakeHTTPTransport{req: req})
+			info := biz.ClientInfoFromContext(svc.injectClientInfo(ctx))
+			if info.IP != tc.want || info.UserAgent != "ua" {
+				t.Fatalf("client info = %+v, want ip %s", info, tc.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,9 @@ type Claims struct {
 	// 0=user, 1=admin
 	Role int8 `json:"role"`
 
+	// SessionID 服务端会话标识，仅管理员 token 携带；jti 见 RegisteredClaims.ID。
+	SessionID string `json:"sid,omitempty"`
+
 	jwt.RegisteredClaims
 }
 
@@ -44,6 +47,32 @@ func NewToken(cfg Config, userID int, username string, role int8) (string, time.
 	return signed, expireAt, nil
 }
 
+// NewSessionToken 签发绑定服务端会话的 access token：sid 指向会话，jti 用于校验是否为会话当前 token。
+func NewSessionToken(cfg Config, userID int, username string, role int8, sessionID, tokenID string) (string, time.Time, error) {
+	now := time.Now()
+	expireAt := now.Add(cfg.ExpireDuration)
+
+	claims := &Claims{
+		UserID:    userID,
+		Username:  username,
+		Role:      role,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(expireAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Subject:   "access_token",
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(cfg.Secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expireAt, nil
+}
+
 func ParseToken(secret []byte, tokenStr string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(t *jwt.Token) (interface{}, error) {
 		return secret, nil
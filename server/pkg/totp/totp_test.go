@@ -0,0 +1,53 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录 B 的 SHA1 测试向量（密钥 "12345678901234567890"，取后 6 位）。
+func TestCode_RFC6238Vectors(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		got, err := Code(secret, Step(time.Unix(tt.unix, 0)))
+		if err != nil || got != tt.want {
+			t.Fatalf("Code(t=%d) = %s, %v; want %s", tt.unix, got, err, tt.want)
+		}
+	}
+}
+
+func TestValidate_Skew(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	now := time.Unix(1760860800, 0)
+	prev, _ := Code(secret, Step(now)-1)
+	if step, ok := Validate(secret, prev, now, 1); !ok || step != Step(now)-1 {
+		t.Fatalf("previous step should pass with skew 1, got %d %v", step, ok)
+	}
+	old, _ := Code(secret, Step(now)-2)
+	if _, ok := Validate(secret, old, now, 1); ok {
+		t.Fatalf("code two steps old should fail")
+	}
+	if _, ok := Validate(secret, "12345", now, 1); ok {
+		t.Fatalf("short code should fail")
+	}
+	uri := ProvisioningURI("ERP", "root", secret)
+	if !strings.HasPrefix(uri, "otpauth://totp/ERP:root?") || !strings.Contains(uri, "secret="+secret) {
+		t.Fatalf("unexpected uri %s", uri)
+	}
+}